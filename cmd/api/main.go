@@ -3,12 +3,14 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
 
 	"github.com/dlsu-lscs/lscs-core-api/internal/config"
 	"github.com/dlsu-lscs/lscs-core-api/internal/logging"
@@ -43,7 +45,7 @@ import (
 // @name Authorization
 // @description Google OAuth ID token (format: Bearer <google_id_token>)
 
-func gracefulShutdown(apiServer *http.Server, done chan bool) {
+func gracefulShutdown(apiServer *http.Server, grpcServer *grpc.Server, done chan bool) {
 	// Create context that listens for the interrupt signal from the OS.
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
@@ -61,6 +63,19 @@ func gracefulShutdown(apiServer *http.Server, done chan bool) {
 	if err := apiServer.Shutdown(ctx); err != nil {
 		log.Error().Err(err).Msg("server forced to shutdown")
 	}
+	if grpcServer != nil {
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			log.Error().Msg("gRPC server forced to shutdown")
+			grpcServer.Stop()
+		}
+	}
 
 	log.Info().Msg("server exited")
 
@@ -79,9 +94,25 @@ func main() {
 
 	srv := server.NewServer(cfg)
 
+	// the gRPC server for internal services runs alongside the HTTP server
+	var grpcServer *grpc.Server
+	if cfg.GRPCPort != 0 {
+		lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GRPCPort))
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to listen for gRPC")
+		}
+		grpcServer = server.NewGRPCServer(cfg)
+		go func() {
+			if err := grpcServer.Serve(lis); err != nil {
+				log.Error().Err(err).Msg("gRPC server error")
+			}
+		}()
+		log.Info().Int("port", cfg.GRPCPort).Msg("starting gRPC server")
+	}
+
 	done := make(chan bool, 1)
 
-	go gracefulShutdown(srv, done)
+	go gracefulShutdown(srv, grpcServer, done)
 
 	log.Info().
 		Int("port", cfg.Port).
@@ -0,0 +1,408 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// GrantTypeClientCredentials is the OAuth2 grant used by service clients
+const GrantTypeClientCredentials = "client_credentials"
+
+// default lifetime of client access tokens
+const defaultClientTokenTTL = 15 * time.Minute
+
+// ClientScopes are the scopes that can be granted to OAuth2 clients
+var ClientScopes = []string{ScopeMembersRead, ScopeCommitteesRead, ScopeAdmin}
+
+// ClientClaims are the claims of an access token issued to an OAuth2 client.
+// The subject is the client ID.
+type ClientClaims struct {
+	ClientID string `json:"client_id"`
+	Scope    string `json:"scope"`
+	jwt.RegisteredClaims
+}
+
+// TokenResponse is the OAuth2 access token response (RFC 6749 section 5.1)
+type TokenResponse struct {
+	AccessToken string `json:"access_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	TokenType   string `json:"token_type" example:"Bearer"`
+	ExpiresIn   int    `json:"expires_in" example:"900"`
+	Scope       string `json:"scope" example:"members:read committees:read"`
+	IDToken     string `json:"id_token,omitempty" example:"eyJhbGciOiJSUzI1NiIsImtpZCI6Ii4uLiJ9..."`
+}
+
+// TokenErrorResponse is the OAuth2 error response (RFC 6749 section 5.2)
+type TokenErrorResponse struct {
+	Error            string `json:"error" example:"invalid_client"`
+	ErrorDescription string `json:"error_description,omitempty" example:"Client authentication failed"`
+}
+
+// CreateClientRequest represents the request body for registering an OAuth2 client.
+// Service clients need scopes, OIDC relying parties need redirect URIs; a client can have both.
+type CreateClientRequest struct {
+	Name         string   `json:"name" validate:"required,max=255" example:"LSCS Discord Bot"`
+	Scopes       []string `json:"scopes" validate:"omitempty" example:"members:read,committees:read"`
+	RedirectURIs []string `json:"redirect_uris" validate:"omitempty,dive,url" example:"https://events.lscs.org/auth/callback"`
+}
+
+// CreateClientResponse is returned once when a client is registered.
+// The client secret is never shown again.
+type CreateClientResponse struct {
+	ClientID     string   `json:"client_id" example:"lscs_3f9a1c2b7d4e8f60"`
+	ClientSecret string   `json:"client_secret" example:"8c1f0b7a..."`
+	Name         string   `json:"name" example:"LSCS Discord Bot"`
+	Scopes       []string `json:"scopes" example:"members:read,committees:read"`
+	RedirectURIs []string `json:"redirect_uris,omitempty" example:"https://events.lscs.org/auth/callback"`
+}
+
+// ClientResponse represents a registered OAuth2 client
+type ClientResponse struct {
+	ClientID     string   `json:"client_id" example:"lscs_3f9a1c2b7d4e8f60"`
+	Name         string   `json:"name" example:"LSCS Discord Bot"`
+	Scopes       []string `json:"scopes" example:"members:read,committees:read"`
+	RedirectURIs []string `json:"redirect_uris,omitempty" example:"https://events.lscs.org/auth/callback"`
+	CreatedBy    *int32   `json:"created_by,omitempty" example:"12345678"`
+	CreatedAt    string   `json:"created_at,omitempty" example:"2026-10-18T09:00:00Z"`
+}
+
+// ClientHandler is the OAuth2 authorization server: it handles client registration,
+// the client credentials flow and the OIDC provider endpoints (see oidc.go)
+type ClientHandler struct {
+	jwtSecret  []byte
+	tokenTTL   time.Duration
+	issuer     string
+	signingKey *rsa.PrivateKey
+	keyID      string
+	dbService  database.Service
+}
+
+// NewClientHandler creates a new OAuth2 client handler
+func NewClientHandler(cfg *config.Config, dbService database.Service) *ClientHandler {
+	ttl := defaultClientTokenTTL
+	if cfg.OAuthClientTokenTTL > 0 {
+		ttl = cfg.OAuthClientTokenTTL
+	}
+
+	signingKey, err := LoadOIDCSigningKey(cfg)
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to load OIDC signing key")
+	}
+
+	return &ClientHandler{
+		jwtSecret:  []byte(cfg.JWTSecret),
+		tokenTTL:   ttl,
+		issuer:     cfg.OIDCIssuer,
+		signingKey: signingKey,
+		keyID:      keyID(&signingKey.PublicKey),
+		dbService:  dbService,
+	}
+}
+
+// HashClientSecret returns the hex-encoded SHA-256 hash of a client secret
+func HashClientSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+// ParseRedirectURIs splits the space separated redirect URIs of a client
+func ParseRedirectURIs(redirectURIs string) []string {
+	return strings.Fields(redirectURIs)
+}
+
+// ParseScopes splits a space or comma separated scope list
+func ParseScopes(scope string) []string {
+	return strings.FieldsFunc(scope, func(r rune) bool {
+		return r == ' ' || r == ','
+	})
+}
+
+// GenerateClientToken issues a short-lived access token for a client
+func (h *ClientHandler) GenerateClientToken(clientID string, scopes []string) (string, error) {
+	now := time.Now()
+	claims := &ClientClaims{
+		ClientID: clientID,
+		Scope:    strings.Join(scopes, " "),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   clientID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(h.tokenTTL)),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, err := token.SignedString(h.jwtSecret)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate client token: %w", err)
+	}
+	return tokenString, nil
+}
+
+// tokenError writes an OAuth2 error response
+func tokenError(c echo.Context, status int, code, description string) error {
+	return c.JSON(status, TokenErrorResponse{Error: code, ErrorDescription: description})
+}
+
+// TokenHandler issues access tokens using the client credentials grant,
+// and ID tokens using the OIDC authorization code grant
+// @Summary OAuth2 token endpoint
+// @Description Exchange client credentials (client_credentials) or an authorization code with its PKCE verifier (authorization_code) for short-lived tokens. Clients authenticate with HTTP Basic auth or the client_id and client_secret form fields.
+// @Tags oauth
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param grant_type formData string true "client_credentials or authorization_code"
+// @Param scope formData string false "Space-separated subset of the client's scopes (client_credentials)"
+// @Param code formData string false "Authorization code (authorization_code)"
+// @Param redirect_uri formData string false "Redirect URI used at the authorization endpoint (authorization_code)"
+// @Param code_verifier formData string false "PKCE code verifier (authorization_code)"
+// @Param client_id formData string false "Client ID (if not using Basic auth)"
+// @Param client_secret formData string false "Client secret (if not using Basic auth)"
+// @Success 200 {object} TokenResponse "Access token issued"
+// @Failure 400 {object} TokenErrorResponse "Invalid request or scope"
+// @Failure 401 {object} TokenErrorResponse "Client authentication failed"
+// @Failure 500 {object} TokenErrorResponse "Internal server error"
+// @Router /oauth/token [post]
+func (h *ClientHandler) TokenHandler(c echo.Context) error {
+	// token responses must never be cached
+	c.Response().Header().Set("Cache-Control", "no-store")
+	c.Response().Header().Set("Pragma", "no-cache")
+
+	grantType := c.FormValue("grant_type")
+	if grantType != GrantTypeClientCredentials && grantType != GrantTypeAuthorizationCode {
+		return tokenError(c, http.StatusBadRequest, "unsupported_grant_type", "Only client_credentials and authorization_code are supported")
+	}
+
+	clientID, clientSecret, ok := c.Request().BasicAuth()
+	if !ok {
+		clientID = c.FormValue("client_id")
+		clientSecret = c.FormValue("client_secret")
+	}
+	if clientID == "" || clientSecret == "" {
+		return tokenError(c, http.StatusUnauthorized, "invalid_client", "Client authentication failed")
+	}
+
+	q := repository.New(h.dbService.GetConnection())
+	client, err := q.GetOAuthClient(c.Request().Context(), clientID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.Warn().Str("client_id", clientID).Msg("unknown OAuth client")
+			return tokenError(c, http.StatusUnauthorized, "invalid_client", "Client authentication failed")
+		}
+		log.Error().Err(err).Str("client_id", clientID).Msg("failed to get OAuth client")
+		return tokenError(c, http.StatusInternalServerError, "server_error", "Internal server error")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(HashClientSecret(clientSecret)), []byte(client.ClientSecretHash)) != 1 {
+		log.Warn().Str("client_id", clientID).Msg("invalid OAuth client secret")
+		return tokenError(c, http.StatusUnauthorized, "invalid_client", "Client authentication failed")
+	}
+
+	if grantType == GrantTypeAuthorizationCode {
+		return h.authorizationCodeGrant(c, client)
+	}
+
+	// default to every scope the client was registered with
+	allowed := ParseScopes(client.Scopes)
+	if len(allowed) == 0 {
+		return tokenError(c, http.StatusBadRequest, "unauthorized_client", "Client is not allowed to use client_credentials")
+	}
+	scopes := allowed
+	if requested := ParseScopes(c.FormValue("scope")); len(requested) > 0 {
+		for _, scope := range requested {
+			if !slices.Contains(allowed, scope) {
+				return tokenError(c, http.StatusBadRequest, "invalid_scope", fmt.Sprintf("Scope %s is not allowed for this client", scope))
+			}
+		}
+		scopes = requested
+	}
+
+	accessToken, err := h.GenerateClientToken(client.ClientID, scopes)
+	if err != nil {
+		log.Error().Err(err).Str("client_id", clientID).Msg("failed to generate client token")
+		return tokenError(c, http.StatusInternalServerError, "server_error", "Internal server error")
+	}
+
+	log.Info().Str("client_id", clientID).Strs("scopes", scopes).Msg("issued client access token")
+
+	return c.JSON(http.StatusOK, TokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(h.tokenTTL.Seconds()),
+		Scope:       strings.Join(scopes, " "),
+	})
+}
+
+// CreateClientHandler registers a new OAuth2 client
+// @Summary Register OAuth2 client
+// @Description Register a service client for the client credentials flow. The client secret is only returned once. Admin only.
+// @Tags oauth
+// @Accept json
+// @Produce json
+// @Param request body CreateClientRequest true "Client registration"
+// @Success 201 {object} CreateClientResponse "Client registered"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Admin access required"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /oauth/clients [post]
+func (h *ClientHandler) CreateClientHandler(c echo.Context) error {
+	principal, ok := GetPrincipal(c)
+	if !ok {
+		log.Error().Msg("principal not found in context")
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	var req CreateClientRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	if len(req.Scopes) == 0 && len(req.RedirectURIs) == 0 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "At least one scope or redirect URI is required"})
+	}
+	for _, scope := range req.Scopes {
+		if !slices.Contains(ClientScopes, scope) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": fmt.Sprintf("Unknown scope: %s", scope)})
+		}
+	}
+
+	clientID, err := randomHex(8)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to generate client ID")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	clientID = "lscs_" + clientID
+
+	clientSecret, err := randomHex(32)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to generate client secret")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	q := repository.New(h.dbService.GetConnection())
+	err = q.CreateOAuthClient(c.Request().Context(), repository.CreateOAuthClientParams{
+		ClientID:         clientID,
+		ClientSecretHash: HashClientSecret(clientSecret),
+		Name:             req.Name,
+		Scopes:           strings.Join(req.Scopes, " "),
+		RedirectUris:     sql.NullString{String: strings.Join(req.RedirectURIs, " "), Valid: len(req.RedirectURIs) > 0},
+		CreatedBy:        sql.NullInt32{Int32: principal.MemberID, Valid: true},
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to store OAuth client")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Error storing client"})
+	}
+
+	log.Info().
+		Str("client_id", clientID).
+		Int32("created_by", principal.MemberID).
+		Strs("scopes", req.Scopes).
+		Msg("registered OAuth client")
+
+	return c.JSON(http.StatusCreated, CreateClientResponse{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Name:         req.Name,
+		Scopes:       req.Scopes,
+		RedirectURIs: req.RedirectURIs,
+	})
+}
+
+// ListClientsHandler lists registered OAuth2 clients
+// @Summary List OAuth2 clients
+// @Description List registered service clients. Admin only.
+// @Tags oauth
+// @Produce json
+// @Success 200 {array} ClientResponse "List of clients"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Admin access required"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /oauth/clients [get]
+func (h *ClientHandler) ListClientsHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+	clients, err := q.ListOAuthClients(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list OAuth clients")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	response := make([]ClientResponse, 0, len(clients))
+	for _, client := range clients {
+		item := ClientResponse{
+			ClientID:     client.ClientID,
+			Name:         client.Name,
+			Scopes:       ParseScopes(client.Scopes),
+			RedirectURIs: ParseRedirectURIs(client.RedirectUris.String),
+		}
+		if client.CreatedBy.Valid {
+			createdBy := client.CreatedBy.Int32
+			item.CreatedBy = &createdBy
+		}
+		if client.CreatedAt.Valid {
+			item.CreatedAt = client.CreatedAt.Time.Format(time.RFC3339)
+		}
+		response = append(response, item)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// DeleteClientHandler deletes an OAuth2 client.
+// Tokens already issued to the client stop working immediately.
+// @Summary Delete OAuth2 client
+// @Description Delete a service client and invalidate its tokens. Admin only.
+// @Tags oauth
+// @Produce json
+// @Param client_id path string true "Client ID"
+// @Success 200 {object} map[string]string "Client deleted"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Admin access required"
+// @Failure 404 {object} helpers.ErrorResponse "Client not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /oauth/clients/{client_id} [delete]
+func (h *ClientHandler) DeleteClientHandler(c echo.Context) error {
+	clientID := c.Param("client_id")
+
+	q := repository.New(h.dbService.GetConnection())
+	rows, err := q.DeleteOAuthClient(c.Request().Context(), clientID)
+	if err != nil {
+		log.Error().Err(err).Str("client_id", clientID).Msg("failed to delete OAuth client")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	if rows == 0 {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Client not found"})
+	}
+
+	log.Info().Str("client_id", clientID).Msg("deleted OAuth client")
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Client deleted successfully"})
+}
+
+// randomHex returns n random bytes, hex-encoded
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
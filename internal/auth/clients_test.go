@@ -0,0 +1,223 @@
+package auth
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+)
+
+var oauthClientColumns = []string{"client_id", "client_secret_hash", "name", "scopes", "created_by", "created_at", "redirect_uris"}
+
+func newTokenRequest(form url.Values) (*http.Request, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/oauth/token", strings.NewReader(form.Encode()))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+	return req, httptest.NewRecorder()
+}
+
+func TestTokenHandler(t *testing.T) {
+	cfg := &config.Config{JWTSecret: "test-secret", OAuthClientTokenTTL: 5 * time.Minute}
+
+	t.Run("success - basic auth", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT client_id, client_secret_hash, name, scopes, created_by, created_at").
+			WithArgs("lscs_bot").
+			WillReturnRows(sqlmock.NewRows(oauthClientColumns).
+				AddRow("lscs_bot", HashClientSecret("s3cret"), "Bot", "members:read committees:read", 1, time.Now(), nil))
+
+		h := NewClientHandler(cfg, &mockDBService{db: db})
+		e := echo.New()
+		req, rec := newTokenRequest(url.Values{"grant_type": {"client_credentials"}, "scope": {"members:read"}})
+		req.SetBasicAuth("lscs_bot", "s3cret")
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, h.TokenHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+
+			var resp TokenResponse
+			json.Unmarshal(rec.Body.Bytes(), &resp)
+			assert.Equal(t, "Bearer", resp.TokenType)
+			assert.Equal(t, 300, resp.ExpiresIn)
+			assert.Equal(t, "members:read", resp.Scope)
+
+			claims := new(ClientClaims)
+			_, err := jwt.ParseWithClaims(resp.AccessToken, claims, func(t *jwt.Token) (interface{}, error) {
+				return []byte("test-secret"), nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, "lscs_bot", claims.ClientID)
+			assert.Equal(t, "lscs_bot", claims.Subject)
+			assert.NotNil(t, claims.ExpiresAt)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("unsupported grant type", func(t *testing.T) {
+		h := NewClientHandler(cfg, &mockDBService{})
+		e := echo.New()
+		req, rec := newTokenRequest(url.Values{"grant_type": {"password"}})
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, h.TokenHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), "unsupported_grant_type")
+		}
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT client_id, client_secret_hash, name, scopes, created_by, created_at").
+			WithArgs("lscs_bot").
+			WillReturnRows(sqlmock.NewRows(oauthClientColumns).
+				AddRow("lscs_bot", HashClientSecret("s3cret"), "Bot", "members:read", 1, time.Now(), nil))
+
+		h := NewClientHandler(cfg, &mockDBService{db: db})
+		e := echo.New()
+		req, rec := newTokenRequest(url.Values{
+			"grant_type":    {"client_credentials"},
+			"client_id":     {"lscs_bot"},
+			"client_secret": {"wrong"},
+		})
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, h.TokenHandler(c)) {
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+			assert.Contains(t, rec.Body.String(), "invalid_client")
+		}
+	})
+
+	t.Run("unknown client", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT client_id, client_secret_hash, name, scopes, created_by, created_at").
+			WithArgs("nobody").
+			WillReturnError(sql.ErrNoRows)
+
+		h := NewClientHandler(cfg, &mockDBService{db: db})
+		e := echo.New()
+		req, rec := newTokenRequest(url.Values{"grant_type": {"client_credentials"}})
+		req.SetBasicAuth("nobody", "s3cret")
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, h.TokenHandler(c)) {
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		}
+	})
+
+	t.Run("scope not granted to client", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT client_id, client_secret_hash, name, scopes, created_by, created_at").
+			WithArgs("lscs_bot").
+			WillReturnRows(sqlmock.NewRows(oauthClientColumns).
+				AddRow("lscs_bot", HashClientSecret("s3cret"), "Bot", "members:read", 1, time.Now(), nil))
+
+		h := NewClientHandler(cfg, &mockDBService{db: db})
+		e := echo.New()
+		req, rec := newTokenRequest(url.Values{"grant_type": {"client_credentials"}, "scope": {"admin"}})
+		req.SetBasicAuth("lscs_bot", "s3cret")
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, h.TokenHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), "invalid_scope")
+		}
+	})
+}
+
+func TestCreateClientHandler(t *testing.T) {
+	cfg := &config.Config{JWTSecret: "test-secret"}
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("INSERT INTO oauth_clients").
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "Bot", "members:read", nil, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		h := NewClientHandler(cfg, &mockDBService{db: db})
+		e := echo.New()
+		body, _ := json.Marshal(CreateClientRequest{Name: "Bot", Scopes: []string{ScopeMembersRead}})
+		req := httptest.NewRequest(http.MethodPost, "/oauth/clients", bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		SetPrincipal(c, &Principal{MemberID: 1, Method: AuthMethodSession})
+
+		if assert.NoError(t, h.CreateClientHandler(c)) {
+			assert.Equal(t, http.StatusCreated, rec.Code)
+			var resp CreateClientResponse
+			json.Unmarshal(rec.Body.Bytes(), &resp)
+			assert.True(t, strings.HasPrefix(resp.ClientID, "lscs_"))
+			assert.Len(t, resp.ClientSecret, 64)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("unknown scope", func(t *testing.T) {
+		h := NewClientHandler(cfg, &mockDBService{})
+		e := echo.New()
+		body, _ := json.Marshal(CreateClientRequest{Name: "Bot", Scopes: []string{"members:write"}})
+		req := httptest.NewRequest(http.MethodPost, "/oauth/clients", bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		SetPrincipal(c, &Principal{MemberID: 1, Method: AuthMethodSession})
+
+		if assert.NoError(t, h.CreateClientHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
+
+func TestDeleteClientHandler(t *testing.T) {
+	cfg := &config.Config{JWTSecret: "test-secret"}
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("DELETE FROM oauth_clients").
+			WithArgs("missing").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		h := NewClientHandler(cfg, &mockDBService{db: db})
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodDelete, "/oauth/clients/missing", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("client_id")
+		c.SetParamValues("missing")
+
+		if assert.NoError(t, h.DeleteClientHandler(c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
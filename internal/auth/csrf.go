@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+)
+
+const (
+	// CSRFCookieName is the cookie that carries the CSRF token to same-site clients
+	CSRFCookieName = "csrf_token"
+	// CSRFHeaderName is the header that clients must echo the CSRF token in
+	CSRFHeaderName = "X-CSRF-Token"
+)
+
+// CSRFResponse represents the response for the /auth/csrf endpoint
+type CSRFResponse struct {
+	CSRFToken string `json:"csrf_token" example:"3f6c2b..."`
+}
+
+// csrfSecret returns the key used to sign CSRF tokens.
+// falls back to the JWT secret when SESSION_SECRET is not configured.
+func csrfSecret(cfg *config.Config) []byte {
+	if cfg.SessionSecret != "" {
+		return []byte(cfg.SessionSecret)
+	}
+	return []byte(cfg.JWTSecret)
+}
+
+// GenerateCSRFToken derives the CSRF token for a session.
+// The token is an HMAC of the session ID, so it is bound to the session and
+// does not need to be stored server-side.
+func GenerateCSRFToken(cfg *config.Config, sessionID string) string {
+	mac := hmac.New(sha256.New, csrfSecret(cfg))
+	mac.Write([]byte("csrf:" + sessionID))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ValidateCSRFToken checks a client-supplied token against the session's expected token
+func ValidateCSRFToken(cfg *config.Config, sessionID, token string) bool {
+	if sessionID == "" || token == "" {
+		return false
+	}
+	expected := GenerateCSRFToken(cfg, sessionID)
+	return hmac.Equal([]byte(expected), []byte(token))
+}
+
+// setCSRFCookie sets the CSRF token cookie. It is readable by JavaScript so
+// same-site clients can echo it back in the X-CSRF-Token header.
+func setCSRFCookie(c echo.Context, cfg *config.Config, token string, maxAge int) {
+	cookie := &http.Cookie{
+		Name:     CSRFCookieName,
+		Value:    token,
+		Path:     "/",
+		HttpOnly: false,
+		Secure:   cfg.IsProduction(),
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   maxAge,
+	}
+	c.SetCookie(cookie)
+}
+
+// CSRFTokenHandler returns the CSRF token for the current session
+// @Summary Get CSRF token
+// @Description Returns the CSRF token bound to the current session. Send it in the X-CSRF-Token header on mutating requests.
+// @Tags auth
+// @Produce json
+// @Success 200 {object} CSRFResponse "CSRF token"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Security SessionAuth
+// @Router /auth/csrf [get]
+func (h *OAuthHandler) CSRFTokenHandler(c echo.Context) error {
+	principal, ok := GetPrincipal(c)
+	if !ok || principal.SessionID == "" {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	token := GenerateCSRFToken(h.cfg, principal.SessionID)
+	return c.JSON(http.StatusOK, CSRFResponse{CSRFToken: token})
+}
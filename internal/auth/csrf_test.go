@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+)
+
+func TestCSRFToken(t *testing.T) {
+	cfg := &config.Config{SessionSecret: "test-session-secret"}
+
+	t.Run("token is deterministic per session", func(t *testing.T) {
+		assert.Equal(t, GenerateCSRFToken(cfg, "session-a"), GenerateCSRFToken(cfg, "session-a"))
+		assert.NotEqual(t, GenerateCSRFToken(cfg, "session-a"), GenerateCSRFToken(cfg, "session-b"))
+	})
+
+	t.Run("valid token", func(t *testing.T) {
+		token := GenerateCSRFToken(cfg, "session-a")
+		assert.True(t, ValidateCSRFToken(cfg, "session-a", token))
+	})
+
+	t.Run("token from another session is rejected", func(t *testing.T) {
+		token := GenerateCSRFToken(cfg, "session-b")
+		assert.False(t, ValidateCSRFToken(cfg, "session-a", token))
+	})
+
+	t.Run("empty token is rejected", func(t *testing.T) {
+		assert.False(t, ValidateCSRFToken(cfg, "session-a", ""))
+	})
+
+	t.Run("falls back to JWT secret", func(t *testing.T) {
+		jwtOnly := &config.Config{JWTSecret: "jwt-secret"}
+		token := GenerateCSRFToken(jwtOnly, "session-a")
+		assert.True(t, ValidateCSRFToken(jwtOnly, "session-a", token))
+		assert.False(t, ValidateCSRFToken(cfg, "session-a", token))
+	})
+}
+
+func TestCSRFTokenHandler(t *testing.T) {
+	cfg := &config.Config{SessionSecret: "test-session-secret"}
+	h := NewOAuthHandler(cfg, nil, nil)
+
+	t.Run("returns token for session", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/auth/csrf", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		SetPrincipal(c, &Principal{MemberID: 1, Method: AuthMethodSession, SessionID: "session-a"})
+
+		if assert.NoError(t, h.CSRFTokenHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var resp CSRFResponse
+			json.Unmarshal(rec.Body.Bytes(), &resp)
+			assert.Equal(t, GenerateCSRFToken(cfg, "session-a"), resp.CSRFToken)
+		}
+	})
+
+	t.Run("no session", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/auth/csrf", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, h.CSRFTokenHandler(c)) {
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		}
+	})
+}
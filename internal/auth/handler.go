@@ -16,6 +16,7 @@ import (
 	"github.com/dlsu-lscs/lscs-core-api/internal/database"
 	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
 	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+	"github.com/dlsu-lscs/lscs-core-api/internal/webhook"
 )
 
 // RequestKeyRequest represents the request body for requesting an API key
@@ -37,13 +38,17 @@ type Handler struct {
 	authService Service
 	dbService   database.Service
 	rbacService *RBACService
+	events      *webhook.Publisher
+	notifier    RoleNotifier // may be nil
 }
 
-func NewHandler(authService Service, dbService database.Service, rbacService *RBACService) *Handler {
+func NewHandler(authService Service, dbService database.Service, rbacService *RBACService, notifier RoleNotifier) *Handler {
 	return &Handler{
 		authService: authService,
 		dbService:   dbService,
 		rbacService: rbacService,
+		events:      webhook.NewPublisher(dbService),
+		notifier:    notifier,
 	}
 }
 
@@ -57,6 +62,7 @@ func NewHandler(authService Service, dbService database.Service, rbacService *RB
 // @Success 200 {object} RequestKeyResponse "API key generated successfully"
 // @Failure 400 {object} helpers.ErrorResponse "Invalid request"
 // @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} StepUpRequiredResponse "Recent re-authentication required (admin keys)"
 // @Failure 404 {object} helpers.ErrorResponse "Member not found"
 // @Failure 409 {object} helpers.ErrorResponse "Origin already exists"
 // @Failure 500 {object} helpers.ErrorResponse "Internal server error"
@@ -67,12 +73,13 @@ func (h *Handler) RequestKeyHandler(c echo.Context) error {
 	q := repository.New(dbconn)
 	ctx := c.Request().Context()
 
-	// NOTE: this is set via google middleware
-	emailRequestor, ok := c.Get("user_email").(string)
-	if !ok || emailRequestor == "" {
-		log.Error().Msg("user_email not found in context")
+	// NOTE: this is set via the authenticator chain (session or Google ID token)
+	principal, ok := GetPrincipal(c)
+	if !ok || principal.Email == "" {
+		log.Error().Msg("principal not found in context")
 		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
 	}
+	emailRequestor := principal.Email
 
 	isAuthorized := h.rbacService.CanAccessAPIByEmail(c.Request().Context(), emailRequestor)
 	if !isAuthorized {
@@ -87,6 +94,11 @@ func (h *Handler) RequestKeyHandler(c echo.Context) error {
 		return err
 	}
 
+	// admin keys are unrestricted, so they need a fresh login
+	if req.IsAdmin && !principal.RecentlyAuthenticated {
+		return StepUpRequired(c, principal, "request_admin_key")
+	}
+
 	memberInfo, err := q.GetMemberInfo(ctx, emailRequestor)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -221,11 +233,12 @@ func (h *Handler) ListAPIKeys(c echo.Context) error {
 	dbconn := h.dbService.GetConnection()
 	q := repository.New(dbconn)
 
-	// Get user email from context (set by session middleware)
-	email, ok := c.Get("user_email").(string)
-	if !ok || email == "" {
+	// Get user email from the authenticated principal
+	principal, ok := GetPrincipal(c)
+	if !ok || principal.Email == "" {
 		return helpers.ErrUnauthorized(c, "")
 	}
+	email := principal.Email
 
 	// Check RND AVP+ authorization
 	isAuthorized := h.rbacService.CanAccessAPIByEmail(ctx, email)
@@ -288,11 +301,12 @@ func (h *Handler) RevokeAPIKey(c echo.Context) error {
 	dbconn := h.dbService.GetConnection()
 	q := repository.New(dbconn)
 
-	// Get user email from context
-	email, ok := c.Get("user_email").(string)
-	if !ok || email == "" {
+	// Get user email from the authenticated principal
+	principal, ok := GetPrincipal(c)
+	if !ok || principal.Email == "" {
 		return helpers.ErrUnauthorized(c, "")
 	}
+	email := principal.Email
 
 	// Check RND AVP+ authorization
 	isAuthorized := h.rbacService.CanAccessAPIByEmail(ctx, email)
@@ -308,17 +322,22 @@ func (h *Handler) RevokeAPIKey(c echo.Context) error {
 	}
 
 	// Delete the key (will only succeed if member_email matches)
-	err = q.DeleteAPIKeyById(ctx, repository.DeleteAPIKeyByIdParams{
+	rows, err := q.DeleteAPIKeyById(ctx, repository.DeleteAPIKeyByIdParams{
 		ApiKeyID:    int32(apiKeyID),
 		MemberEmail: email,
 	})
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return helpers.ErrNotFound(c, "API key not found or you don't have permission to revoke it")
-		}
 		log.Error().Err(err).Int64("api_key_id", apiKeyID).Str("email", email).Msg("failed to revoke API key")
 		return helpers.ErrInternal(c, "Failed to revoke API key")
 	}
+	if rows == 0 {
+		return helpers.ErrNotFound(c, "API key not found or you don't have permission to revoke it")
+	}
+
+	h.events.Publish(ctx, webhook.EventAPIKeyRevoked, webhook.APIKeyRevokedData{
+		APIKeyID:    int32(apiKeyID),
+		MemberEmail: email,
+	})
 
 	return c.JSON(http.StatusOK, map[string]string{
 		"message": "API key revoked successfully",
@@ -61,8 +61,9 @@ func TestRequestKeyHandler(t *testing.T) {
 		c := e.NewContext(req, rec)
 
 		testEmail := "test@dlsu.edu.ph"
-		// set user_email in context (set by Google OAuth middleware)
-		c.Set("user_email", testEmail)
+		// set principal in context (set by the authenticator chain)
+		// admin keys need a recent login (step-up)
+		SetPrincipal(c, &Principal{MemberID: 1, Email: testEmail, Method: AuthMethodSession, RecentlyAuthenticated: true})
 
 		db, mock, err := sqlmock.New()
 		assert.NoError(t, err)
@@ -104,7 +105,7 @@ func TestRequestKeyHandler(t *testing.T) {
 		dbService := &mockDBService{db: db}
 		authService := &mockAuthService{}
 		rbacService := NewRBACService(dbService)
-		h := NewHandler(authService, dbService, rbacService)
+		h := NewHandler(authService, dbService, rbacService, nil)
 
 		if assert.NoError(t, h.RequestKeyHandler(c)) {
 			assert.Equal(t, http.StatusOK, rec.Code)
@@ -128,8 +129,8 @@ func TestRequestKeyHandler(t *testing.T) {
 		c := e.NewContext(req, rec)
 
 		testEmail := "test@dlsu.edu.ph"
-		// set user_email in context
-		c.Set("user_email", testEmail)
+		// set principal in context
+		SetPrincipal(c, &Principal{MemberID: 1, Email: testEmail, Method: AuthMethodSession})
 
 		db, mock, err := sqlmock.New()
 		assert.NoError(t, err)
@@ -146,13 +147,55 @@ func TestRequestKeyHandler(t *testing.T) {
 		dbService := &mockDBService{db: db}
 		authService := &mockAuthService{}
 		rbacService := NewRBACService(dbService)
-		h := NewHandler(authService, dbService, rbacService)
+		h := NewHandler(authService, dbService, rbacService, nil)
 
 		if assert.NoError(t, h.RequestKeyHandler(c)) {
 			assert.Equal(t, http.StatusForbidden, rec.Code)
 		}
 	})
 
+	t.Run("fail - admin key without recent login", func(t *testing.T) {
+		e := echo.New()
+		reqBody := RequestKeyRequest{
+			Project: "Test Project",
+			IsAdmin: true,
+		}
+		jsonBody, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPost, "/request-key", bytes.NewReader(jsonBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		testEmail := "test@dlsu.edu.ph"
+		// session is valid but the login is too old for an admin key
+		SetPrincipal(c, &Principal{MemberID: 1, Email: testEmail, Method: AuthMethodSession})
+
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		authInfoRow := sqlmock.NewRows([]string{
+			"id", "position_id", "committee_id",
+		}).AddRow(1, "AVP", "RND")
+		mock.ExpectQuery("SELECT id, position_id, committee_id FROM members").
+			WithArgs(testEmail).
+			WillReturnRows(authInfoRow)
+
+		dbService := &mockDBService{db: db}
+		authService := &mockAuthService{}
+		rbacService := NewRBACService(dbService)
+		h := NewHandler(authService, dbService, rbacService, nil)
+
+		if assert.NoError(t, h.RequestKeyHandler(c)) {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+			var resp StepUpRequiredResponse
+			json.Unmarshal(rec.Body.Bytes(), &resp)
+			assert.Equal(t, StepUpRequiredCode, resp.Code)
+			assert.Equal(t, StepUpReauthURL, resp.ReauthURL)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("fail - not an LSCS member", func(t *testing.T) {
 		e := echo.New()
 		reqBody := RequestKeyRequest{
@@ -166,8 +209,8 @@ func TestRequestKeyHandler(t *testing.T) {
 		c := e.NewContext(req, rec)
 
 		testEmail := "test@dlsu.edu.ph"
-		// set user_email in context
-		c.Set("user_email", testEmail)
+		// set principal in context
+		SetPrincipal(c, &Principal{MemberID: 1, Email: testEmail, Method: AuthMethodSession})
 
 		db, mock, err := sqlmock.New()
 		assert.NoError(t, err)
@@ -181,14 +224,14 @@ func TestRequestKeyHandler(t *testing.T) {
 		dbService := &mockDBService{db: db}
 		authService := &mockAuthService{}
 		rbacService := NewRBACService(dbService)
-		h := NewHandler(authService, dbService, rbacService)
+		h := NewHandler(authService, dbService, rbacService, nil)
 
 		if assert.NoError(t, h.RequestKeyHandler(c)) {
 			assert.Equal(t, http.StatusForbidden, rec.Code)
 		}
 	})
 
-	t.Run("fail - user_email not in context", func(t *testing.T) {
+	t.Run("fail - principal not in context", func(t *testing.T) {
 		e := echo.New()
 		reqBody := RequestKeyRequest{
 			Project: "Test Project",
@@ -200,7 +243,7 @@ func TestRequestKeyHandler(t *testing.T) {
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
 
-		// don't set user_email in context
+		// don't set principal in context
 
 		db, _, err := sqlmock.New()
 		assert.NoError(t, err)
@@ -209,7 +252,7 @@ func TestRequestKeyHandler(t *testing.T) {
 		dbService := &mockDBService{db: db}
 		authService := &mockAuthService{}
 		rbacService := NewRBACService(dbService)
-		h := NewHandler(authService, dbService, rbacService)
+		h := NewHandler(authService, dbService, rbacService, nil)
 
 		if assert.NoError(t, h.RequestKeyHandler(c)) {
 			assert.Equal(t, http.StatusUnauthorized, rec.Code)
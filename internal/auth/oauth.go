@@ -86,15 +86,17 @@ type MeResponse struct {
 // @Description Redirects to Google OAuth consent screen for web UI login
 // @Tags auth
 // @Param remember query bool false "Remember me for 30 days"
+// @Param reauth query bool false "Re-authenticate the current session (step-up for sensitive actions)"
 // @Param redirect query string false "URL to redirect after login"
 // @Success 302 "Redirect to Google OAuth"
 // @Router /auth/google/login [get]
 func (h *OAuthHandler) GoogleLoginHandler(c echo.Context) error {
 	rememberMe := c.QueryParam("remember") == "true"
+	reauth := c.QueryParam("reauth") == "true"
 	redirectURL := c.QueryParam("redirect")
 
-	// build state parameter (encodes remember me and redirect URL)
-	state := fmt.Sprintf("%t|%s", rememberMe, redirectURL)
+	// build state parameter (encodes remember me, reauth and redirect URL)
+	state := fmt.Sprintf("%t|%t|%s", rememberMe, reauth, redirectURL)
 
 	params := url.Values{}
 	params.Set("client_id", h.cfg.GoogleClientID)
@@ -104,6 +106,10 @@ func (h *OAuthHandler) GoogleLoginHandler(c echo.Context) error {
 	params.Set("state", state)
 	params.Set("access_type", "online")
 	params.Set("prompt", "select_account")
+	if reauth {
+		// ask Google for a fresh login instead of reusing its own session
+		params.Set("max_age", "0")
+	}
 
 	authURL := googleAuthURL + "?" + params.Encode()
 	return c.Redirect(http.StatusFound, authURL)
@@ -136,14 +142,18 @@ func (h *OAuthHandler) GoogleCallbackHandler(c echo.Context) error {
 
 	// parse state parameter
 	rememberMe := false
+	reauth := false
 	redirectPath := ""
 	if state != "" {
-		parts := strings.SplitN(state, "|", 2)
+		parts := strings.SplitN(state, "|", 3)
 		if len(parts) >= 1 {
 			rememberMe = parts[0] == "true"
 		}
 		if len(parts) >= 2 {
-			redirectPath = parts[1]
+			reauth = parts[1] == "true"
+		}
+		if len(parts) >= 3 {
+			redirectPath = parts[2]
 		}
 	}
 
@@ -173,6 +183,29 @@ func (h *OAuthHandler) GoogleCallbackHandler(c echo.Context) error {
 		return c.Redirect(http.StatusFound, h.cfg.FrontendURL()+"/login?error=db_error")
 	}
 
+	// step-up: refresh the auth time of the current session instead of starting a new one
+	if reauth {
+		if cookie, err := c.Cookie(sessionCookie); err == nil && cookie.Value != "" {
+			existing, err := h.sessionService.GetSession(c.Request().Context(), cookie.Value)
+			if err == nil && existing.MemberID != member.ID {
+				log.Warn().
+					Int32("session_member_id", existing.MemberID).
+					Int32("member_id", member.ID).
+					Msg("re-authentication with a different account")
+				return c.Redirect(http.StatusFound, h.cfg.FrontendURL()+"/login?error=reauth_mismatch")
+			}
+			if err == nil {
+				if err := h.sessionService.MarkReauthenticated(c.Request().Context(), existing.ID); err != nil {
+					log.Error().Err(err).Msg("failed to record re-authentication")
+					return c.Redirect(http.StatusFound, h.cfg.FrontendURL()+"/login?error=session_update")
+				}
+				log.Info().Int32("member_id", member.ID).Msg("user re-authenticated")
+				return c.Redirect(http.StatusFound, h.loginRedirectURL(redirectPath))
+			}
+		}
+		// no valid session to step up, fall through to a normal (fresh) login
+	}
+
 	// get client info for session
 	userAgent := c.Request().UserAgent()
 	ipAddress := c.RealIP()
@@ -184,8 +217,9 @@ func (h *OAuthHandler) GoogleCallbackHandler(c echo.Context) error {
 		return c.Redirect(http.StatusFound, h.cfg.FrontendURL()+"/login?error=session_create")
 	}
 
-	// set session cookie
+	// set session cookie and the CSRF token bound to it
 	h.setSessionCookie(c, session.ID, rememberMe)
+	h.setCSRFCookie(c, session.ID, rememberMe)
 
 	log.Info().
 		Int32("member_id", member.ID).
@@ -193,13 +227,17 @@ func (h *OAuthHandler) GoogleCallbackHandler(c echo.Context) error {
 		Bool("remember_me", rememberMe).
 		Msg("user logged in")
 
-	// redirect to frontend
+	return c.Redirect(http.StatusFound, h.loginRedirectURL(redirectPath))
+}
+
+// loginRedirectURL returns where to send the member after login: the frontend,
+// or back to the OIDC authorization endpoint for "Sign in with LSCS"
+func (h *OAuthHandler) loginRedirectURL(redirectPath string) string {
 	redirectTo := h.cfg.FrontendURL()
-	if redirectPath != "" {
-		redirectTo = redirectTo + redirectPath
+	if strings.HasPrefix(redirectPath, "/oauth/authorize?") {
+		redirectTo = h.cfg.OIDCIssuer
 	}
-
-	return c.Redirect(http.StatusFound, redirectTo)
+	return redirectTo + redirectPath
 }
 
 // LogoutHandler logs out the user by deleting the session
@@ -221,8 +259,9 @@ func (h *OAuthHandler) LogoutHandler(c echo.Context) error {
 		// continue to clear cookie even if db delete fails
 	}
 
-	// clear cookie
+	// clear cookies
 	h.clearSessionCookie(c)
+	setCSRFCookie(c, h.cfg, "", -1)
 
 	return c.JSON(http.StatusOK, map[string]string{"message": "Logged out successfully"})
 }
@@ -237,11 +276,12 @@ func (h *OAuthHandler) LogoutHandler(c echo.Context) error {
 // @Failure 500 {object} helpers.ErrorResponse "Internal server error"
 // @Router /auth/me [get]
 func (h *OAuthHandler) MeHandler(c echo.Context) error {
-	// user_id and user_email are set by session middleware
-	memberID, ok := c.Get("user_id").(int32)
+	// the principal is set by the authenticator chain
+	principal, ok := GetPrincipal(c)
 	if !ok {
 		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
 	}
+	memberID := principal.MemberID
 
 	q := repository.New(h.dbService.GetConnection())
 	member, err := q.GetMemberInfoById(c.Request().Context(), memberID)
@@ -375,6 +415,15 @@ func (h *OAuthHandler) setSessionCookie(c echo.Context, sessionID string, rememb
 	c.SetCookie(cookie)
 }
 
+// setCSRFCookie sets the CSRF token cookie with the same lifetime as the session cookie
+func (h *OAuthHandler) setCSRFCookie(c echo.Context, sessionID string, rememberMe bool) {
+	maxAge := h.cfg.SessionDuration
+	if rememberMe {
+		maxAge = h.cfg.SessionRememberDuration
+	}
+	setCSRFCookie(c, h.cfg, GenerateCSRFToken(h.cfg, sessionID), maxAge)
+}
+
 // clearSessionCookie removes the session cookie
 func (h *OAuthHandler) clearSessionCookie(c echo.Context) {
 	cookie := &http.Cookie{
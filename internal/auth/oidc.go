@@ -0,0 +1,568 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"database/sql"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// GrantTypeAuthorizationCode is the OAuth2 grant used by OIDC relying parties
+const GrantTypeAuthorizationCode = "authorization_code"
+
+// OIDC scopes that relying parties can request at the authorization endpoint
+const (
+	ScopeOpenID  = "openid"
+	ScopeEmail   = "email"
+	ScopeProfile = "profile"
+)
+
+// OIDCScopes are the scopes supported by the authorization endpoint
+var OIDCScopes = []string{ScopeOpenID, ScopeEmail, ScopeProfile}
+
+// authorization codes are single-use and short-lived
+const authorizationCodeTTL = 5 * time.Minute
+
+// only S256 is accepted; "plain" PKCE offers no protection against code interception
+const codeChallengeMethodS256 = "S256"
+
+// MemberClaims are the LSCS member claims shared by ID tokens and the userinfo endpoint.
+// email is released with the "email" scope, everything else with the "profile" scope.
+type MemberClaims struct {
+	Email         string   `json:"email,omitempty"`
+	EmailVerified bool     `json:"email_verified,omitempty"`
+	Name          string   `json:"name,omitempty"`
+	Nickname      string   `json:"nickname,omitempty"`
+	Picture       string   `json:"picture,omitempty"`
+	Committee     string   `json:"committee,omitempty"`
+	CommitteeName string   `json:"committee_name,omitempty"`
+	Division      string   `json:"division,omitempty"`
+	DivisionName  string   `json:"division_name,omitempty"`
+	Position      string   `json:"position,omitempty"`
+	PositionName  string   `json:"position_name,omitempty"`
+	House         string   `json:"house,omitempty"`
+	Roles         []string `json:"roles,omitempty"`
+}
+
+// IDTokenClaims are the claims of an ID token issued to a relying party
+type IDTokenClaims struct {
+	Nonce           string `json:"nonce,omitempty"`
+	AuthorizedParty string `json:"azp,omitempty"`
+	MemberClaims
+	jwt.RegisteredClaims
+}
+
+// OIDCAccessClaims are the claims of an access token for the userinfo endpoint.
+// The subject is the member ID.
+type OIDCAccessClaims struct {
+	ClientID string `json:"client_id"`
+	Scope    string `json:"scope"`
+	jwt.RegisteredClaims
+}
+
+// UserInfoResponse is the response of the userinfo endpoint
+type UserInfoResponse struct {
+	Subject string `json:"sub" example:"12212345"`
+	MemberClaims
+}
+
+// DiscoveryResponse is the OpenID Provider metadata
+type DiscoveryResponse struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JwksURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+// JWK is a public RSA signing key
+type JWK struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// JWKSResponse is the JSON Web Key Set of the provider
+type JWKSResponse struct {
+	Keys []JWK `json:"keys"`
+}
+
+// LoadOIDCSigningKey reads the RSA key used to sign ID tokens.
+// If no key file is configured, an ephemeral key is generated; tokens signed
+// with it become invalid on restart, so this is only meant for development.
+func LoadOIDCSigningKey(cfg *config.Config) (*rsa.PrivateKey, error) {
+	if cfg.OIDCSigningKeyFile == "" {
+		log.Warn().Msg("OIDC_SIGNING_KEY_FILE not set, generating an ephemeral OIDC signing key")
+		return rsa.GenerateKey(rand.Reader, 2048)
+	}
+
+	data, err := os.ReadFile(cfg.OIDCSigningKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OIDC signing key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("OIDC signing key is not PEM-encoded")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OIDC signing key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("OIDC signing key must be an RSA key")
+	}
+	return key, nil
+}
+
+// keyID derives a stable key ID from the public key
+func keyID(key *rsa.PublicKey) string {
+	der, _ := x509.MarshalPKIXPublicKey(key)
+	sum := sha256.Sum256(der)
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
+
+// VerifyCodeChallenge checks a PKCE code verifier against an S256 code challenge
+func VerifyCodeChallenge(verifier, challenge string) bool {
+	if verifier == "" || challenge == "" {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	computed := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(challenge)) == 1
+}
+
+// redirectWithError sends an authorization error back to the relying party
+func redirectWithError(c echo.Context, redirectURI, state, code, description string) error {
+	params := url.Values{}
+	params.Set("error", code)
+	params.Set("error_description", description)
+	if state != "" {
+		params.Set("state", state)
+	}
+	return c.Redirect(http.StatusFound, appendQuery(redirectURI, params))
+}
+
+// appendQuery adds query parameters to a URL that may already have some
+func appendQuery(rawURL string, params url.Values) string {
+	sep := "?"
+	if strings.Contains(rawURL, "?") {
+		sep = "&"
+	}
+	return rawURL + sep + params.Encode()
+}
+
+// DiscoveryHandler serves the OpenID Provider metadata
+// @Summary OpenID Connect discovery
+// @Description Returns the OpenID Provider configuration for "Sign in with LSCS"
+// @Tags oidc
+// @Produce json
+// @Success 200 {object} DiscoveryResponse "Provider metadata"
+// @Router /.well-known/openid-configuration [get]
+func (h *ClientHandler) DiscoveryHandler(c echo.Context) error {
+	return c.JSON(http.StatusOK, DiscoveryResponse{
+		Issuer:                            h.issuer,
+		AuthorizationEndpoint:             h.issuer + "/oauth/authorize",
+		TokenEndpoint:                     h.issuer + "/oauth/token",
+		UserinfoEndpoint:                  h.issuer + "/oauth/userinfo",
+		JwksURI:                           h.issuer + "/oauth/jwks",
+		ScopesSupported:                   OIDCScopes,
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{GrantTypeAuthorizationCode, GrantTypeClientCredentials},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{jwt.SigningMethodRS256.Alg()},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post"},
+		CodeChallengeMethodsSupported:     []string{codeChallengeMethodS256},
+		ClaimsSupported: []string{
+			"sub", "iss", "aud", "exp", "iat", "nonce", "azp",
+			"email", "email_verified", "name", "nickname", "picture",
+			"committee", "committee_name", "division", "division_name",
+			"position", "position_name", "house", "roles",
+		},
+	})
+}
+
+// JWKSHandler serves the public keys used to verify ID tokens
+// @Summary OpenID Connect JWKS
+// @Description Returns the JSON Web Key Set used to sign ID tokens
+// @Tags oidc
+// @Produce json
+// @Success 200 {object} JWKSResponse "Signing keys"
+// @Router /oauth/jwks [get]
+func (h *ClientHandler) JWKSHandler(c echo.Context) error {
+	pub := &h.signingKey.PublicKey
+	return c.JSON(http.StatusOK, JWKSResponse{
+		Keys: []JWK{{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: jwt.SigningMethodRS256.Alg(),
+			Kid: h.keyID,
+			N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+// AuthorizeHandler is the OIDC authorization endpoint.
+// Members without a session are sent through Google login first and then back here.
+// Clients are registered by admins, so there is no separate consent screen.
+// @Summary OpenID Connect authorization endpoint
+// @Description Starts "Sign in with LSCS". Requires response_type=code and PKCE (S256). Redirects back to the client with an authorization code.
+// @Tags oidc
+// @Param response_type query string true "Must be code"
+// @Param client_id query string true "Client ID"
+// @Param redirect_uri query string true "Registered redirect URI"
+// @Param scope query string true "Space-separated scopes, must include openid"
+// @Param state query string false "Opaque value returned to the client"
+// @Param nonce query string false "Value echoed in the ID token"
+// @Param code_challenge query string true "PKCE code challenge"
+// @Param code_challenge_method query string true "Must be S256"
+// @Param prompt query string false "none to fail instead of asking the member to log in"
+// @Success 302 "Redirect to the client with code and state, or to Google login"
+// @Failure 400 {object} helpers.ErrorResponse "Unknown client or redirect URI"
+// @Router /oauth/authorize [get]
+func (h *ClientHandler) AuthorizeHandler(c echo.Context) error {
+	clientID := c.QueryParam("client_id")
+	redirectURI := c.QueryParam("redirect_uri")
+	state := c.QueryParam("state")
+
+	q := repository.New(h.dbService.GetConnection())
+	ctx := c.Request().Context()
+
+	// the client and redirect URI must be checked before redirecting anywhere
+	client, err := q.GetOAuthClient(ctx, clientID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Unknown client_id"})
+		}
+		log.Error().Err(err).Str("client_id", clientID).Msg("failed to get OAuth client")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	if !slices.Contains(ParseRedirectURIs(client.RedirectUris.String), redirectURI) {
+		log.Warn().Str("client_id", clientID).Str("redirect_uri", redirectURI).Msg("unregistered redirect URI")
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid redirect_uri"})
+	}
+
+	if c.QueryParam("response_type") != "code" {
+		return redirectWithError(c, redirectURI, state, "unsupported_response_type", "Only the code response type is supported")
+	}
+
+	scopes := ParseScopes(c.QueryParam("scope"))
+	if !slices.Contains(scopes, ScopeOpenID) {
+		return redirectWithError(c, redirectURI, state, "invalid_scope", "The openid scope is required")
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(OIDCScopes, scope) {
+			return redirectWithError(c, redirectURI, state, "invalid_scope", fmt.Sprintf("Unsupported scope: %s", scope))
+		}
+	}
+
+	codeChallenge := c.QueryParam("code_challenge")
+	if codeChallenge == "" || c.QueryParam("code_challenge_method") != codeChallengeMethodS256 {
+		return redirectWithError(c, redirectURI, state, "invalid_request", "PKCE with code_challenge_method=S256 is required")
+	}
+
+	// set by the optional session authenticator
+	principal, ok := GetPrincipal(c)
+	if !ok {
+		if c.QueryParam("prompt") == "none" {
+			return redirectWithError(c, redirectURI, state, "login_required", "Member is not logged in")
+		}
+		loginURL := "/auth/google/login?redirect=" + url.QueryEscape(c.Request().URL.RequestURI())
+		return c.Redirect(http.StatusFound, loginURL)
+	}
+
+	code, err := randomHex(32)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to generate authorization code")
+		return redirectWithError(c, redirectURI, state, "server_error", "Internal server error")
+	}
+
+	nonce := c.QueryParam("nonce")
+	err = q.CreateAuthorizationCode(ctx, repository.CreateAuthorizationCodeParams{
+		CodeHash:      HashClientSecret(code),
+		ClientID:      client.ClientID,
+		MemberID:      principal.MemberID,
+		RedirectUri:   redirectURI,
+		Scope:         strings.Join(scopes, " "),
+		Nonce:         sql.NullString{String: nonce, Valid: nonce != ""},
+		CodeChallenge: codeChallenge,
+		ExpiresAt:     time.Now().Add(authorizationCodeTTL),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("client_id", clientID).Msg("failed to store authorization code")
+		return redirectWithError(c, redirectURI, state, "server_error", "Internal server error")
+	}
+
+	log.Info().
+		Str("client_id", clientID).
+		Int32("member_id", principal.MemberID).
+		Msg("issued OIDC authorization code")
+
+	params := url.Values{}
+	params.Set("code", code)
+	if state != "" {
+		params.Set("state", state)
+	}
+	return c.Redirect(http.StatusFound, appendQuery(redirectURI, params))
+}
+
+// authorizationCodeGrant exchanges an authorization code for an ID token and access token.
+// The client has already been authenticated by TokenHandler.
+func (h *ClientHandler) authorizationCodeGrant(c echo.Context, client repository.OauthClient) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	code := c.FormValue("code")
+	if code == "" {
+		return tokenError(c, http.StatusBadRequest, "invalid_request", "Missing code")
+	}
+	codeHash := HashClientSecret(code)
+
+	authCode, err := q.GetAuthorizationCode(ctx, codeHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return tokenError(c, http.StatusBadRequest, "invalid_grant", "Invalid or expired authorization code")
+		}
+		log.Error().Err(err).Msg("failed to get authorization code")
+		return tokenError(c, http.StatusInternalServerError, "server_error", "Internal server error")
+	}
+
+	if authCode.ClientID != client.ClientID || authCode.RedirectUri != c.FormValue("redirect_uri") {
+		log.Warn().Str("client_id", client.ClientID).Msg("authorization code used by another client or redirect URI")
+		return tokenError(c, http.StatusBadRequest, "invalid_grant", "Invalid or expired authorization code")
+	}
+	if !VerifyCodeChallenge(c.FormValue("code_verifier"), authCode.CodeChallenge) {
+		return tokenError(c, http.StatusBadRequest, "invalid_grant", "Invalid code_verifier")
+	}
+
+	// codes are single-use; only the request that deletes it may redeem it
+	rows, err := q.DeleteAuthorizationCode(ctx, codeHash)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to delete authorization code")
+		return tokenError(c, http.StatusInternalServerError, "server_error", "Internal server error")
+	}
+	if rows == 0 {
+		return tokenError(c, http.StatusBadRequest, "invalid_grant", "Invalid or expired authorization code")
+	}
+
+	scopes := ParseScopes(authCode.Scope)
+	claims, err := h.memberClaims(ctx, authCode.MemberID, scopes)
+	if err != nil {
+		log.Error().Err(err).Int32("member_id", authCode.MemberID).Msg("failed to build member claims")
+		return tokenError(c, http.StatusInternalServerError, "server_error", "Internal server error")
+	}
+
+	now := time.Now()
+	subject := strconv.Itoa(int(authCode.MemberID))
+	idToken, err := h.signRS256(&IDTokenClaims{
+		Nonce:           authCode.Nonce.String,
+		AuthorizedParty: client.ClientID,
+		MemberClaims:    *claims,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    h.issuer,
+			Subject:   subject,
+			Audience:  jwt.ClaimStrings{client.ClientID},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(h.tokenTTL)),
+		},
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to sign ID token")
+		return tokenError(c, http.StatusInternalServerError, "server_error", "Internal server error")
+	}
+
+	accessToken, err := h.signRS256(&OIDCAccessClaims{
+		ClientID: client.ClientID,
+		Scope:    authCode.Scope,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    h.issuer,
+			Subject:   subject,
+			Audience:  jwt.ClaimStrings{h.issuer + "/oauth/userinfo"},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(h.tokenTTL)),
+		},
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to sign OIDC access token")
+		return tokenError(c, http.StatusInternalServerError, "server_error", "Internal server error")
+	}
+
+	log.Info().
+		Str("client_id", client.ClientID).
+		Int32("member_id", authCode.MemberID).
+		Msg("issued OIDC ID token")
+
+	return c.JSON(http.StatusOK, TokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(h.tokenTTL.Seconds()),
+		Scope:       authCode.Scope,
+		IDToken:     idToken,
+	})
+}
+
+// UserInfoHandler returns the claims of the member an OIDC access token was issued for
+// @Summary OpenID Connect userinfo
+// @Description Returns the claims of the signed-in member, limited to the scopes granted to the access token
+// @Tags oidc
+// @Produce json
+// @Success 200 {object} UserInfoResponse "Member claims"
+// @Failure 401 {object} helpers.ErrorResponse "Invalid or expired access token"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /oauth/userinfo [get]
+func (h *ClientHandler) UserInfoHandler(c echo.Context) error {
+	authHeader := c.Request().Header.Get(echo.HeaderAuthorization)
+	tokenString, ok := strings.CutPrefix(authHeader, "Bearer ")
+	if !ok || tokenString == "" {
+		c.Response().Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	claims := new(OIDCAccessClaims)
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		return &h.signingKey.PublicKey, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(h.issuer),
+		jwt.WithAudience(h.issuer+"/oauth/userinfo"),
+	)
+	if err != nil {
+		log.Debug().Err(err).Msg("invalid OIDC access token")
+		c.Response().Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Invalid or expired access token"})
+	}
+
+	memberID, err := strconv.ParseInt(claims.Subject, 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Invalid or expired access token"})
+	}
+
+	memberClaims, err := h.memberClaims(c.Request().Context(), int32(memberID), ParseScopes(claims.Scope))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Member no longer exists"})
+		}
+		log.Error().Err(err).Int64("member_id", memberID).Msg("failed to build member claims")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return c.JSON(http.StatusOK, UserInfoResponse{
+		Subject:      claims.Subject,
+		MemberClaims: *memberClaims,
+	})
+}
+
+// memberClaims builds the member claims released for the granted scopes
+func (h *ClientHandler) memberClaims(ctx context.Context, memberID int32, scopes []string) (*MemberClaims, error) {
+	claims := &MemberClaims{}
+	if !slices.Contains(scopes, ScopeEmail) && !slices.Contains(scopes, ScopeProfile) {
+		return claims, nil
+	}
+
+	q := repository.New(h.dbService.GetConnection())
+	member, err := q.GetMemberInfoById(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(scopes, ScopeEmail) {
+		claims.Email = member.Email
+		// only DLSU Google accounts can log in, so the email is verified by Google
+		claims.EmailVerified = true
+	}
+
+	if slices.Contains(scopes, ScopeProfile) {
+		claims.Name = member.FullName
+		claims.Nickname = member.Nickname.String
+		claims.Picture = member.ImageUrl.String
+		claims.Committee = member.CommitteeID.String
+		claims.CommitteeName = member.CommitteeName.String
+		claims.Division = member.DivisionID.String
+		claims.DivisionName = member.DivisionName.String
+		claims.Position = member.PositionID.String
+		claims.PositionName = member.PositionName.String
+		claims.House = member.HouseName.String
+
+		roles, err := q.GetMemberRoles(ctx, memberID)
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range roles {
+			claims.Roles = append(claims.Roles, role.ID)
+		}
+	}
+
+	return claims, nil
+}
+
+// signRS256 signs claims with the OIDC signing key
+func (h *ClientHandler) signRS256(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = h.keyID
+	return token.SignedString(h.signingKey)
+}
+
+// StartAuthorizationCodeCleanupJob starts a background goroutine that periodically
+// deletes expired OIDC authorization codes
+func StartAuthorizationCodeCleanupJob(ctx context.Context, dbService database.Service, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("authorization code cleanup job stopped")
+				return
+			case <-ticker.C:
+				q := repository.New(dbService.GetConnection())
+				if err := q.CleanupExpiredAuthorizationCodes(ctx); err != nil {
+					log.Error().Err(err).Msg("failed to cleanup expired authorization codes")
+				} else {
+					log.Debug().Msg("expired authorization codes cleanup completed")
+				}
+			}
+		}
+	}()
+}
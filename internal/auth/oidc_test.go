@@ -0,0 +1,287 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+)
+
+const testRedirectURI = "https://events.lscs.org/auth/callback"
+
+func testCodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func expectRelyingParty(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("SELECT client_id, client_secret_hash, name, scopes, created_by, created_at, redirect_uris").
+		WithArgs("lscs_events").
+		WillReturnRows(sqlmock.NewRows(oauthClientColumns).
+			AddRow("lscs_events", HashClientSecret("s3cret"), "Events", "", 1, time.Now(), testRedirectURI))
+}
+
+func authorizeQuery() url.Values {
+	return url.Values{
+		"response_type":         {"code"},
+		"client_id":             {"lscs_events"},
+		"redirect_uri":          {testRedirectURI},
+		"scope":                 {"openid email profile"},
+		"state":                 {"xyz"},
+		"nonce":                 {"n-0S6"},
+		"code_challenge":        {testCodeChallenge("verifier")},
+		"code_challenge_method": {"S256"},
+	}
+}
+
+func TestVerifyCodeChallenge(t *testing.T) {
+	challenge := testCodeChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")
+	assert.True(t, VerifyCodeChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk", challenge))
+	assert.False(t, VerifyCodeChallenge("wrong", challenge))
+	assert.False(t, VerifyCodeChallenge("", challenge))
+}
+
+func TestDiscoveryAndJWKS(t *testing.T) {
+	h := NewClientHandler(&config.Config{OIDCIssuer: "https://core.api.dlsu-lscs.org"}, &mockDBService{})
+	e := echo.New()
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/.well-known/openid-configuration", nil), rec)
+	if assert.NoError(t, h.DiscoveryHandler(c)) {
+		var resp DiscoveryResponse
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.Equal(t, "https://core.api.dlsu-lscs.org", resp.Issuer)
+		assert.Equal(t, "https://core.api.dlsu-lscs.org/oauth/jwks", resp.JwksURI)
+		assert.Equal(t, []string{"S256"}, resp.CodeChallengeMethodsSupported)
+	}
+
+	rec = httptest.NewRecorder()
+	c = e.NewContext(httptest.NewRequest(http.MethodGet, "/oauth/jwks", nil), rec)
+	if assert.NoError(t, h.JWKSHandler(c)) {
+		var resp JWKSResponse
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		if assert.Len(t, resp.Keys, 1) {
+			assert.Equal(t, "RS256", resp.Keys[0].Alg)
+			assert.Equal(t, h.keyID, resp.Keys[0].Kid)
+		}
+	}
+}
+
+func TestAuthorizeHandler(t *testing.T) {
+	cfg := &config.Config{OIDCIssuer: "https://core.api.dlsu-lscs.org"}
+
+	t.Run("no session redirects to login", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		expectRelyingParty(mock)
+
+		h := NewClientHandler(cfg, &mockDBService{db: db})
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/oauth/authorize?"+authorizeQuery().Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, h.AuthorizeHandler(c)) {
+			assert.Equal(t, http.StatusFound, rec.Code)
+			assert.Contains(t, rec.Header().Get("Location"), "/auth/google/login?redirect=%2Foauth%2Fauthorize")
+		}
+	})
+
+	t.Run("unregistered redirect URI", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		expectRelyingParty(mock)
+
+		h := NewClientHandler(cfg, &mockDBService{db: db})
+		e := echo.New()
+		query := authorizeQuery()
+		query.Set("redirect_uri", "https://evil.example.com/callback")
+		req := httptest.NewRequest(http.MethodGet, "/oauth/authorize?"+query.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, h.AuthorizeHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("PKCE is required", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		expectRelyingParty(mock)
+
+		h := NewClientHandler(cfg, &mockDBService{db: db})
+		e := echo.New()
+		query := authorizeQuery()
+		query.Del("code_challenge")
+		req := httptest.NewRequest(http.MethodGet, "/oauth/authorize?"+query.Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, h.AuthorizeHandler(c)) {
+			assert.Equal(t, http.StatusFound, rec.Code)
+			location, _ := url.Parse(rec.Header().Get("Location"))
+			assert.Equal(t, "invalid_request", location.Query().Get("error"))
+			assert.Equal(t, "xyz", location.Query().Get("state"))
+		}
+	})
+
+	t.Run("success issues code", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		expectRelyingParty(mock)
+		mock.ExpectExec("INSERT INTO oidc_authorization_codes").
+			WithArgs(sqlmock.AnyArg(), "lscs_events", 1, testRedirectURI, "openid email profile", "n-0S6", testCodeChallenge("verifier"), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		h := NewClientHandler(cfg, &mockDBService{db: db})
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/oauth/authorize?"+authorizeQuery().Encode(), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		SetPrincipal(c, &Principal{MemberID: 1, Method: AuthMethodSession, SessionID: "session-a"})
+
+		if assert.NoError(t, h.AuthorizeHandler(c)) {
+			assert.Equal(t, http.StatusFound, rec.Code)
+			location, _ := url.Parse(rec.Header().Get("Location"))
+			assert.Equal(t, "events.lscs.org", location.Host)
+			assert.Len(t, location.Query().Get("code"), 64)
+			assert.Equal(t, "xyz", location.Query().Get("state"))
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAuthorizationCodeGrant(t *testing.T) {
+	cfg := &config.Config{OIDCIssuer: "https://core.api.dlsu-lscs.org", OAuthClientTokenTTL: 5 * time.Minute}
+	codeColumns := []string{"code_hash", "client_id", "member_id", "redirect_uri", "scope", "nonce", "code_challenge", "expires_at", "created_at"}
+
+	expectCode := func(mock sqlmock.Sqlmock) {
+		expectRelyingParty(mock)
+		mock.ExpectQuery("SELECT code_hash, client_id, member_id").
+			WithArgs(HashClientSecret("the-code")).
+			WillReturnRows(sqlmock.NewRows(codeColumns).
+				AddRow(HashClientSecret("the-code"), "lscs_events", 1, testRedirectURI, "openid profile", "n-0S6", testCodeChallenge("verifier"), time.Now().Add(time.Minute), time.Now()))
+	}
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectCode(mock)
+		mock.ExpectExec("DELETE FROM oidc_authorization_codes").
+			WithArgs(HashClientSecret("the-code")).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT").
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{
+				"id", "email", "full_name", "nickname", "image_url",
+				"committee_id", "committee_name", "division_id", "division_name",
+				"position_id", "position_name", "house_name",
+				"contact_number", "college", "program", "interests", "discord", "fb_link", "telegram",
+			}).AddRow(1, "test@dlsu.edu.ph", "Test User", nil, nil,
+				"RND", "Research and Development", "INT", "Internals",
+				"VP", "Vice President", nil, nil, nil, nil, nil, nil, nil, nil))
+		mock.ExpectQuery("SELECT r.id, r.name, r.description").
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "granted_by", "granted_at"}).
+				AddRow("ADMIN", "Administrator", nil, nil, nil))
+
+		h := NewClientHandler(cfg, &mockDBService{db: db})
+		e := echo.New()
+		req, rec := newTokenRequest(url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {"the-code"},
+			"redirect_uri":  {testRedirectURI},
+			"code_verifier": {"verifier"},
+		})
+		req.SetBasicAuth("lscs_events", "s3cret")
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, h.TokenHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var resp TokenResponse
+			json.Unmarshal(rec.Body.Bytes(), &resp)
+
+			claims := new(IDTokenClaims)
+			_, err := jwt.ParseWithClaims(resp.IDToken, claims, func(t *jwt.Token) (interface{}, error) {
+				return &h.signingKey.PublicKey, nil
+			}, jwt.WithIssuer("https://core.api.dlsu-lscs.org"), jwt.WithAudience("lscs_events"))
+			assert.NoError(t, err)
+			assert.Equal(t, "1", claims.Subject)
+			assert.Equal(t, "n-0S6", claims.Nonce)
+			assert.Equal(t, "RND", claims.Committee)
+			assert.Equal(t, "VP", claims.Position)
+			assert.Equal(t, "INT", claims.Division)
+			assert.Equal(t, []string{"ADMIN"}, claims.Roles)
+			// email scope was not granted
+			assert.Empty(t, claims.Email)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("wrong code verifier", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		expectCode(mock)
+
+		h := NewClientHandler(cfg, &mockDBService{db: db})
+		e := echo.New()
+		req, rec := newTokenRequest(url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {"the-code"},
+			"redirect_uri":  {testRedirectURI},
+			"code_verifier": {"not-the-verifier"},
+		})
+		req.SetBasicAuth("lscs_events", "s3cret")
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, h.TokenHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), "invalid_grant")
+		}
+	})
+
+	t.Run("code already redeemed", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		expectCode(mock)
+		mock.ExpectExec("DELETE FROM oidc_authorization_codes").
+			WithArgs(HashClientSecret("the-code")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		h := NewClientHandler(cfg, &mockDBService{db: db})
+		e := echo.New()
+		req, rec := newTokenRequest(url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {"the-code"},
+			"redirect_uri":  {testRedirectURI},
+			"code_verifier": {"verifier"},
+		})
+		req.SetBasicAuth("lscs_events", "s3cret")
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, h.TokenHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Contains(t, rec.Body.String(), "invalid_grant")
+		}
+	})
+}
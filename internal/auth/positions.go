@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// defaultPositionHierarchy is used until the ranks are loaded from the positions table
+// (higher number = more authority)
+var defaultPositionHierarchy = map[string]int{
+	"PRES": 7,
+	"EVP":  6,
+	"VP":   5,
+	"AVP":  4,
+	"CT":   3,
+	"JO":   2,
+	"MEM":  1,
+}
+
+// positionLevels caches the position ranks used for authorization
+var positionLevels = &positionCache{levels: defaultPositionHierarchy}
+
+type positionCache struct {
+	mu     sync.RWMutex
+	levels map[string]int
+}
+
+func (p *positionCache) level(positionID string) int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.levels[positionID] // unknown position has no authority
+}
+
+func (p *positionCache) set(levels map[string]int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.levels = levels
+}
+
+// LoadPositionLevels reloads the position ranks from the positions table.
+// Call it after changing positions; StartPositionRefreshJob picks up changes made by other instances.
+func LoadPositionLevels(ctx context.Context, dbService database.Service) error {
+	positions, err := repository.New(dbService.GetConnection()).ListPositions(ctx)
+	if err != nil {
+		return err
+	}
+	if len(positions) == 0 {
+		// fresh database, keep the current hierarchy
+		log.Warn().Msg("no positions found, keeping the current position hierarchy")
+		return nil
+	}
+
+	levels := make(map[string]int, len(positions))
+	for _, p := range positions {
+		levels[p.PositionID] = int(p.PositionRank)
+	}
+	positionLevels.set(levels)
+	return nil
+}
+
+// StartPositionRefreshJob loads the position ranks now and then reloads them periodically
+func StartPositionRefreshJob(ctx context.Context, dbService database.Service, interval time.Duration) {
+	if err := LoadPositionLevels(ctx, dbService); err != nil {
+		log.Error().Err(err).Msg("failed to load position hierarchy, using defaults")
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("position refresh job stopped")
+				return
+			case <-ticker.C:
+				if err := LoadPositionLevels(ctx, dbService); err != nil {
+					log.Error().Err(err).Msg("failed to refresh position hierarchy")
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AuthMethod identifies how a request was authenticated
+type AuthMethod string
+
+const (
+	AuthMethodSession AuthMethod = "session" // web UI session cookie
+	AuthMethodAPIKey  AuthMethod = "api_key" // API key JWT (Bearer)
+	AuthMethodGoogle  AuthMethod = "google"  // Google OAuth ID token (Bearer)
+	AuthMethodClient  AuthMethod = "client"  // OAuth2 client credentials access token (Bearer)
+)
+
+// API scopes granted to non-interactive principals (API keys, OAuth2 clients)
+const (
+	ScopeMembersRead    = "members:read"
+	ScopeCommitteesRead = "committees:read"
+	ScopeAdmin          = "admin"
+)
+
+// DefaultAPIKeyScopes are the scopes granted to dev and prod API keys
+var DefaultAPIKeyScopes = []string{ScopeMembersRead, ScopeCommitteesRead}
+
+// principalContextKey is the echo context key the principal is stored under
+const principalContextKey = "principal"
+
+// ErrNoCredentials is returned by an authenticator when the request does not
+// carry credentials for its method, so the next authenticator can be tried.
+var ErrNoCredentials = errors.New("no credentials")
+
+// Principal is the authenticated identity behind a request.
+// Client principals act as a registered service rather than a member,
+// so MemberID and Email are empty for them.
+type Principal struct {
+	MemberID  int32
+	Email     string
+	Method    AuthMethod
+	SessionID string   // set for session principals
+	KeyID     int32    // set for API key principals
+	ClientID  string   // set for OAuth2 client principals
+	Scopes    []string // only enforced for API key and client principals
+
+	// AuthTime is when the member last logged in with Google (zero if unknown).
+	// RecentlyAuthenticated is set by the authenticator when AuthTime is within
+	// the step-up window, see RequireRecentAuth.
+	AuthTime              time.Time
+	RecentlyAuthenticated bool
+}
+
+// IsInteractive returns true if the principal is a member acting directly
+// (web session or Google ID token) rather than through an API key or client
+func (p *Principal) IsInteractive() bool {
+	return p.Method == AuthMethodSession || p.Method == AuthMethodGoogle
+}
+
+// HasScope checks if the principal was granted a scope.
+// Interactive principals act as the member themselves, so scopes do not apply;
+// what they can do is decided by RBAC instead.
+func (p *Principal) HasScope(scope string) bool {
+	if p.IsInteractive() {
+		return true
+	}
+	return slices.Contains(p.Scopes, scope) || slices.Contains(p.Scopes, ScopeAdmin)
+}
+
+// IsClient returns true if the principal is an OAuth2 service client
+func (p *Principal) IsClient() bool {
+	return p.Method == AuthMethodClient
+}
+
+// SetPrincipal stores the principal in the request context.
+// The legacy user_id, user_email and session_id keys are set as well.
+func SetPrincipal(c echo.Context, p *Principal) {
+	c.Set(principalContextKey, p)
+	c.Set("user_id", p.MemberID)
+	c.Set("user_email", p.Email)
+	if p.SessionID != "" {
+		c.Set("session_id", p.SessionID)
+	}
+}
+
+// GetPrincipal returns the principal stored in the request context
+func GetPrincipal(c echo.Context) (*Principal, bool) {
+	p, ok := c.Get(principalContextKey).(*Principal)
+	return p, ok && p != nil
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrincipal_HasScope(t *testing.T) {
+	t.Run("interactive principals are not scope-restricted", func(t *testing.T) {
+		session := &Principal{Method: AuthMethodSession}
+		google := &Principal{Method: AuthMethodGoogle}
+		assert.True(t, session.HasScope(ScopeMembersRead))
+		assert.True(t, google.HasScope(ScopeAdmin))
+	})
+
+	t.Run("API key principal with granted scope", func(t *testing.T) {
+		p := &Principal{Method: AuthMethodAPIKey, Scopes: DefaultAPIKeyScopes}
+		assert.True(t, p.HasScope(ScopeMembersRead))
+		assert.False(t, p.HasScope(ScopeAdmin))
+	})
+
+	t.Run("admin scope implies every scope", func(t *testing.T) {
+		p := &Principal{Method: AuthMethodAPIKey, Scopes: []string{ScopeAdmin}}
+		assert.True(t, p.HasScope(ScopeCommitteesRead))
+	})
+
+	t.Run("API key principal without scopes", func(t *testing.T) {
+		p := &Principal{Method: AuthMethodAPIKey}
+		assert.False(t, p.HasScope(ScopeMembersRead))
+	})
+}
+
+func TestSetPrincipal(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	_, ok := GetPrincipal(c)
+	assert.False(t, ok)
+
+	SetPrincipal(c, &Principal{MemberID: 42, Email: "test@dlsu.edu.ph", Method: AuthMethodSession, SessionID: "abc"})
+
+	p, ok := GetPrincipal(c)
+	assert.True(t, ok)
+	assert.Equal(t, int32(42), p.MemberID)
+
+	// legacy context keys are kept in sync
+	assert.Equal(t, int32(42), c.Get("user_id"))
+	assert.Equal(t, "test@dlsu.edu.ph", c.Get("user_email"))
+	assert.Equal(t, "abc", c.Get("session_id"))
+}
@@ -10,17 +10,6 @@ import (
 	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
 )
 
-// Position hierarchy levels (higher number = more authority)
-var positionHierarchy = map[string]int{
-	"PRES": 7,
-	"EVP":  6,
-	"VP":   5,
-	"AVP":  4,
-	"CT":   3,
-	"JO":   2,
-	"MEM":  1,
-}
-
 // RBACService handles role-based access control
 type RBACService struct {
 	dbService database.Service
@@ -31,12 +20,9 @@ func NewRBACService(dbService database.Service) *RBACService {
 	return &RBACService{dbService: dbService}
 }
 
-// GetPositionLevel returns the hierarchy level for a position
+// GetPositionLevel returns the hierarchy level for a position (the rank in the positions table)
 func GetPositionLevel(positionID string) int {
-	if level, ok := positionHierarchy[positionID]; ok {
-		return level
-	}
-	return 0 // unknown position has no authority
+	return positionLevels.level(positionID)
 }
 
 // IsHigherPosition returns true if position1 has higher authority than position2
@@ -168,6 +154,62 @@ func (s *RBACService) CanManageRoles(ctx context.Context, actorID int32) bool {
 	return s.IsAdmin(ctx, actorID)
 }
 
+// CanManageCommitteeEvents checks if an actor can create, edit or delete a committee's events:
+// 1. Admin role (any committee)
+// 2. PRES/EVP (any committee)
+// 3. VP/AVP of the same committee
+func (s *RBACService) CanManageCommitteeEvents(ctx context.Context, actorID int32, committeeID string) bool {
+	if s.IsAdmin(ctx, actorID) {
+		return true
+	}
+
+	q := repository.New(s.dbService.GetConnection())
+	actor, err := q.GetMemberInfoById(ctx, actorID)
+	if err != nil {
+		log.Error().Err(err).Int32("actor_id", actorID).Msg("failed to get actor info for event access check")
+		return false
+	}
+
+	return canManageCommittee(actor.PositionID.String, actor.CommitteeID.String, committeeID)
+}
+
+// CanManageCommittees reports, for each of the given committees, whether the actor can manage its events.
+// It is equivalent to CanManageCommitteeEvents for each committee, but looks up the actor only once.
+func (s *RBACService) CanManageCommittees(ctx context.Context, actorID int32, committeeIDs ...string) map[string]bool {
+	result := make(map[string]bool, len(committeeIDs))
+
+	if s.IsAdmin(ctx, actorID) {
+		for _, id := range committeeIDs {
+			result[id] = true
+		}
+		return result
+	}
+
+	q := repository.New(s.dbService.GetConnection())
+	actor, err := q.GetMemberInfoById(ctx, actorID)
+	if err != nil {
+		log.Error().Err(err).Int32("actor_id", actorID).Msg("failed to get actor info for event access check")
+		return result
+	}
+
+	for _, id := range committeeIDs {
+		result[id] = canManageCommittee(actor.PositionID.String, actor.CommitteeID.String, id)
+	}
+	return result
+}
+
+// canManageCommittee returns true if a member with the given position and committee
+// can manage resources owned by committeeID
+func canManageCommittee(position, memberCommittee, committeeID string) bool {
+	// EVP and PRES can manage every committee
+	if GetPositionLevel(position) >= GetPositionLevel("EVP") {
+		return true
+	}
+
+	// VP and AVP can manage only their own committee
+	return GetPositionLevel(position) >= GetPositionLevel("AVP") && memberCommittee == committeeID
+}
+
 // CanAccessAPIKeyManagement checks if a member can access API key management
 // Requirements: Member of RND committee OR AVP+ position
 func (s *RBACService) CanAccessAPIKeyManagement(ctx context.Context, memberID int32) bool {
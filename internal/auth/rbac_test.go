@@ -125,3 +125,30 @@ func TestEditableFields(t *testing.T) {
 		}
 	})
 }
+
+func TestCanManageCommittee(t *testing.T) {
+	tests := []struct {
+		name            string
+		position        string
+		memberCommittee string
+		committeeID     string
+		want            bool
+	}{
+		{"PRES manages any committee", "PRES", "EXEC", "RND", true},
+		{"EVP manages any committee", "EVP", "EXEC", "RND", true},
+		{"VP manages own committee", "VP", "RND", "RND", true},
+		{"AVP manages own committee", "AVP", "RND", "RND", true},
+
+		{"VP cannot manage other committee", "VP", "EXT", "RND", false},
+		{"AVP cannot manage other committee", "AVP", "EXT", "RND", false},
+		{"CT cannot manage own committee", "CT", "RND", "RND", false},
+		{"MEM cannot manage own committee", "MEM", "RND", "RND", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := canManageCommittee(tt.position, tt.memberCommittee, tt.committeeID)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// RoleResponse represents a role
+type RoleResponse struct {
+	ID          string `json:"id" example:"ADMIN"`
+	Name        string `json:"name" example:"Administrator"`
+	Description string `json:"description,omitempty" example:"Full access to every member and setting"`
+}
+
+// MemberRoleResponse represents a role granted to a member
+type MemberRoleResponse struct {
+	RoleResponse
+	GrantedBy *int32 `json:"granted_by,omitempty" example:"12012345"`
+	GrantedAt string `json:"granted_at,omitempty" example:"2026-10-18T09:00:00Z"`
+}
+
+// GrantRoleRequest represents the request body for granting a role
+type GrantRoleRequest struct {
+	RoleID string `json:"role_id" validate:"required,max=20" example:"ADMIN"`
+}
+
+// RoleNotifier is told when a member is granted a role
+type RoleNotifier interface {
+	NotifyRoleGranted(ctx context.Context, memberID int32, role repository.Role)
+}
+
+// ListRolesHandler lists all roles
+// @Summary List roles
+// @Description List every role that can be granted to members. Admin only.
+// @Tags roles
+// @Produce json
+// @Success 200 {array} RoleResponse "List of roles"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Admin access required"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /auth/roles [get]
+func (h *Handler) ListRolesHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+	roles, err := q.GetAllRoles(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list roles")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	response := make([]RoleResponse, 0, len(roles))
+	for _, role := range roles {
+		response = append(response, RoleResponse{
+			ID:          role.ID,
+			Name:        role.Name,
+			Description: role.Description.String,
+		})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ListMemberRolesHandler lists the roles granted to a member
+// @Summary List member roles
+// @Description List the roles granted to a member. Admin only.
+// @Tags roles
+// @Produce json
+// @Param id path int true "Member ID"
+// @Success 200 {array} MemberRoleResponse "Roles of the member"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid member ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Admin access required"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /auth/members/{id}/roles [get]
+func (h *Handler) ListMemberRolesHandler(c echo.Context) error {
+	memberID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid member ID"})
+	}
+
+	roles, err := h.rbacService.GetMemberRoles(c.Request().Context(), int32(memberID))
+	if err != nil {
+		log.Error().Err(err).Int64("member_id", memberID).Msg("failed to get member roles")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	response := make([]MemberRoleResponse, 0, len(roles))
+	for _, role := range roles {
+		item := MemberRoleResponse{
+			RoleResponse: RoleResponse{
+				ID:          role.ID,
+				Name:        role.Name,
+				Description: role.Description.String,
+			},
+		}
+		if role.GrantedBy.Valid {
+			grantedBy := role.GrantedBy.Int32
+			item.GrantedBy = &grantedBy
+		}
+		if role.GrantedAt.Valid {
+			item.GrantedAt = role.GrantedAt.Time.Format(time.RFC3339)
+		}
+		response = append(response, item)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GrantRoleHandler grants a role to a member
+// @Summary Grant role
+// @Description Grant a role to a member. Admin only, and requires a recent re-authentication (step-up).
+// @Tags roles
+// @Accept json
+// @Produce json
+// @Param id path int true "Member ID"
+// @Param request body GrantRoleRequest true "Role to grant"
+// @Success 201 {object} map[string]string "Role granted"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} StepUpRequiredResponse "Admin access or recent re-authentication required"
+// @Failure 404 {object} helpers.ErrorResponse "Member or role not found"
+// @Failure 409 {object} helpers.ErrorResponse "Member already has the role"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /auth/members/{id}/roles [post]
+func (h *Handler) GrantRoleHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	memberID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid member ID"})
+	}
+
+	var req GrantRoleRequest
+	if err := helpers.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	if _, err := q.CheckIdIfMember(ctx, int32(memberID)); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Member not found"})
+		}
+		log.Error().Err(err).Int64("member_id", memberID).Msg("failed to check member")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	role, err := q.GetRoleById(ctx, req.RoleID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Role not found"})
+		}
+		log.Error().Err(err).Str("role", req.RoleID).Msg("failed to get role")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	if h.rbacService.HasRole(ctx, int32(memberID), req.RoleID) {
+		return c.JSON(http.StatusConflict, map[string]string{"error": "Member already has this role"})
+	}
+
+	if err := h.rbacService.GrantRole(ctx, int32(memberID), req.RoleID, principal.MemberID); err != nil {
+		log.Error().Err(err).Int64("member_id", memberID).Str("role", req.RoleID).Msg("failed to grant role")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Error granting role"})
+	}
+
+	log.Info().
+		Int32("granted_by", principal.MemberID).
+		Int64("member_id", memberID).
+		Str("role", req.RoleID).
+		Msg("role granted")
+
+	if h.notifier != nil {
+		h.notifier.NotifyRoleGranted(ctx, int32(memberID), role)
+	}
+
+	return c.JSON(http.StatusCreated, map[string]string{"message": "Role granted successfully"})
+}
+
+// RevokeRoleHandler revokes a role from a member
+// @Summary Revoke role
+// @Description Revoke a role from a member. Admin only.
+// @Tags roles
+// @Produce json
+// @Param id path int true "Member ID"
+// @Param role_id path string true "Role ID"
+// @Success 200 {object} map[string]string "Role revoked"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid member ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Admin access required"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /auth/members/{id}/roles/{role_id} [delete]
+func (h *Handler) RevokeRoleHandler(c echo.Context) error {
+	principal, ok := GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	memberID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid member ID"})
+	}
+	roleID := c.Param("role_id")
+
+	if err := h.rbacService.RevokeRole(c.Request().Context(), int32(memberID), roleID); err != nil {
+		log.Error().Err(err).Int64("member_id", memberID).Str("role", roleID).Msg("failed to revoke role")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Error revoking role"})
+	}
+
+	log.Info().
+		Int32("revoked_by", principal.MemberID).
+		Int64("member_id", memberID).
+		Str("role", roleID).
+		Msg("role revoked")
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Role revoked successfully"})
+}
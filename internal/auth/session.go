@@ -19,6 +19,7 @@ type SessionService interface {
 	GetSession(ctx context.Context, sessionID string) (*SessionWithMember, error)
 	UpdateActivity(ctx context.Context, sessionID string) error
 	ExtendSession(ctx context.Context, sessionID string, duration time.Duration) error
+	MarkReauthenticated(ctx context.Context, sessionID string) error
 	DeleteSession(ctx context.Context, sessionID string) error
 	DeleteAllSessionsForMember(ctx context.Context, memberID int32) error
 	CleanupExpiredSessions(ctx context.Context) error
@@ -34,6 +35,7 @@ type Session struct {
 	LastActivity time.Time
 	UserAgent    string
 	IPAddress    string
+	AuthTime     time.Time // last Google login on this session, used for step-up checks
 }
 
 // SessionWithMember includes member info for context population
@@ -115,6 +117,7 @@ func (s *sessionService) CreateSession(ctx context.Context, memberID int32, reme
 		LastActivity: time.Now(),
 		UserAgent:    userAgent,
 		IPAddress:    ipAddress,
+		AuthTime:     time.Now(),
 	}, nil
 }
 
@@ -148,6 +151,9 @@ func (s *sessionService) GetSession(ctx context.Context, sessionID string) (*Ses
 	if row.IpAddress.Valid {
 		session.IPAddress = row.IpAddress.String
 	}
+	if row.AuthTime.Valid {
+		session.AuthTime = row.AuthTime.Time
+	}
 
 	return session, nil
 }
@@ -168,6 +174,12 @@ func (s *sessionService) ExtendSession(ctx context.Context, sessionID string, du
 	})
 }
 
+// MarkReauthenticated records a fresh Google login on an existing session
+func (s *sessionService) MarkReauthenticated(ctx context.Context, sessionID string) error {
+	q := repository.New(s.db)
+	return q.UpdateSessionAuthTime(ctx, sessionID)
+}
+
 // DeleteSession removes a session
 func (s *sessionService) DeleteSession(ctx context.Context, sessionID string) error {
 	q := repository.New(s.db)
@@ -72,9 +72,9 @@ func TestSessionService_GetSession(t *testing.T) {
 		expiresAt := now.Add(12 * time.Hour)
 
 		rows := sqlmock.NewRows([]string{
-			"id", "member_id", "created_at", "expires_at", "last_activity", "user_agent", "ip_address", "email", "full_name",
+			"id", "member_id", "created_at", "expires_at", "last_activity", "user_agent", "ip_address", "auth_time", "email", "full_name",
 		}).AddRow(
-			sessionID, int32(123), now, expiresAt, now, "Mozilla/5.0", "192.168.1.1", "test@dlsu.edu.ph", "Test User",
+			sessionID, int32(123), now, expiresAt, now, "Mozilla/5.0", "192.168.1.1", now, "test@dlsu.edu.ph", "Test User",
 		)
 
 		mock.ExpectQuery("SELECT").
@@ -88,6 +88,7 @@ func TestSessionService_GetSession(t *testing.T) {
 		assert.Equal(t, int32(123), session.MemberID)
 		assert.Equal(t, "test@dlsu.edu.ph", session.Email)
 		assert.Equal(t, "Test User", session.FullName)
+		assert.WithinDuration(t, now, session.AuthTime, time.Second)
 
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
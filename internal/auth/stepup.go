@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+)
+
+// StepUpRequiredCode is the error code returned when a sensitive action needs a fresh login
+const StepUpRequiredCode = "step_up_required"
+
+// StepUpReauthURL starts a Google re-authentication for the current session.
+// The frontend appends &redirect=<path> to come back to the action afterwards.
+const StepUpReauthURL = "/auth/google/login?reauth=true"
+
+// StepUpRequiredResponse tells the frontend to send the member through Google login
+// again (reauth_url) and retry the action afterwards
+type StepUpRequiredResponse struct {
+	Error     string `json:"error" example:"Recent re-authentication required"`
+	Code      string `json:"code" example:"step_up_required"`
+	ReauthURL string `json:"reauth_url" example:"/auth/google/login?reauth=true"`
+}
+
+// IsRecentAuth returns true if authTime is set and within maxAge
+func IsRecentAuth(authTime time.Time, maxAge time.Duration) bool {
+	return !authTime.IsZero() && time.Since(authTime) <= maxAge
+}
+
+// StepUpRequired writes the step_up_required error for a sensitive action.
+// Handlers call it when principal.RecentlyAuthenticated is false.
+func StepUpRequired(c echo.Context, principal *Principal, action string) error {
+	log.Warn().
+		Int32("member_id", principal.MemberID).
+		Str("action", action).
+		Msg("step-up re-authentication required")
+
+	return c.JSON(http.StatusForbidden, StepUpRequiredResponse{
+		Error:     "Recent re-authentication required",
+		Code:      StepUpRequiredCode,
+		ReauthURL: StepUpReauthURL,
+	})
+}
@@ -0,0 +1,16 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsRecentAuth(t *testing.T) {
+	maxAge := 10 * time.Minute
+
+	assert.True(t, IsRecentAuth(time.Now().Add(-time.Minute), maxAge))
+	assert.False(t, IsRecentAuth(time.Now().Add(-time.Hour), maxAge))
+	assert.False(t, IsRecentAuth(time.Time{}, maxAge))
+}
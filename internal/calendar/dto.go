@@ -0,0 +1,26 @@
+package calendar
+
+import "time"
+
+// FeedURLs are the iCalendar feed URLs of a member. The token in them is the only credential,
+// so they should be treated like passwords.
+type FeedURLs struct {
+	Org       string `json:"org" example:"https://api.example.com/calendar/feeds/3f2a.../org.ics"`
+	Mine      string `json:"mine" example:"https://api.example.com/calendar/feeds/3f2a.../mine.ics"`
+	Committee string `json:"committee,omitempty" example:"https://api.example.com/calendar/feeds/3f2a.../committees/RND.ics"`
+}
+
+// FeedTokenResponse is returned when a feed token is created or rotated.
+// The token is only shown once.
+type FeedTokenResponse struct {
+	Token     string    `json:"token" example:"3f2a9c..."`
+	Feeds     FeedURLs  `json:"feeds"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FeedTokenStatusResponse describes the feed token of a member, without the token itself
+type FeedTokenStatusResponse struct {
+	Active     bool       `json:"active" example:"true"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
@@ -0,0 +1,93 @@
+package calendar
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+	"github.com/dlsu-lscs/lscs-core-api/internal/tracker"
+)
+
+// stageNames are the names of tracker stages shown in deadline summaries
+var stageNames = map[tracker.Stage]string{
+	tracker.StagePreacts:     "Pre-acts",
+	tracker.StagePostacts:    "Post-acts",
+	tracker.StageFinPreacts:  "Finance pre-acts",
+	tracker.StageFinPostacts: "Finance post-acts",
+}
+
+// generateToken creates a feed token and the hash stored for it
+func generateToken() (token, hash string, err error) {
+	bytes := make([]byte, 32) // 256 bits
+	if _, err := rand.Read(bytes); err != nil {
+		return "", "", err
+	}
+	token = hex.EncodeToString(bytes)
+	return token, hashToken(token), nil
+}
+
+// hashToken returns the SHA-256 hash of a feed token, as stored in calendar_feed_tokens
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// eventDateEvent converts a date range of an event to a calendar event.
+// Every date range is its own VEVENT, since events can span several.
+func eventDateEvent(d repository.ListCalendarEventDatesRow, domain string) Event {
+	description := []string{}
+	if d.BriefDescription.Valid && d.BriefDescription.String != "" {
+		description = append(description, d.BriefDescription.String, "")
+	}
+	description = append(description, "Committee: "+d.CommitteeID, "ARN: "+d.Arn)
+
+	return Event{
+		UID:         fmt.Sprintf("event-date-%d@%s", d.ID, domain),
+		Summary:     d.Name,
+		Description: strings.Join(description, "\n"),
+		Location:    d.Venue.String,
+		Categories:  []string{d.CommitteeID},
+		Start:       d.StartTime,
+		End:         d.EndTime,
+	}
+}
+
+// deadlineEvents converts the deadlines of an event tracker to calendar events
+func deadlineEvents(t repository.ListEventTrackersRow, domain string) []Event {
+	et := trackerFromRow(t)
+
+	events := []Event{}
+	for _, stage := range tracker.Stages {
+		deadline := tracker.StageDeadline(et, stage)
+		if !deadline.Valid {
+			continue
+		}
+		events = append(events, Event{
+			UID:         fmt.Sprintf("tracker-%d-%s@%s", t.EventID, stage, domain),
+			Summary:     fmt.Sprintf("%s deadline: %s", stageNames[stage], t.Name),
+			Description: fmt.Sprintf("Status: %s\nCommittee: %s", tracker.StageStatus(et, stage), t.CommitteeID),
+			Categories:  []string{t.CommitteeID, "Deadline"},
+			Start:       deadline.Time,
+		})
+	}
+	return events
+}
+
+func trackerFromRow(r repository.ListEventTrackersRow) repository.EventTracker {
+	return repository.EventTracker{
+		EventID:             r.EventID,
+		PreactsDeadline:     r.PreactsDeadline,
+		PreactsStatus:       r.PreactsStatus,
+		PostactsDeadline:    r.PostactsDeadline,
+		PostactsStatus:      r.PostactsStatus,
+		DocuDriveID:         r.DocuDriveID,
+		FinDriveID:          r.FinDriveID,
+		FinPreactsDeadline:  r.FinPreactsDeadline,
+		FinPreactsStatus:    r.FinPreactsStatus,
+		FinPostactsDeadline: r.FinPostactsDeadline,
+		FinPostactsStatus:   r.FinPostactsStatus,
+	}
+}
@@ -0,0 +1,359 @@
+package calendar
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// feedHistory is how far back feeds include events and deadlines
+const feedHistory = 90 * 24 * time.Hour
+
+// Handler serves iCalendar feeds of events and tracker deadlines.
+// Calendar apps can't log in, so feeds are authenticated by a per-member token in the URL
+// instead of a session; the token can be rotated to revoke old URLs.
+type Handler struct {
+	cfg       *config.Config
+	dbService database.Service
+}
+
+func NewHandler(cfg *config.Config, dbService database.Service) *Handler {
+	return &Handler{
+		cfg:       cfg,
+		dbService: dbService,
+	}
+}
+
+// GetFeedTokenHandler returns whether the member has a feed token
+// @Summary Get calendar feed token status
+// @Description Check whether the authenticated member has a calendar feed token. The token itself is only shown when it is created.
+// @Tags calendar
+// @Produce json
+// @Success 200 {object} FeedTokenStatusResponse "Feed token status"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /calendar/token [get]
+func (h *Handler) GetFeedTokenHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	token, err := q.GetCalendarFeedToken(c.Request().Context(), principal.MemberID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(http.StatusOK, FeedTokenStatusResponse{Active: false})
+		}
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to get calendar feed token")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	resp := FeedTokenStatusResponse{Active: true}
+	if token.CreatedAt.Valid {
+		resp.CreatedAt = &token.CreatedAt.Time
+	}
+	if token.LastUsedAt.Valid {
+		resp.LastUsedAt = &token.LastUsedAt.Time
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// RotateFeedTokenHandler creates a new feed token for the member
+// @Summary Create or rotate calendar feed token
+// @Description Create a calendar feed token for the authenticated member, replacing the previous one (feed URLs with the old token stop working). Returns the token and feed URLs; the token is not shown again.
+// @Tags calendar
+// @Produce json
+// @Success 201 {object} FeedTokenResponse "New feed token"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /calendar/token [post]
+func (h *Handler) RotateFeedTokenHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	db := h.dbService.GetConnection()
+	q := repository.New(db)
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	member, err := q.GetMemberInfoById(ctx, principal.MemberID)
+	if err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to get member")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	token, hash, err := generateToken()
+	if err != nil {
+		log.Error().Err(err).Msg("failed to generate calendar feed token")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to begin transaction")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	defer tx.Rollback()
+	qtx := q.WithTx(tx)
+
+	if err := qtx.DeleteCalendarFeedToken(ctx, principal.MemberID); err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to delete calendar feed token")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	if err := qtx.CreateCalendarFeedToken(ctx, repository.CreateCalendarFeedTokenParams{
+		MemberID:  principal.MemberID,
+		TokenHash: hash,
+	}); err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to create calendar feed token")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error().Err(err).Msg("failed to commit calendar feed token")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().Int32("member_id", principal.MemberID).Msg("calendar feed token rotated")
+
+	return c.JSON(http.StatusCreated, FeedTokenResponse{
+		Token:     token,
+		Feeds:     h.feedURLs(token, member.CommitteeID.String),
+		CreatedAt: time.Now(),
+	})
+}
+
+// RevokeFeedTokenHandler deletes the member's feed token
+// @Summary Revoke calendar feed token
+// @Description Delete the authenticated member's calendar feed token. Feed URLs stop working until a new token is created.
+// @Tags calendar
+// @Produce json
+// @Success 200 {object} map[string]string "Feed token revoked"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /calendar/token [delete]
+func (h *Handler) RevokeFeedTokenHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	if err := q.DeleteCalendarFeedToken(c.Request().Context(), principal.MemberID); err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to delete calendar feed token")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().Int32("member_id", principal.MemberID).Msg("calendar feed token revoked")
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Feed token revoked successfully"})
+}
+
+// OrgFeedHandler serves the org-wide feed
+// @Summary Org-wide calendar feed
+// @Description iCalendar feed of every event and tracker deadline of the organization, from 90 days ago. Authenticated by the feed token in the URL.
+// @Tags calendar
+// @Produce text/calendar
+// @Param token path string true "Feed token"
+// @Success 200 {string} string "iCalendar feed"
+// @Failure 404 {object} helpers.ErrorResponse "Feed not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Router /calendar/feeds/{token}/org.ics [get]
+func (h *Handler) OrgFeedHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	if _, ok, err := h.authenticate(c); !ok {
+		return err
+	}
+
+	since := time.Now().Add(-feedHistory)
+	dates, err := q.ListCalendarEventDates(ctx, since)
+	if err != nil {
+		return h.feedError(c, err)
+	}
+	trackers, err := q.ListEventTrackers(ctx)
+	if err != nil {
+		return h.feedError(c, err)
+	}
+
+	return h.writeCalendar(c, "LSCS events", dates, trackers, since)
+}
+
+// MyFeedHandler serves the feed of the events the member heads
+// @Summary My events calendar feed
+// @Description iCalendar feed of the events the token's member heads and their tracker deadlines, from 90 days ago. Authenticated by the feed token in the URL.
+// @Tags calendar
+// @Produce text/calendar
+// @Param token path string true "Feed token"
+// @Success 200 {string} string "iCalendar feed"
+// @Failure 404 {object} helpers.ErrorResponse "Feed not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Router /calendar/feeds/{token}/mine.ics [get]
+func (h *Handler) MyFeedHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	memberID, ok, err := h.authenticate(c)
+	if !ok {
+		return err
+	}
+
+	since := time.Now().Add(-feedHistory)
+	rows, err := q.ListCalendarEventDatesByHead(ctx, repository.ListCalendarEventDatesByHeadParams{
+		EndTime:  since,
+		MemberID: memberID,
+	})
+	if err != nil {
+		return h.feedError(c, err)
+	}
+	trackerRows, err := q.ListEventTrackersByHead(ctx, memberID)
+	if err != nil {
+		return h.feedError(c, err)
+	}
+
+	dates := make([]repository.ListCalendarEventDatesRow, len(rows))
+	for i, r := range rows {
+		dates[i] = repository.ListCalendarEventDatesRow(r)
+	}
+	trackers := make([]repository.ListEventTrackersRow, len(trackerRows))
+	for i, r := range trackerRows {
+		trackers[i] = repository.ListEventTrackersRow(r)
+	}
+
+	return h.writeCalendar(c, "My LSCS events", dates, trackers, since)
+}
+
+// CommitteeFeedHandler serves the feed of a committee's events
+// @Summary Committee calendar feed
+// @Description iCalendar feed of a committee's events and their tracker deadlines, from 90 days ago. Authenticated by the feed token in the URL.
+// @Tags calendar
+// @Produce text/calendar
+// @Param token path string true "Feed token"
+// @Param committee_id path string true "Committee ID, optionally followed by .ics"
+// @Success 200 {string} string "iCalendar feed"
+// @Failure 404 {object} helpers.ErrorResponse "Feed not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Router /calendar/feeds/{token}/committees/{committee_id} [get]
+func (h *Handler) CommitteeFeedHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	if _, ok, err := h.authenticate(c); !ok {
+		return err
+	}
+
+	committeeID := strings.TrimSuffix(c.Param("committee_id"), ".ics")
+	since := time.Now().Add(-feedHistory)
+	rows, err := q.ListCalendarEventDatesByCommittee(ctx, repository.ListCalendarEventDatesByCommitteeParams{
+		EndTime:     since,
+		CommitteeID: committeeID,
+	})
+	if err != nil {
+		return h.feedError(c, err)
+	}
+	trackerRows, err := q.ListEventTrackersByCommittee(ctx, committeeID)
+	if err != nil {
+		return h.feedError(c, err)
+	}
+
+	dates := make([]repository.ListCalendarEventDatesRow, len(rows))
+	for i, r := range rows {
+		dates[i] = repository.ListCalendarEventDatesRow(r)
+	}
+	trackers := make([]repository.ListEventTrackersRow, len(trackerRows))
+	for i, r := range trackerRows {
+		trackers[i] = repository.ListEventTrackersRow(r)
+	}
+
+	return h.writeCalendar(c, committeeID+" events", dates, trackers, since)
+}
+
+// authenticate checks the feed token in the "token" path parameter and returns its member.
+// Unknown tokens get a 404 so feed URLs can't be probed. If ok is false, the error response was already written.
+func (h *Handler) authenticate(c echo.Context) (int32, bool, error) {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	token, err := q.GetCalendarFeedTokenByHash(ctx, hashToken(c.Param("token")))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, false, c.JSON(http.StatusNotFound, map[string]string{"error": "Feed not found"})
+		}
+		log.Error().Err(err).Msg("failed to get calendar feed token")
+		return 0, false, c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	if err := q.TouchCalendarFeedToken(ctx, token.MemberID); err != nil {
+		log.Warn().Err(err).Int32("member_id", token.MemberID).Msg("failed to update calendar feed token usage")
+	}
+
+	return token.MemberID, true, nil
+}
+
+// writeCalendar writes the events and the deadlines since the given time as an .ics file
+func (h *Handler) writeCalendar(c echo.Context, name string, dates []repository.ListCalendarEventDatesRow, trackers []repository.ListEventTrackersRow, since time.Time) error {
+	domain := h.domain()
+
+	cal := Calendar{Name: name, Stamp: time.Now(), Events: []Event{}}
+	for _, d := range dates {
+		cal.Events = append(cal.Events, eventDateEvent(d, domain))
+	}
+	for _, t := range trackers {
+		for _, e := range deadlineEvents(t, domain) {
+			if !e.Start.Before(since) {
+				cal.Events = append(cal.Events, e)
+			}
+		}
+	}
+	sort.SliceStable(cal.Events, func(i, j int) bool {
+		return cal.Events[i].Start.Before(cal.Events[j].Start)
+	})
+
+	c.Response().Header().Set("Cache-Control", "private, max-age=300")
+	return c.Blob(http.StatusOK, "text/calendar; charset=utf-8", cal.Encode())
+}
+
+func (h *Handler) feedError(c echo.Context, err error) error {
+	log.Error().Err(err).Msg("failed to build calendar feed")
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+}
+
+// feedURLs returns the feed URLs for a token. committeeID is the member's committee, if any.
+func (h *Handler) feedURLs(token, committeeID string) FeedURLs {
+	base := fmt.Sprintf("%s/calendar/feeds/%s", h.cfg.PublicBaseURL, token)
+	urls := FeedURLs{
+		Org:  base + "/org.ics",
+		Mine: base + "/mine.ics",
+	}
+	if committeeID != "" {
+		urls.Committee = fmt.Sprintf("%s/committees/%s.ics", base, url.PathEscape(committeeID))
+	}
+	return urls
+}
+
+// domain returns the host used in event UIDs, so they are globally unique (RFC 5545 section 3.8.4.7)
+func (h *Handler) domain() string {
+	if u, err := url.Parse(h.cfg.PublicBaseURL); err == nil && u.Hostname() != "" {
+		return u.Hostname()
+	}
+	return "lscs-core-api"
+}
@@ -0,0 +1,196 @@
+package calendar
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+)
+
+// mockDBService is a mock implementation of the database.Service interface.
+type mockDBService struct {
+	db *sql.DB
+}
+
+func (m *mockDBService) Health() map[string]string {
+	return nil
+}
+
+func (m *mockDBService) Close() error {
+	return nil
+}
+
+func (m *mockDBService) GetConnection() *sql.DB {
+	return m.db
+}
+
+const testToken = "feed-token"
+
+var (
+	tokenColumns     = []string{"member_id", "token_hash", "created_at", "last_used_at"}
+	eventDateColumns = []string{
+		"id", "event_id", "start_time", "end_time", "name", "arn", "committee_id", "venue", "brief_description",
+	}
+	trackerColumns = []string{
+		"event_id", "preacts_deadline", "preacts_status", "postacts_deadline", "postacts_status",
+		"docu_drive_id", "fin_drive_id", "fin_preacts_deadline", "fin_preacts_status",
+		"fin_postacts_deadline", "fin_postacts_status", "name", "committee_id",
+	}
+)
+
+func newHandler(db *sql.DB) *Handler {
+	return NewHandler(&config.Config{PublicBaseURL: "https://api.example.com"}, &mockDBService{db: db})
+}
+
+func newFeedContext(path string, names, values []string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames(names...)
+	c.SetParamValues(values...)
+	return c, rec
+}
+
+func expectToken(mock sqlmock.Sqlmock, memberID int32) {
+	mock.ExpectQuery("SELECT (.+) FROM calendar_feed_tokens WHERE token_hash = ?").
+		WithArgs(hashToken(testToken)).
+		WillReturnRows(sqlmock.NewRows(tokenColumns).AddRow(memberID, hashToken(testToken), time.Now(), nil))
+	mock.ExpectExec("UPDATE calendar_feed_tokens SET last_used_at").
+		WithArgs(memberID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func TestOrgFeedHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		start := time.Now().Add(7 * 24 * time.Hour).Truncate(time.Second)
+		expectToken(mock, 1)
+		mock.ExpectQuery("SELECT (.+) FROM event_dates d").
+			WithArgs(sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows(eventDateColumns).
+				AddRow(10, 1, start, start.Add(2*time.Hour), "Intro to Go", "2526T1-RND-001", "RND", "Online", "Learn Go"))
+		mock.ExpectQuery("SELECT (.+) FROM event_trackers t").
+			WillReturnRows(sqlmock.NewRows(trackerColumns).
+				AddRow(1, start.Add(-48*time.Hour), "ONGOING", nil, "INIT", nil, nil, nil, nil, nil, nil, "Intro to Go", "RND").
+				AddRow(2, time.Now().Add(-365*24*time.Hour), "APPROVED", nil, "INIT", nil, nil, nil, nil, nil, nil, "Old event", "RND"))
+
+		c, rec := newFeedContext("/calendar/feeds/"+testToken+"/org.ics", []string{"token"}, []string{testToken})
+
+		if assert.NoError(t, newHandler(db).OrgFeedHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.True(t, strings.HasPrefix(rec.Header().Get(echo.HeaderContentType), "text/calendar"))
+			body := rec.Body.String()
+			assert.Contains(t, body, "UID:event-date-10@api.example.com")
+			assert.Contains(t, body, "SUMMARY:Pre-acts deadline: Intro to Go")
+			// deadlines older than the feed history are left out
+			assert.NotContains(t, body, "Old event")
+			// deadlines come first since they are before the event
+			assert.Less(t, strings.Index(body, "tracker-1-preacts"), strings.Index(body, "event-date-10"))
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - unknown token", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM calendar_feed_tokens WHERE token_hash = ?").
+			WithArgs(hashToken("wrong")).
+			WillReturnError(sql.ErrNoRows)
+
+		c, rec := newFeedContext("/calendar/feeds/wrong/org.ics", []string{"token"}, []string{"wrong"})
+
+		if assert.NoError(t, newHandler(db).OrgFeedHandler(c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCommitteeFeedHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	expectToken(mock, 1)
+	mock.ExpectQuery("SELECT (.+) FROM event_dates d").
+		WithArgs(sqlmock.AnyArg(), "RND").
+		WillReturnRows(sqlmock.NewRows(eventDateColumns))
+	mock.ExpectQuery("SELECT (.+) FROM event_trackers t").
+		WithArgs("RND").
+		WillReturnRows(sqlmock.NewRows(trackerColumns))
+
+	c, rec := newFeedContext("/calendar/feeds/"+testToken+"/committees/RND.ics",
+		[]string{"token", "committee_id"}, []string{testToken, "RND.ics"})
+
+	if assert.NoError(t, newHandler(db).CommitteeFeedHandler(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "X-WR-CALNAME:RND events")
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRotateFeedTokenHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM members m").
+		WithArgs(int32(1)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "email", "full_name", "nickname", "image_url",
+			"committee_id", "committee_name",
+			"division_id", "division_name",
+			"position_id", "position_name",
+			"house_name",
+			"contact_number", "college", "program",
+			"interests", "discord", "fb_link", "telegram",
+		}).AddRow(
+			1, "test@dlsu.edu.ph", "Test User", nil, nil,
+			"RND", nil,
+			nil, nil,
+			"MEM", nil,
+			nil,
+			nil, nil, nil,
+			nil, nil, nil, nil,
+		))
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM calendar_feed_tokens").
+		WithArgs(int32(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO calendar_feed_tokens").
+		WithArgs(int32(1), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/calendar/token", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+
+	if assert.NoError(t, newHandler(db).RotateFeedTokenHandler(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		var resp FeedTokenResponse
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.Len(t, resp.Token, 64)
+		assert.Equal(t, "https://api.example.com/calendar/feeds/"+resp.Token+"/org.ics", resp.Feeds.Org)
+		assert.Equal(t, "https://api.example.com/calendar/feeds/"+resp.Token+"/committees/RND.ics", resp.Feeds.Committee)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,67 @@
+package calendar
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscapeText(t *testing.T) {
+	assert.Equal(t, `Workshop\, day 1\; bring laptops\nRoom A\\B`, escapeText("Workshop, day 1; bring laptops\r\nRoom A\\B"))
+}
+
+func TestWriteLineFolding(t *testing.T) {
+	var buf bytes.Buffer
+	writeLine(&buf, "DESCRIPTION:"+strings.Repeat("é", 60))
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n")
+	assert.Greater(t, len(lines), 1)
+	for i, line := range lines {
+		assert.LessOrEqual(t, len(line), maxLineOctets)
+		if i > 0 {
+			assert.True(t, strings.HasPrefix(line, " "))
+		}
+	}
+
+	// unfolding gives back the original line, without split characters
+	unfolded := strings.ReplaceAll(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n ", "")
+	assert.Equal(t, "DESCRIPTION:"+strings.Repeat("é", 60), unfolded)
+}
+
+func TestCalendarEncode(t *testing.T) {
+	start := time.Date(2026, 11, 5, 21, 0, 0, 0, time.FixedZone("PHT", 8*60*60))
+	cal := Calendar{
+		Name:  "LSCS events",
+		Stamp: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+		Events: []Event{
+			{
+				UID:        "event-date-1@example.com",
+				Summary:    "Intro to Go, part 1",
+				Location:   "Online",
+				Categories: []string{"RND"},
+				Start:      start,
+				End:        start.Add(2 * time.Hour),
+			},
+			{
+				UID:     "tracker-1-preacts@example.com",
+				Summary: "Pre-acts deadline: Intro to Go",
+				Start:   start,
+			},
+		},
+	}
+
+	out := string(cal.Encode())
+	assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(out, "END:VCALENDAR\r\n"))
+	assert.Contains(t, out, "X-WR-CALNAME:LSCS events\r\n")
+	assert.Contains(t, out, "DTSTART:20261105T130000Z\r\n")
+	assert.Contains(t, out, "DTEND:20261105T150000Z\r\n")
+	assert.Contains(t, out, "SUMMARY:Intro to Go\\, part 1\r\n")
+	assert.Contains(t, out, "DTSTAMP:20261018T000000Z\r\n")
+	assert.Equal(t, 2, strings.Count(out, "BEGIN:VEVENT"))
+	// the deadline has no end
+	assert.Equal(t, 1, strings.Count(out, "DTEND:"))
+}
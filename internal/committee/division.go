@@ -0,0 +1,267 @@
+package committee
+
+import (
+	"database/sql"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// GetAllDivisionsHandler godoc
+// @Summary Get all divisions
+// @Description Retrieves a list of all divisions in the organization
+// @Tags divisions
+// @Produce json
+// @Success 200 {object} GetAllDivisionsResponse "List of divisions"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /divisions [get]
+func (h *Handler) GetAllDivisionsHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	divisions, err := q.GetAllDivisions(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get all divisions")
+		return helpers.ErrInternal(c, "")
+	}
+
+	response := make([]DivisionResponse, len(divisions))
+	for i, div := range divisions {
+		response[i] = DivisionResponse{
+			DivisionID:   div.DivisionID,
+			DivisionName: div.DivisionName,
+		}
+		if div.DivisionHead.Valid {
+			response[i].DivisionHead = &div.DivisionHead.Int32
+		}
+	}
+
+	return c.JSON(http.StatusOK, GetAllDivisionsResponse{
+		Divisions: response,
+	})
+}
+
+// GetDivisionHandler godoc
+// @Summary Get division
+// @Description Retrieves a division with its head
+// @Tags divisions
+// @Produce json
+// @Param id path string true "Division ID"
+// @Success 200 {object} DivisionResponse "Division"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "Division not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /divisions/{id} [get]
+func (h *Handler) GetDivisionHandler(c echo.Context) error {
+	return h.respondWithDivision(c, http.StatusOK, normalizeID(c.Param("id")))
+}
+
+// CreateDivisionHandler godoc
+// @Summary Create division
+// @Description Creates a division. Admin only.
+// @Tags divisions
+// @Accept json
+// @Produce json
+// @Param request body CreateDivisionRequest true "Division"
+// @Success 201 {object} DivisionResponse "Created division"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 409 {object} helpers.ErrorResponse "Division already exists"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /divisions [post]
+func (h *Handler) CreateDivisionHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	var req CreateDivisionRequest
+	if err := c.Bind(&req); err != nil {
+		return helpers.ErrBadRequest(c, "Invalid request format")
+	}
+	req.DivisionID = normalizeID(req.DivisionID)
+	req.DivisionName = strings.TrimSpace(req.DivisionName)
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	err := q.CreateDivision(c.Request().Context(), repository.CreateDivisionParams{
+		DivisionID:   req.DivisionID,
+		DivisionName: req.DivisionName,
+	})
+	if err != nil {
+		if helpers.IsDuplicateEntry(err) {
+			return helpers.ErrConflict(c, "Division already exists")
+		}
+		log.Error().Err(err).Msg("failed to create division")
+		return helpers.ErrInternal(c, "")
+	}
+
+	log.Info().Str("division_id", req.DivisionID).Int32("created_by", principalID(c)).Msg("division created")
+
+	return h.respondWithDivision(c, http.StatusCreated, req.DivisionID)
+}
+
+// UpdateDivisionHandler godoc
+// @Summary Update division
+// @Description Renames a division. Admin only.
+// @Tags divisions
+// @Accept json
+// @Produce json
+// @Param id path string true "Division ID"
+// @Param request body UpdateDivisionRequest true "Division"
+// @Success 200 {object} DivisionResponse "Updated division"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Division not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /divisions/{id} [put]
+func (h *Handler) UpdateDivisionHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+	id := normalizeID(c.Param("id"))
+
+	var req UpdateDivisionRequest
+	if err := c.Bind(&req); err != nil {
+		return helpers.ErrBadRequest(c, "Invalid request format")
+	}
+	req.DivisionName = strings.TrimSpace(req.DivisionName)
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	if _, err := q.GetDivision(ctx, id); err != nil {
+		return notFoundOrInternal(c, err, "Division not found", "failed to get division")
+	}
+
+	if err := q.UpdateDivision(ctx, repository.UpdateDivisionParams{DivisionName: req.DivisionName, DivisionID: id}); err != nil {
+		log.Error().Err(err).Str("division_id", id).Msg("failed to update division")
+		return helpers.ErrInternal(c, "")
+	}
+
+	log.Info().Str("division_id", id).Int32("updated_by", principalID(c)).Msg("division updated")
+
+	return h.respondWithDivision(c, http.StatusOK, id)
+}
+
+// SetDivisionHeadHandler godoc
+// @Summary Assign division head
+// @Description Assigns the head of a division. The head must be a member of one of the division's committees. Omitting member_id removes the head. Admin only.
+// @Tags divisions
+// @Accept json
+// @Produce json
+// @Param id path string true "Division ID"
+// @Param request body HeadRequest true "Head"
+// @Success 200 {object} DivisionResponse "Updated division"
+// @Failure 400 {object} helpers.ErrorResponse "Unknown member or not a member of the division"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Division not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /divisions/{id}/head [put]
+func (h *Handler) SetDivisionHeadHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+	id := normalizeID(c.Param("id"))
+
+	var req HeadRequest
+	if err := c.Bind(&req); err != nil {
+		return helpers.ErrBadRequest(c, "Invalid request format")
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	if _, err := q.GetDivision(ctx, id); err != nil {
+		return notFoundOrInternal(c, err, "Division not found", "failed to get division")
+	}
+
+	var head sql.NullInt32
+	if req.MemberID != nil {
+		member, err := q.GetMemberInfoById(ctx, *req.MemberID)
+		if err == sql.ErrNoRows {
+			return helpers.ErrBadRequest(c, "Unknown member")
+		}
+		if err != nil {
+			log.Error().Err(err).Int32("member_id", *req.MemberID).Msg("failed to get member")
+			return helpers.ErrInternal(c, "")
+		}
+		if member.DivisionID.String != id {
+			return helpers.ErrBadRequest(c, "Head must be a member of a committee in the division")
+		}
+		head = sql.NullInt32{Int32: member.ID, Valid: true}
+	}
+
+	if err := q.SetDivisionHead(ctx, repository.SetDivisionHeadParams{DivisionHead: head, DivisionID: id}); err != nil {
+		log.Error().Err(err).Str("division_id", id).Msg("failed to set division head")
+		return helpers.ErrInternal(c, "")
+	}
+
+	log.Info().
+		Str("division_id", id).
+		Int32("head", head.Int32).
+		Int32("assigned_by", principalID(c)).
+		Msg("division head assigned")
+
+	return h.respondWithDivision(c, http.StatusOK, id)
+}
+
+// DeleteDivisionHandler godoc
+// @Summary Delete division
+// @Description Deletes a division. Its committees are left without a division. Admin only.
+// @Tags divisions
+// @Produce json
+// @Param id path string true "Division ID"
+// @Success 200 {object} map[string]string "Division deleted"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Division not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /divisions/{id} [delete]
+func (h *Handler) DeleteDivisionHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+	id := normalizeID(c.Param("id"))
+
+	rows, err := q.DeleteDivision(c.Request().Context(), id)
+	if err != nil {
+		log.Error().Err(err).Str("division_id", id).Msg("failed to delete division")
+		return helpers.ErrInternal(c, "")
+	}
+	if rows == 0 {
+		return helpers.ErrNotFound(c, "Division not found")
+	}
+
+	log.Info().Str("division_id", id).Int32("deleted_by", principalID(c)).Msg("division deleted")
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Division deleted successfully"})
+}
+
+func (h *Handler) respondWithDivision(c echo.Context, status int, id string) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	division, err := q.GetDivision(c.Request().Context(), id)
+	if err != nil {
+		return notFoundOrInternal(c, err, "Division not found", "failed to get division")
+	}
+
+	response := DivisionResponse{
+		DivisionID:   division.DivisionID,
+		DivisionName: division.DivisionName,
+		Head:         toHeadResponse(division.DivisionHead, division.HeadName),
+	}
+	if division.DivisionHead.Valid {
+		response.DivisionHead = &division.DivisionHead.Int32
+	}
+
+	return c.JSON(status, response)
+}
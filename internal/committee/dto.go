@@ -1,5 +1,7 @@
 package committee
 
+import "github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+
 // CommitteeResponse represents a committee in API responses
 type CommitteeResponse struct {
 	CommitteeID   string  `json:"committee_id" example:"CREATIVES"`
@@ -12,3 +14,82 @@ type CommitteeResponse struct {
 type GetAllCommitteesResponse struct {
 	Committees []CommitteeResponse `json:"committees"`
 }
+
+// CreateCommitteeRequest represents the request body for creating a committee
+type CreateCommitteeRequest struct {
+	CommitteeID   string  `json:"committee_id" validate:"required,max=10,alphanum" example:"RND"`
+	CommitteeName string  `json:"committee_name" validate:"required,max=100" example:"Research and Development"`
+	DivisionID    *string `json:"division_id,omitempty" validate:"omitempty,max=10" example:"INTERNALS"`
+}
+
+// UpdateCommitteeRequest represents the request body for renaming a committee or moving it to another division.
+// Omitting division_id leaves the committee without a division.
+type UpdateCommitteeRequest struct {
+	CommitteeName string  `json:"committee_name" validate:"required,max=100" example:"Research and Development"`
+	DivisionID    *string `json:"division_id,omitempty" validate:"omitempty,max=10" example:"INTERNALS"`
+}
+
+// CreateDivisionRequest represents the request body for creating a division
+type CreateDivisionRequest struct {
+	DivisionID   string `json:"division_id" validate:"required,max=10,alphanum" example:"INTERNALS"`
+	DivisionName string `json:"division_name" validate:"required,max=100" example:"Internals"`
+}
+
+// UpdateDivisionRequest represents the request body for renaming a division
+type UpdateDivisionRequest struct {
+	DivisionName string `json:"division_name" validate:"required,max=100" example:"Internals"`
+}
+
+// HeadRequest represents the request body for assigning a committee or division head.
+// Omitting member_id removes the head.
+type HeadRequest struct {
+	MemberID *int32 `json:"member_id,omitempty" validate:"omitempty,gt=0" example:"12312345"`
+}
+
+// HeadResponse represents the head of a committee or division
+type HeadResponse struct {
+	ID       int32  `json:"id" example:"12312345"`
+	FullName string `json:"full_name" example:"Juan Dela Cruz"`
+}
+
+// DivisionResponse represents a division in API responses
+type DivisionResponse struct {
+	DivisionID   string        `json:"division_id" example:"INTERNALS"`
+	DivisionName string        `json:"division_name" example:"Internals"`
+	DivisionHead *int32        `json:"division_head,omitempty" example:"123"`
+	Head         *HeadResponse `json:"head,omitempty"`
+}
+
+// GetAllDivisionsResponse is the response for the GET /divisions endpoint
+type GetAllDivisionsResponse struct {
+	Divisions []DivisionResponse `json:"divisions"`
+}
+
+// CommitteeMemberResponse represents a member listed under a committee
+type CommitteeMemberResponse struct {
+	ID       int32                  `json:"id" example:"12312345"`
+	FullName string                 `json:"full_name" example:"Juan Dela Cruz"`
+	Nickname helpers.NullableString `json:"nickname"`
+	Email    string                 `json:"email" example:"juan_delacruz@dlsu.edu.ph"`
+	ImageURL helpers.NullableString `json:"image_url"`
+}
+
+// PositionGroupResponse lists the members of a committee holding a position.
+// Members without a position are grouped under a null position_id.
+type PositionGroupResponse struct {
+	PositionID   helpers.NullableString    `json:"position_id"`
+	PositionName helpers.NullableString    `json:"position_name"`
+	Members      []CommitteeMemberResponse `json:"members"`
+}
+
+// CommitteeDetailResponse is the response for the GET /committees/{id} endpoint.
+// Positions are ordered from the highest position down.
+type CommitteeDetailResponse struct {
+	CommitteeID   string                  `json:"committee_id" example:"RND"`
+	CommitteeName string                  `json:"committee_name" example:"Research and Development"`
+	DivisionID    *string                 `json:"division_id,omitempty" example:"INTERNALS"`
+	DivisionName  *string                 `json:"division_name,omitempty" example:"Internals"`
+	Head          *HeadResponse           `json:"head,omitempty"`
+	MemberCount   int                     `json:"member_count" example:"25"`
+	Positions     []PositionGroupResponse `json:"positions"`
+}
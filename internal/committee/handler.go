@@ -1,10 +1,14 @@
 package committee
 
 import (
+	"database/sql"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
 	"github.com/dlsu-lscs/lscs-core-api/internal/database"
 	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
 	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
@@ -62,3 +66,313 @@ func (h *Handler) GetAllCommitteesHandler(c echo.Context) error {
 		Committees: response,
 	})
 }
+
+// GetCommitteeHandler godoc
+// @Summary Get committee
+// @Description Retrieves a committee with its division, head and members grouped by position (highest position first)
+// @Tags committees
+// @Produce json
+// @Param id path string true "Committee ID"
+// @Success 200 {object} CommitteeDetailResponse "Committee"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "Committee not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /committees/{id} [get]
+func (h *Handler) GetCommitteeHandler(c echo.Context) error {
+	return h.respondWithCommittee(c, http.StatusOK, normalizeID(c.Param("id")))
+}
+
+// CreateCommitteeHandler godoc
+// @Summary Create committee
+// @Description Creates a committee, optionally under a division. Admin only.
+// @Tags committees
+// @Accept json
+// @Produce json
+// @Param request body CreateCommitteeRequest true "Committee"
+// @Success 201 {object} CommitteeDetailResponse "Created committee"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request or unknown division"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 409 {object} helpers.ErrorResponse "Committee already exists"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /committees [post]
+func (h *Handler) CreateCommitteeHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	var req CreateCommitteeRequest
+	if err := c.Bind(&req); err != nil {
+		return helpers.ErrBadRequest(c, "Invalid request format")
+	}
+	req.CommitteeID = normalizeID(req.CommitteeID)
+	req.CommitteeName = strings.TrimSpace(req.CommitteeName)
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	err := q.CreateCommittee(c.Request().Context(), repository.CreateCommitteeParams{
+		CommitteeID:   req.CommitteeID,
+		CommitteeName: req.CommitteeName,
+		DivisionID:    toDivisionID(req.DivisionID),
+	})
+	if err != nil {
+		switch {
+		case helpers.IsDuplicateEntry(err):
+			return helpers.ErrConflict(c, "Committee already exists")
+		case helpers.IsForeignKeyViolation(err):
+			return helpers.ErrBadRequest(c, "Unknown division")
+		}
+		log.Error().Err(err).Msg("failed to create committee")
+		return helpers.ErrInternal(c, "")
+	}
+
+	log.Info().Str("committee_id", req.CommitteeID).Int32("created_by", principalID(c)).Msg("committee created")
+
+	return h.respondWithCommittee(c, http.StatusCreated, req.CommitteeID)
+}
+
+// UpdateCommitteeHandler godoc
+// @Summary Update committee
+// @Description Renames a committee and sets its division. Omitting division_id leaves the committee without a division. Admin only.
+// @Tags committees
+// @Accept json
+// @Produce json
+// @Param id path string true "Committee ID"
+// @Param request body UpdateCommitteeRequest true "Committee"
+// @Success 200 {object} CommitteeDetailResponse "Updated committee"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request or unknown division"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Committee not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /committees/{id} [put]
+func (h *Handler) UpdateCommitteeHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+	id := normalizeID(c.Param("id"))
+
+	var req UpdateCommitteeRequest
+	if err := c.Bind(&req); err != nil {
+		return helpers.ErrBadRequest(c, "Invalid request format")
+	}
+	req.CommitteeName = strings.TrimSpace(req.CommitteeName)
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	if _, err := q.GetCommittee(ctx, id); err != nil {
+		return notFoundOrInternal(c, err, "Committee not found", "failed to get committee")
+	}
+
+	if err := q.UpdateCommittee(ctx, repository.UpdateCommitteeParams{
+		CommitteeName: req.CommitteeName,
+		DivisionID:    toDivisionID(req.DivisionID),
+		CommitteeID:   id,
+	}); err != nil {
+		if helpers.IsForeignKeyViolation(err) {
+			return helpers.ErrBadRequest(c, "Unknown division")
+		}
+		log.Error().Err(err).Str("committee_id", id).Msg("failed to update committee")
+		return helpers.ErrInternal(c, "")
+	}
+
+	log.Info().Str("committee_id", id).Int32("updated_by", principalID(c)).Msg("committee updated")
+
+	return h.respondWithCommittee(c, http.StatusOK, id)
+}
+
+// SetCommitteeHeadHandler godoc
+// @Summary Assign committee head
+// @Description Assigns the head of a committee. The head must be a member of the committee. Omitting member_id removes the head. Admin only.
+// @Tags committees
+// @Accept json
+// @Produce json
+// @Param id path string true "Committee ID"
+// @Param request body HeadRequest true "Head"
+// @Success 200 {object} CommitteeDetailResponse "Updated committee"
+// @Failure 400 {object} helpers.ErrorResponse "Unknown member or not a member of the committee"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Committee not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /committees/{id}/head [put]
+func (h *Handler) SetCommitteeHeadHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+	id := normalizeID(c.Param("id"))
+
+	var req HeadRequest
+	if err := c.Bind(&req); err != nil {
+		return helpers.ErrBadRequest(c, "Invalid request format")
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	if _, err := q.GetCommittee(ctx, id); err != nil {
+		return notFoundOrInternal(c, err, "Committee not found", "failed to get committee")
+	}
+
+	var head sql.NullInt32
+	if req.MemberID != nil {
+		member, err := q.GetMemberInfoById(ctx, *req.MemberID)
+		if err == sql.ErrNoRows {
+			return helpers.ErrBadRequest(c, "Unknown member")
+		}
+		if err != nil {
+			log.Error().Err(err).Int32("member_id", *req.MemberID).Msg("failed to get member")
+			return helpers.ErrInternal(c, "")
+		}
+		if member.CommitteeID.String != id {
+			return helpers.ErrBadRequest(c, "Head must be a member of the committee")
+		}
+		head = sql.NullInt32{Int32: member.ID, Valid: true}
+	}
+
+	if err := q.SetCommitteeHead(ctx, repository.SetCommitteeHeadParams{CommitteeHead: head, CommitteeID: id}); err != nil {
+		log.Error().Err(err).Str("committee_id", id).Msg("failed to set committee head")
+		return helpers.ErrInternal(c, "")
+	}
+
+	log.Info().
+		Str("committee_id", id).
+		Int32("head", head.Int32).
+		Int32("assigned_by", principalID(c)).
+		Msg("committee head assigned")
+
+	return h.respondWithCommittee(c, http.StatusOK, id)
+}
+
+// DeleteCommitteeHandler godoc
+// @Summary Delete committee
+// @Description Deletes a committee. Its members are left without a committee. Committees with events cannot be deleted. Admin only.
+// @Tags committees
+// @Produce json
+// @Param id path string true "Committee ID"
+// @Success 200 {object} map[string]string "Committee deleted"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Committee not found"
+// @Failure 409 {object} helpers.ErrorResponse "Committee has events"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /committees/{id} [delete]
+func (h *Handler) DeleteCommitteeHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+	id := normalizeID(c.Param("id"))
+
+	rows, err := q.DeleteCommittee(c.Request().Context(), id)
+	if err != nil {
+		if helpers.IsForeignKeyViolation(err) {
+			return helpers.ErrConflict(c, "Committee has events and cannot be deleted")
+		}
+		log.Error().Err(err).Str("committee_id", id).Msg("failed to delete committee")
+		return helpers.ErrInternal(c, "")
+	}
+	if rows == 0 {
+		return helpers.ErrNotFound(c, "Committee not found")
+	}
+
+	log.Info().Str("committee_id", id).Int32("deleted_by", principalID(c)).Msg("committee deleted")
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Committee deleted successfully"})
+}
+
+func (h *Handler) respondWithCommittee(c echo.Context, status int, id string) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	committee, err := q.GetCommittee(ctx, id)
+	if err != nil {
+		return notFoundOrInternal(c, err, "Committee not found", "failed to get committee")
+	}
+
+	members, err := q.ListCommitteeMembers(ctx, sql.NullString{String: id, Valid: true})
+	if err != nil {
+		log.Error().Err(err).Str("committee_id", id).Msg("failed to list committee members")
+		return helpers.ErrInternal(c, "")
+	}
+
+	response := CommitteeDetailResponse{
+		CommitteeID:   committee.CommitteeID,
+		CommitteeName: committee.CommitteeName,
+		Head:          toHeadResponse(committee.CommitteeHead, committee.HeadName),
+		MemberCount:   len(members),
+		Positions:     groupByPosition(members),
+	}
+	if committee.DivisionID.Valid {
+		response.DivisionID = &committee.DivisionID.String
+		response.DivisionName = &committee.DivisionName.String
+	}
+
+	return c.JSON(status, response)
+}
+
+// groupByPosition groups committee members by position, highest position first.
+// Members keep their order (by name) within a group.
+func groupByPosition(members []repository.ListCommitteeMembersRow) []PositionGroupResponse {
+	groups := []PositionGroupResponse{}
+	index := map[string]int{}
+	for _, m := range members {
+		i, ok := index[m.PositionID.String]
+		if !ok {
+			i = len(groups)
+			index[m.PositionID.String] = i
+			groups = append(groups, PositionGroupResponse{
+				PositionID:   helpers.NullableString{NullString: m.PositionID},
+				PositionName: helpers.NullableString{NullString: m.PositionName},
+				Members:      []CommitteeMemberResponse{},
+			})
+		}
+		groups[i].Members = append(groups[i].Members, CommitteeMemberResponse{
+			ID:       m.ID,
+			FullName: m.FullName,
+			Nickname: helpers.NullableString{NullString: m.Nickname},
+			Email:    m.Email,
+			ImageURL: helpers.NullableString{NullString: m.ImageUrl},
+		})
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return auth.GetPositionLevel(groups[i].PositionID.String) > auth.GetPositionLevel(groups[j].PositionID.String)
+	})
+	return groups
+}
+
+func toHeadResponse(id sql.NullInt32, name sql.NullString) *HeadResponse {
+	if !id.Valid {
+		return nil
+	}
+	return &HeadResponse{ID: id.Int32, FullName: name.String}
+}
+
+// toDivisionID converts an optional division ID to sql.NullString, an empty ID means no division
+func toDivisionID(id *string) sql.NullString {
+	if id == nil || normalizeID(*id) == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: normalizeID(*id), Valid: true}
+}
+
+// normalizeID returns committee and division IDs the way they are stored (e.g. "RND")
+func normalizeID(id string) string {
+	return strings.ToUpper(strings.TrimSpace(id))
+}
+
+func notFoundOrInternal(c echo.Context, err error, notFound, msg string) error {
+	if err == sql.ErrNoRows {
+		return helpers.ErrNotFound(c, notFound)
+	}
+	log.Error().Err(err).Msg(msg)
+	return helpers.ErrInternal(c, "")
+}
+
+func principalID(c echo.Context) int32 {
+	if principal, ok := auth.GetPrincipal(c); ok {
+		return principal.MemberID
+	}
+	return 0
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -59,3 +60,91 @@ func TestGetAllCommitteesHandler(t *testing.T) {
 		assert.Equal(t, "INT", *resp.Committees[0].DivisionID)
 	}
 }
+
+func TestGetCommitteeHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM committees c").
+		WithArgs("RND").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"committee_id", "committee_name", "committee_head", "division_id", "division_name", "head_name",
+		}).AddRow("RND", "Research and Development", 1, "INT", "Internals", "Head User"))
+	mock.ExpectQuery("SELECT (.+) FROM members m").
+		WithArgs(sql.NullString{String: "RND", Valid: true}).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "full_name", "nickname", "email", "image_url", "position_id", "position_name",
+		}).
+			AddRow(3, "Member One", nil, "one@dlsu.edu.ph", nil, "MEM", "Member").
+			AddRow(1, "Head User", nil, "head@dlsu.edu.ph", nil, "VP", "Vice President").
+			AddRow(2, "Member Two", nil, "two@dlsu.edu.ph", nil, "MEM", "Member"))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/committees/rnd", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("rnd")
+
+	if assert.NoError(t, NewHandler(&mockDBService{db: db}).GetCommitteeHandler(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		// helpers.NullableString only marshals, so decode the positions loosely
+		var resp struct {
+			MemberCount int           `json:"member_count"`
+			Head        *HeadResponse `json:"head"`
+			Positions   []struct {
+				PositionID string            `json:"position_id"`
+				Members    []json.RawMessage `json:"members"`
+			} `json:"positions"`
+		}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.Equal(t, 3, resp.MemberCount)
+		if assert.NotNil(t, resp.Head) {
+			assert.Equal(t, "Head User", resp.Head.FullName)
+		}
+		if assert.Len(t, resp.Positions, 2) {
+			assert.Equal(t, "VP", resp.Positions[0].PositionID)
+			assert.Len(t, resp.Positions[1].Members, 2)
+		}
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetCommitteeHeadHandlerNotMember(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM committees c").
+		WithArgs("RND").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"committee_id", "committee_name", "committee_head", "division_id", "division_name", "head_name",
+		}).AddRow("RND", "Research and Development", nil, nil, nil, nil))
+	mock.ExpectQuery("SELECT (.+) FROM members m").
+		WithArgs(int32(5)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "email", "full_name", "nickname", "image_url",
+			"committee_id", "committee_name", "division_id", "division_name",
+			"position_id", "position_name", "house_name",
+			"contact_number", "college", "program", "interests", "discord", "fb_link", "telegram",
+		}).AddRow(
+			5, "other@dlsu.edu.ph", "Other User", nil, nil,
+			"PUB", "Publicity", nil, nil,
+			"VP", nil, nil,
+			nil, nil, nil, nil, nil, nil, nil,
+		))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPut, "/committees/RND/head", strings.NewReader(`{"member_id":5}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("RND")
+
+	if assert.NoError(t, NewHandler(&mockDBService{db: db}).SetCommitteeHeadHandler(c)) {
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
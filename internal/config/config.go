@@ -16,6 +16,7 @@ type Config struct {
 	ServerIdleTimeout  time.Duration
 	ServerReadTimeout  time.Duration
 	ServerWriteTimeout time.Duration
+	PublicBaseURL      string // base URL this API is reached at, used in links it hands out (e.g. calendar feeds)
 
 	// Database
 	DBHost     string
@@ -34,11 +35,85 @@ type Config struct {
 	GoogleClientSecret string
 	OAuthRedirectURL   string
 
+	// OAuth2 client credentials (service-to-service)
+	OAuthClientTokenTTL time.Duration
+
+	// OIDC provider ("Sign in with LSCS")
+	OIDCIssuer         string // issuer identifier, PublicBaseURL unless the issuer is served elsewhere
+	OIDCSigningKeyFile string // PEM-encoded RSA private key used to sign ID tokens
+
 	// Session (Web UI)
 	SessionSecret           string
 	SessionDuration         int // seconds, default 24 hours
 	SessionRememberDuration int // seconds, default 30 days
 
+	// Step-up re-authentication for sensitive actions
+	StepUpMaxAge time.Duration // how recent the last Google login must be
+
+	// Event trackers: committees that review documentation and finance requirements
+	DocuCommitteeID string
+	FinCommitteeID  string
+
+	// Publicity requests: committee that claims and produces publicity materials
+	PubCommitteeID string
+
+	// Event tracker deadlines: defaults are computed from the event dates
+	TrackerDeadlineJobInterval  time.Duration
+	TrackerPreactsDaysBefore    int   // pre-acts are due this many days before the event starts
+	TrackerPostactsDaysAfter    int   // post-acts are due this many days after the event ends
+	TrackerFinPreactsDaysBefore int   // same, for finance pre-acts
+	TrackerFinPostactsDaysAfter int   // same, for finance post-acts
+	TrackerReminderDays         []int // reminders are sent this many days before a deadline
+	TrackerOverdueNoticeDays    int   // overdue notices are only sent this many days after a deadline
+
+	// Outbound webhooks
+	WebhookDispatchInterval time.Duration // how often the delivery queue is polled
+	WebhookMaxAttempts      int           // deliveries are marked FAILED after this many attempts
+	WebhookTimeout          time.Duration // timeout of each delivery request
+
+	// Discord role sync: roles are mapped from committee, position and house IDs
+	DiscordBotToken       string
+	DiscordGuildID        string
+	DiscordClientID       string // OAuth app members sign in with to link their account
+	DiscordClientSecret   string
+	DiscordCommitteeRoles map[string]string // committee_id -> Discord role ID
+	DiscordPositionRoles  map[string]string // position_id -> Discord role ID
+	DiscordHouseRoles     map[string]string // house id -> Discord role ID
+
+	// Telegram bot (webhook mode)
+	TelegramBotToken      string
+	TelegramWebhookSecret string        // sent by Telegram in X-Telegram-Bot-Api-Secret-Token
+	TelegramLinkCodeTTL   time.Duration // how long a one-time link code is valid
+
+	// Google Drive event folders: created from a template once an event's pre-acts are approved
+	DriveCredentialsFile string        // service account key (JSON)
+	DriveParentFolderID  string        // event folders are created in this folder
+	DriveTemplateFile    string        // folder template (JSON); a built-in template is used if empty
+	DriveFake            bool          // use an in-memory fake instead of Google Drive, for local testing
+	DriveJobInterval     time.Duration // how often approved events are checked for missing folders
+
+	// Email notifications: queued in an outbox and sent by a background dispatcher
+	EmailSink             string // "smtp", "file" (writes .eml files, for development) or "log"
+	EmailFrom             string
+	EmailOutboxDir        string        // where the file sink writes emails
+	EmailDispatchInterval time.Duration // how often the outbox is polled
+	EmailMaxAttempts      int           // emails are marked FAILED after this many attempts
+	SMTPHost              string
+	SMTPPort              int // STARTTLS is used when the server supports it
+	SMTPUsername          string
+	SMTPPassword          string
+	KeyExpiryWarningDays  []int // API key owners are warned this many days before the key expires
+
+	// In-app notifications: open streams check for notifications added by other instances this often
+	NotificationStreamPollInterval time.Duration
+
+	// GraphQL: queries over these limits are rejected before they run
+	GraphQLMaxCost  int // estimated number of objects a query may load, see internal/graph/cost.go
+	GraphQLMaxDepth int
+
+	// gRPC API for internal services, served alongside the HTTP server (0 disables it)
+	GRPCPort int
+
 	// CORS
 	AllowedOrigins []string
 
@@ -62,6 +137,8 @@ func Load() (*Config, error) {
 		return cfg, nil
 	}
 
+	publicBaseURL := strings.TrimSuffix(getEnv("PUBLIC_BASE_URL", "http://localhost:8080"), "/")
+
 	cfg = &Config{
 		// Server
 		Port:               getEnvInt("PORT", 8080),
@@ -69,6 +146,7 @@ func Load() (*Config, error) {
 		ServerIdleTimeout:  getEnvDuration("SERVER_IDLE_TIMEOUT", time.Minute),
 		ServerReadTimeout:  getEnvDuration("SERVER_READ_TIMEOUT", 10*time.Second),
 		ServerWriteTimeout: getEnvDuration("SERVER_WRITE_TIMEOUT", 30*time.Second),
+		PublicBaseURL:      publicBaseURL,
 
 		// Database
 		DBHost:     getEnvRequired("DB_HOST"),
@@ -87,11 +165,85 @@ func Load() (*Config, error) {
 		GoogleClientSecret: getEnv("GOOGLE_CLIENT_SECRET", ""),
 		OAuthRedirectURL:   getEnv("OAUTH_REDIRECT_URL", "http://localhost:8080/auth/google/callback"),
 
+		// OAuth2 client credentials (service-to-service)
+		OAuthClientTokenTTL: getEnvDuration("OAUTH_CLIENT_TOKEN_TTL", 15*time.Minute),
+
+		// OIDC provider ("Sign in with LSCS")
+		OIDCIssuer:         strings.TrimSuffix(getEnv("OIDC_ISSUER", publicBaseURL), "/"),
+		OIDCSigningKeyFile: getEnv("OIDC_SIGNING_KEY_FILE", ""),
+
 		// Session (Web UI)
 		SessionSecret:           getEnv("SESSION_SECRET", ""),
 		SessionDuration:         getEnvInt("SESSION_DURATION", 86400),            // 24 hours
 		SessionRememberDuration: getEnvInt("SESSION_REMEMBER_DURATION", 2592000), // 30 days
 
+		// Step-up re-authentication for sensitive actions
+		StepUpMaxAge: getEnvDuration("STEP_UP_MAX_AGE", 10*time.Minute),
+
+		// Event trackers
+		DocuCommitteeID: getEnv("DOCU_COMMITTEE_ID", "DOCU"),
+		FinCommitteeID:  getEnv("FIN_COMMITTEE_ID", "FIN"),
+
+		// Publicity requests
+		PubCommitteeID: getEnv("PUB_COMMITTEE_ID", "PUB"),
+
+		// Event tracker deadlines
+		TrackerDeadlineJobInterval:  getEnvDuration("TRACKER_DEADLINE_JOB_INTERVAL", time.Hour),
+		TrackerPreactsDaysBefore:    getEnvInt("TRACKER_PREACTS_DAYS_BEFORE", 14),
+		TrackerPostactsDaysAfter:    getEnvInt("TRACKER_POSTACTS_DAYS_AFTER", 14),
+		TrackerFinPreactsDaysBefore: getEnvInt("TRACKER_FIN_PREACTS_DAYS_BEFORE", 21),
+		TrackerFinPostactsDaysAfter: getEnvInt("TRACKER_FIN_POSTACTS_DAYS_AFTER", 30),
+		TrackerReminderDays:         getEnvIntList("TRACKER_REMINDER_DAYS", []int{7, 3, 1}),
+		TrackerOverdueNoticeDays:    getEnvInt("TRACKER_OVERDUE_NOTICE_DAYS", 7),
+
+		// Outbound webhooks
+		WebhookDispatchInterval: getEnvDuration("WEBHOOK_DISPATCH_INTERVAL", 5*time.Second),
+		WebhookMaxAttempts:      getEnvInt("WEBHOOK_MAX_ATTEMPTS", 8),
+		WebhookTimeout:          getEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+
+		// Discord role sync
+		DiscordBotToken:       getEnv("DISCORD_BOT_TOKEN", ""),
+		DiscordGuildID:        getEnv("DISCORD_GUILD_ID", ""),
+		DiscordClientID:       getEnv("DISCORD_CLIENT_ID", ""),
+		DiscordClientSecret:   getEnv("DISCORD_CLIENT_SECRET", ""),
+		DiscordCommitteeRoles: getEnvMap("DISCORD_COMMITTEE_ROLES"),
+		DiscordPositionRoles:  getEnvMap("DISCORD_POSITION_ROLES"),
+		DiscordHouseRoles:     getEnvMap("DISCORD_HOUSE_ROLES"),
+
+		// Telegram bot
+		TelegramBotToken:      getEnv("TELEGRAM_BOT_TOKEN", ""),
+		TelegramWebhookSecret: getEnv("TELEGRAM_WEBHOOK_SECRET", ""),
+		TelegramLinkCodeTTL:   getEnvDuration("TELEGRAM_LINK_CODE_TTL", 10*time.Minute),
+
+		// Google Drive event folders
+		DriveCredentialsFile: getEnv("DRIVE_CREDENTIALS_FILE", ""),
+		DriveParentFolderID:  getEnv("DRIVE_PARENT_FOLDER_ID", ""),
+		DriveTemplateFile:    getEnv("DRIVE_TEMPLATE_FILE", ""),
+		DriveFake:            getEnv("DRIVE_FAKE", "") == "true",
+		DriveJobInterval:     getEnvDuration("DRIVE_JOB_INTERVAL", 5*time.Minute),
+
+		// Email notifications
+		EmailSink:             getEnv("EMAIL_SINK", "log"),
+		EmailFrom:             getEnv("EMAIL_FROM", "LSCS Core <no-reply@dlsu-lscs.org>"),
+		EmailOutboxDir:        getEnv("EMAIL_OUTBOX_DIR", "tmp/emails"),
+		EmailDispatchInterval: getEnvDuration("EMAIL_DISPATCH_INTERVAL", 30*time.Second),
+		EmailMaxAttempts:      getEnvInt("EMAIL_MAX_ATTEMPTS", 6),
+		SMTPHost:              getEnv("SMTP_HOST", ""),
+		SMTPPort:              getEnvInt("SMTP_PORT", 587),
+		SMTPUsername:          getEnv("SMTP_USERNAME", ""),
+		SMTPPassword:          getEnv("SMTP_PASSWORD", ""),
+		KeyExpiryWarningDays:  getEnvIntList("KEY_EXPIRY_WARNING_DAYS", []int{14, 3}),
+
+		// In-app notifications
+		NotificationStreamPollInterval: getEnvDuration("NOTIFICATION_STREAM_POLL_INTERVAL", 10*time.Second),
+
+		// GraphQL
+		GraphQLMaxCost:  getEnvInt("GRAPHQL_MAX_COST", 10000),
+		GraphQLMaxDepth: getEnvInt("GRAPHQL_MAX_DEPTH", 10),
+
+		// gRPC
+		GRPCPort: getEnvInt("GRPC_PORT", 9090),
+
 		// CORS
 		AllowedOrigins: getEnvList("ALLOWED_ORIGINS", []string{"http://localhost:3000"}),
 
@@ -166,11 +318,21 @@ func (c *Config) validate() error {
 	if c.JWTSecret == "" {
 		missing = append(missing, "JWT_SECRET")
 	}
+	// an explicit smtp sink never falls back to logging emails, which would mark them sent
+	if c.EmailSink == "smtp" && c.SMTPHost == "" {
+		missing = append(missing, "SMTP_HOST")
+	}
 
 	if len(missing) > 0 {
 		return fmt.Errorf("missing required environment variables: %s", strings.Join(missing, ", "))
 	}
 
+	switch c.EmailSink {
+	case "smtp", "file", "log":
+	default:
+		return fmt.Errorf("invalid EMAIL_SINK: %s (must be one of: smtp, file, log)", c.EmailSink)
+	}
+
 	// validate log level
 	validLevels := map[string]bool{
 		"trace": true, "debug": true, "info": true,
@@ -222,6 +384,35 @@ func getEnvList(key string, defaultValue []string) []string {
 	return defaultValue
 }
 
+func getEnvIntList(key string, defaultValue []int) []int {
+	values := getEnvList(key, nil)
+	if len(values) == 0 {
+		return defaultValue
+	}
+	result := make([]int, 0, len(values))
+	for _, value := range values {
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return defaultValue
+		}
+		result = append(result, i)
+	}
+	return result
+}
+
+// getEnvMap reads a comma-separated list of key=value pairs, e.g. "RND=1234,PUB=5678"
+func getEnvMap(key string) map[string]string {
+	result := map[string]string{}
+	for _, pair := range getEnvList(key, nil) {
+		k, v, ok := strings.Cut(pair, "=")
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		if ok && k != "" && v != "" {
+			result[k] = v
+		}
+	}
+	return result
+}
+
 func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
 		if d, err := time.ParseDuration(value); err == nil {
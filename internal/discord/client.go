@@ -0,0 +1,165 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	apiBaseURL = "https://discord.com/api/v10"
+	// membersPageSize is the maximum page size of the list guild members endpoint
+	membersPageSize = 1000
+	// maxRateLimitRetries is how many times a rate limited request is retried
+	maxRateLimitRetries = 3
+)
+
+// GuildMember is a member of the Discord server
+type GuildMember struct {
+	UserID        string
+	Username      string
+	Discriminator string // "0" for accounts on the new username system
+	Bot           bool
+	Roles         []string
+}
+
+// Client is the subset of the Discord API used by the role sync
+type Client interface {
+	ListGuildMembers(ctx context.Context) ([]GuildMember, error)
+	AddRole(ctx context.Context, userID, roleID, reason string) error
+	RemoveRole(ctx context.Context, userID, roleID, reason string) error
+}
+
+// HTTPClient calls the Discord REST API as a bot
+type HTTPClient struct {
+	baseURL string
+	token   string
+	guildID string
+	client  *http.Client
+}
+
+func NewHTTPClient(token, guildID string) *HTTPClient {
+	return &HTTPClient{
+		baseURL: apiBaseURL,
+		token:   token,
+		guildID: guildID,
+		client:  &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+type apiGuildMember struct {
+	User struct {
+		ID            string `json:"id"`
+		Username      string `json:"username"`
+		Discriminator string `json:"discriminator"`
+		Bot           bool   `json:"bot"`
+	} `json:"user"`
+	Roles []string `json:"roles"`
+}
+
+// ListGuildMembers lists every member of the server. It requires the Server Members intent.
+func (c *HTTPClient) ListGuildMembers(ctx context.Context) ([]GuildMember, error) {
+	var members []GuildMember
+	after := "0"
+	for {
+		query := url.Values{"limit": {strconv.Itoa(membersPageSize)}, "after": {after}}
+		var page []apiGuildMember
+		if err := c.do(ctx, http.MethodGet, "/guilds/"+c.guildID+"/members?"+query.Encode(), "", &page); err != nil {
+			return nil, err
+		}
+		for _, m := range page {
+			members = append(members, GuildMember{
+				UserID:        m.User.ID,
+				Username:      m.User.Username,
+				Discriminator: m.User.Discriminator,
+				Bot:           m.User.Bot,
+				Roles:         m.Roles,
+			})
+		}
+		if len(page) < membersPageSize {
+			return members, nil
+		}
+		after = page[len(page)-1].User.ID
+	}
+}
+
+func (c *HTTPClient) AddRole(ctx context.Context, userID, roleID, reason string) error {
+	return c.do(ctx, http.MethodPut, c.memberRolePath(userID, roleID), reason, nil)
+}
+
+func (c *HTTPClient) RemoveRole(ctx context.Context, userID, roleID, reason string) error {
+	return c.do(ctx, http.MethodDelete, c.memberRolePath(userID, roleID), reason, nil)
+}
+
+func (c *HTTPClient) memberRolePath(userID, roleID string) string {
+	return "/guilds/" + c.guildID + "/members/" + url.PathEscape(userID) + "/roles/" + url.PathEscape(roleID)
+}
+
+// do sends a request, waiting out rate limits, and decodes the response into out if it is not nil
+func (c *HTTPClient) do(ctx context.Context, method, path, reason string, out any) error {
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bot "+c.token)
+		req.Header.Set("User-Agent", "DiscordBot (https://github.com/dlsu-lscs/lscs-core-api, 1.0)")
+		if reason != "" {
+			req.Header.Set("X-Audit-Log-Reason", url.PathEscape(reason))
+		}
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return err
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests && attempt < maxRateLimitRetries {
+			if err := sleep(ctx, retryAfter(resp, body)); err != nil {
+				return err
+			}
+			continue
+		}
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("discord %s %s: %d: %s", method, path, resp.StatusCode, body)
+		}
+		if out != nil {
+			return json.Unmarshal(body, out)
+		}
+		return nil
+	}
+}
+
+// retryAfter reads how long to wait from a 429 response
+func retryAfter(resp *http.Response, body []byte) time.Duration {
+	var rateLimit struct {
+		RetryAfter float64 `json:"retry_after"`
+	}
+	if json.Unmarshal(body, &rateLimit) == nil && rateLimit.RetryAfter > 0 {
+		return time.Duration(rateLimit.RetryAfter * float64(time.Second))
+	}
+	if seconds, err := strconv.ParseFloat(resp.Header.Get("Retry-After"), 64); err == nil {
+		return time.Duration(seconds * float64(time.Second))
+	}
+	return time.Second
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,76 @@
+package discord
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPClient(t *testing.T) {
+	t.Run("success - paginates guild members", func(t *testing.T) {
+		standIn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bot token", r.Header.Get("Authorization"))
+			assert.Equal(t, "/guilds/g1/members", r.URL.Path)
+			if r.URL.Query().Get("after") != "0" {
+				fmt.Fprint(w, `[]`)
+				return
+			}
+			w.Write([]byte("["))
+			for i := range membersPageSize {
+				if i > 0 {
+					w.Write([]byte(","))
+				}
+				fmt.Fprintf(w, `{"user":{"id":"%d","username":"user%d","discriminator":"0"},"roles":["r1"]}`, i+1, i+1)
+			}
+			w.Write([]byte("]"))
+		}))
+		defer standIn.Close()
+
+		client := NewHTTPClient("token", "g1")
+		client.baseURL = standIn.URL
+
+		members, err := client.ListGuildMembers(t.Context())
+		assert.NoError(t, err)
+		assert.Len(t, members, membersPageSize)
+		assert.Equal(t, GuildMember{UserID: "1", Username: "user1", Discriminator: "0", Roles: []string{"r1"}}, members[0])
+	})
+
+	t.Run("success - waits out rate limits", func(t *testing.T) {
+		calls := 0
+		standIn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "/guilds/g1/members/u1/roles/r1", r.URL.Path)
+			if calls == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				fmt.Fprint(w, `{"retry_after":0.01}`)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer standIn.Close()
+
+		client := NewHTTPClient("token", "g1")
+		client.baseURL = standIn.URL
+
+		assert.NoError(t, client.AddRole(t.Context(), "u1", "r1", auditReason))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("fail - error response", func(t *testing.T) {
+		standIn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"Missing Permissions","code":50013}`)
+		}))
+		defer standIn.Close()
+
+		client := NewHTTPClient("token", "g1")
+		client.baseURL = standIn.URL
+
+		err := client.RemoveRole(t.Context(), "u1", "r1", auditReason)
+		assert.ErrorContains(t, err, "403")
+	})
+}
@@ -0,0 +1,71 @@
+package discord
+
+import (
+	"time"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+)
+
+// Plan is the set of role changes that brings the Discord server in line with the member records
+type Plan struct {
+	GeneratedAt time.Time         `json:"generated_at"`
+	Changes     []MemberChange    `json:"changes"`
+	Unmatched   []UnmatchedMember `json:"unmatched"`
+	Summary     PlanSummary       `json:"summary"`
+}
+
+// PlanSummary counts what the plan covers
+type PlanSummary struct {
+	LinkedMembers  int `json:"linked_members" example:"120"` // members with a linked Discord account
+	MatchedMembers int `json:"matched_members" example:"112"`
+	RolesToAdd     int `json:"roles_to_add" example:"14"`
+	RolesToRemove  int `json:"roles_to_remove" example:"3"`
+}
+
+// MemberChange is the roles to add to and remove from one Discord user.
+// MemberID is empty for server members that aren't linked to an LSCS member.
+type MemberChange struct {
+	DiscordUserID   string       `json:"discord_user_id" example:"80351110224678912"`
+	DiscordUsername string       `json:"discord_username" example:"juandc"`
+	MemberID        *int32       `json:"member_id,omitempty" example:"12312345"`
+	FullName        string       `json:"full_name,omitempty" example:"Juan Dela Cruz"`
+	AddRoles        []RoleChange `json:"add_roles"`
+	RemoveRoles     []RoleChange `json:"remove_roles"`
+}
+
+// RoleChange is a managed role and where its mapping comes from (e.g. committee:RND)
+type RoleChange struct {
+	RoleID string `json:"role_id" example:"111111111111111111"`
+	Source string `json:"source" example:"committee:RND"`
+}
+
+// UnmatchedMember is an LSCS member whose linked Discord account isn't on the server
+type UnmatchedMember struct {
+	MemberID int32  `json:"member_id" example:"12312345"`
+	FullName string `json:"full_name" example:"Juan Dela Cruz"`
+	Discord  string `json:"discord" example:"juandc"` // username when the account was linked
+	Reason   string `json:"reason" example:"not_in_server"`
+}
+
+// FailedChange is a role change that the Discord API rejected
+type FailedChange struct {
+	DiscordUserID string `json:"discord_user_id" example:"80351110224678912"`
+	RoleID        string `json:"role_id" example:"111111111111111111"`
+	Action        string `json:"action" example:"add"`
+	Error         string `json:"error"`
+}
+
+// SyncResponse is the response for POST /discord/sync: the plan that was applied and its outcome
+type SyncResponse struct {
+	Plan
+	Applied int            `json:"applied" example:"17"`
+	Failed  []FailedChange `json:"failed"`
+}
+
+// LinkResponse is the Discord account linked to the authenticated member
+type LinkResponse struct {
+	Linked          bool                   `json:"linked" example:"true"`
+	DiscordUserID   string                 `json:"discord_user_id,omitempty" example:"80351110224678912"`
+	DiscordUsername helpers.NullableString `json:"discord_username"`
+	LinkedAt        *time.Time             `json:"linked_at,omitempty"`
+}
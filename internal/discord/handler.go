@@ -0,0 +1,251 @@
+package discord
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// Handler exposes the Discord role sync (admin only) and lets members link their Discord account
+type Handler struct {
+	dbService   database.Service
+	syncer      *Syncer // nil when Discord isn't configured
+	oauth       OAuth
+	secret      string
+	frontendURL string
+	now         func() time.Time
+}
+
+func NewHandler(cfg *config.Config, dbService database.Service) *Handler {
+	h := &Handler{dbService: dbService, secret: cfg.JWTSecret, frontendURL: cfg.FrontendURL(), now: time.Now}
+	if cfg.DiscordBotToken == "" || cfg.DiscordGuildID == "" || cfg.DiscordClientID == "" || cfg.DiscordClientSecret == "" {
+		log.Info().Msg("Discord configuration not complete, role sync disabled")
+		return h
+	}
+	client := NewHTTPClient(cfg.DiscordBotToken, cfg.DiscordGuildID)
+	h.syncer = NewSyncer(dbService, client, RoleMapFromConfig(cfg))
+	h.oauth = NewOAuthClient(cfg.DiscordClientID, cfg.DiscordClientSecret, cfg.PublicBaseURL+"/discord/link/callback")
+	return h
+}
+
+// PlanSyncHandler godoc
+// @Summary Preview the Discord role sync
+// @Description Dry run: lists the Discord role changes a sync would make, without making them. Admin only.
+// @Tags discord
+// @Produce json
+// @Success 200 {object} Plan "Planned role changes"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Failure 503 {object} helpers.ErrorResponse "Discord integration not configured"
+// @Security SessionAuth
+// @Router /discord/sync [get]
+func (h *Handler) PlanSyncHandler(c echo.Context) error {
+	if h.syncer == nil {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": "Discord integration not configured"})
+	}
+
+	plan, err := h.syncer.Plan(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to plan discord role sync")
+		return helpers.ErrInternal(c, "")
+	}
+
+	return c.JSON(http.StatusOK, plan)
+}
+
+// SyncHandler godoc
+// @Summary Sync Discord roles
+// @Description Computes the role changes and applies them to the Discord server. Failed changes are reported and retried by the next sync. Admin only.
+// @Tags discord
+// @Produce json
+// @Success 200 {object} SyncResponse "Applied role changes"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Failure 503 {object} helpers.ErrorResponse "Discord integration not configured"
+// @Security SessionAuth
+// @Router /discord/sync [post]
+func (h *Handler) SyncHandler(c echo.Context) error {
+	if h.syncer == nil {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": "Discord integration not configured"})
+	}
+
+	ctx := c.Request().Context()
+	plan, err := h.syncer.Plan(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to plan discord role sync")
+		return helpers.ErrInternal(c, "")
+	}
+
+	applied, failed := h.syncer.Apply(ctx, plan)
+	return c.JSON(http.StatusOK, SyncResponse{Plan: plan, Applied: applied, Failed: failed})
+}
+
+// StartLinkHandler godoc
+// @Summary Link my Discord account
+// @Description Redirects to Discord to sign in. After signing in, the account is linked to the authenticated member and used by the role sync.
+// @Tags discord
+// @Success 302 "Redirect to Discord"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 503 {object} helpers.ErrorResponse "Discord integration not configured"
+// @Security SessionAuth
+// @Router /discord/link/start [get]
+func (h *Handler) StartLinkHandler(c echo.Context) error {
+	if h.syncer == nil {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": "Discord integration not configured"})
+	}
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return helpers.ErrUnauthorized(c, "")
+	}
+
+	state := signLinkState(h.secret, principal.MemberID, h.now().Add(linkStateTTL))
+	return c.Redirect(http.StatusFound, h.oauth.AuthURL(state))
+}
+
+// LinkCallbackHandler godoc
+// @Summary Handle the Discord sign-in callback
+// @Description Links the Discord account the member signed in with and redirects to the frontend. A Discord account can only be linked to one member; linking it again moves it.
+// @Tags discord
+// @Param code query string true "Authorization code from Discord"
+// @Param state query string true "State issued by /discord/link/start"
+// @Success 302 "Redirect to the frontend with the result"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 503 {object} helpers.ErrorResponse "Discord integration not configured"
+// @Security SessionAuth
+// @Router /discord/link/callback [get]
+func (h *Handler) LinkCallbackHandler(c echo.Context) error {
+	if h.syncer == nil {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": "Discord integration not configured"})
+	}
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return helpers.ErrUnauthorized(c, "")
+	}
+
+	if errorParam := c.QueryParam("error"); errorParam != "" {
+		log.Warn().Str("error", errorParam).Int32("member_id", principal.MemberID).Msg("discord sign-in denied")
+		return h.linkResult(c, "oauth_denied")
+	}
+	// the state must have been issued to the member whose session finishes the flow
+	memberID, err := verifyLinkState(h.secret, c.QueryParam("state"), h.now())
+	if err != nil || memberID != principal.MemberID {
+		return h.linkResult(c, "invalid_state")
+	}
+	code := c.QueryParam("code")
+	if code == "" {
+		return h.linkResult(c, "no_code")
+	}
+
+	ctx := c.Request().Context()
+	user, err := h.oauth.Identify(ctx, code)
+	if err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to identify discord account")
+		return h.linkResult(c, "user_info")
+	}
+
+	q := repository.New(h.dbService.GetConnection())
+	// an account can only be linked to one member
+	if _, err := q.DeleteDiscordLinkByUser(ctx, user.ID); err != nil {
+		log.Error().Err(err).Str("discord_user_id", user.ID).Msg("failed to unlink previous member")
+		return h.linkResult(c, "db_error")
+	}
+	if err := q.LinkDiscordAccount(ctx, repository.LinkDiscordAccountParams{
+		MemberID:        principal.MemberID,
+		DiscordUserID:   user.ID,
+		DiscordUsername: sql.NullString{String: user.Username, Valid: user.Username != ""},
+	}); err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to link discord account")
+		return h.linkResult(c, "db_error")
+	}
+
+	log.Info().Int32("member_id", principal.MemberID).Str("discord_user_id", user.ID).Msg("discord account linked")
+	return h.linkResult(c, "")
+}
+
+// linkResult redirects to the Discord settings page of the frontend, with the error code if linking failed
+func (h *Handler) linkResult(c echo.Context, errorCode string) error {
+	target := h.frontendURL + "/settings/discord?linked=true"
+	if errorCode != "" {
+		target = h.frontendURL + "/settings/discord?error=" + url.QueryEscape(errorCode)
+	}
+	return c.Redirect(http.StatusFound, target)
+}
+
+// GetLinkHandler godoc
+// @Summary Get my Discord link
+// @Description Returns the Discord account linked to the authenticated member, if any
+// @Tags discord
+// @Produce json
+// @Success 200 {object} LinkResponse "Discord link"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /discord/link [get]
+func (h *Handler) GetLinkHandler(c echo.Context) error {
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return helpers.ErrUnauthorized(c, "")
+	}
+
+	q := repository.New(h.dbService.GetConnection())
+	link, err := q.GetDiscordLink(c.Request().Context(), principal.MemberID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return c.JSON(http.StatusOK, LinkResponse{})
+		}
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to get discord link")
+		return helpers.ErrInternal(c, "")
+	}
+
+	resp := LinkResponse{
+		Linked:          true,
+		DiscordUserID:   link.DiscordUserID,
+		DiscordUsername: helpers.NullableString{NullString: link.DiscordUsername},
+	}
+	if link.LinkedAt.Valid {
+		resp.LinkedAt = &link.LinkedAt.Time
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// UnlinkHandler godoc
+// @Summary Unlink my Discord account
+// @Description Unlinks the Discord account of the authenticated member. The next role sync removes its managed roles.
+// @Tags discord
+// @Success 204 "Unlinked"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "No linked Discord account"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /discord/link [delete]
+func (h *Handler) UnlinkHandler(c echo.Context) error {
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return helpers.ErrUnauthorized(c, "")
+	}
+
+	q := repository.New(h.dbService.GetConnection())
+	rows, err := q.DeleteDiscordLink(c.Request().Context(), principal.MemberID)
+	if err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to unlink discord account")
+		return helpers.ErrInternal(c, "")
+	}
+	if rows == 0 {
+		return helpers.ErrNotFound(c, "No linked Discord account")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
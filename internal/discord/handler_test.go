@@ -0,0 +1,177 @@
+package discord
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+)
+
+func TestPlanSyncHandler(t *testing.T) {
+	t.Run("success - dry run makes no changes", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectLinkedMembers(mock)
+
+		client := newFakeServer()
+		h := &Handler{syncer: NewSyncer(&mockDBService{db: db}, client, testRoles)}
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/discord/sync", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, h.PlanSyncHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var plan Plan
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &plan))
+			assert.Len(t, plan.Changes, 3)
+			assert.Equal(t, 3, plan.Summary.RolesToAdd)
+		}
+		assert.Empty(t, client.calls)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - not configured", func(t *testing.T) {
+		h := NewHandler(&config.Config{}, nil)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/discord/sync", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, h.PlanSyncHandler(c)) {
+			assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		}
+	})
+}
+
+func TestSyncHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	expectLinkedMembers(mock)
+
+	client := newFakeServer()
+	h := &Handler{syncer: NewSyncer(&mockDBService{db: db}, client, testRoles)}
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/discord/sync", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, h.SyncHandler(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp SyncResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, 5, resp.Applied)
+		assert.Empty(t, resp.Failed)
+	}
+	assert.Len(t, client.calls, 5)
+}
+
+// fakeOAuth signs everyone in as the same Discord account
+type fakeOAuth struct {
+	user User
+	err  error
+}
+
+func (f *fakeOAuth) AuthURL(state string) string {
+	return "https://discord.test/authorize?state=" + state
+}
+
+func (f *fakeOAuth) Identify(ctx context.Context, code string) (User, error) {
+	return f.user, f.err
+}
+
+func TestLinkHandlers(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	newHandler := func(db *sql.DB) *Handler {
+		return &Handler{
+			dbService:   &mockDBService{db: db},
+			syncer:      &Syncer{},
+			oauth:       &fakeOAuth{user: User{ID: "80351110224678912", Username: "juandc"}},
+			secret:      "s3cret",
+			frontendURL: "https://core.lscs.org",
+			now:         func() time.Time { return now },
+		}
+	}
+	newContext := func(target string, memberID int32) (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		auth.SetPrincipal(c, &auth.Principal{MemberID: memberID, Method: auth.AuthMethodSession})
+		return c, rec
+	}
+
+	t.Run("success - start redirects with a signed state", func(t *testing.T) {
+		h := newHandler(nil)
+		c, rec := newContext("/discord/link/start", 12312345)
+		if assert.NoError(t, h.StartLinkHandler(c)) {
+			assert.Equal(t, http.StatusFound, rec.Code)
+			state := strings.TrimPrefix(rec.Header().Get(echo.HeaderLocation), "https://discord.test/authorize?state=")
+			memberID, err := verifyLinkState("s3cret", state, now)
+			assert.NoError(t, err)
+			assert.Equal(t, int32(12312345), memberID)
+		}
+	})
+
+	t.Run("success - callback links the account", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("DELETE FROM discord_links WHERE discord_user_id").
+			WithArgs("80351110224678912").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO discord_links").
+			WithArgs(int32(12312345), "80351110224678912", "juandc").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		state := url.QueryEscape(signLinkState("s3cret", 12312345, now.Add(linkStateTTL)))
+		c, rec := newContext("/discord/link/callback?code=abc&state="+state, 12312345)
+		if assert.NoError(t, newHandler(db).LinkCallbackHandler(c)) {
+			assert.Equal(t, http.StatusFound, rec.Code)
+			assert.Equal(t, "https://core.lscs.org/settings/discord?linked=true", rec.Header().Get(echo.HeaderLocation))
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - state issued to another member", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		state := url.QueryEscape(signLinkState("s3cret", 12254321, now.Add(linkStateTTL)))
+		c, rec := newContext("/discord/link/callback?code=abc&state="+state, 12312345)
+		if assert.NoError(t, newHandler(db).LinkCallbackHandler(c)) {
+			assert.Equal(t, http.StatusFound, rec.Code)
+			assert.Equal(t, "https://core.lscs.org/settings/discord?error=invalid_state", rec.Header().Get(echo.HeaderLocation))
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - not configured", func(t *testing.T) {
+		h := NewHandler(&config.Config{}, nil)
+		c, rec := newContext("/discord/link/start", 12312345)
+		if assert.NoError(t, h.StartLinkHandler(c)) {
+			assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package discord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinkState(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	state := signLinkState("s3cret", 12312345, now.Add(linkStateTTL))
+
+	memberID, err := verifyLinkState("s3cret", state, now)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(12312345), memberID)
+
+	_, err = verifyLinkState("s3cret", state, now.Add(linkStateTTL))
+	assert.ErrorIs(t, err, ErrInvalidLinkState, "expired")
+
+	_, err = verifyLinkState("other", state, now)
+	assert.ErrorIs(t, err, ErrInvalidLinkState, "wrong secret")
+
+	forged := signLinkState("s3cret", 12254321, now.Add(linkStateTTL))
+	_, err = verifyLinkState("s3cret", "12312345"+forged[len("12254321"):], now)
+	assert.ErrorIs(t, err, ErrInvalidLinkState, "member swapped")
+
+	_, err = verifyLinkState("s3cret", "not-a-state", now)
+	assert.ErrorIs(t, err, ErrInvalidLinkState)
+}
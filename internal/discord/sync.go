@@ -0,0 +1,234 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// ReasonNotInServer is reported for linked members whose Discord account isn't on the server
+const ReasonNotInServer = "not_in_server"
+
+// auditReason is shown in the Discord audit log for every role change
+const auditReason = "LSCS Core role sync"
+
+// RoleMap maps committees, positions and houses to Discord role IDs.
+// Only mapped roles are managed; other roles on the server are never touched.
+type RoleMap struct {
+	Committees map[string]string
+	Positions  map[string]string
+	Houses     map[string]string // keyed by house id
+}
+
+func RoleMapFromConfig(cfg *config.Config) RoleMap {
+	return RoleMap{
+		Committees: cfg.DiscordCommitteeRoles,
+		Positions:  cfg.DiscordPositionRoles,
+		Houses:     cfg.DiscordHouseRoles,
+	}
+}
+
+// managed returns every mapped role with the mappings that point to it
+func (m RoleMap) managed() map[string]string {
+	sources := map[string][]string{}
+	add := func(kind string, mapping map[string]string) {
+		for key, roleID := range mapping {
+			sources[roleID] = append(sources[roleID], kind+":"+key)
+		}
+	}
+	add("committee", m.Committees)
+	add("position", m.Positions)
+	add("house", m.Houses)
+
+	managed := make(map[string]string, len(sources))
+	for roleID, s := range sources {
+		slices.Sort(s)
+		managed[roleID] = strings.Join(s, ",")
+	}
+	return managed
+}
+
+// desired returns the roles a member should have, with the mapping that grants each
+func (m RoleMap) desired(member repository.ListDiscordLinkedMembersRow) map[string]string {
+	desired := map[string]string{}
+	if member.CommitteeID.Valid {
+		if roleID, ok := m.Committees[member.CommitteeID.String]; ok {
+			desired[roleID] = "committee:" + member.CommitteeID.String
+		}
+	}
+	if member.PositionID.Valid {
+		if roleID, ok := m.Positions[member.PositionID.String]; ok {
+			desired[roleID] = "position:" + member.PositionID.String
+		}
+	}
+	if member.HouseID.Valid {
+		houseID := strconv.Itoa(int(member.HouseID.Int32))
+		if roleID, ok := m.Houses[houseID]; ok {
+			desired[roleID] = "house:" + houseID
+		}
+	}
+	return desired
+}
+
+// Syncer computes and applies the role changes between member records and the Discord server
+type Syncer struct {
+	dbService database.Service
+	client    Client
+	roles     RoleMap
+	now       func() time.Time
+}
+
+func NewSyncer(dbService database.Service, client Client, roles RoleMap) *Syncer {
+	return &Syncer{dbService: dbService, client: client, roles: roles, now: time.Now}
+}
+
+// Plan compares the roles members should have with the roles they have on the server.
+// Members are matched by the Discord account they linked by signing in with Discord, never
+// by the handle on their profile. Server members that aren't linked to an LSCS member lose
+// their managed roles; bots are left alone.
+func (s *Syncer) Plan(ctx context.Context) (Plan, error) {
+	q := repository.New(s.dbService.GetConnection())
+
+	members, err := q.ListDiscordLinkedMembers(ctx)
+	if err != nil {
+		return Plan{}, fmt.Errorf("list discord linked members: %w", err)
+	}
+	guild, err := s.client.ListGuildMembers(ctx)
+	if err != nil {
+		return Plan{}, fmt.Errorf("list guild members: %w", err)
+	}
+
+	byID := make(map[string]GuildMember, len(guild))
+	for _, gm := range guild {
+		if !gm.Bot {
+			byID[gm.UserID] = gm
+		}
+	}
+
+	managed := s.roles.managed()
+	plan := Plan{
+		GeneratedAt: s.now(),
+		Changes:     []MemberChange{},
+		Unmatched:   []UnmatchedMember{},
+		Summary:     PlanSummary{LinkedMembers: len(members)},
+	}
+
+	matched := map[string]bool{}
+	for _, member := range members {
+		gm, ok := byID[member.DiscordUserID]
+		if !ok {
+			plan.Unmatched = append(plan.Unmatched, UnmatchedMember{
+				MemberID: member.ID,
+				FullName: member.FullName,
+				Discord:  member.DiscordUsername.String,
+				Reason:   ReasonNotInServer,
+			})
+			continue
+		}
+
+		matched[gm.UserID] = true
+		plan.Summary.MatchedMembers++
+		memberID := member.ID
+		plan.addChange(gm, &memberID, member.FullName, s.roles.desired(member), managed)
+	}
+
+	for _, gm := range guild {
+		if !gm.Bot && !matched[gm.UserID] {
+			plan.addChange(gm, nil, "", nil, managed)
+		}
+	}
+
+	return plan, nil
+}
+
+// addChange adds the difference between a server member's managed roles and the desired ones
+func (p *Plan) addChange(gm GuildMember, memberID *int32, fullName string, desired, managed map[string]string) {
+	change := MemberChange{
+		DiscordUserID:   gm.UserID,
+		DiscordUsername: gm.Username,
+		MemberID:        memberID,
+		FullName:        fullName,
+		AddRoles:        []RoleChange{},
+		RemoveRoles:     []RoleChange{},
+	}
+
+	has := map[string]bool{}
+	for _, roleID := range gm.Roles {
+		has[roleID] = true
+		if _, isManaged := managed[roleID]; isManaged {
+			if _, keep := desired[roleID]; !keep {
+				change.RemoveRoles = append(change.RemoveRoles, RoleChange{RoleID: roleID, Source: managed[roleID]})
+			}
+		}
+	}
+	for _, roleID := range slices.Sorted(maps.Keys(desired)) {
+		if !has[roleID] {
+			change.AddRoles = append(change.AddRoles, RoleChange{RoleID: roleID, Source: desired[roleID]})
+		}
+	}
+
+	if len(change.AddRoles) == 0 && len(change.RemoveRoles) == 0 {
+		return
+	}
+	slices.SortFunc(change.RemoveRoles, func(a, b RoleChange) int { return strings.Compare(a.RoleID, b.RoleID) })
+	p.Changes = append(p.Changes, change)
+	p.Summary.RolesToAdd += len(change.AddRoles)
+	p.Summary.RolesToRemove += len(change.RemoveRoles)
+}
+
+// Apply makes the planned role changes and returns how many succeeded and the ones that failed.
+// A failed change doesn't stop the rest; running the sync again retries it.
+func (s *Syncer) Apply(ctx context.Context, plan Plan) (int, []FailedChange) {
+	applied := 0
+	failed := []FailedChange{}
+
+	apply := func(change MemberChange, role RoleChange, action string) {
+		var err error
+		if action == "add" {
+			err = s.client.AddRole(ctx, change.DiscordUserID, role.RoleID, auditReason)
+		} else {
+			err = s.client.RemoveRole(ctx, change.DiscordUserID, role.RoleID, auditReason)
+		}
+		if err != nil {
+			log.Error().Err(err).
+				Str("discord_user_id", change.DiscordUserID).
+				Str("role_id", role.RoleID).
+				Str("action", action).
+				Msg("failed to sync discord role")
+			failed = append(failed, FailedChange{
+				DiscordUserID: change.DiscordUserID,
+				RoleID:        role.RoleID,
+				Action:        action,
+				Error:         err.Error(),
+			})
+			return
+		}
+		applied++
+	}
+
+	for _, change := range plan.Changes {
+		for _, role := range change.AddRoles {
+			apply(change, role, "add")
+		}
+		for _, role := range change.RemoveRoles {
+			apply(change, role, "remove")
+		}
+	}
+
+	log.Info().
+		Int("applied", applied).
+		Int("failed", len(failed)).
+		Int("unmatched", len(plan.Unmatched)).
+		Msg("discord role sync applied")
+	return applied, failed
+}
@@ -0,0 +1,146 @@
+package discord
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockDBService is a mock implementation of the database.Service interface.
+type mockDBService struct {
+	db *sql.DB
+}
+
+func (m *mockDBService) Health() map[string]string {
+	return nil
+}
+
+func (m *mockDBService) Close() error {
+	return nil
+}
+
+func (m *mockDBService) GetConnection() *sql.DB {
+	return m.db
+}
+
+// fakeClient is an in-memory Discord server
+type fakeClient struct {
+	members []GuildMember
+	failOn  string // role ID whose changes fail
+	calls   []string
+}
+
+func (f *fakeClient) ListGuildMembers(ctx context.Context) ([]GuildMember, error) {
+	return f.members, nil
+}
+
+func (f *fakeClient) AddRole(ctx context.Context, userID, roleID, reason string) error {
+	f.calls = append(f.calls, "add "+userID+" "+roleID)
+	if roleID == f.failOn {
+		return errors.New("missing permissions")
+	}
+	return nil
+}
+
+func (f *fakeClient) RemoveRole(ctx context.Context, userID, roleID, reason string) error {
+	f.calls = append(f.calls, "remove "+userID+" "+roleID)
+	if roleID == f.failOn {
+		return errors.New("missing permissions")
+	}
+	return nil
+}
+
+var (
+	linkedColumns = []string{"id", "full_name", "discord_user_id", "discord_username", "committee_id", "position_id", "house_id"}
+	testRoles     = RoleMap{
+		Committees: map[string]string{"RND": "r-rnd", "PUB": "r-pub"},
+		Positions:  map[string]string{"AVP": "r-avp"},
+		Houses:     map[string]string{"1": "r-house1"},
+	}
+)
+
+func newFakeServer() *fakeClient {
+	return &fakeClient{members: []GuildMember{
+		// RND member with their committee role, missing the AVP and house roles
+		{UserID: "u1", Username: "juandc", Discriminator: "0", Roles: []string{"r-rnd", "r-unrelated"}},
+		// moved from PUB to RND
+		{UserID: "u2", Username: "Maria", Discriminator: "4821", Roles: []string{"r-pub"}},
+		// not linked to any member, even though a member may have typed this handle on their profile
+		{UserID: "u3", Username: "alumnus", Discriminator: "0", Roles: []string{"r-pub", "r-unrelated"}},
+		// bots are never touched
+		{UserID: "u4", Username: "botty", Bot: true, Roles: []string{"r-rnd"}},
+	}}
+}
+
+func expectLinkedMembers(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("SELECT (.+) FROM discord_links l").
+		WillReturnRows(sqlmock.NewRows(linkedColumns).
+			AddRow(12312345, "Juan Dela Cruz", "u1", "juandc", "RND", "AVP", 1).
+			AddRow(12254321, "Maria Clara", "u2", "maria", "RND", nil, nil).
+			AddRow(12200000, "Crisostomo Ibarra", "u9", "ibarra", "PUB", nil, nil))
+}
+
+func TestSyncerPlan(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	expectLinkedMembers(mock)
+
+	plan, err := NewSyncer(&mockDBService{db: db}, newFakeServer(), testRoles).Plan(t.Context())
+	assert.NoError(t, err)
+
+	assert.Equal(t, PlanSummary{LinkedMembers: 3, MatchedMembers: 2, RolesToAdd: 3, RolesToRemove: 2}, plan.Summary)
+	if assert.Len(t, plan.Changes, 3) {
+		juan := plan.Changes[0]
+		assert.Equal(t, "u1", juan.DiscordUserID)
+		assert.Equal(t, int32(12312345), *juan.MemberID)
+		assert.Equal(t, []RoleChange{{RoleID: "r-avp", Source: "position:AVP"}, {RoleID: "r-house1", Source: "house:1"}}, juan.AddRoles)
+		assert.Empty(t, juan.RemoveRoles)
+
+		maria := plan.Changes[1]
+		assert.Equal(t, "u2", maria.DiscordUserID)
+		assert.Equal(t, []RoleChange{{RoleID: "r-rnd", Source: "committee:RND"}}, maria.AddRoles)
+		assert.Equal(t, []RoleChange{{RoleID: "r-pub", Source: "committee:PUB"}}, maria.RemoveRoles)
+
+		alumnus := plan.Changes[2]
+		assert.Equal(t, "u3", alumnus.DiscordUserID)
+		assert.Nil(t, alumnus.MemberID)
+		assert.Empty(t, alumnus.AddRoles)
+		assert.Equal(t, []RoleChange{{RoleID: "r-pub", Source: "committee:PUB"}}, alumnus.RemoveRoles)
+	}
+	assert.Equal(t, []UnmatchedMember{
+		{MemberID: 12200000, FullName: "Crisostomo Ibarra", Discord: "ibarra", Reason: ReasonNotInServer},
+	}, plan.Unmatched)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSyncerApply(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	expectLinkedMembers(mock)
+
+	client := newFakeServer()
+	client.failOn = "r-house1"
+	syncer := NewSyncer(&mockDBService{db: db}, client, testRoles)
+
+	plan, err := syncer.Plan(t.Context())
+	assert.NoError(t, err)
+
+	applied, failed := syncer.Apply(t.Context(), plan)
+	assert.Equal(t, 4, applied)
+	assert.Equal(t, []FailedChange{{DiscordUserID: "u1", RoleID: "r-house1", Action: "add", Error: "missing permissions"}}, failed)
+	assert.Equal(t, []string{
+		"add u1 r-avp",
+		"add u1 r-house1",
+		"add u2 r-rnd",
+		"remove u2 r-pub",
+		"remove u3 r-pub",
+	}, client.calls)
+}
@@ -0,0 +1,69 @@
+package drive
+
+import (
+	"context"
+	"fmt"
+
+	gdrive "google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+)
+
+const folderMimeType = "application/vnd.google-apps.folder"
+
+// RoleWriter lets a user add and edit files in a folder
+const RoleWriter = "writer"
+
+// Client is the part of the Google Drive API used to set up event folders
+type Client interface {
+	// CreateFolder creates a folder inside parentID and returns its ID
+	CreateFolder(ctx context.Context, name, parentID string) (string, error)
+	// Share gives an email address access to a file or folder
+	Share(ctx context.Context, fileID, email, role string) error
+}
+
+// GoogleClient is a Client for the Google Drive v3 API, authenticated as a service account.
+// Shared drives are supported, so the parent folder may live in one.
+type GoogleClient struct {
+	service *gdrive.Service
+}
+
+// NewGoogleClient creates a client from a service account key file
+func NewGoogleClient(ctx context.Context, credentialsFile string) (*GoogleClient, error) {
+	service, err := gdrive.NewService(ctx, option.WithCredentialsFile(credentialsFile), option.WithScopes(gdrive.DriveScope))
+	if err != nil {
+		return nil, fmt.Errorf("create drive service: %w", err)
+	}
+	return &GoogleClient{service: service}, nil
+}
+
+func (c *GoogleClient) CreateFolder(ctx context.Context, name, parentID string) (string, error) {
+	folder := &gdrive.File{Name: name, MimeType: folderMimeType}
+	if parentID != "" {
+		folder.Parents = []string{parentID}
+	}
+
+	created, err := c.service.Files.Create(folder).
+		SupportsAllDrives(true).
+		Fields("id").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return "", fmt.Errorf("create folder %q: %w", name, err)
+	}
+	return created.Id, nil
+}
+
+func (c *GoogleClient) Share(ctx context.Context, fileID, email, role string) error {
+	permission := &gdrive.Permission{Type: "user", Role: role, EmailAddress: email}
+
+	_, err := c.service.Permissions.Create(fileID, permission).
+		SupportsAllDrives(true).
+		SendNotificationEmail(true).
+		Fields("id").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return fmt.Errorf("share %s with %s: %w", fileID, email, err)
+	}
+	return nil
+}
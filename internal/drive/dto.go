@@ -0,0 +1,42 @@
+package drive
+
+import (
+	"time"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// FoldersResponse is the state of an event's Drive folders
+type FoldersResponse struct {
+	EventID               int32                  `json:"event_id" example:"42"`
+	Status                string                 `json:"status" example:"DONE"`
+	RootFolderID          helpers.NullableString `json:"root_folder_id"`
+	DocumentationFolderID helpers.NullableString `json:"documentation_folder_id"`
+	FinanceFolderID       helpers.NullableString `json:"finance_folder_id"`
+	PublicityFolderID     helpers.NullableString `json:"publicity_folder_id"`
+	Attempts              int32                  `json:"attempts" example:"1"`
+	LastError             helpers.NullableString `json:"last_error"`
+	NextAttemptAt         *time.Time             `json:"next_attempt_at,omitempty"`
+	ProvisionedAt         *time.Time             `json:"provisioned_at,omitempty"`
+}
+
+func toFoldersResponse(f repository.EventDriveFolder) FoldersResponse {
+	resp := FoldersResponse{
+		EventID:               f.EventID,
+		Status:                f.Status,
+		RootFolderID:          helpers.NullableString{NullString: f.RootFolderID},
+		DocumentationFolderID: helpers.NullableString{NullString: f.DocuFolderID},
+		FinanceFolderID:       helpers.NullableString{NullString: f.FinFolderID},
+		PublicityFolderID:     helpers.NullableString{NullString: f.PubFolderID},
+		Attempts:              f.Attempts,
+		LastError:             helpers.NullableString{NullString: f.LastError},
+	}
+	if f.Status == StatusPending && f.NextAttemptAt.Valid {
+		resp.NextAttemptAt = &f.NextAttemptAt.Time
+	}
+	if f.ProvisionedAt.Valid {
+		resp.ProvisionedAt = &f.ProvisionedAt.Time
+	}
+	return resp
+}
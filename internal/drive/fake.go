@@ -0,0 +1,64 @@
+package drive
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// FakeClient is an in-memory Client for local testing without a Google account.
+// Folder IDs look like "fake-folder-1".
+type FakeClient struct {
+	mu      sync.Mutex
+	folders []FakeFolder
+	shares  []FakeShare
+}
+
+// FakeFolder is a folder created with a FakeClient
+type FakeFolder struct {
+	ID       string
+	Name     string
+	ParentID string
+}
+
+// FakeShare is a permission granted with a FakeClient
+type FakeShare struct {
+	FileID string
+	Email  string
+	Role   string
+}
+
+func NewFakeClient() *FakeClient {
+	return &FakeClient{}
+}
+
+func (c *FakeClient) CreateFolder(ctx context.Context, name, parentID string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := fmt.Sprintf("fake-folder-%d", len(c.folders)+1)
+	c.folders = append(c.folders, FakeFolder{ID: id, Name: name, ParentID: parentID})
+	return id, nil
+}
+
+func (c *FakeClient) Share(ctx context.Context, fileID, email, role string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.shares = append(c.shares, FakeShare{FileID: fileID, Email: email, Role: role})
+	return nil
+}
+
+// Folders returns the folders created so far, in creation order
+func (c *FakeClient) Folders() []FakeFolder {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]FakeFolder(nil), c.folders...)
+}
+
+// Shares returns the permissions granted so far, in order
+func (c *FakeClient) Shares() []FakeShare {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]FakeShare(nil), c.shares...)
+}
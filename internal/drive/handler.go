@@ -0,0 +1,107 @@
+package drive
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// Handler shows and re-runs the provisioning of event Drive folders. The routes are admin only.
+type Handler struct {
+	dbService   database.Service
+	provisioner *Provisioner // nil when Google Drive isn't configured
+}
+
+func NewHandler(dbService database.Service, provisioner *Provisioner) *Handler {
+	return &Handler{dbService: dbService, provisioner: provisioner}
+}
+
+// GetFoldersHandler godoc
+// @Summary Get an event's Drive folders
+// @Description Returns the Drive folders created for an event and the state of their provisioning. Admin only.
+// @Tags events
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} FoldersResponse "Drive folders"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid event ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "No Drive folders for this event yet"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/drive-folders [get]
+func (h *Handler) GetFoldersHandler(c echo.Context) error {
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return helpers.ErrBadRequest(c, "Invalid event ID")
+	}
+
+	q := repository.New(h.dbService.GetConnection())
+	folders, err := q.GetEventDriveFolders(c.Request().Context(), int32(eventID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return helpers.ErrNotFound(c, "No Drive folders for this event yet")
+		}
+		log.Error().Err(err).Int64("event_id", eventID).Msg("failed to get event drive folders")
+		return helpers.ErrInternal(c, "")
+	}
+
+	return c.JSON(http.StatusOK, toFoldersResponse(folders))
+}
+
+// ProvisionFoldersHandler godoc
+// @Summary Create an event's Drive folders
+// @Description Creates the event's missing Drive folders now, shares them with the event heads and stores the folder IDs, even if its pre-acts aren't approved yet.
+// @Description Folders created earlier are reused, so this also retries failed provisioning. Drive errors are reported in last_error. Admin only.
+// @Tags events
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} FoldersResponse "Drive folders"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid event ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Failure 503 {object} helpers.ErrorResponse "Google Drive integration not configured"
+// @Security SessionAuth
+// @Router /events/{id}/drive-folders [post]
+func (h *Handler) ProvisionFoldersHandler(c echo.Context) error {
+	if h.provisioner == nil {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": "Google Drive integration not configured"})
+	}
+	eventID, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return helpers.ErrBadRequest(c, "Invalid event ID")
+	}
+
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	// a manual run starts over the attempts of failed provisioning
+	if err := q.RequeueEventDriveFolders(ctx, repository.RequeueEventDriveFoldersParams{
+		NextAttemptAt: sql.NullTime{Time: h.provisioner.now(), Valid: true},
+		EventID:       int32(eventID),
+	}); err != nil {
+		log.Error().Err(err).Int64("event_id", eventID).Msg("failed to requeue event drive folders")
+		return helpers.ErrInternal(c, "")
+	}
+
+	folders, err := h.provisioner.Provision(ctx, int32(eventID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return helpers.ErrNotFound(c, "Event not found")
+		}
+		log.Error().Err(err).Int64("event_id", eventID).Msg("failed to provision event drive folders")
+		return helpers.ErrInternal(c, "")
+	}
+
+	return c.JSON(http.StatusOK, toFoldersResponse(folders))
+}
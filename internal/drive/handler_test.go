@@ -0,0 +1,96 @@
+package drive
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func newEventContext(method, id string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, "/events/"+id+"/drive-folders", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(id)
+	return c, rec
+}
+
+func TestGetFoldersHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM event_drive_folders WHERE event_id = ?").
+			WithArgs(int32(42)).
+			WillReturnRows(sqlmock.NewRows(folderColumns).AddRow(42, StatusDone, "root", "docu", "fin", "pub", "{}", 1, testNow, nil, testNow, testNow))
+
+		h := NewHandler(&mockDBService{db: db}, nil)
+		c, rec := newEventContext(http.MethodGet, "42")
+		if assert.NoError(t, h.GetFoldersHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var resp map[string]any
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, StatusDone, resp["status"])
+			assert.Equal(t, "pub", resp["publicity_folder_id"])
+			assert.Equal(t, "", resp["last_error"])
+			assert.NotContains(t, resp, "next_attempt_at")
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - not provisioned yet", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM event_drive_folders WHERE event_id = ?").
+			WillReturnError(sql.ErrNoRows)
+
+		h := NewHandler(&mockDBService{db: db}, nil)
+		c, rec := newEventContext(http.MethodGet, "42")
+		if assert.NoError(t, h.GetFoldersHandler(c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestProvisionFoldersHandler(t *testing.T) {
+	t.Run("fail - not configured", func(t *testing.T) {
+		h := NewHandler(nil, nil)
+		c, rec := newEventContext(http.MethodPost, "42")
+		if assert.NoError(t, h.ProvisionFoldersHandler(c)) {
+			assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		}
+	})
+
+	t.Run("fail - event not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("UPDATE event_drive_folders SET status = 'PENDING'").
+			WithArgs(testNow, int32(99)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(99)).
+			WillReturnError(sql.ErrNoRows)
+
+		client := NewFakeClient()
+		h := NewHandler(&mockDBService{db: db}, newTestProvisioner(db, client))
+		c, rec := newEventContext(http.MethodPost, "99")
+		if assert.NoError(t, h.ProvisionFoldersHandler(c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+		assert.Empty(t, client.Folders())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
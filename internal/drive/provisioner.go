@@ -0,0 +1,293 @@
+package drive
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+	"github.com/dlsu-lscs/lscs-core-api/internal/scheduler"
+)
+
+// Provisioning statuses
+const (
+	StatusPending = "PENDING"
+	StatusDone    = "DONE"
+	StatusFailed  = "FAILED"
+)
+
+const (
+	// JobName identifies the provisioning job in scheduled_jobs
+	JobName = "drive_event_folders"
+	// maxAttempts is how many times provisioning is tried before it is marked FAILED
+	maxAttempts = 6
+	// batchSize is how many events one run provisions
+	batchSize = 20
+)
+
+// Provisioner creates the Drive folders of events from a template, shares them with the
+// event heads and stores the folder IDs back on the event
+type Provisioner struct {
+	dbService database.Service
+	client    Client
+	template  Folder
+	parentID  string
+	now       func() time.Time
+	mu        sync.Mutex // keeps the job and manual runs from creating the same folders twice
+}
+
+func NewProvisioner(dbService database.Service, client Client, template Folder, parentID string) *Provisioner {
+	return &Provisioner{
+		dbService: dbService,
+		client:    client,
+		template:  template,
+		parentID:  parentID,
+		now:       time.Now,
+	}
+}
+
+// NewProvisionerFromConfig returns a provisioner using Google Drive, or the in-memory fake if DRIVE_FAKE is set.
+// It returns nil if the integration isn't configured.
+func NewProvisionerFromConfig(ctx context.Context, cfg *config.Config, dbService database.Service) *Provisioner {
+	template := DefaultTemplate()
+	if cfg.DriveTemplateFile != "" {
+		loaded, err := LoadTemplate(cfg.DriveTemplateFile)
+		if err != nil {
+			log.Error().Err(err).Str("path", cfg.DriveTemplateFile).Msg("failed to load drive template, event folders disabled")
+			return nil
+		}
+		template = loaded
+	}
+
+	if cfg.DriveFake {
+		log.Warn().Msg("using the fake Google Drive client; event folders are not really created")
+		return NewProvisioner(dbService, NewFakeClient(), template, cfg.DriveParentFolderID)
+	}
+
+	if cfg.DriveCredentialsFile == "" || cfg.DriveParentFolderID == "" {
+		log.Info().Msg("Google Drive configuration not complete, event folders disabled")
+		return nil
+	}
+	client, err := NewGoogleClient(ctx, cfg.DriveCredentialsFile)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create Google Drive client, event folders disabled")
+		return nil
+	}
+	return NewProvisioner(dbService, client, template, cfg.DriveParentFolderID)
+}
+
+// StartProvisionJob periodically creates the folders of events whose pre-acts were approved.
+// It does nothing if the provisioner is nil.
+func StartProvisionJob(ctx context.Context, dbService database.Service, cfg *config.Config, p *Provisioner) {
+	if p == nil {
+		return
+	}
+	scheduler.Start(ctx, dbService, scheduler.Job{
+		Name:     JobName,
+		Interval: cfg.DriveJobInterval,
+		Run:      p.Run,
+	})
+}
+
+// Run provisions the events that are due. Failures are recorded per event and retried with backoff.
+func (p *Provisioner) Run(ctx context.Context) error {
+	q := repository.New(p.dbService.GetConnection())
+
+	events, err := q.ListEventsDueForDriveFolders(ctx, repository.ListEventsDueForDriveFoldersParams{
+		NextAttemptAt: sql.NullTime{Time: p.now(), Valid: true},
+		Limit:         batchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("list events due for drive folders: %w", err)
+	}
+
+	for _, event := range events {
+		record, err := p.Provision(ctx, event.ID)
+		if err != nil {
+			log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to provision event drive folders")
+			continue
+		}
+		if record.Status != StatusDone {
+			log.Warn().Int32("event_id", event.ID).Str("status", record.Status).Str("error", record.LastError.String).
+				Msg("event drive folders not provisioned")
+		}
+	}
+	return nil
+}
+
+// Provision creates whatever folders of the event are missing, shares the event folder with the event heads
+// and stores the folder IDs. It is safe to run again: folders created by an earlier run are reused.
+// Drive errors are recorded on the returned record; only database errors are returned.
+func (p *Provisioner) Provision(ctx context.Context, eventID int32) (repository.EventDriveFolder, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	q := repository.New(p.dbService.GetConnection())
+	now := p.now()
+
+	event, err := q.GetEventById(ctx, eventID)
+	if err != nil {
+		return repository.EventDriveFolder{}, fmt.Errorf("get event: %w", err)
+	}
+	if err := q.CreateEventDriveFolders(ctx, repository.CreateEventDriveFoldersParams{
+		EventID:       eventID,
+		NextAttemptAt: sql.NullTime{Time: now, Valid: true},
+	}); err != nil {
+		return repository.EventDriveFolder{}, fmt.Errorf("create drive folder record: %w", err)
+	}
+	record, err := q.GetEventDriveFolders(ctx, eventID)
+	if err != nil {
+		return repository.EventDriveFolder{}, fmt.Errorf("get drive folder record: %w", err)
+	}
+
+	folders := map[string]string{}
+	if record.Folders.Valid && record.Folders.String != "" {
+		if err := json.Unmarshal([]byte(record.Folders.String), &folders); err != nil {
+			return repository.EventDriveFolder{}, fmt.Errorf("parse stored folder IDs: %w", err)
+		}
+	}
+
+	namer := eventNamer(event.Arn, event.Name, event.CommitteeID)
+	driveErr := p.createFolders(ctx, p.template, "", p.parentID, namer.Replace, folders)
+
+	// save what was created even if a later folder failed, so the next run resumes from there
+	ids := p.folderIDs(folders)
+	encoded, err := json.Marshal(folders)
+	if err != nil {
+		return repository.EventDriveFolder{}, fmt.Errorf("encode folder IDs: %w", err)
+	}
+	if err := q.SaveEventDriveFolderIDs(ctx, repository.SaveEventDriveFolderIDsParams{
+		RootFolderID: nullString(folders[""]),
+		DocuFolderID: nullString(ids[KindDocu]),
+		FinFolderID:  nullString(ids[KindFin]),
+		PubFolderID:  nullString(ids[KindPub]),
+		Folders:      sql.NullString{String: string(encoded), Valid: true},
+		EventID:      eventID,
+	}); err != nil {
+		return repository.EventDriveFolder{}, fmt.Errorf("save folder IDs: %w", err)
+	}
+
+	if driveErr == nil {
+		driveErr = p.shareWithHeads(ctx, q, eventID, folders[""])
+	}
+	if driveErr == nil {
+		if err := p.storeEventIDs(ctx, q, eventID, ids); err != nil {
+			return repository.EventDriveFolder{}, err
+		}
+	}
+
+	finish := repository.FinishEventDriveFoldersParams{
+		Status:   StatusDone,
+		Attempts: record.Attempts + 1,
+		EventID:  eventID,
+	}
+	if driveErr != nil {
+		finish.LastError = sql.NullString{String: driveErr.Error(), Valid: true}
+		if finish.Attempts >= maxAttempts {
+			finish.Status = StatusFailed
+		} else {
+			finish.Status = StatusPending
+			finish.NextAttemptAt = sql.NullTime{Time: now.Add(retryDelay(int(finish.Attempts))), Valid: true}
+		}
+	} else {
+		finish.ProvisionedAt = sql.NullTime{Time: now, Valid: true}
+	}
+	if err := q.FinishEventDriveFolders(ctx, finish); err != nil {
+		return repository.EventDriveFolder{}, fmt.Errorf("update drive folder record: %w", err)
+	}
+
+	if driveErr == nil {
+		log.Info().Int32("event_id", eventID).Str("folder_id", folders[""]).Msg("event drive folders provisioned")
+	}
+	return q.GetEventDriveFolders(ctx, eventID)
+}
+
+// createFolders creates the template folder and its descendants, skipping those already in folders.
+// Created IDs are added to folders by template key.
+func (p *Provisioner) createFolders(ctx context.Context, folder Folder, key, parentID string, name func(string) string, folders map[string]string) error {
+	id, ok := folders[key]
+	if !ok {
+		created, err := p.client.CreateFolder(ctx, name(folder.Name), parentID)
+		if err != nil {
+			return err
+		}
+		id = created
+		folders[key] = id
+	}
+
+	for _, child := range folder.Children {
+		if err := p.createFolders(ctx, child, childKey(key, child.Name), id, name, folders); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// folderIDs returns the IDs of the created folders that have a kind
+func (p *Provisioner) folderIDs(folders map[string]string) map[string]string {
+	ids := map[string]string{}
+	_ = p.template.walk("", func(key string, folder Folder) error {
+		if folder.Kind != "" && folders[key] != "" {
+			ids[folder.Kind] = folders[key]
+		}
+		return nil
+	})
+	return ids
+}
+
+// shareWithHeads gives the event heads edit access to the event folder; subfolders inherit it
+func (p *Provisioner) shareWithHeads(ctx context.Context, q *repository.Queries, eventID int32, folderID string) error {
+	heads, err := q.ListEventHeads(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("list event heads: %w", err)
+	}
+	for _, head := range heads {
+		if err := p.client.Share(ctx, folderID, head.Email, RoleWriter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storeEventIDs fills in the tracker and publicity request drive IDs that weren't set by hand
+func (p *Provisioner) storeEventIDs(ctx context.Context, q *repository.Queries, eventID int32, ids map[string]string) error {
+	if id := ids[KindDocu]; id != "" {
+		if err := q.FillTrackerDocuDriveID(ctx, repository.FillTrackerDocuDriveIDParams{DocuDriveID: nullString(id), EventID: eventID}); err != nil {
+			return fmt.Errorf("store documentation folder ID: %w", err)
+		}
+	}
+	if id := ids[KindFin]; id != "" {
+		if err := q.FillTrackerFinDriveID(ctx, repository.FillTrackerFinDriveIDParams{FinDriveID: nullString(id), EventID: eventID}); err != nil {
+			return fmt.Errorf("store finance folder ID: %w", err)
+		}
+	}
+	if id := ids[KindPub]; id != "" {
+		if _, err := q.FillPubRequestDriveIDs(ctx, repository.FillPubRequestDriveIDsParams{
+			PubDriveID: id,
+			EventID:    sql.NullInt32{Int32: eventID, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("store publicity folder ID: %w", err)
+		}
+	}
+	return nil
+}
+
+// retryDelay is 5 minutes after the first failure, doubling up to 6 hours
+func retryDelay(attempts int) time.Duration {
+	delay := 5 * time.Minute
+	for i := 1; i < attempts && delay < 6*time.Hour; i++ {
+		delay *= 2
+	}
+	return min(delay, 6*time.Hour)
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
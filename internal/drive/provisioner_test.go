@@ -0,0 +1,194 @@
+package drive
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockDBService struct {
+	db *sql.DB
+}
+
+func (m *mockDBService) Health() map[string]string {
+	return nil
+}
+
+func (m *mockDBService) Close() error {
+	return nil
+}
+
+func (m *mockDBService) GetConnection() *sql.DB {
+	return m.db
+}
+
+// failingShareClient creates folders but can't share them
+type failingShareClient struct {
+	*FakeClient
+}
+
+func (c failingShareClient) Share(ctx context.Context, fileID, email, role string) error {
+	return errors.New("drive: 403 insufficient permissions")
+}
+
+var testNow = time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+
+var folderColumns = []string{
+	"event_id", "status", "root_folder_id", "docu_folder_id", "fin_folder_id", "pub_folder_id",
+	"folders", "attempts", "next_attempt_at", "last_error", "provisioned_at", "created_at",
+}
+
+func eventRow(id int32) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "arn", "name", "committee_id", "type", "nature_id", "term_id", "created_at", "duration_id",
+		"brief_description", "goals", "objectives", "strategies", "measures", "budget_allocation", "venue",
+		"docu_head", "fin_head",
+	}).AddRow(
+		id, "2526T1-RND-001", "Intro to Go Workshop", "RND", "Workshop", 1, 1, testNow, nil,
+		nil, nil, nil, nil, nil, "1500.00", "Online",
+		nil, nil,
+	)
+}
+
+func newTestProvisioner(db *sql.DB, client Client) *Provisioner {
+	p := NewProvisioner(&mockDBService{db: db}, client, DefaultTemplate(), "parent")
+	p.now = func() time.Time { return testNow }
+	return p
+}
+
+func TestProvision(t *testing.T) {
+	t.Run("success - creates, shares and stores the folders", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(42)).
+			WillReturnRows(eventRow(42))
+		mock.ExpectExec("INSERT IGNORE INTO event_drive_folders").
+			WithArgs(int32(42), testNow).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT (.+) FROM event_drive_folders WHERE event_id = ?").
+			WillReturnRows(sqlmock.NewRows(folderColumns).AddRow(42, StatusPending, nil, nil, nil, nil, nil, 0, testNow, nil, nil, testNow))
+		mock.ExpectExec("UPDATE event_drive_folders SET root_folder_id").
+			WithArgs("fake-folder-1", "fake-folder-2", "fake-folder-5", "fake-folder-8", sqlmock.AnyArg(), int32(42)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT (.+) FROM event_heads eh").
+			WithArgs(int32(42)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "email", "position_id", "committee_id"}).
+				AddRow(12312345, "Juan Dela Cruz", "juan_delacruz@dlsu.edu.ph", "MEM", "RND").
+				AddRow(12312346, "Maria Santos", "maria_santos@dlsu.edu.ph", "MEM", "RND"))
+		mock.ExpectExec("UPDATE event_trackers SET docu_drive_id").
+			WithArgs("fake-folder-2", int32(42)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE event_trackers SET fin_drive_id").
+			WithArgs("fake-folder-5", int32(42)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE pub_requests SET pub_drive_id").
+			WithArgs("fake-folder-8", int32(42)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec("UPDATE event_drive_folders SET status").
+			WithArgs(StatusDone, int32(1), nil, nil, testNow, int32(42)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT (.+) FROM event_drive_folders WHERE event_id = ?").
+			WillReturnRows(sqlmock.NewRows(folderColumns).AddRow(42, StatusDone, "fake-folder-1", "fake-folder-2", "fake-folder-5", "fake-folder-8", "{}", 1, testNow, nil, testNow, testNow))
+
+		client := NewFakeClient()
+		record, err := newTestProvisioner(db, client).Provision(context.Background(), 42)
+		assert.NoError(t, err)
+		assert.Equal(t, StatusDone, record.Status)
+
+		folders := client.Folders()
+		if assert.Len(t, folders, 8) {
+			assert.Equal(t, FakeFolder{ID: "fake-folder-1", Name: "2526T1-RND-001 - Intro to Go Workshop", ParentID: "parent"}, folders[0])
+			assert.Equal(t, FakeFolder{ID: "fake-folder-3", Name: "Pre-acts", ParentID: "fake-folder-2"}, folders[2])
+		}
+		assert.Equal(t, []FakeShare{
+			{FileID: "fake-folder-1", Email: "juan_delacruz@dlsu.edu.ph", Role: RoleWriter},
+			{FileID: "fake-folder-1", Email: "maria_santos@dlsu.edu.ph", Role: RoleWriter},
+		}, client.Shares())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("failure - resumes from stored folders and schedules a retry", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		stored := `{"":"root","Documentation":"docu","Documentation/Pre-acts":"pre","Documentation/Post-acts":"post"}`
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WillReturnRows(eventRow(42))
+		mock.ExpectExec("INSERT IGNORE INTO event_drive_folders").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT (.+) FROM event_drive_folders WHERE event_id = ?").
+			WillReturnRows(sqlmock.NewRows(folderColumns).AddRow(42, StatusPending, "root", "docu", nil, nil, stored, 1, testNow, "timeout", nil, testNow))
+		mock.ExpectExec("UPDATE event_drive_folders SET root_folder_id").
+			WithArgs("root", "docu", "fake-folder-1", "fake-folder-4", sqlmock.AnyArg(), int32(42)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT (.+) FROM event_heads eh").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "email", "position_id", "committee_id"}).
+				AddRow(12312345, "Juan Dela Cruz", "juan_delacruz@dlsu.edu.ph", "MEM", "RND"))
+		mock.ExpectExec("UPDATE event_drive_folders SET status").
+			WithArgs(StatusPending, int32(2), testNow.Add(10*time.Minute), sqlmock.AnyArg(), nil, int32(42)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT (.+) FROM event_drive_folders WHERE event_id = ?").
+			WillReturnRows(sqlmock.NewRows(folderColumns).AddRow(42, StatusPending, "root", "docu", "fake-folder-1", "fake-folder-4", "{}", 2, testNow.Add(10*time.Minute), "drive: 403", nil, testNow))
+
+		client := failingShareClient{NewFakeClient()}
+		record, err := newTestProvisioner(db, client).Provision(context.Background(), 42)
+		assert.NoError(t, err)
+		assert.Equal(t, StatusPending, record.Status)
+
+		// only the finance and publicity folders were missing
+		folders := client.Folders()
+		if assert.Len(t, folders, 4) {
+			assert.Equal(t, FakeFolder{ID: "fake-folder-1", Name: "Finance", ParentID: "root"}, folders[0])
+			assert.Equal(t, FakeFolder{ID: "fake-folder-4", Name: "Publicity", ParentID: "root"}, folders[3])
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRun(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM events e").
+		WithArgs(testNow, int32(batchSize)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "arn", "name", "committee_id"}))
+
+	assert.NoError(t, newTestProvisioner(db, NewFakeClient()).Run(context.Background()))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 5*time.Minute, retryDelay(1))
+	assert.Equal(t, 10*time.Minute, retryDelay(2))
+	assert.Equal(t, 80*time.Minute, retryDelay(5))
+	assert.Equal(t, 6*time.Hour, retryDelay(20))
+}
+
+func TestTemplateValidate(t *testing.T) {
+	assert.NoError(t, DefaultTemplate().Validate())
+
+	tests := []struct {
+		name     string
+		template Folder
+	}{
+		{"unnamed folder", Folder{Name: "{{name}}", Children: []Folder{{Name: " "}}}},
+		{"unknown kind", Folder{Name: "{{name}}", Children: []Folder{{Name: "Docs", Kind: "docs"}}}},
+		{"kind used twice", Folder{Name: "{{name}}", Children: []Folder{{Name: "A", Kind: KindPub}, {Name: "B", Kind: KindPub}}}},
+		{"duplicate sibling names", Folder{Name: "{{name}}", Children: []Folder{{Name: "A"}, {Name: "A"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, tt.template.Validate())
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package drive
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Folder kinds mark the folders whose IDs are stored back on the event
+const (
+	KindDocu = "docu" // documentation; stored as the tracker's docu_drive_id
+	KindFin  = "fin"  // finance; stored as the tracker's fin_drive_id
+	KindPub  = "pub"  // publicity; used as the pub_drive_id of the event's publicity requests
+)
+
+// Folder is a folder in an event's folder template.
+// Names may use the {{arn}}, {{name}} and {{committee}} placeholders.
+type Folder struct {
+	Name     string   `json:"name"`
+	Kind     string   `json:"kind,omitempty"`
+	Children []Folder `json:"children,omitempty"`
+}
+
+// DefaultTemplate is used when no template file is configured
+func DefaultTemplate() Folder {
+	return Folder{
+		Name: "{{arn}} - {{name}}",
+		Children: []Folder{
+			{Name: "Documentation", Kind: KindDocu, Children: []Folder{{Name: "Pre-acts"}, {Name: "Post-acts"}}},
+			{Name: "Finance", Kind: KindFin, Children: []Folder{{Name: "Pre-acts"}, {Name: "Post-acts"}}},
+			{Name: "Publicity", Kind: KindPub},
+		},
+	}
+}
+
+// LoadTemplate reads a folder template from a JSON file
+func LoadTemplate(path string) (Folder, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Folder{}, fmt.Errorf("read drive template: %w", err)
+	}
+
+	var root Folder
+	if err := json.Unmarshal(data, &root); err != nil {
+		return Folder{}, fmt.Errorf("parse drive template: %w", err)
+	}
+	if err := root.Validate(); err != nil {
+		return Folder{}, fmt.Errorf("invalid drive template: %w", err)
+	}
+	return root, nil
+}
+
+// Validate checks that every folder has a name, sibling names are unique and each kind is used at most once
+func (f Folder) Validate() error {
+	kinds := map[string]bool{}
+	return f.walk("", func(key string, folder Folder) error {
+		if strings.TrimSpace(folder.Name) == "" {
+			return fmt.Errorf("folder %q has no name", key)
+		}
+		if folder.Kind == "" {
+			return nil
+		}
+		if folder.Kind != KindDocu && folder.Kind != KindFin && folder.Kind != KindPub {
+			return fmt.Errorf("folder %q has unknown kind %q", key, folder.Kind)
+		}
+		if kinds[folder.Kind] {
+			return fmt.Errorf("kind %q is used more than once", folder.Kind)
+		}
+		kinds[folder.Kind] = true
+		return nil
+	})
+}
+
+// walk calls fn for the folder and its descendants, parents first.
+// A folder's key is the path of template names below the root, which stays stable when an event is renamed.
+func (f Folder) walk(key string, fn func(key string, folder Folder) error) error {
+	if err := fn(key, f); err != nil {
+		return err
+	}
+	seen := map[string]bool{}
+	for _, child := range f.Children {
+		if seen[child.Name] {
+			return fmt.Errorf("folder %q has two children named %q", key, child.Name)
+		}
+		seen[child.Name] = true
+		if err := child.walk(childKey(key, child.Name), fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func childKey(parentKey, name string) string {
+	if parentKey == "" {
+		return name
+	}
+	return parentKey + "/" + name
+}
+
+// eventNamer fills in the placeholders of a template folder name
+func eventNamer(arn, name, committeeID string) *strings.Replacer {
+	return strings.NewReplacer("{{arn}}", arn, "{{name}}", name, "{{committee}}", committeeID)
+}
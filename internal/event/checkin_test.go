@@ -0,0 +1,34 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckInToken(t *testing.T) {
+	token := SignCheckInToken("secret", 3, 12412345)
+
+	eventID, studentID, err := VerifyCheckInToken("secret", token)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), eventID)
+	assert.Equal(t, int32(12412345), studentID)
+
+	tests := []struct {
+		name   string
+		secret string
+		token  string
+	}{
+		{"wrong secret", "other", token},
+		{"tampered student", "secret", "3.12412346" + token[len("3.12412345"):]},
+		{"missing signature", "secret", "3.12412345"},
+		{"not numeric", "secret", "a.b.c"},
+		{"empty", "secret", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := VerifyCheckInToken(tt.secret, tt.token)
+			assert.ErrorIs(t, err, ErrInvalidCheckInToken)
+		})
+	}
+}
@@ -0,0 +1,405 @@
+package event
+
+import (
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// DefaultVenue is used when an event is created without a venue
+const DefaultVenue = "Online"
+
+// EventDateRange represents one date range of an event (events can span several)
+type EventDateRange struct {
+	StartTime time.Time `json:"start_time" validate:"required" example:"2026-11-05T13:00:00+08:00"`
+	EndTime   time.Time `json:"end_time" validate:"required,gtfield=StartTime" example:"2026-11-05T17:00:00+08:00"`
+}
+
+// EventRequest represents the request body for creating or replacing an event
+type EventRequest struct {
+	ARN              string   `json:"arn" validate:"required,max=20" example:"2526T1-RND-001"`
+	Name             string   `json:"name" validate:"required,max=255" example:"Intro to Go Workshop"`
+	CommitteeID      string   `json:"committee_id" validate:"required,max=10" example:"RND"`
+	Type             *string  `json:"type,omitempty" validate:"omitempty,max=100" example:"Workshop"`
+	NatureID         int32    `json:"nature_id" validate:"required,gt=0" example:"1"`
+	TermID           int32    `json:"term_id,omitempty" validate:"omitempty,gt=0" example:"1"`
+	DurationID       *int32   `json:"duration_id,omitempty" validate:"omitempty,gt=0" example:"1"`
+	BriefDescription *string  `json:"brief_description,omitempty"`
+	Goals            *string  `json:"goals,omitempty"`
+	Objectives       *string  `json:"objectives,omitempty"`
+	Strategies       *string  `json:"strategies,omitempty"`
+	Measures         *string  `json:"measures,omitempty"`
+	BudgetAllocation *float64 `json:"budget_allocation,omitempty" validate:"omitempty,gte=0" example:"1500.00"`
+	Venue            *string  `json:"venue,omitempty" validate:"omitempty,max=255" example:"Gokongwei Hall"`
+	DocuHead         *int32   `json:"docu_head,omitempty" validate:"omitempty,gt=0" example:"12312345"`
+	FinHead          *int32   `json:"fin_head,omitempty" validate:"omitempty,gt=0" example:"12312346"`
+	// Dates replaces all date ranges of the event; omit it on update to keep the current ones
+	Dates []EventDateRange `json:"dates,omitempty" validate:"omitempty,dive"`
+}
+
+// AddEventHeadRequest represents the request body for assigning an event head
+type AddEventHeadRequest struct {
+	MemberID int32 `json:"member_id" validate:"required,gt=0" example:"12312345"`
+}
+
+// EventDateResponse represents a date range of an event
+type EventDateResponse struct {
+	ID        int32     `json:"id" example:"1"`
+	StartTime time.Time `json:"start_time" example:"2026-11-05T13:00:00+08:00"`
+	EndTime   time.Time `json:"end_time" example:"2026-11-05T17:00:00+08:00"`
+}
+
+// EventHeadResponse represents a member assigned as head of an event
+type EventHeadResponse struct {
+	MemberID    int32                  `json:"member_id" example:"12312345"`
+	FullName    string                 `json:"full_name" example:"Juan Dela Cruz"`
+	Email       string                 `json:"email" example:"juan_delacruz@dlsu.edu.ph"`
+	PositionID  helpers.NullableString `json:"position_id"`
+	CommitteeID helpers.NullableString `json:"committee_id"`
+}
+
+// EventResponse represents an event in API responses.
+// Dates and heads are only included when fetching a single event.
+type EventResponse struct {
+	ID               int32                  `json:"id" example:"1"`
+	ARN              string                 `json:"arn" example:"2526T1-RND-001"`
+	Name             string                 `json:"name" example:"Intro to Go Workshop"`
+	CommitteeID      string                 `json:"committee_id" example:"RND"`
+	Type             helpers.NullableString `json:"type"`
+	NatureID         int32                  `json:"nature_id" example:"1"`
+	TermID           int32                  `json:"term_id" example:"1"`
+	DurationID       *int32                 `json:"duration_id,omitempty" example:"1"`
+	BriefDescription helpers.NullableString `json:"brief_description"`
+	Goals            helpers.NullableString `json:"goals"`
+	Objectives       helpers.NullableString `json:"objectives"`
+	Strategies       helpers.NullableString `json:"strategies"`
+	Measures         helpers.NullableString `json:"measures"`
+	BudgetAllocation float64                `json:"budget_allocation" example:"1500.00"`
+	Venue            helpers.NullableString `json:"venue"`
+	DocuHead         *int32                 `json:"docu_head,omitempty" example:"12312345"`
+	FinHead          *int32                 `json:"fin_head,omitempty" example:"12312346"`
+	CreatedAt        *time.Time             `json:"created_at,omitempty"`
+	Dates            []EventDateResponse    `json:"dates,omitempty"`
+	Heads            []EventHeadResponse    `json:"heads,omitempty"`
+}
+
+// ListEventsResponse is the response for the GET /events endpoint
+type ListEventsResponse struct {
+	Events []EventResponse `json:"events"`
+}
+
+// LookupResponse represents an entry of a lookup table (natures, types, durations)
+type LookupResponse struct {
+	ID   int32  `json:"id" example:"1"`
+	Name string `json:"name" example:"Academic"`
+}
+
+// TermResponse represents an academic term
+type TermResponse struct {
+	ID        int32 `json:"id" example:"1"`
+	Term      int32 `json:"term" example:"1"`
+	StartYear int32 `json:"start_year" example:"2025"`
+	EndYear   int32 `json:"end_year" example:"2026"`
+}
+
+// EventOptionsResponse lists the values accepted by the event form
+type EventOptionsResponse struct {
+	Natures   []LookupResponse `json:"natures"`
+	Types     []LookupResponse `json:"types"`
+	Durations []LookupResponse `json:"durations"`
+	Terms     []TermResponse   `json:"terms"`
+}
+
+func toEventResponse(e repository.Event) EventResponse {
+	resp := EventResponse{
+		ID:               e.ID,
+		ARN:              e.Arn,
+		Name:             e.Name,
+		CommitteeID:      e.CommitteeID,
+		Type:             helpers.NullableString{NullString: e.Type},
+		NatureID:         e.NatureID,
+		TermID:           e.TermID,
+		BriefDescription: helpers.NullableString{NullString: e.BriefDescription},
+		Goals:            helpers.NullableString{NullString: e.Goals},
+		Objectives:       helpers.NullableString{NullString: e.Objectives},
+		Strategies:       helpers.NullableString{NullString: e.Strategies},
+		Measures:         helpers.NullableString{NullString: e.Measures},
+		Venue:            helpers.NullableString{NullString: e.Venue},
+	}
+	if e.DurationID.Valid {
+		resp.DurationID = &e.DurationID.Int32
+	}
+	if e.DocuHead.Valid {
+		resp.DocuHead = &e.DocuHead.Int32
+	}
+	if e.FinHead.Valid {
+		resp.FinHead = &e.FinHead.Int32
+	}
+	if e.CreatedAt.Valid {
+		resp.CreatedAt = &e.CreatedAt.Time
+	}
+	if e.BudgetAllocation.Valid {
+		// DECIMAL(10,2) is scanned as a string
+		resp.BudgetAllocation, _ = strconv.ParseFloat(e.BudgetAllocation.String, 64)
+	}
+	return resp
+}
+
+// toNullString converts an optional string to sql.NullString
+func toNullString(s *string) sql.NullString {
+	if s == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *s, Valid: true}
+}
+
+// toNullInt32 converts an optional int32 to sql.NullInt32
+func toNullInt32(i *int32) sql.NullInt32 {
+	if i == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: *i, Valid: true}
+}
+
+// budgetString formats a budget for the DECIMAL(10,2) column
+func budgetString(budget *float64) sql.NullString {
+	if budget == nil {
+		return sql.NullString{String: "0.00", Valid: true}
+	}
+	return sql.NullString{String: strconv.FormatFloat(*budget, 'f', 2, 64), Valid: true}
+}
+
+// RegisterRequest represents the request body for registering a non-member for an event
+type RegisterRequest struct {
+	StudentID    int32   `json:"student_id" validate:"required,gt=0" example:"12412345"`
+	StudentName  string  `json:"student_name" validate:"required,max=255" example:"Maria Santos"`
+	StudentEmail string  `json:"student_email" validate:"required,email,max=255" example:"maria_santos@dlsu.edu.ph"`
+	Notes        *string `json:"notes,omitempty" validate:"omitempty,max=512" example:"Vegetarian"`
+}
+
+// VerifyRegistrationRequest represents the request body for confirming a public registration
+type VerifyRegistrationRequest struct {
+	Token string `json:"token" validate:"required" example:"1.12412345.1793952000.Xb7q..."`
+}
+
+// PendingRegistrationResponse is the response of a public registration, which is confirmed from the emailed link
+type PendingRegistrationResponse struct {
+	Message   string    `json:"message" example:"Check your email to confirm your registration"`
+	Email     string    `json:"email" example:"maria_santos@dlsu.edu.ph"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// RegisterMeRequest represents the optional request body for a member registering themselves
+type RegisterMeRequest struct {
+	Notes *string `json:"notes,omitempty" validate:"omitempty,max=512" example:"Vegetarian"`
+}
+
+// CheckInRequest represents the request body for checking in a participant (the scanned QR payload)
+type CheckInRequest struct {
+	Token string `json:"token" validate:"required" example:"1.12412345.q3Zk..."`
+}
+
+// ParticipantResponse represents a participant of an event
+type ParticipantResponse struct {
+	EventID      int32                  `json:"event_id" example:"1"`
+	StudentID    int32                  `json:"student_id" example:"12412345"`
+	StudentName  helpers.NullableString `json:"student_name"`
+	StudentEmail helpers.NullableString `json:"student_email"`
+	Notes        helpers.NullableString `json:"notes"`
+	RegisteredAt *time.Time             `json:"registered_at,omitempty"`
+	CheckedInAt  *time.Time             `json:"checked_in_at,omitempty"`
+	CheckedInBy  *int32                 `json:"checked_in_by,omitempty" example:"12312345"`
+	VerifiedAt   *time.Time             `json:"verified_at,omitempty"`
+}
+
+// ListParticipantsResponse is the response for the GET /events/:id/participants endpoint
+type ListParticipantsResponse struct {
+	Participants []ParticipantResponse `json:"participants"`
+	Total        int                   `json:"total" example:"120"`
+	CheckedIn    int                   `json:"checked_in" example:"98"`
+}
+
+// TicketResponse contains the signed check-in token of a participant, to be shown as a QR code
+type TicketResponse struct {
+	Participant  ParticipantResponse `json:"participant"`
+	CheckInToken string              `json:"check_in_token" example:"1.12412345.q3Zk..."`
+}
+
+// CheckInResponse is the response of a check-in scan
+type CheckInResponse struct {
+	Participant      ParticipantResponse `json:"participant"`
+	AlreadyCheckedIn bool                `json:"already_checked_in" example:"false"`
+}
+
+// ImportRowError describes a CSV row that could not be imported
+type ImportRowError struct {
+	Line  int    `json:"line" example:"4"`
+	Error string `json:"error" example:"student_id must be a positive number"`
+}
+
+// ImportParticipantsResponse summarizes a CSV import
+type ImportParticipantsResponse struct {
+	Added   int              `json:"added" example:"40"`
+	Skipped int              `json:"skipped" example:"2"`
+	Errors  []ImportRowError `json:"errors,omitempty"`
+}
+
+func toParticipantResponse(p repository.EventParticipant) ParticipantResponse {
+	resp := ParticipantResponse{
+		EventID:      p.EventID,
+		StudentID:    p.StudentID,
+		StudentName:  helpers.NullableString{NullString: p.StudentName},
+		StudentEmail: helpers.NullableString{NullString: p.StudentEmail},
+		Notes:        helpers.NullableString{NullString: p.Notes},
+	}
+	if p.RegisteredAt.Valid {
+		resp.RegisteredAt = &p.RegisteredAt.Time
+	}
+	if p.CheckedInAt.Valid {
+		resp.CheckedInAt = &p.CheckedInAt.Time
+	}
+	if p.CheckedInBy.Valid {
+		resp.CheckedInBy = &p.CheckedInBy.Int32
+	}
+	if p.VerifiedAt.Valid {
+		resp.VerifiedAt = &p.VerifiedAt.Time
+	}
+	return resp
+}
+
+// FileUploadURLRequest represents the request body for uploading a document of an event
+type FileUploadURLRequest struct {
+	FileName    string `json:"file_name" validate:"required,max=200" example:"activity-proposal.pdf"`
+	ContentType string `json:"content_type" validate:"required,max=100" example:"application/pdf"`
+}
+
+// FileUploadURLResponse contains the pre-signed URL a document is uploaded to
+type FileUploadURLResponse struct {
+	UploadURL string `json:"upload_url" example:"https://storage.example.com/bucket/event-files/1/..."`
+	ObjectKey string `json:"object_key" example:"event-files/1/20261018-120000-activity-proposal.pdf"`
+	ExpiresIn int    `json:"expires_in" example:"900"`
+}
+
+// CompleteFileUploadRequest represents the request body for saving an uploaded document.
+// With file_id, the document replaces the file of an existing slot (such as a required document);
+// otherwise it is added at the end of the event's files and needs a title.
+type CompleteFileUploadRequest struct {
+	ObjectKey   string  `json:"object_key" validate:"required,max=255" example:"event-files/1/20261018-120000-activity-proposal.pdf"`
+	ContentType string  `json:"content_type" validate:"required,max=100" example:"application/pdf"`
+	Title       *string `json:"title,omitempty" validate:"omitempty,max=255" example:"Activity proposal"`
+	FileID      *int32  `json:"file_id,omitempty" validate:"omitempty,gt=0" example:"1"`
+}
+
+// ReorderFilesRequest lists every file of an event in its new order
+type ReorderFilesRequest struct {
+	FileIDs []int32 `json:"file_ids" validate:"required,min=1,dive,gt=0" example:"3,1,2"`
+}
+
+// FileTransitionRequest represents the request body for changing the status of a file
+type FileTransitionRequest struct {
+	Status string  `json:"status" validate:"required,max=20" example:"REVISE"`
+	Note   *string `json:"note,omitempty" validate:"omitempty,max=2000" example:"Please attach the signed budget page"`
+}
+
+// FileMemberResponse represents the uploader or reviewer of a file
+type FileMemberResponse struct {
+	ID       int32  `json:"id" example:"12312345"`
+	FullName string `json:"full_name" example:"Juan Dela Cruz"`
+}
+
+// EventFileResponse represents a document of an event.
+// download_url is only set for uploaded files when storage is available.
+type EventFileResponse struct {
+	ID           int32                  `json:"id" example:"1"`
+	EventID      int32                  `json:"event_id" example:"1"`
+	Title        string                 `json:"title" example:"Activity proposal"`
+	Order        int32                  `json:"order" example:"1"`
+	Status       string                 `json:"status" example:"DONE"`
+	TemplateID   *int32                 `json:"template_id,omitempty" example:"1"`
+	Uploaded     bool                   `json:"uploaded" example:"true"`
+	ObjectKey    helpers.NullableString `json:"object_key"`
+	ContentType  helpers.NullableString `json:"content_type"`
+	UploadedBy   *FileMemberResponse    `json:"uploaded_by,omitempty"`
+	UploadedAt   *time.Time             `json:"uploaded_at,omitempty"`
+	ReviewerNote helpers.NullableString `json:"reviewer_note"`
+	ReviewedBy   *FileMemberResponse    `json:"reviewed_by,omitempty"`
+	ReviewedAt   *time.Time             `json:"reviewed_at,omitempty"`
+	DownloadURL  string                 `json:"download_url,omitempty"`
+	Next         []FileTransition       `json:"next"`
+}
+
+// ListFilesResponse lists the documents of an event in order
+type ListFilesResponse struct {
+	Files    []EventFileResponse `json:"files"`
+	Total    int                 `json:"total" example:"5"`
+	Approved int                 `json:"approved" example:"3"`
+}
+
+// DocumentTemplateResponse represents a document template
+type DocumentTemplateResponse struct {
+	ID          int32  `json:"id" example:"1"`
+	Title       string `json:"title" example:"Activity proposal"`
+	Order       int32  `json:"order" example:"1"`
+	Required    bool   `json:"required" example:"true"`
+	DownloadURL string `json:"download_url,omitempty"`
+}
+
+// ListDocumentTemplatesResponse is the response for the GET /events/document-templates endpoint
+type ListDocumentTemplatesResponse struct {
+	Templates []DocumentTemplateResponse `json:"templates"`
+}
+
+// FileStatusResponse represents a status of the file review workflow
+type FileStatusResponse struct {
+	ID   string `json:"id" example:"REVISE"`
+	Name string `json:"name" example:"For revision"`
+}
+
+// FileWorkflowResponse is the response for the GET /events/file-workflow endpoint
+type FileWorkflowResponse struct {
+	Initial     string               `json:"initial" example:"DRAFTING"`
+	Statuses    []FileStatusResponse `json:"statuses"`
+	Transitions []FileTransition     `json:"transitions"`
+}
+
+// CreateTemplateFilesResponse summarizes the required documents added to an event
+type CreateTemplateFilesResponse struct {
+	Created int64 `json:"created" example:"2"`
+}
+
+func toEventFileResponse(f repository.GetEventFileRow) EventFileResponse {
+	resp := EventFileResponse{
+		ID:           f.ID,
+		EventID:      f.EventID,
+		Title:        f.Title,
+		Order:        f.FileOrder,
+		Status:       f.FileStatus,
+		Uploaded:     f.FileKey.Valid,
+		ObjectKey:    helpers.NullableString{NullString: f.FileKey},
+		ContentType:  helpers.NullableString{NullString: f.ContentType},
+		UploadedBy:   toFileMemberResponse(f.UploadedBy, f.UploadedByName),
+		ReviewerNote: helpers.NullableString{NullString: f.ReviewerNote},
+		ReviewedBy:   toFileMemberResponse(f.ReviewedBy, f.ReviewedByName),
+		Next:         NextFileTransitions(f.FileStatus),
+	}
+	if f.TemplateID.Valid {
+		resp.TemplateID = &f.TemplateID.Int32
+	}
+	if f.UploadedAt.Valid {
+		resp.UploadedAt = &f.UploadedAt.Time
+	}
+	if f.ReviewedAt.Valid {
+		resp.ReviewedAt = &f.ReviewedAt.Time
+	}
+	return resp
+}
+
+func toFileMemberResponse(id sql.NullInt32, name sql.NullString) *FileMemberResponse {
+	if !id.Valid {
+		return nil
+	}
+	return &FileMemberResponse{ID: id.Int32, FullName: name.String}
+}
@@ -0,0 +1,871 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+	"github.com/dlsu-lscs/lscs-core-api/internal/storage"
+)
+
+// File status IDs (file_statuses table)
+const (
+	FileStatusDrafting = "DRAFTING"
+	FileStatusDone     = "DONE"
+	FileStatusRevise   = "REVISE"
+	FileStatusApproved = "APPROVED"
+)
+
+// FileRole is the side of an event's documents allowed to make a transition
+type FileRole string
+
+const (
+	// FileRoleOrganizer is the event's committee managers and heads, who prepare the documents
+	FileRoleOrganizer FileRole = "organizer"
+	// FileRoleReviewer is the Documentation managers and the event's documentation heads
+	FileRoleReviewer FileRole = "reviewer"
+)
+
+// FileTransition is an allowed status change of an event file
+type FileTransition struct {
+	From string   `json:"from" example:"DONE"`
+	To   string   `json:"to" example:"APPROVED"`
+	By   FileRole `json:"by" example:"reviewer"`
+}
+
+// FileTransitions is the document review workflow: organizers upload a document and mark it done,
+// reviewers approve it or send it back for revision with a note.
+var FileTransitions = []FileTransition{
+	{From: FileStatusDrafting, To: FileStatusDone, By: FileRoleOrganizer},
+	{From: FileStatusDone, To: FileStatusDrafting, By: FileRoleOrganizer},
+	{From: FileStatusDone, To: FileStatusApproved, By: FileRoleReviewer},
+	{From: FileStatusDone, To: FileStatusRevise, By: FileRoleReviewer},
+	{From: FileStatusRevise, To: FileStatusDone, By: FileRoleOrganizer},
+	{From: FileStatusApproved, To: FileStatusRevise, By: FileRoleReviewer},
+}
+
+// NextFileTransitions returns the transitions allowed from a file status
+func NextFileTransitions(from string) []FileTransition {
+	next := []FileTransition{}
+	for _, t := range FileTransitions {
+		if t.From == from {
+			next = append(next, t)
+		}
+	}
+	return next
+}
+
+// FindFileTransition returns the transition from one file status to another, if it is allowed
+func FindFileTransition(from, to string) (FileTransition, bool) {
+	for _, t := range FileTransitions {
+		if t.From == from && t.To == to {
+			return t, true
+		}
+	}
+	return FileTransition{}, false
+}
+
+// fileStatusAfterUpload returns the status of a file after a new version is uploaded.
+// Files sent back for revision keep their status (and reviewer note) until they are marked done again;
+// any other file has to be submitted again.
+func fileStatusAfterUpload(status string) string {
+	if status == FileStatusRevise {
+		return FileStatusRevise
+	}
+	return FileStatusDrafting
+}
+
+// fileAccess describes what a member can do with the files of an event
+type fileAccess struct {
+	organizer bool // manages the event's committee or heads the event
+	reviewer  bool // manages the Documentation committee or is one of the event's documentation heads
+}
+
+func (a fileAccess) can(role FileRole) bool {
+	switch role {
+	case FileRoleOrganizer:
+		return a.organizer
+	case FileRoleReviewer:
+		return a.reviewer
+	}
+	return false
+}
+
+// FileHandler handles the documents of events: uploads, ordering and review.
+// Files are stored in S3 under storage.EventFilePrefix and uploaded directly by clients with pre-signed URLs.
+type FileHandler struct {
+	cfg         *config.Config
+	dbService   database.Service
+	rbacService *auth.RBACService
+	s3Service   *storage.S3Service
+}
+
+func NewFileHandler(cfg *config.Config, dbService database.Service, rbacService *auth.RBACService, s3Service *storage.S3Service) *FileHandler {
+	return &FileHandler{
+		cfg:         cfg,
+		dbService:   dbService,
+		rbacService: rbacService,
+		s3Service:   s3Service,
+	}
+}
+
+// GetFileWorkflowHandler returns the statuses and transitions of event files
+// @Summary Get file review workflow
+// @Description Get the statuses of event files and the transitions allowed between them
+// @Tags event-files
+// @Produce json
+// @Success 200 {object} FileWorkflowResponse "File review workflow"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/file-workflow [get]
+func (h *FileHandler) GetFileWorkflowHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	statuses, err := q.ListFileStatuses(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list file statuses")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	resp := FileWorkflowResponse{
+		Initial:     FileStatusDrafting,
+		Statuses:    make([]FileStatusResponse, 0, len(statuses)),
+		Transitions: FileTransitions,
+	}
+	for _, s := range statuses {
+		resp.Statuses = append(resp.Statuses, FileStatusResponse{ID: s.ID, Name: s.Title})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ListDocumentTemplatesHandler lists the document templates
+// @Summary List document templates
+// @Description List the document templates in order. Required templates are added to every new event as empty files. download_url is only set when storage is available.
+// @Tags event-files
+// @Produce json
+// @Success 200 {object} ListDocumentTemplatesResponse "Document templates"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/document-templates [get]
+func (h *FileHandler) ListDocumentTemplatesHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	templates, err := q.ListDocumentTemplates(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list document templates")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	resp := ListDocumentTemplatesResponse{Templates: make([]DocumentTemplateResponse, 0, len(templates))}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, DocumentTemplateResponse{
+			ID:          t.ID,
+			Title:       t.DocTitle,
+			Order:       t.DocOrder,
+			Required:    t.Required,
+			DownloadURL: h.downloadURL(ctx, t.DocKey),
+		})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ListFilesHandler lists the documents of an event
+// @Summary List event files
+// @Description List the documents of an event in order, with their review status. Only for the event's organizers and documentation reviewers.
+// @Tags event-files
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} ListFilesResponse "Event files"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid event ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Cannot access the files of this event"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/files [get]
+func (h *FileHandler) ListFilesHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	event, _, ok, err := h.getFileAccess(c)
+	if !ok {
+		return err
+	}
+
+	files, err := q.ListEventFiles(ctx, event.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to list event files")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	resp := ListFilesResponse{Files: make([]EventFileResponse, 0, len(files)), Total: len(files)}
+	for _, f := range files {
+		if f.FileStatus == FileStatusApproved {
+			resp.Approved++
+		}
+		resp.Files = append(resp.Files, h.toFileResponse(ctx, repository.GetEventFileRow(f)))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CreateFileUploadURLHandler generates a pre-signed URL for uploading a document of an event
+// @Summary Get event file upload URL
+// @Description Get a pre-signed URL to upload a document of an event directly to storage. After uploading, save the file with POST /events/{id}/files. Only for the event's organizers.
+// @Tags event-files
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body FileUploadURLRequest true "File name and content type"
+// @Success 200 {object} FileUploadURLResponse "Pre-signed upload URL"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Only the event's organizers can manage its files"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Failure 503 {object} helpers.ErrorResponse "Storage service not available"
+// @Security SessionAuth
+// @Router /events/{id}/files/upload-url [post]
+func (h *FileHandler) CreateFileUploadURLHandler(c echo.Context) error {
+	if !h.s3Service.IsEnabled() {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": "Storage service not available"})
+	}
+
+	var req FileUploadURLRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+	if !storage.IsValidDocumentType(req.ContentType) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Unsupported file type"})
+	}
+
+	event, _, ok, err := h.getOrganizerEvent(c)
+	if !ok {
+		return err
+	}
+
+	uploadURL, objectKey, err := h.s3Service.GenerateDocumentUploadURL(c.Request().Context(), event.ID, req.FileName, req.ContentType)
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to generate document upload URL")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to generate upload URL"})
+	}
+
+	return c.JSON(http.StatusOK, FileUploadURLResponse{
+		UploadURL: uploadURL,
+		ObjectKey: objectKey,
+		ExpiresIn: 900, // 15 minutes
+	})
+}
+
+// CompleteFileUploadHandler saves a document uploaded with a pre-signed URL
+// @Summary Save uploaded event file
+// @Description Save a document uploaded with a pre-signed URL. With file_id, the upload replaces the file of an existing slot (such as a required document); otherwise it is added as a new file at the end of the list and needs a title. Only for the event's organizers.
+// @Tags event-files
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body CompleteFileUploadRequest true "Uploaded object"
+// @Success 200 {object} EventFileResponse "Replaced file"
+// @Success 201 {object} EventFileResponse "New file"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request or object not uploaded"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Only the event's organizers can manage its files"
+// @Failure 404 {object} helpers.ErrorResponse "Event or file not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Failure 503 {object} helpers.ErrorResponse "Storage service not available"
+// @Security SessionAuth
+// @Router /events/{id}/files [post]
+func (h *FileHandler) CompleteFileUploadHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	if !h.s3Service.IsEnabled() {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": "Storage service not available"})
+	}
+
+	var req CompleteFileUploadRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+	if !storage.IsValidDocumentType(req.ContentType) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Unsupported file type"})
+	}
+	if req.FileID == nil && (req.Title == nil || strings.TrimSpace(*req.Title) == "") {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "A title is required for new files"})
+	}
+
+	event, principal, ok, err := h.getOrganizerEvent(c)
+	if !ok {
+		return err
+	}
+
+	// clients can only save objects uploaded for this event
+	if !strings.HasPrefix(req.ObjectKey, storage.EventFilePrefix(event.ID)) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid object key"})
+	}
+	exists, err := h.s3Service.ObjectExists(ctx, req.ObjectKey)
+	if err != nil {
+		log.Error().Err(err).Str("object_key", req.ObjectKey).Msg("failed to check uploaded object")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	if !exists {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "File has not been uploaded"})
+	}
+
+	now := sql.NullTime{Time: time.Now(), Valid: true}
+	uploader := sql.NullInt32{Int32: principal.MemberID, Valid: true}
+	contentType := sql.NullString{String: req.ContentType, Valid: true}
+	objectKey := sql.NullString{String: req.ObjectKey, Valid: true}
+
+	if req.FileID != nil {
+		file, ok, err := h.getFile(c, event.ID, *req.FileID)
+		if !ok {
+			return err
+		}
+
+		if err := q.UpdateEventFileUpload(ctx, repository.UpdateEventFileUploadParams{
+			FileKey:     objectKey,
+			ContentType: contentType,
+			UploadedBy:  uploader,
+			UploadedAt:  now,
+			FileStatus:  fileStatusAfterUpload(file.FileStatus),
+			ID:          file.ID,
+		}); err != nil {
+			log.Error().Err(err).Int32("file_id", file.ID).Msg("failed to update event file")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+		}
+
+		// the previous version is no longer referenced
+		if file.FileKey.Valid && file.FileKey.String != req.ObjectKey {
+			if err := h.s3Service.DeleteObject(ctx, file.FileKey.String); err != nil {
+				log.Warn().Err(err).Str("object_key", file.FileKey.String).Msg("failed to delete previous event file")
+			}
+		}
+
+		log.Info().
+			Int32("event_id", event.ID).
+			Int32("file_id", file.ID).
+			Int32("uploaded_by", principal.MemberID).
+			Msg("event file replaced")
+
+		return h.respondWithFile(c, http.StatusOK, event.ID, file.ID)
+	}
+
+	files, err := q.ListEventFiles(ctx, event.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to list event files")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	order := int32(1)
+	if len(files) > 0 {
+		order = files[len(files)-1].FileOrder + 1
+	}
+
+	id, err := q.CreateEventFile(ctx, repository.CreateEventFileParams{
+		EventID:     event.ID,
+		FileKey:     objectKey,
+		FileOrder:   order,
+		FileStatus:  FileStatusDrafting,
+		Title:       strings.TrimSpace(*req.Title),
+		ContentType: contentType,
+		UploadedBy:  uploader,
+		UploadedAt:  now,
+	})
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to create event file")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().
+		Int32("event_id", event.ID).
+		Int64("file_id", id).
+		Int32("uploaded_by", principal.MemberID).
+		Msg("event file added")
+
+	return h.respondWithFile(c, http.StatusCreated, event.ID, int32(id))
+}
+
+// ReorderFilesHandler changes the order of an event's files
+// @Summary Reorder event files
+// @Description Set the order of an event's files. file_ids must list every file of the event exactly once. Only for the event's organizers.
+// @Tags event-files
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body ReorderFilesRequest true "File IDs in their new order"
+// @Success 200 {object} ListFilesResponse "Reordered files"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Only the event's organizers can manage its files"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/files/order [put]
+func (h *FileHandler) ReorderFilesHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	db := h.dbService.GetConnection()
+	q := repository.New(db)
+
+	var req ReorderFilesRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	event, principal, ok, err := h.getOrganizerEvent(c)
+	if !ok {
+		return err
+	}
+
+	files, err := q.ListEventFiles(ctx, event.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to list event files")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	if err := validateFileOrder(req.FileIDs, files); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to begin transaction")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	defer tx.Rollback()
+	qtx := q.WithTx(tx)
+
+	for i, id := range req.FileIDs {
+		if err := qtx.UpdateEventFileOrder(ctx, repository.UpdateEventFileOrderParams{
+			FileOrder: int32(i + 1),
+			ID:        id,
+			EventID:   event.ID,
+		}); err != nil {
+			log.Error().Err(err).Int32("file_id", id).Msg("failed to update file order")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error().Err(err).Msg("failed to commit file order")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().
+		Int32("event_id", event.ID).
+		Int32("changed_by", principal.MemberID).
+		Msg("event files reordered")
+
+	return h.ListFilesHandler(c)
+}
+
+// TransitionFileHandler changes the review status of a file
+// @Summary Change event file status
+// @Description Move a file to another status of the review workflow (see GET /events/file-workflow). Organizers mark files done; documentation reviewers approve them or send them back for revision, which needs a note.
+// @Tags event-files
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param file_id path int true "File ID"
+// @Param request body FileTransitionRequest true "New status"
+// @Success 200 {object} EventFileResponse "Updated file"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request or transition"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Transition can only be made by another role"
+// @Failure 404 {object} helpers.ErrorResponse "Event or file not found"
+// @Failure 409 {object} helpers.ErrorResponse "File not uploaded or status changed concurrently"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/files/{file_id}/transitions [post]
+func (h *FileHandler) TransitionFileHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	var req FileTransitionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	event, principal, acc, ok, err := h.getFileAccessWithPrincipal(c)
+	if !ok {
+		return err
+	}
+
+	fileID, err := parseFileID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid file ID"})
+	}
+	file, ok, err := h.getFile(c, event.ID, fileID)
+	if !ok {
+		return err
+	}
+
+	transition, found := FindFileTransition(file.FileStatus, req.Status)
+	if !found {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": fmt.Sprintf("Cannot move file from %s to %s", file.FileStatus, req.Status),
+		})
+	}
+	if !acc.can(transition.By) {
+		who := "the event's organizers"
+		if transition.By == FileRoleReviewer {
+			who = "documentation reviewers"
+		}
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "This transition can only be made by " + who})
+	}
+	if req.Status == FileStatusDone && !file.FileKey.Valid {
+		return c.JSON(http.StatusConflict, map[string]string{"error": "Upload the file before marking it done"})
+	}
+	note := toNullString(req.Note)
+	if note.Valid {
+		note.String = strings.TrimSpace(note.String)
+		note.Valid = note.String != ""
+	}
+	if req.Status == FileStatusRevise && !note.Valid {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "A note is required when asking for revisions"})
+	}
+
+	var rows int64
+	if transition.By == FileRoleReviewer {
+		rows, err = q.ReviewEventFile(ctx, repository.ReviewEventFileParams{
+			FileStatus:   req.Status,
+			ReviewerNote: note,
+			ReviewedBy:   sql.NullInt32{Int32: principal.MemberID, Valid: true},
+			ReviewedAt:   sql.NullTime{Time: time.Now(), Valid: true},
+			ID:           file.ID,
+			FileStatus_2: file.FileStatus,
+		})
+	} else {
+		rows, err = q.UpdateEventFileStatus(ctx, repository.UpdateEventFileStatusParams{
+			FileStatus:   req.Status,
+			ID:           file.ID,
+			FileStatus_2: file.FileStatus,
+		})
+	}
+	if err != nil {
+		log.Error().Err(err).Int32("file_id", file.ID).Msg("failed to update file status")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	if rows == 0 {
+		return c.JSON(http.StatusConflict, map[string]string{"error": "Status was changed by someone else, reload and try again"})
+	}
+
+	log.Info().
+		Int32("event_id", event.ID).
+		Int32("file_id", file.ID).
+		Str("from", file.FileStatus).
+		Str("to", req.Status).
+		Int32("changed_by", principal.MemberID).
+		Msg("event file status changed")
+
+	return h.respondWithFile(c, http.StatusOK, event.ID, file.ID)
+}
+
+// DeleteFileHandler removes a file of an event
+// @Summary Delete event file
+// @Description Remove a file of an event and its stored object. Required documents cannot be removed; upload a new version instead. Only for the event's organizers.
+// @Tags event-files
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param file_id path int true "File ID"
+// @Success 200 {object} map[string]string "File deleted"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Only the event's organizers can manage its files"
+// @Failure 404 {object} helpers.ErrorResponse "Event or file not found"
+// @Failure 409 {object} helpers.ErrorResponse "Required documents cannot be removed"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/files/{file_id} [delete]
+func (h *FileHandler) DeleteFileHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	event, principal, ok, err := h.getOrganizerEvent(c)
+	if !ok {
+		return err
+	}
+
+	fileID, err := parseFileID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid file ID"})
+	}
+	file, ok, err := h.getFile(c, event.ID, fileID)
+	if !ok {
+		return err
+	}
+	if file.TemplateID.Valid {
+		return c.JSON(http.StatusConflict, map[string]string{"error": "Required documents cannot be removed, upload a new version instead"})
+	}
+
+	if err := q.DeleteEventFile(ctx, repository.DeleteEventFileParams{ID: file.ID, EventID: event.ID}); err != nil {
+		log.Error().Err(err).Int32("file_id", file.ID).Msg("failed to delete event file")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	if file.FileKey.Valid && h.s3Service.IsEnabled() {
+		if err := h.s3Service.DeleteObject(ctx, file.FileKey.String); err != nil {
+			log.Warn().Err(err).Str("object_key", file.FileKey.String).Msg("failed to delete event file object")
+		}
+	}
+
+	log.Info().
+		Int32("event_id", event.ID).
+		Int32("file_id", file.ID).
+		Int32("deleted_by", principal.MemberID).
+		Msg("event file deleted")
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "File deleted successfully"})
+}
+
+// CreateTemplateFilesHandler adds the missing required documents to an event
+// @Summary Add required documents
+// @Description Add an empty file for every required document template the event does not have yet. New events get them when they are created; use this after templates are added. Only for the event's organizers.
+// @Tags event-files
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} CreateTemplateFilesResponse "Number of files added"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid event ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Only the event's organizers can manage its files"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/files/from-templates [post]
+func (h *FileHandler) CreateTemplateFilesHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	event, principal, ok, err := h.getOrganizerEvent(c)
+	if !ok {
+		return err
+	}
+
+	created, err := createRequiredFiles(c.Request().Context(), q, event.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to create required files")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().
+		Int32("event_id", event.ID).
+		Int64("created", created).
+		Int32("created_by", principal.MemberID).
+		Msg("required event files added")
+
+	return c.JSON(http.StatusOK, CreateTemplateFilesResponse{Created: created})
+}
+
+// createRequiredFiles adds an empty file for every required document template.
+// Templates the event already has are skipped; returns the number of files added.
+func createRequiredFiles(ctx context.Context, q *repository.Queries, eventID int32) (int64, error) {
+	templates, err := q.ListRequiredDocumentTemplates(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var created int64
+	for _, t := range templates {
+		rows, err := q.CreateTemplateFile(ctx, repository.CreateTemplateFileParams{
+			EventID:    eventID,
+			TemplateID: sql.NullInt32{Int32: t.ID, Valid: true},
+			Title:      t.DocTitle,
+			FileOrder:  t.DocOrder,
+			FileStatus: FileStatusDrafting,
+		})
+		if err != nil {
+			return created, err
+		}
+		created += rows
+	}
+	return created, nil
+}
+
+// validateFileOrder checks that ids lists every file exactly once
+func validateFileOrder(ids []int32, files []repository.ListEventFilesRow) error {
+	if len(ids) != len(files) {
+		return fmt.Errorf("file_ids must list all %d files of the event", len(files))
+	}
+
+	remaining := make(map[int32]bool, len(files))
+	for _, f := range files {
+		remaining[f.ID] = true
+	}
+	for _, id := range ids {
+		if !remaining[id] {
+			return fmt.Errorf("file %d is not a file of the event or is listed twice", id)
+		}
+		delete(remaining, id)
+	}
+	return nil
+}
+
+// getFileAccess loads the event in the "id" path parameter and checks that the principal
+// is one of its organizers or documentation reviewers. If ok is false, the error response was already written.
+func (h *FileHandler) getFileAccess(c echo.Context) (repository.Event, fileAccess, bool, error) {
+	event, _, acc, ok, err := h.getFileAccessWithPrincipal(c)
+	return event, acc, ok, err
+}
+
+func (h *FileHandler) getFileAccessWithPrincipal(c echo.Context) (repository.Event, *auth.Principal, fileAccess, bool, error) {
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return repository.Event{}, nil, fileAccess{}, false, c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	event, ok, err := h.getEvent(c)
+	if !ok {
+		return event, nil, fileAccess{}, false, err
+	}
+
+	acc := h.getAccess(c.Request().Context(), principal, event)
+	if !acc.organizer && !acc.reviewer {
+		log.Warn().
+			Int32("member_id", principal.MemberID).
+			Int32("event_id", event.ID).
+			Msg("event files access denied")
+		return event, nil, acc, false, c.JSON(http.StatusForbidden, map[string]string{"error": "Cannot access the files of this event"})
+	}
+
+	return event, principal, acc, true, nil
+}
+
+// getOrganizerEvent loads the event in the "id" path parameter and checks that the principal
+// is one of its organizers. If ok is false, the error response was already written.
+func (h *FileHandler) getOrganizerEvent(c echo.Context) (repository.Event, *auth.Principal, bool, error) {
+	event, principal, acc, ok, err := h.getFileAccessWithPrincipal(c)
+	if !ok {
+		return event, nil, false, err
+	}
+	if !acc.organizer {
+		return event, nil, false, c.JSON(http.StatusForbidden, map[string]string{"error": "Only the event's organizers can manage its files"})
+	}
+	return event, principal, true, nil
+}
+
+func (h *FileHandler) getAccess(ctx context.Context, principal *auth.Principal, event repository.Event) fileAccess {
+	q := repository.New(h.dbService.GetConnection())
+	actorID := principal.MemberID
+
+	managed := h.rbacService.CanManageCommittees(ctx, actorID, event.CommitteeID, h.cfg.DocuCommitteeID)
+	acc := fileAccess{
+		organizer: managed[event.CommitteeID],
+		reviewer:  managed[h.cfg.DocuCommitteeID] || (event.DocuHead.Valid && event.DocuHead.Int32 == actorID),
+	}
+
+	if !acc.organizer {
+		isHead, err := q.IsEventHead(ctx, repository.IsEventHeadParams{EventID: event.ID, MemberID: actorID})
+		if err != nil {
+			log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to check event head")
+		}
+		acc.organizer = isHead
+	}
+	if !acc.reviewer {
+		isDocuHead, err := q.IsEventDocuHead(ctx, repository.IsEventDocuHeadParams{EventID: event.ID, MemberID: actorID})
+		if err != nil {
+			log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to check documentation head")
+		}
+		acc.reviewer = isDocuHead
+	}
+
+	return acc
+}
+
+// getEvent loads the event in the "id" path parameter.
+// If ok is false, the error response was already written.
+func (h *FileHandler) getEvent(c echo.Context) (repository.Event, bool, error) {
+	q := repository.New(h.dbService.GetConnection())
+
+	eventID, err := parseEventID(c)
+	if err != nil {
+		return repository.Event{}, false, c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid event ID"})
+	}
+
+	event, err := q.GetEventById(c.Request().Context(), eventID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return repository.Event{}, false, c.JSON(http.StatusNotFound, map[string]string{"error": "Event not found"})
+		}
+		log.Error().Err(err).Int32("event_id", eventID).Msg("failed to get event")
+		return repository.Event{}, false, c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return event, true, nil
+}
+
+// getFile loads a file of an event. If ok is false, the error response was already written.
+func (h *FileHandler) getFile(c echo.Context, eventID, fileID int32) (repository.GetEventFileRow, bool, error) {
+	q := repository.New(h.dbService.GetConnection())
+
+	file, err := q.GetEventFile(c.Request().Context(), repository.GetEventFileParams{ID: fileID, EventID: eventID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return file, false, c.JSON(http.StatusNotFound, map[string]string{"error": "File not found"})
+		}
+		log.Error().Err(err).Int32("file_id", fileID).Msg("failed to get event file")
+		return file, false, c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return file, true, nil
+}
+
+func (h *FileHandler) respondWithFile(c echo.Context, status int, eventID, fileID int32) error {
+	file, ok, err := h.getFile(c, eventID, fileID)
+	if !ok {
+		return err
+	}
+	return c.JSON(status, h.toFileResponse(c.Request().Context(), file))
+}
+
+func (h *FileHandler) toFileResponse(ctx context.Context, f repository.GetEventFileRow) EventFileResponse {
+	resp := toEventFileResponse(f)
+	if f.FileKey.Valid {
+		resp.DownloadURL = h.downloadURL(ctx, f.FileKey.String)
+	}
+	return resp
+}
+
+// downloadURL returns a pre-signed download URL for an object, or "" when storage is not available
+func (h *FileHandler) downloadURL(ctx context.Context, objectKey string) string {
+	if objectKey == "" || !h.s3Service.IsEnabled() {
+		return ""
+	}
+	url, err := h.s3Service.GenerateDownloadURL(ctx, objectKey)
+	if err != nil {
+		log.Warn().Err(err).Str("object_key", objectKey).Msg("failed to generate download URL")
+		return ""
+	}
+	return url
+}
+
+func parseFileID(c echo.Context) (int32, error) {
+	id, err := strconv.ParseInt(c.Param("file_id"), 10, 32)
+	return int32(id), err
+}
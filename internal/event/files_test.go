@@ -0,0 +1,255 @@
+package event
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+	"github.com/dlsu-lscs/lscs-core-api/internal/storage"
+)
+
+var eventFileColumns = []string{
+	"id", "event_id", "file_key", "file_order", "file_status", "title", "template_id", "content_type",
+	"uploaded_by", "uploaded_at", "reviewer_note", "reviewed_by", "reviewed_at",
+	"uploaded_by_name", "reviewed_by_name",
+}
+
+func newFileHandler(db *sql.DB) *FileHandler {
+	dbService := &mockDBService{db: db}
+	return NewFileHandler(&config.Config{DocuCommitteeID: "DOCU"}, dbService, auth.NewRBACService(dbService), &storage.S3Service{})
+}
+
+func eventFileRow(id int32, status string, fileKey any) *sqlmock.Rows {
+	return sqlmock.NewRows(eventFileColumns).AddRow(
+		id, 1, fileKey, 1, status, "Activity proposal", 1, nil,
+		nil, nil, nil, nil, nil,
+		nil, nil,
+	)
+}
+
+// expectFileAccess mocks the access lookups of a member of the given committee on event 1 of RND
+func expectFileAccess(mock sqlmock.Sqlmock, memberID int32, position, committeeID string) {
+	mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+		WithArgs(int32(1)).
+		WillReturnRows(eventRow(1, "RND"))
+	expectActor(mock, memberID, position, committeeID)
+	if committeeID != "RND" {
+		mock.ExpectQuery("SELECT EXISTS(.+)event_heads").
+			WithArgs(int32(1), memberID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	}
+	if committeeID != "DOCU" {
+		mock.ExpectQuery("SELECT EXISTS(.+)event_docu_head").
+			WithArgs(int32(1), memberID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	}
+}
+
+func TestFileTransitions(t *testing.T) {
+	transition, ok := FindFileTransition(FileStatusDone, FileStatusApproved)
+	assert.True(t, ok)
+	assert.Equal(t, FileRoleReviewer, transition.By)
+
+	_, ok = FindFileTransition(FileStatusDrafting, FileStatusApproved)
+	assert.False(t, ok)
+
+	assert.Len(t, NextFileTransitions(FileStatusDone), 3)
+	assert.Empty(t, NextFileTransitions("UNKNOWN"))
+
+	assert.Equal(t, FileStatusRevise, fileStatusAfterUpload(FileStatusRevise))
+	assert.Equal(t, FileStatusDrafting, fileStatusAfterUpload(FileStatusApproved))
+}
+
+func TestValidateFileOrder(t *testing.T) {
+	files := []repository.ListEventFilesRow{{ID: 1}, {ID: 2}, {ID: 3}}
+
+	assert.NoError(t, validateFileOrder([]int32{3, 1, 2}, files))
+	assert.Error(t, validateFileOrder([]int32{1, 2}, files))
+	assert.Error(t, validateFileOrder([]int32{1, 2, 2}, files))
+	assert.Error(t, validateFileOrder([]int32{1, 2, 4}, files))
+}
+
+func TestTransitionFileHandler(t *testing.T) {
+	newContext := func(body string) (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/events/1/files/5/transitions", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id", "file_id")
+		c.SetParamValues("1", "5")
+		return c, rec
+	}
+
+	t.Run("success - reviewer asks for revision", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectFileAccess(mock, 2, "VP", "DOCU")
+		mock.ExpectQuery("SELECT (.+) FROM event_files f").
+			WithArgs(int32(5), int32(1)).
+			WillReturnRows(eventFileRow(5, FileStatusDone, "event-files/1/proposal.pdf"))
+		mock.ExpectExec("UPDATE event_files").
+			WithArgs(FileStatusRevise, "Missing signatures", int32(2), sqlmock.AnyArg(), int32(5), FileStatusDone).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT (.+) FROM event_files f").
+			WithArgs(int32(5), int32(1)).
+			WillReturnRows(eventFileRow(5, FileStatusRevise, "event-files/1/proposal.pdf"))
+
+		c, rec := newContext(`{"status":"REVISE","note":"Missing signatures"}`)
+		auth.SetPrincipal(c, &auth.Principal{MemberID: 2, Method: auth.AuthMethodSession})
+
+		if assert.NoError(t, newFileHandler(db).TransitionFileHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var resp EventFileResponse
+			json.Unmarshal(rec.Body.Bytes(), &resp)
+			assert.Equal(t, FileStatusRevise, resp.Status)
+			assert.Empty(t, resp.DownloadURL)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - revision without note", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectFileAccess(mock, 2, "VP", "DOCU")
+		mock.ExpectQuery("SELECT (.+) FROM event_files f").
+			WithArgs(int32(5), int32(1)).
+			WillReturnRows(eventFileRow(5, FileStatusDone, "event-files/1/proposal.pdf"))
+
+		c, rec := newContext(`{"status":"REVISE","note":"  "}`)
+		auth.SetPrincipal(c, &auth.Principal{MemberID: 2, Method: auth.AuthMethodSession})
+
+		if assert.NoError(t, newFileHandler(db).TransitionFileHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - organizer cannot approve", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectFileAccess(mock, 1, "VP", "RND")
+		mock.ExpectQuery("SELECT (.+) FROM event_files f").
+			WithArgs(int32(5), int32(1)).
+			WillReturnRows(eventFileRow(5, FileStatusDone, "event-files/1/proposal.pdf"))
+
+		c, rec := newContext(`{"status":"APPROVED"}`)
+		auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+
+		if assert.NoError(t, newFileHandler(db).TransitionFileHandler(c)) {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - done before upload", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectFileAccess(mock, 1, "VP", "RND")
+		mock.ExpectQuery("SELECT (.+) FROM event_files f").
+			WithArgs(int32(5), int32(1)).
+			WillReturnRows(eventFileRow(5, FileStatusDrafting, nil))
+
+		c, rec := newContext(`{"status":"DONE"}`)
+		auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+
+		if assert.NoError(t, newFileHandler(db).TransitionFileHandler(c)) {
+			assert.Equal(t, http.StatusConflict, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - status changed concurrently", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectFileAccess(mock, 1, "VP", "RND")
+		mock.ExpectQuery("SELECT (.+) FROM event_files f").
+			WithArgs(int32(5), int32(1)).
+			WillReturnRows(eventFileRow(5, FileStatusDrafting, "event-files/1/proposal.pdf"))
+		mock.ExpectExec("UPDATE event_files SET file_status").
+			WithArgs(FileStatusDone, int32(5), FileStatusDrafting).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		c, rec := newContext(`{"status":"DONE"}`)
+		auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+
+		if assert.NoError(t, newFileHandler(db).TransitionFileHandler(c)) {
+			assert.Equal(t, http.StatusConflict, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCreateTemplateFilesHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	expectFileAccess(mock, 1, "VP", "RND")
+	mock.ExpectQuery("SELECT (.+) FROM document_templates WHERE required = TRUE").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "doc_title", "doc_key", "doc_order", "required"}).
+			AddRow(1, "Activity proposal", "templates/proposal.docx", 1, true).
+			AddRow(2, "Budget proposal", "templates/budget.xlsx", 2, true))
+	mock.ExpectExec("INSERT IGNORE INTO event_files").
+		WithArgs(int32(1), sql.NullInt32{Int32: 1, Valid: true}, "Activity proposal", int32(1), FileStatusDrafting).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT IGNORE INTO event_files").
+		WithArgs(int32(1), sql.NullInt32{Int32: 2, Valid: true}, "Budget proposal", int32(2), FileStatusDrafting).
+		WillReturnResult(sqlmock.NewResult(9, 1))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/events/1/files/from-templates", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+
+	if assert.NoError(t, newFileHandler(db).CreateTemplateFilesHandler(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp CreateTemplateFilesResponse
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.Equal(t, int64(1), resp.Created)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateFileUploadURLHandlerStorageDisabled(t *testing.T) {
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/events/1/files/upload-url",
+		strings.NewReader(`{"file_name":"proposal.pdf","content_type":"application/pdf"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+
+	if assert.NoError(t, newFileHandler(db).CreateFileUploadURLHandler(c)) {
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	}
+}
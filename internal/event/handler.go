@@ -0,0 +1,672 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+	"github.com/dlsu-lscs/lscs-core-api/internal/term"
+)
+
+type Handler struct {
+	dbService   database.Service
+	rbacService *auth.RBACService
+	terms       *term.Resolver
+}
+
+func NewHandler(dbService database.Service, rbacService *auth.RBACService) *Handler {
+	return &Handler{
+		dbService:   dbService,
+		rbacService: rbacService,
+		terms:       term.NewResolver(dbService),
+	}
+}
+
+// ListEventsHandler lists events
+// @Summary List events
+// @Description List events, newest first. Filter by committee and/or term. Only events of the current term are listed unless term_id is given; term_id=all lists every term.
+// @Tags events
+// @Produce json
+// @Param committee_id query string false "Committee ID"
+// @Param term_id query string false "Term ID, \"current\" (default) or \"all\""
+// @Success 200 {object} ListEventsResponse "List of events"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid term ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events [get]
+func (h *Handler) ListEventsHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	committeeID := c.QueryParam("committee_id")
+	termID, _, err := h.terms.Resolve(ctx, c.QueryParam("term_id"))
+	switch {
+	case errors.Is(err, term.ErrInvalidTerm):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid term ID"})
+	case errors.Is(err, term.ErrNoTerm):
+		// no terms yet, nothing to filter by
+	case err != nil:
+		log.Error().Err(err).Msg("failed to resolve term")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	var events []repository.Event
+	switch {
+	case committeeID != "":
+		events, err = q.ListEventsByCommittee(ctx, committeeID)
+	case termID != 0:
+		events, err = q.ListEventsByTerm(ctx, termID)
+	default:
+		events, err = q.ListEvents(ctx)
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list events")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	response := make([]EventResponse, 0, len(events))
+	for _, e := range events {
+		// committee filter is done in SQL, term filter is applied on top of it
+		if termID != 0 && e.TermID != termID {
+			continue
+		}
+		response = append(response, toEventResponse(e))
+	}
+
+	return c.JSON(http.StatusOK, ListEventsResponse{Events: response})
+}
+
+// GetEventOptionsHandler lists the values accepted by the event form
+// @Summary Get event form options
+// @Description List the event natures, types, durations and terms
+// @Tags events
+// @Produce json
+// @Success 200 {object} EventOptionsResponse "Event form options"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/options [get]
+func (h *Handler) GetEventOptionsHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	natures, err := q.ListEventNatures(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list event natures")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	types, err := q.ListEventTypes(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list event types")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	durations, err := q.ListEventDurations(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list event durations")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	terms, err := q.ListTerms(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list terms")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	response := EventOptionsResponse{
+		Natures:   make([]LookupResponse, 0, len(natures)),
+		Types:     make([]LookupResponse, 0, len(types)),
+		Durations: make([]LookupResponse, 0, len(durations)),
+		Terms:     make([]TermResponse, 0, len(terms)),
+	}
+	for _, n := range natures {
+		response.Natures = append(response.Natures, LookupResponse{ID: n.ID, Name: n.Name})
+	}
+	for _, t := range types {
+		response.Types = append(response.Types, LookupResponse{ID: t.ID, Name: t.Name})
+	}
+	for _, d := range durations {
+		response.Durations = append(response.Durations, LookupResponse{ID: d.ID, Name: d.Name})
+	}
+	for _, t := range terms {
+		response.Terms = append(response.Terms, TermResponse{ID: t.ID, Term: t.Term, StartYear: t.StartYear, EndYear: t.EndYear})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetEventHandler retrieves an event with its dates and heads
+// @Summary Get event
+// @Description Get an event, including its date ranges and assigned event heads
+// @Tags events
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} EventResponse "Event"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid event ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id} [get]
+func (h *Handler) GetEventHandler(c echo.Context) error {
+	eventID, err := parseEventID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid event ID"})
+	}
+
+	return h.respondWithEvent(c, http.StatusOK, eventID)
+}
+
+// CreateEventHandler creates an event
+// @Summary Create event
+// @Description Create an event with its date ranges. The event belongs to the current term unless term_id is given. Committee VPs/AVPs can only create events for their own committee.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Param request body EventRequest true "Event"
+// @Success 201 {object} EventResponse "Created event"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Cannot manage events of this committee"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events [post]
+func (h *Handler) CreateEventHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	db := h.dbService.GetConnection()
+	q := repository.New(db)
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	var req EventRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	if !h.canManage(ctx, principal, req.CommitteeID) {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Cannot manage events of this committee"})
+	}
+
+	if req.TermID == 0 {
+		current, err := h.terms.Current(ctx)
+		if errors.Is(err, term.ErrNoTerm) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "There is no current term, term_id is required"})
+		}
+		if err != nil {
+			log.Error().Err(err).Msg("failed to resolve current term")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+		}
+		req.TermID = current.ID
+	}
+
+	if ok, err := h.isValidType(ctx, q, req.Type); err != nil {
+		log.Error().Err(err).Msg("failed to check event type")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	} else if !ok {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Unknown event type"})
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to begin transaction")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	defer tx.Rollback()
+	qtx := q.WithTx(tx)
+
+	id, err := qtx.CreateEvent(ctx, eventParams(req))
+	if err != nil {
+		return h.writeError(c, err, "failed to create event")
+	}
+	eventID := int32(id)
+
+	if err := createDates(ctx, qtx, eventID, req.Dates); err != nil {
+		return h.writeError(c, err, "failed to create event dates")
+	}
+
+	if _, err := createRequiredFiles(ctx, qtx, eventID); err != nil {
+		return h.writeError(c, err, "failed to create required event files")
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error().Err(err).Msg("failed to commit event")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().
+		Int32("event_id", eventID).
+		Str("committee", req.CommitteeID).
+		Int32("created_by", principal.MemberID).
+		Msg("event created")
+
+	return h.respondWithEvent(c, http.StatusCreated, eventID)
+}
+
+// UpdateEventHandler replaces an event
+// @Summary Update event
+// @Description Replace an event's details. Date ranges are replaced only when "dates" is given and the term is kept when term_id is omitted. Moving an event to another committee requires access to both committees.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body EventRequest true "Event"
+// @Success 200 {object} EventResponse "Updated event"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Cannot manage events of this committee"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id} [put]
+func (h *Handler) UpdateEventHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	db := h.dbService.GetConnection()
+	q := repository.New(db)
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	eventID, err := parseEventID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid event ID"})
+	}
+
+	var req EventRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	existing, err := q.GetEventById(ctx, eventID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Event not found"})
+		}
+		log.Error().Err(err).Int32("event_id", eventID).Msg("failed to get event")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	if !h.canManage(ctx, principal, existing.CommitteeID) ||
+		(req.CommitteeID != existing.CommitteeID && !h.canManage(ctx, principal, req.CommitteeID)) {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Cannot manage events of this committee"})
+	}
+	if req.TermID == 0 {
+		req.TermID = existing.TermID
+	}
+
+	if ok, err := h.isValidType(ctx, q, req.Type); err != nil {
+		log.Error().Err(err).Msg("failed to check event type")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	} else if !ok {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Unknown event type"})
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to begin transaction")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	defer tx.Rollback()
+	qtx := q.WithTx(tx)
+
+	params := eventParams(req)
+	if err := qtx.UpdateEvent(ctx, repository.UpdateEventParams{
+		Arn:              params.Arn,
+		Name:             params.Name,
+		CommitteeID:      params.CommitteeID,
+		Type:             params.Type,
+		NatureID:         params.NatureID,
+		TermID:           params.TermID,
+		DurationID:       params.DurationID,
+		BriefDescription: params.BriefDescription,
+		Goals:            params.Goals,
+		Objectives:       params.Objectives,
+		Strategies:       params.Strategies,
+		Measures:         params.Measures,
+		BudgetAllocation: params.BudgetAllocation,
+		Venue:            params.Venue,
+		DocuHead:         params.DocuHead,
+		FinHead:          params.FinHead,
+		ID:               eventID,
+	}); err != nil {
+		return h.writeError(c, err, "failed to update event")
+	}
+
+	// dates are only replaced when given
+	if req.Dates != nil {
+		if err := qtx.DeleteEventDates(ctx, eventID); err != nil {
+			return h.writeError(c, err, "failed to delete event dates")
+		}
+		if err := createDates(ctx, qtx, eventID, req.Dates); err != nil {
+			return h.writeError(c, err, "failed to create event dates")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error().Err(err).Msg("failed to commit event")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().
+		Int32("event_id", eventID).
+		Str("committee", req.CommitteeID).
+		Int32("updated_by", principal.MemberID).
+		Msg("event updated")
+
+	return h.respondWithEvent(c, http.StatusOK, eventID)
+}
+
+// DeleteEventHandler deletes an event
+// @Summary Delete event
+// @Description Delete an event along with its dates and heads
+// @Tags events
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} map[string]string "Event deleted"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid event ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Cannot manage events of this committee"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id} [delete]
+func (h *Handler) DeleteEventHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	existing, ok, err := h.getManagedEvent(c, q, principal)
+	if !ok {
+		return err
+	}
+
+	if _, err := q.DeleteEvent(ctx, existing.ID); err != nil {
+		log.Error().Err(err).Int32("event_id", existing.ID).Msg("failed to delete event")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Error deleting event"})
+	}
+
+	log.Info().
+		Int32("event_id", existing.ID).
+		Int32("deleted_by", principal.MemberID).
+		Msg("event deleted")
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Event deleted successfully"})
+}
+
+// AddEventHeadHandler assigns a member as head of an event
+// @Summary Add event head
+// @Description Assign a member as head of an event
+// @Tags events
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body AddEventHeadRequest true "Member to assign"
+// @Success 201 {object} EventResponse "Updated event"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Cannot manage events of this committee"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 409 {object} helpers.ErrorResponse "Member is already a head of this event"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/heads [post]
+func (h *Handler) AddEventHeadHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	var req AddEventHeadRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	existing, ok, err := h.getManagedEvent(c, q, principal)
+	if !ok {
+		return err
+	}
+
+	if err := q.AddEventHead(ctx, repository.AddEventHeadParams{
+		EventID:  existing.ID,
+		MemberID: req.MemberID,
+	}); err != nil {
+		if helpers.IsDuplicateEntry(err) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": "Member is already a head of this event"})
+		}
+		return h.writeError(c, err, "failed to add event head")
+	}
+
+	log.Info().
+		Int32("event_id", existing.ID).
+		Int32("member_id", req.MemberID).
+		Int32("assigned_by", principal.MemberID).
+		Msg("event head assigned")
+
+	return h.respondWithEvent(c, http.StatusCreated, existing.ID)
+}
+
+// RemoveEventHeadHandler unassigns a head from an event
+// @Summary Remove event head
+// @Description Remove a member from the heads of an event
+// @Tags events
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param member_id path int true "Member ID"
+// @Success 200 {object} map[string]string "Event head removed"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Cannot manage events of this committee"
+// @Failure 404 {object} helpers.ErrorResponse "Event or event head not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/heads/{member_id} [delete]
+func (h *Handler) RemoveEventHeadHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	memberID, err := strconv.ParseInt(c.Param("member_id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid member ID"})
+	}
+
+	existing, ok, err := h.getManagedEvent(c, q, principal)
+	if !ok {
+		return err
+	}
+
+	rows, err := q.RemoveEventHead(ctx, repository.RemoveEventHeadParams{
+		EventID:  existing.ID,
+		MemberID: int32(memberID),
+	})
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", existing.ID).Msg("failed to remove event head")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Error removing event head"})
+	}
+	if rows == 0 {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Event head not found"})
+	}
+
+	log.Info().
+		Int32("event_id", existing.ID).
+		Int64("member_id", memberID).
+		Int32("removed_by", principal.MemberID).
+		Msg("event head removed")
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Event head removed successfully"})
+}
+
+// getManagedEvent loads the event in the "id" path parameter and checks that the
+// principal can manage it. If ok is false, the error response was already written.
+func (h *Handler) getManagedEvent(c echo.Context, q *repository.Queries, principal *auth.Principal) (repository.Event, bool, error) {
+	eventID, err := parseEventID(c)
+	if err != nil {
+		return repository.Event{}, false, c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid event ID"})
+	}
+
+	existing, err := q.GetEventById(c.Request().Context(), eventID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return repository.Event{}, false, c.JSON(http.StatusNotFound, map[string]string{"error": "Event not found"})
+		}
+		log.Error().Err(err).Int32("event_id", eventID).Msg("failed to get event")
+		return repository.Event{}, false, c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	if !h.canManage(c.Request().Context(), principal, existing.CommitteeID) {
+		return repository.Event{}, false, c.JSON(http.StatusForbidden, map[string]string{"error": "Cannot manage events of this committee"})
+	}
+
+	return existing, true, nil
+}
+
+// canManage checks event management access and logs denials
+func (h *Handler) canManage(ctx context.Context, principal *auth.Principal, committeeID string) bool {
+	if h.rbacService.CanManageCommitteeEvents(ctx, principal.MemberID, committeeID) {
+		return true
+	}
+	log.Warn().
+		Int32("member_id", principal.MemberID).
+		Str("committee", committeeID).
+		Msg("event management access denied")
+	return false
+}
+
+// isValidType checks that the event type (if given) is one of event_types
+func (h *Handler) isValidType(ctx context.Context, q *repository.Queries, eventType *string) (bool, error) {
+	if eventType == nil || *eventType == "" {
+		return true, nil
+	}
+	return q.CheckEventTypeExists(ctx, *eventType)
+}
+
+// respondWithEvent writes the event with its dates and heads
+func (h *Handler) respondWithEvent(c echo.Context, status int, eventID int32) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	e, err := q.GetEventById(ctx, eventID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Event not found"})
+		}
+		log.Error().Err(err).Int32("event_id", eventID).Msg("failed to get event")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	dates, err := q.ListEventDates(ctx, eventID)
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", eventID).Msg("failed to get event dates")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	heads, err := q.ListEventHeads(ctx, eventID)
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", eventID).Msg("failed to get event heads")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	response := toEventResponse(e)
+	for _, d := range dates {
+		response.Dates = append(response.Dates, EventDateResponse{ID: d.ID, StartTime: d.StartTime, EndTime: d.EndTime})
+	}
+	for _, head := range heads {
+		response.Heads = append(response.Heads, EventHeadResponse{
+			MemberID:    head.ID,
+			FullName:    head.FullName,
+			Email:       head.Email,
+			PositionID:  helpers.NullableString{NullString: head.PositionID},
+			CommitteeID: helpers.NullableString{NullString: head.CommitteeID},
+		})
+	}
+
+	return c.JSON(status, response)
+}
+
+// writeError maps write errors to responses: unknown references (committee, nature,
+// term, duration, member) are client errors, anything else is logged as internal
+func (h *Handler) writeError(c echo.Context, err error, msg string) error {
+	if helpers.IsForeignKeyViolation(err) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Unknown committee, nature, term, duration or member"})
+	}
+	log.Error().Err(err).Msg(msg)
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+}
+
+func parseEventID(c echo.Context) (int32, error) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	return int32(id), err
+}
+
+// eventParams converts the request to the columns of the events table
+func eventParams(req EventRequest) repository.CreateEventParams {
+	venue := req.Venue
+	if venue == nil || *venue == "" {
+		defaultVenue := DefaultVenue
+		venue = &defaultVenue
+	}
+
+	return repository.CreateEventParams{
+		Arn:              req.ARN,
+		Name:             req.Name,
+		CommitteeID:      req.CommitteeID,
+		Type:             toNullString(req.Type),
+		NatureID:         req.NatureID,
+		TermID:           req.TermID,
+		DurationID:       toNullInt32(req.DurationID),
+		BriefDescription: toNullString(req.BriefDescription),
+		Goals:            toNullString(req.Goals),
+		Objectives:       toNullString(req.Objectives),
+		Strategies:       toNullString(req.Strategies),
+		Measures:         toNullString(req.Measures),
+		BudgetAllocation: budgetString(req.BudgetAllocation),
+		Venue:            toNullString(venue),
+		DocuHead:         toNullInt32(req.DocuHead),
+		FinHead:          toNullInt32(req.FinHead),
+	}
+}
+
+func createDates(ctx context.Context, q *repository.Queries, eventID int32, dates []EventDateRange) error {
+	for _, d := range dates {
+		if err := q.CreateEventDate(ctx, repository.CreateEventDateParams{
+			EventID:   eventID,
+			StartTime: d.StartTime,
+			EndTime:   d.EndTime,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
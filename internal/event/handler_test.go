@@ -0,0 +1,290 @@
+package event
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+)
+
+// mockDBService is a mock implementation of the database.Service interface.
+type mockDBService struct {
+	db *sql.DB
+}
+
+func (m *mockDBService) Health() map[string]string {
+	return nil
+}
+
+func (m *mockDBService) Close() error {
+	return nil
+}
+
+func (m *mockDBService) GetConnection() *sql.DB {
+	return m.db
+}
+
+var eventColumns = []string{
+	"id", "arn", "name", "committee_id", "type", "nature_id", "term_id", "created_at", "duration_id",
+	"brief_description", "goals", "objectives", "strategies", "measures", "budget_allocation", "venue",
+	"docu_head", "fin_head",
+}
+
+func eventRow(id int32, committeeID string) *sqlmock.Rows {
+	return sqlmock.NewRows(eventColumns).AddRow(
+		id, "2526T1-RND-001", "Intro to Go Workshop", committeeID, "Workshop", 1, 1, time.Now(), nil,
+		nil, nil, nil, nil, nil, "1500.00", "Online",
+		nil, nil,
+	)
+}
+
+// expectActor mocks the RBAC lookups of CanManageCommitteeEvents for a non-admin member
+func expectActor(mock sqlmock.Sqlmock, memberID int32, position, committeeID string) {
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(memberID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery("SELECT (.+) FROM members m").
+		WithArgs(memberID).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "email", "full_name", "nickname", "image_url",
+			"committee_id", "committee_name",
+			"division_id", "division_name",
+			"position_id", "position_name",
+			"house_name",
+			"contact_number", "college", "program",
+			"interests", "discord", "fb_link", "telegram",
+		}).AddRow(
+			memberID, "test@dlsu.edu.ph", "Test User", nil, nil,
+			committeeID, nil,
+			nil, nil,
+			position, nil,
+			nil,
+			nil, nil, nil,
+			nil, nil, nil, nil,
+		))
+}
+
+func newHandler(db *sql.DB) *Handler {
+	dbService := &mockDBService{db: db}
+	return NewHandler(dbService, auth.NewRBACService(dbService))
+}
+
+func TestListEventsHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	// defaults to the current term
+	mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "term", "start_year", "end_year", "start_date", "end_date", "is_active"}).
+			AddRow(1, 1, 2025, 2026, nil, nil, true))
+	mock.ExpectQuery("SELECT (.+) FROM events WHERE committee_id = ?").
+		WithArgs("RND").
+		WillReturnRows(eventRow(1, "RND"))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/events?committee_id=RND", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, newHandler(db).ListEventsHandler(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp ListEventsResponse
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.Len(t, resp.Events, 1)
+		assert.Equal(t, "RND", resp.Events[0].CommitteeID)
+		assert.Equal(t, 1500.0, resp.Events[0].BudgetAllocation)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetEventHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		start := time.Date(2026, 11, 5, 13, 0, 0, 0, time.UTC)
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(eventRow(1, "RND"))
+		mock.ExpectQuery("SELECT (.+) FROM event_dates").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "event_id", "start_time", "end_time"}).
+				AddRow(1, 1, start, start.Add(4*time.Hour)))
+		mock.ExpectQuery("SELECT (.+) FROM event_heads").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "email", "position_id", "committee_id"}).
+				AddRow(12312345, "Juan Dela Cruz", "juan@dlsu.edu.ph", "CT", "RND"))
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/events/1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		if assert.NoError(t, newHandler(db).GetEventHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var resp EventResponse
+			json.Unmarshal(rec.Body.Bytes(), &resp)
+			assert.Len(t, resp.Dates, 1)
+			assert.Len(t, resp.Heads, 1)
+			assert.Equal(t, int32(12312345), resp.Heads[0].MemberID)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(99)).
+			WillReturnError(sql.ErrNoRows)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/events/99", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("99")
+
+		if assert.NoError(t, newHandler(db).GetEventHandler(c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+	})
+}
+
+func TestCreateEventHandler(t *testing.T) {
+	start := time.Date(2026, 11, 5, 13, 0, 0, 0, time.UTC)
+	body := EventRequest{
+		ARN:         "2526T1-RND-001",
+		Name:        "Intro to Go Workshop",
+		CommitteeID: "RND",
+		NatureID:    1,
+		TermID:      1,
+		Dates:       []EventDateRange{{StartTime: start, EndTime: start.Add(4 * time.Hour)}},
+	}
+
+	t.Run("success - VP of own committee", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectActor(mock, 1, "VP", "RND")
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO events").
+			WillReturnResult(sqlmock.NewResult(7, 1))
+		mock.ExpectExec("INSERT INTO event_dates").
+			WithArgs(int32(7), start, start.Add(4*time.Hour)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery("SELECT (.+) FROM document_templates WHERE required = TRUE").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "doc_title", "doc_key", "doc_order", "required"}).
+				AddRow(1, "Activity proposal", "templates/proposal.docx", 1, true))
+		mock.ExpectExec("INSERT IGNORE INTO event_files").
+			WithArgs(int32(7), sql.NullInt32{Int32: 1, Valid: true}, "Activity proposal", int32(1), FileStatusDrafting).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(7)).
+			WillReturnRows(eventRow(7, "RND"))
+		mock.ExpectQuery("SELECT (.+) FROM event_dates").
+			WithArgs(int32(7)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "event_id", "start_time", "end_time"}))
+		mock.ExpectQuery("SELECT (.+) FROM event_heads").
+			WithArgs(int32(7)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "email", "position_id", "committee_id"}))
+
+		jsonBody, _ := json.Marshal(body)
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/events", bytes.NewReader(jsonBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+
+		if assert.NoError(t, newHandler(db).CreateEventHandler(c)) {
+			assert.Equal(t, http.StatusCreated, rec.Code)
+			var resp EventResponse
+			json.Unmarshal(rec.Body.Bytes(), &resp)
+			assert.Equal(t, int32(7), resp.ID)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - VP of another committee", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectActor(mock, 1, "VP", "EXT")
+
+		jsonBody, _ := json.Marshal(body)
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/events", bytes.NewReader(jsonBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+
+		if assert.NoError(t, newHandler(db).CreateEventHandler(c)) {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - end before start", func(t *testing.T) {
+		invalid := body
+		invalid.Dates = []EventDateRange{{StartTime: start, EndTime: start.Add(-time.Hour)}}
+
+		jsonBody, _ := json.Marshal(invalid)
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/events", bytes.NewReader(jsonBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+
+		if assert.NoError(t, newHandler(nil).CreateEventHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
+
+func TestRemoveEventHeadHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+		WithArgs(int32(1)).
+		WillReturnRows(eventRow(1, "RND"))
+	expectActor(mock, 1, "AVP", "RND")
+	mock.ExpectExec("DELETE FROM event_heads").
+		WithArgs(int32(1), int32(5)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/events/1/heads/5", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id", "member_id")
+	c.SetParamValues("1", "5")
+	auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+
+	if assert.NoError(t, newHandler(db).RemoveEventHeadHandler(c)) {
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
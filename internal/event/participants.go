@@ -0,0 +1,898 @@
+package event
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// maxImportRows limits the size of a participant CSV import
+const maxImportRows = 5000
+
+// RegistrationNotifier emails the verification link of a public registration (notification.Notifier)
+type RegistrationNotifier interface {
+	NotifyRegistrationPending(ctx context.Context, r PendingRegistration) error
+}
+
+// PendingRegistration is a public registration waiting for its email to be verified
+type PendingRegistration struct {
+	EventID     int32
+	EventName   string
+	StudentName string
+	Email       string
+	Token       string
+	ExpiresAt   time.Time
+}
+
+// ParticipantHandler handles event registration, check-in and attendance.
+// Officers are the members who can manage the event's committee (see CanManageCommitteeEvents)
+// and the event's assigned heads.
+type ParticipantHandler struct {
+	cfg         *config.Config
+	dbService   database.Service
+	rbacService *auth.RBACService
+	notifier    RegistrationNotifier
+	now         func() time.Time
+}
+
+func NewParticipantHandler(cfg *config.Config, dbService database.Service, rbacService *auth.RBACService, notifier RegistrationNotifier) *ParticipantHandler {
+	return &ParticipantHandler{
+		cfg:         cfg,
+		dbService:   dbService,
+		rbacService: rbacService,
+		notifier:    notifier,
+		now:         time.Now,
+	}
+}
+
+// RegisterHandler registers a non-member for an event
+// @Summary Register for event
+// @Description Register a non-member (any DLSU student) for an event with their DLSU email. A student ID stays tied to the email it was first verified with. A verification link is emailed to the student; the check-in ticket is issued once it is opened (see POST /events/{id}/register/verify). Registering again before that replaces the registration and sends a new link. Members register with POST /events/{id}/participants/me instead.
+// @Tags participants
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body RegisterRequest true "Registration"
+// @Success 202 {object} PendingRegistrationResponse "Verification email sent"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request or not a DLSU email"
+// @Failure 403 {object} helpers.ErrorResponse "Student ID or email belongs to a member"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 409 {object} helpers.ErrorResponse "Already registered, or the student ID or email is tied to another registration"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Router /events/{id}/register [post]
+func (h *ParticipantHandler) RegisterHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	var req RegisterRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+	if !isStudentEmail(req.StudentEmail) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Register with your DLSU email"})
+	}
+
+	event, ok, err := h.getEvent(c)
+	if !ok {
+		return err
+	}
+
+	// members sign in to register, so nobody can register (or hold) a member's ID for them
+	if _, err := q.CheckIdIfMember(ctx, req.StudentID); err == nil {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "This student ID belongs to a member; sign in to register"})
+	} else if err != sql.ErrNoRows {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to check member for event registration")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	if _, err := q.CheckEmailIfMember(ctx, req.StudentEmail); err == nil {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "This email belongs to a member; sign in to register"})
+	} else if err != sql.ErrNoRows {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to check member for event registration")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	name := req.StudentName
+	email := req.StudentEmail
+	if ok, err := h.checkStudentClaim(c, req.StudentID, email); !ok {
+		return err
+	}
+	// a registration whose email was never verified is replaced, so it can't hold the student ID
+	rows, err := q.UpdatePendingEventParticipant(ctx, repository.UpdatePendingEventParticipantParams{
+		StudentName:  toNullString(&name),
+		StudentEmail: toNullString(&email),
+		Notes:        toNullString(req.Notes),
+		EventID:      event.ID,
+		StudentID:    req.StudentID,
+	})
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to update pending registration")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	if rows == 0 {
+		err := q.CreateEventParticipant(ctx, repository.CreateEventParticipantParams{
+			EventID:      event.ID,
+			StudentID:    req.StudentID,
+			StudentName:  toNullString(&name),
+			StudentEmail: toNullString(&email),
+			Notes:        toNullString(req.Notes),
+		})
+		if err != nil && !helpers.IsDuplicateEntry(err) {
+			log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to register participant")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+		}
+		if err != nil {
+			// the update also matches nothing when it changes nothing (the same registration twice in a second)
+			existing, err := q.GetEventParticipant(ctx, repository.GetEventParticipantParams{EventID: event.ID, StudentID: req.StudentID})
+			if err != nil {
+				log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to get participant")
+				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+			}
+			if existing.VerifiedAt.Valid || existing.StudentEmail.String != email {
+				return c.JSON(http.StatusConflict, map[string]string{"error": "Already registered for this event"})
+			}
+		}
+	}
+
+	expires := h.now().Add(registrationVerifyTTL).Truncate(time.Second)
+	if err := h.notifier.NotifyRegistrationPending(ctx, PendingRegistration{
+		EventID:     event.ID,
+		EventName:   event.Name,
+		StudentName: name,
+		Email:       email,
+		Token:       SignVerificationToken(h.cfg.JWTSecret, event.ID, req.StudentID, email, expires),
+		ExpiresAt:   expires,
+	}); err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to send registration verification email")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().
+		Int32("event_id", event.ID).
+		Int32("student_id", req.StudentID).
+		Msg("participant registration pending verification")
+
+	return c.JSON(http.StatusAccepted, PendingRegistrationResponse{
+		Message:   "Check your email to confirm your registration",
+		Email:     email,
+		ExpiresAt: expires,
+	})
+}
+
+// checkStudentClaim refuses a public registration whose student ID was verified with another email,
+// or whose email was verified with another student ID.
+// If ok is false, the error response was already written.
+func (h *ParticipantHandler) checkStudentClaim(c echo.Context, studentID int32, email string) (ok bool, err error) {
+	q := repository.New(h.dbService.GetConnection())
+	claimed, err := q.IsStudentClaimed(c.Request().Context(), repository.IsStudentClaimedParams{
+		StudentID:      studentID,
+		StudentEmail:   sql.NullString{String: email, Valid: true},
+		StudentEmail_2: sql.NullString{String: email, Valid: true},
+		StudentID_2:    studentID,
+	})
+	if err != nil {
+		log.Error().Err(err).Int32("student_id", studentID).Msg("failed to check student registrations")
+		return false, c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	if claimed {
+		return false, c.JSON(http.StatusConflict, map[string]string{"error": "This student ID or email is already registered with a different email or student ID"})
+	}
+	return true, nil
+}
+
+// VerifyRegistrationHandler confirms a public registration from its emailed link
+// @Summary Verify event registration
+// @Description Confirm a public registration with the token from the verification email and get the signed check-in token to be shown as a QR code. Verifying again returns the same ticket.
+// @Tags participants
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body VerifyRegistrationRequest true "Verification token"
+// @Success 200 {object} TicketResponse "Registration confirmed"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid or expired link"
+// @Failure 404 {object} helpers.ErrorResponse "Event or registration not found"
+// @Failure 409 {object} helpers.ErrorResponse "Student ID or email tied to another registration"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Router /events/{id}/register/verify [post]
+func (h *ParticipantHandler) VerifyRegistrationHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	var req VerifyRegistrationRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	eventID, err := parseEventID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid event ID"})
+	}
+	token := strings.TrimSpace(req.Token)
+	tokenEventID, studentID, _, err := parseVerificationToken(token)
+	if err != nil || tokenEventID != eventID {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid verification link"})
+	}
+
+	key := repository.GetEventParticipantParams{EventID: eventID, StudentID: studentID}
+	participant, err := q.GetEventParticipant(ctx, key)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Registration not found"})
+		}
+		log.Error().Err(err).Int32("event_id", eventID).Msg("failed to get participant")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	if err := checkVerificationToken(h.cfg.JWTSecret, token, participant.StudentEmail.String, h.now()); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid or expired verification link, register again to get a new one"})
+	}
+
+	if !participant.VerifiedAt.Valid {
+		// another registration of the ID or email may have been verified since this one was made
+		if ok, err := h.checkStudentClaim(c, studentID, participant.StudentEmail.String); !ok {
+			return err
+		}
+		rows, err := q.VerifyEventParticipant(ctx, repository.VerifyEventParticipantParams{
+			EventID:      eventID,
+			StudentID:    studentID,
+			StudentEmail: participant.StudentEmail,
+		})
+		if err != nil {
+			log.Error().Err(err).Int32("event_id", eventID).Msg("failed to verify participant")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+		}
+		if rows == 0 {
+			// replaced by another registration since it was loaded
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid or expired verification link, register again to get a new one"})
+		}
+		if participant, err = q.GetEventParticipant(ctx, key); err != nil {
+			log.Error().Err(err).Int32("event_id", eventID).Msg("failed to get participant")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+		}
+
+		log.Info().
+			Int32("event_id", eventID).
+			Int32("student_id", studentID).
+			Msg("participant registered")
+	}
+
+	return c.JSON(http.StatusOK, TicketResponse{
+		Participant:  toParticipantResponse(participant),
+		CheckInToken: SignCheckInToken(h.cfg.JWTSecret, participant.EventID, participant.StudentID),
+	})
+}
+
+// RegisterMeHandler registers the authenticated member for an event
+// @Summary Register myself for event
+// @Description Register the authenticated member for an event using their profile. Returns the signed check-in token to be shown as a QR code.
+// @Tags participants
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body RegisterMeRequest false "Registration notes"
+// @Success 201 {object} TicketResponse "Registered"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 409 {object} helpers.ErrorResponse "Already registered"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/participants/me [post]
+func (h *ParticipantHandler) RegisterMeHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	var req RegisterMeRequest
+	if c.Request().ContentLength > 0 {
+		if err := c.Bind(&req); err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+		}
+		if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+			return helpers.ErrValidation(c, validationErr)
+		}
+	}
+
+	event, ok, err := h.getEvent(c)
+	if !ok {
+		return err
+	}
+
+	member, err := q.GetMemberInfoById(ctx, principal.MemberID)
+	if err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to get member for event registration")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return h.register(c, repository.CreateEventParticipantParams{
+		EventID:      event.ID,
+		StudentID:    member.ID,
+		StudentName:  sql.NullString{String: member.FullName, Valid: true},
+		StudentEmail: sql.NullString{String: member.Email, Valid: true},
+		Notes:        toNullString(req.Notes),
+		VerifiedAt:   sql.NullTime{Time: h.now(), Valid: true},
+	})
+}
+
+// GetMyTicketHandler returns the check-in token of the authenticated member
+// @Summary Get my event ticket
+// @Description Get the authenticated member's registration and signed check-in token for an event
+// @Tags participants
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} TicketResponse "Ticket"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid event ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "Not registered for this event"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/participants/me/ticket [get]
+func (h *ParticipantHandler) GetMyTicketHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	eventID, err := parseEventID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid event ID"})
+	}
+
+	participant, err := q.GetEventParticipant(c.Request().Context(), repository.GetEventParticipantParams{
+		EventID:   eventID,
+		StudentID: principal.MemberID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Not registered for this event"})
+		}
+		log.Error().Err(err).Int32("event_id", eventID).Msg("failed to get participant")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return c.JSON(http.StatusOK, TicketResponse{
+		Participant:  toParticipantResponse(participant),
+		CheckInToken: SignCheckInToken(h.cfg.JWTSecret, participant.EventID, participant.StudentID),
+	})
+}
+
+// ListParticipantsHandler lists the participants of an event
+// @Summary List participants
+// @Description List the participants of an event with their attendance. Officers only.
+// @Tags participants
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} ListParticipantsResponse "Participants"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid event ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not an officer of this event"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/participants [get]
+func (h *ParticipantHandler) ListParticipantsHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	event, ok, err := h.getOfficerEvent(c)
+	if !ok {
+		return err
+	}
+
+	participants, err := q.ListEventParticipants(c.Request().Context(), event.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to list participants")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	response := ListParticipantsResponse{
+		Participants: make([]ParticipantResponse, 0, len(participants)),
+		Total:        len(participants),
+	}
+	for _, p := range participants {
+		if p.CheckedInAt.Valid {
+			response.CheckedIn++
+		}
+		response.Participants = append(response.Participants, toParticipantResponse(p))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ImportParticipantsHandler bulk-adds participants from a CSV file
+// @Summary Import participants
+// @Description Bulk-add participants from a CSV file (multipart field "file"). The header row must contain student_id and may contain student_name, student_email and notes. Already registered students are skipped. Officers only.
+// @Tags participants
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param file formData file true "CSV file"
+// @Success 200 {object} ImportParticipantsResponse "Import summary"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid CSV"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not an officer of this event"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/participants/import [post]
+func (h *ParticipantHandler) ImportParticipantsHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	db := h.dbService.GetConnection()
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	event, ok, err := h.getOfficerEvent(c)
+	if !ok {
+		return err
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "CSV file is required"})
+	}
+	src, err := file.Open()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "CSV file is required"})
+	}
+	defer src.Close()
+
+	rows, rowErrors, err := parseParticipantsCSV(src)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to begin transaction")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	defer tx.Rollback()
+	qtx := repository.New(db).WithTx(tx)
+
+	// officers vouch for the participants they import
+	verifiedAt := sql.NullTime{Time: h.now(), Valid: true}
+	response := ImportParticipantsResponse{Errors: rowErrors}
+	for _, row := range rows {
+		row.params.EventID = event.ID
+		row.params.VerifiedAt = verifiedAt
+		if err := qtx.CreateEventParticipant(ctx, row.params); err != nil {
+			if helpers.IsDuplicateEntry(err) {
+				response.Skipped++
+				continue
+			}
+			log.Error().Err(err).Int32("event_id", event.ID).Int("line", row.line).Msg("failed to import participant")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+		}
+		response.Added++
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error().Err(err).Msg("failed to commit participant import")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().
+		Int32("event_id", event.ID).
+		Int32("imported_by", principal.MemberID).
+		Int("added", response.Added).
+		Int("skipped", response.Skipped).
+		Int("errors", len(response.Errors)).
+		Msg("participants imported")
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// RemoveParticipantHandler removes a participant from an event
+// @Summary Remove participant
+// @Description Remove a participant from an event. Officers only.
+// @Tags participants
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param student_id path int true "Student ID"
+// @Success 200 {object} map[string]string "Participant removed"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not an officer of this event"
+// @Failure 404 {object} helpers.ErrorResponse "Event or participant not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/participants/{student_id} [delete]
+func (h *ParticipantHandler) RemoveParticipantHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	studentID, err := strconv.ParseInt(c.Param("student_id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid student ID"})
+	}
+
+	event, ok, err := h.getOfficerEvent(c)
+	if !ok {
+		return err
+	}
+
+	rows, err := q.DeleteEventParticipant(c.Request().Context(), repository.DeleteEventParticipantParams{
+		EventID:   event.ID,
+		StudentID: int32(studentID),
+	})
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to remove participant")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Error removing participant"})
+	}
+	if rows == 0 {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Participant not found"})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Participant removed successfully"})
+}
+
+// CheckInHandler records the attendance of a participant from a scanned QR code
+// @Summary Check in participant
+// @Description Verify a scanned check-in token and record the participant's attendance. Scanning twice keeps the first check-in time. Officers only.
+// @Tags participants
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body CheckInRequest true "Scanned token"
+// @Success 200 {object} CheckInResponse "Checked in"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid token or token for another event"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not an officer of this event"
+// @Failure 404 {object} helpers.ErrorResponse "Event or participant not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/check-in [post]
+func (h *ParticipantHandler) CheckInHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	var req CheckInRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	event, ok, err := h.getOfficerEvent(c)
+	if !ok {
+		return err
+	}
+
+	tokenEventID, studentID, err := VerifyCheckInToken(h.cfg.JWTSecret, strings.TrimSpace(req.Token))
+	if err != nil {
+		log.Warn().Int32("event_id", event.ID).Msg("invalid check-in token scanned")
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid check-in token"})
+	}
+	if tokenEventID != event.ID {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Ticket is for a different event"})
+	}
+
+	key := repository.GetEventParticipantParams{EventID: event.ID, StudentID: studentID}
+	participant, err := q.GetEventParticipant(ctx, key)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Participant not found"})
+		}
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to get participant")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	if !participant.VerifiedAt.Valid {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Registration has not been verified"})
+	}
+
+	rows, err := q.CheckInEventParticipant(ctx, repository.CheckInEventParticipantParams{
+		CheckedInBy: sql.NullInt32{Int32: principal.MemberID, Valid: true},
+		EventID:     event.ID,
+		StudentID:   studentID,
+	})
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to check in participant")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	participant, err = q.GetEventParticipant(ctx, key)
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to get participant")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	if rows > 0 {
+		log.Info().
+			Int32("event_id", event.ID).
+			Int32("student_id", studentID).
+			Int32("checked_in_by", principal.MemberID).
+			Msg("participant checked in")
+	}
+
+	return c.JSON(http.StatusOK, CheckInResponse{
+		Participant:      toParticipantResponse(participant),
+		AlreadyCheckedIn: rows == 0,
+	})
+}
+
+// ExportAttendanceHandler exports the attendance of an event as CSV
+// @Summary Export attendance
+// @Description Download the participants and attendance of an event as CSV (for reporting to the university). Registrations that were never verified are left out. Officers only.
+// @Tags participants
+// @Produce text/csv
+// @Param id path int true "Event ID"
+// @Success 200 {file} file "Attendance CSV"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid event ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not an officer of this event"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/attendance.csv [get]
+func (h *ParticipantHandler) ExportAttendanceHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	event, ok, err := h.getOfficerEvent(c)
+	if !ok {
+		return err
+	}
+
+	participants, err := q.ListEventParticipants(c.Request().Context(), event.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to list participants for export")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=\"event-%d-attendance.csv\"", event.ID))
+	res.WriteHeader(http.StatusOK)
+
+	w := csv.NewWriter(res)
+	w.Write([]string{"student_id", "student_name", "student_email", "notes", "registered_at", "checked_in_at", "attended"})
+	for _, p := range participants {
+		if !p.VerifiedAt.Valid {
+			continue
+		}
+		attended := "no"
+		if p.CheckedInAt.Valid {
+			attended = "yes"
+		}
+		w.Write([]string{
+			strconv.Itoa(int(p.StudentID)),
+			csvCell(p.StudentName.String),
+			csvCell(p.StudentEmail.String),
+			csvCell(p.Notes.String),
+			formatTime(p.RegisteredAt),
+			formatTime(p.CheckedInAt),
+			attended,
+		})
+	}
+	w.Flush()
+
+	return w.Error()
+}
+
+// register inserts a participant and writes their ticket
+func (h *ParticipantHandler) register(c echo.Context, params repository.CreateEventParticipantParams) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	if err := q.CreateEventParticipant(ctx, params); err != nil {
+		if helpers.IsDuplicateEntry(err) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": "Already registered for this event"})
+		}
+		log.Error().Err(err).Int32("event_id", params.EventID).Msg("failed to register participant")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	participant, err := q.GetEventParticipant(ctx, repository.GetEventParticipantParams{
+		EventID:   params.EventID,
+		StudentID: params.StudentID,
+	})
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", params.EventID).Msg("failed to get participant")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().
+		Int32("event_id", params.EventID).
+		Int32("student_id", params.StudentID).
+		Msg("participant registered")
+
+	return c.JSON(http.StatusCreated, TicketResponse{
+		Participant:  toParticipantResponse(participant),
+		CheckInToken: SignCheckInToken(h.cfg.JWTSecret, participant.EventID, participant.StudentID),
+	})
+}
+
+// getEvent loads the event in the "id" path parameter.
+// If ok is false, the error response was already written.
+func (h *ParticipantHandler) getEvent(c echo.Context) (repository.Event, bool, error) {
+	q := repository.New(h.dbService.GetConnection())
+
+	eventID, err := parseEventID(c)
+	if err != nil {
+		return repository.Event{}, false, c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid event ID"})
+	}
+
+	event, err := q.GetEventById(c.Request().Context(), eventID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return repository.Event{}, false, c.JSON(http.StatusNotFound, map[string]string{"error": "Event not found"})
+		}
+		log.Error().Err(err).Int32("event_id", eventID).Msg("failed to get event")
+		return repository.Event{}, false, c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return event, true, nil
+}
+
+// getOfficerEvent loads the event in the "id" path parameter and checks that the
+// principal is one of its officers. If ok is false, the error response was already written.
+func (h *ParticipantHandler) getOfficerEvent(c echo.Context) (repository.Event, bool, error) {
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return repository.Event{}, false, c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	event, ok, err := h.getEvent(c)
+	if !ok {
+		return event, false, err
+	}
+
+	if !h.isOfficer(c.Request().Context(), principal, event) {
+		log.Warn().
+			Int32("member_id", principal.MemberID).
+			Int32("event_id", event.ID).
+			Msg("event officer access denied")
+		return repository.Event{}, false, c.JSON(http.StatusForbidden, map[string]string{"error": "Not an officer of this event"})
+	}
+
+	return event, true, nil
+}
+
+// isOfficer returns true if the member can manage the event's committee or is one of the event's heads
+func (h *ParticipantHandler) isOfficer(ctx context.Context, principal *auth.Principal, event repository.Event) bool {
+	if h.rbacService.CanManageCommitteeEvents(ctx, principal.MemberID, event.CommitteeID) {
+		return true
+	}
+
+	q := repository.New(h.dbService.GetConnection())
+	isHead, err := q.IsEventHead(ctx, repository.IsEventHeadParams{
+		EventID:  event.ID,
+		MemberID: principal.MemberID,
+	})
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to check event head")
+		return false
+	}
+	return isHead
+}
+
+// importRow is a valid row of a participant CSV
+type importRow struct {
+	line   int
+	params repository.CreateEventParticipantParams
+}
+
+// parseParticipantsCSV reads a participant CSV. Invalid rows are reported and skipped;
+// err is only returned when the file itself cannot be used.
+func parseParticipantsCSV(r io.Reader) ([]importRow, []ImportRowError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("CSV file is empty or invalid")
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		// spreadsheet exports often start with a UTF-8 BOM
+		name = strings.TrimPrefix(name, "\ufeff")
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["student_id"]; !ok {
+		return nil, nil, fmt.Errorf("CSV header must contain a student_id column")
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []importRow
+	var rowErrors []ImportRowError
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if line > maxImportRows+1 {
+			return nil, nil, fmt.Errorf("CSV file has more than %d rows", maxImportRows)
+		}
+		if err != nil {
+			rowErrors = append(rowErrors, ImportRowError{Line: line, Error: "malformed CSV row"})
+			continue
+		}
+
+		studentID, err := strconv.ParseInt(field(record, "student_id"), 10, 32)
+		if err != nil || studentID <= 0 {
+			rowErrors = append(rowErrors, ImportRowError{Line: line, Error: "student_id must be a positive number"})
+			continue
+		}
+
+		email := field(record, "student_email")
+		if email != "" && helpers.GetValidator().Var(email, "email") != nil {
+			rowErrors = append(rowErrors, ImportRowError{Line: line, Error: "student_email must be a valid email address"})
+			continue
+		}
+
+		rows = append(rows, importRow{
+			line: line,
+			params: repository.CreateEventParticipantParams{
+				StudentID:    int32(studentID),
+				StudentName:  optionalString(field(record, "student_name")),
+				StudentEmail: optionalString(email),
+				Notes:        optionalString(field(record, "notes")),
+			},
+		})
+	}
+
+	return rows, rowErrors, nil
+}
+
+// optionalString converts an empty string to NULL
+func optionalString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// csvCell prefixes values that spreadsheet apps would run as a formula with a quote,
+// since names, emails and notes come from the public registration form
+func csvCell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func formatTime(t sql.NullTime) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.Format(time.RFC3339)
+}
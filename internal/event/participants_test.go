@@ -0,0 +1,505 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+)
+
+const testSecret = "test-secret"
+
+var participantColumns = []string{
+	"event_id", "student_id", "student_name", "student_email", "notes", "registered_at", "checked_in_at", "checked_in_by", "verified_at",
+}
+
+var testNow = time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC)
+
+// mockRegistrationNotifier records the verification emails it was asked to send
+type mockRegistrationNotifier struct {
+	sent []PendingRegistration
+}
+
+func (m *mockRegistrationNotifier) NotifyRegistrationPending(_ context.Context, r PendingRegistration) error {
+	m.sent = append(m.sent, r)
+	return nil
+}
+
+func newParticipantHandler(db *sql.DB) *ParticipantHandler {
+	h, _ := newParticipantHandlerWithNotifier(db)
+	return h
+}
+
+func newParticipantHandlerWithNotifier(db *sql.DB) (*ParticipantHandler, *mockRegistrationNotifier) {
+	dbService := &mockDBService{db: db}
+	notifier := &mockRegistrationNotifier{}
+	h := NewParticipantHandler(&config.Config{JWTSecret: testSecret}, dbService, auth.NewRBACService(dbService), notifier)
+	h.now = func() time.Time { return testNow }
+	return h, notifier
+}
+
+// expectNewStudent expects the checks that the student ID and email aren't a member's or tied to another registration
+func expectNewStudent(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("SELECT id FROM members WHERE id = ?").
+		WithArgs(int32(12412345)).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT email FROM members WHERE email = ?").
+		WithArgs("maria_santos@dlsu.edu.ph").
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(int32(12412345), "maria_santos@dlsu.edu.ph", "maria_santos@dlsu.edu.ph", int32(12412345)).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+}
+
+func TestRegisterHandler(t *testing.T) {
+	body := `{"student_id":12412345,"student_name":"Maria Santos","student_email":"maria_santos@dlsu.edu.ph"}`
+
+	register := func(t *testing.T, h *ParticipantHandler, body string) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/events/1/register", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		assert.NoError(t, h.RegisterHandler(c))
+		return rec
+	}
+
+	t.Run("success - verification email sent", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(eventRow(1, "RND"))
+		expectNewStudent(mock)
+		mock.ExpectExec("UPDATE event_participants").
+			WithArgs("Maria Santos", "maria_santos@dlsu.edu.ph", nil, int32(1), int32(12412345)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("INSERT INTO event_participants").
+			WithArgs(int32(1), int32(12412345), "Maria Santos", "maria_santos@dlsu.edu.ph", nil, nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		h, notifier := newParticipantHandlerWithNotifier(db)
+		rec := register(t, h, body)
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "check_in_token")
+		if assert.Len(t, notifier.sent, 1) {
+			sent := notifier.sent[0]
+			assert.Equal(t, "maria_santos@dlsu.edu.ph", sent.Email)
+			assert.NoError(t, checkVerificationToken(testSecret, sent.Token, "maria_santos@dlsu.edu.ph", testNow))
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - replaces an unverified registration", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(eventRow(1, "RND"))
+		expectNewStudent(mock)
+		mock.ExpectExec("UPDATE event_participants").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		h, notifier := newParticipantHandlerWithNotifier(db)
+		rec := register(t, h, body)
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		assert.Len(t, notifier.sent, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - student ID of a member", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(eventRow(1, "RND"))
+		mock.ExpectQuery("SELECT id FROM members WHERE id = ?").
+			WithArgs(int32(12412345)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12412345))
+
+		h, notifier := newParticipantHandlerWithNotifier(db)
+		rec := register(t, h, body)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Empty(t, notifier.sent)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - not a DLSU email", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		h, notifier := newParticipantHandlerWithNotifier(db)
+		rec := register(t, h, `{"student_id":12412345,"student_name":"Maria Santos","student_email":"maria.santos@gmail.com"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Empty(t, notifier.sent)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - student ID verified with another email", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		// someone else's student ID, with the registrant's own DLSU email
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(eventRow(1, "RND"))
+		mock.ExpectQuery("SELECT id FROM members WHERE id = ?").
+			WithArgs(int32(12412345)).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery("SELECT email FROM members WHERE email = ?").
+			WithArgs("impostor@dlsu.edu.ph").
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery("SELECT EXISTS").
+			WithArgs(int32(12412345), "impostor@dlsu.edu.ph", "impostor@dlsu.edu.ph", int32(12412345)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		h, notifier := newParticipantHandlerWithNotifier(db)
+		rec := register(t, h, `{"student_id":12412345,"student_name":"Maria Santos","student_email":"impostor@dlsu.edu.ph"}`)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Empty(t, notifier.sent)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - already registered", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(eventRow(1, "RND"))
+		expectNewStudent(mock)
+		mock.ExpectExec("UPDATE event_participants").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("INSERT INTO event_participants").
+			WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+		mock.ExpectQuery("SELECT (.+) FROM event_participants").
+			WithArgs(int32(1), int32(12412345)).
+			WillReturnRows(sqlmock.NewRows(participantColumns).
+				AddRow(1, 12412345, "Maria Santos", "maria_santos@dlsu.edu.ph", nil, testNow, nil, nil, testNow))
+
+		h, notifier := newParticipantHandlerWithNotifier(db)
+		rec := register(t, h, body)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Empty(t, notifier.sent)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestVerifyRegistrationHandler(t *testing.T) {
+	email := "maria_santos@dlsu.edu.ph"
+	verify := func(t *testing.T, db *sql.DB, token string) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/events/1/register/verify", strings.NewReader(`{"token":"`+token+`"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+
+		assert.NoError(t, newParticipantHandler(db).VerifyRegistrationHandler(c))
+		return rec
+	}
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM event_participants").
+			WithArgs(int32(1), int32(12412345)).
+			WillReturnRows(sqlmock.NewRows(participantColumns).
+				AddRow(1, 12412345, "Maria Santos", email, nil, testNow, nil, nil, nil))
+		mock.ExpectQuery("SELECT EXISTS").
+			WithArgs(int32(12412345), email, email, int32(12412345)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+		mock.ExpectExec("UPDATE event_participants SET verified_at").
+			WithArgs(int32(1), int32(12412345), email).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT (.+) FROM event_participants").
+			WithArgs(int32(1), int32(12412345)).
+			WillReturnRows(sqlmock.NewRows(participantColumns).
+				AddRow(1, 12412345, "Maria Santos", email, nil, testNow, nil, nil, testNow))
+
+		rec := verify(t, db, SignVerificationToken(testSecret, 1, 12412345, email, testNow.Add(time.Hour)))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp TicketResponse
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.Equal(t, SignCheckInToken(testSecret, 1, 12412345), resp.CheckInToken)
+		assert.NotNil(t, resp.Participant.VerifiedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - link sent to another email", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		// the registration was replaced by one with a different email
+		mock.ExpectQuery("SELECT (.+) FROM event_participants").
+			WithArgs(int32(1), int32(12412345)).
+			WillReturnRows(sqlmock.NewRows(participantColumns).
+				AddRow(1, 12412345, "Someone Else", "someone@dlsu.edu.ph", nil, testNow, nil, nil, nil))
+
+		rec := verify(t, db, SignVerificationToken(testSecret, 1, 12412345, email, testNow.Add(time.Hour)))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - student ID verified with another email since", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM event_participants").
+			WithArgs(int32(1), int32(12412345)).
+			WillReturnRows(sqlmock.NewRows(participantColumns).
+				AddRow(1, 12412345, "Maria Santos", email, nil, testNow, nil, nil, nil))
+		mock.ExpectQuery("SELECT EXISTS").
+			WithArgs(int32(12412345), email, email, int32(12412345)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		rec := verify(t, db, SignVerificationToken(testSecret, 1, 12412345, email, testNow.Add(time.Hour)))
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - expired", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM event_participants").
+			WithArgs(int32(1), int32(12412345)).
+			WillReturnRows(sqlmock.NewRows(participantColumns).
+				AddRow(1, 12412345, "Maria Santos", email, nil, testNow, nil, nil, nil))
+
+		rec := verify(t, db, SignVerificationToken(testSecret, 1, 12412345, email, testNow.Add(-time.Minute)))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - check-in token", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		rec := verify(t, db, SignCheckInToken(testSecret, 1, 12412345))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCheckInHandler(t *testing.T) {
+	checkIn := func(t *testing.T, db *sql.DB, token string) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/events/1/check-in", strings.NewReader(`{"token":"`+token+`"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+		auth.SetPrincipal(c, &auth.Principal{MemberID: 2, Method: auth.AuthMethodSession})
+
+		assert.NoError(t, newParticipantHandler(db).CheckInHandler(c))
+		return rec
+	}
+
+	t.Run("success - event head scans twice", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		checkedInAt := time.Now()
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(eventRow(1, "RND"))
+		expectActor(mock, 2, "CT", "EXT")
+		mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM event_heads").
+			WithArgs(int32(1), int32(2)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectQuery("SELECT (.+) FROM event_participants").
+			WithArgs(int32(1), int32(12412345)).
+			WillReturnRows(sqlmock.NewRows(participantColumns).
+				AddRow(1, 12412345, "Maria Santos", nil, nil, time.Now(), checkedInAt, 2, time.Now()))
+		mock.ExpectExec("UPDATE event_participants SET checked_in_at").
+			WithArgs(int32(2), int32(1), int32(12412345)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT (.+) FROM event_participants").
+			WithArgs(int32(1), int32(12412345)).
+			WillReturnRows(sqlmock.NewRows(participantColumns).
+				AddRow(1, 12412345, "Maria Santos", nil, nil, time.Now(), checkedInAt, 2, time.Now()))
+
+		rec := checkIn(t, db, SignCheckInToken(testSecret, 1, 12412345))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp CheckInResponse
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.True(t, resp.AlreadyCheckedIn)
+		assert.NotNil(t, resp.Participant.CheckedInAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - not an officer", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(eventRow(1, "RND"))
+		expectActor(mock, 2, "CT", "RND")
+		mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM event_heads").
+			WithArgs(int32(1), int32(2)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+		rec := checkIn(t, db, SignCheckInToken(testSecret, 1, 12412345))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - ticket for another event", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(eventRow(1, "RND"))
+		expectActor(mock, 2, "VP", "RND")
+
+		rec := checkIn(t, db, SignCheckInToken(testSecret, 2, 12412345))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestImportParticipantsHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+		WithArgs(int32(1)).
+		WillReturnRows(eventRow(1, "RND"))
+	expectActor(mock, 1, "VP", "RND")
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO event_participants").
+		WithArgs(int32(1), int32(12412345), "Maria Santos", "maria@dlsu.edu.ph", nil, testNow).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO event_participants").
+		WithArgs(int32(1), int32(12412346), nil, nil, "walk-in", testNow).
+		WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+	mock.ExpectCommit()
+
+	csvData := "\ufeffStudent_ID,student_name,student_email,notes\n" +
+		"12412345,Maria Santos,maria@dlsu.edu.ph,\n" +
+		"12412346,,,walk-in\n" +
+		"abc,Bad Row,,\n" +
+		"12412347,Bad Email,not-an-email,\n"
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	part, _ := writer.CreateFormFile("file", "participants.csv")
+	part.Write([]byte(csvData))
+	writer.Close()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/events/1/participants/import", &buf)
+	req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+
+	if assert.NoError(t, newParticipantHandler(db).ImportParticipantsHandler(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp ImportParticipantsResponse
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.Equal(t, 1, resp.Added)
+		assert.Equal(t, 1, resp.Skipped)
+		if assert.Len(t, resp.Errors, 2) {
+			assert.Equal(t, 4, resp.Errors[0].Line)
+			assert.Equal(t, 5, resp.Errors[1].Line)
+		}
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExportAttendanceHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	checkedInAt := time.Date(2026, 11, 5, 13, 5, 0, 0, time.UTC)
+	mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+		WithArgs(int32(1)).
+		WillReturnRows(eventRow(1, "RND"))
+	expectActor(mock, 1, "AVP", "RND")
+	mock.ExpectQuery("SELECT (.+) FROM event_participants").
+		WithArgs(int32(1)).
+		WillReturnRows(sqlmock.NewRows(participantColumns).
+			AddRow(1, 12412345, "Maria Santos", "maria@dlsu.edu.ph", nil, checkedInAt, checkedInAt, 1, checkedInAt).
+			AddRow(1, 12412346, "Jose Rizal", nil, "=HYPERLINK(1)", checkedInAt, nil, nil, checkedInAt).
+			AddRow(1, 12412347, "Unverified", "unverified@dlsu.edu.ph", nil, checkedInAt, nil, nil, nil))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/events/1/attendance.csv", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+
+	if assert.NoError(t, newParticipantHandler(db).ExportAttendanceHandler(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Header().Get(echo.HeaderContentType), "text/csv")
+		lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+		assert.Len(t, lines, 3)
+		assert.Equal(t, "12412345,Maria Santos,maria@dlsu.edu.ph,,2026-11-05T13:05:00Z,2026-11-05T13:05:00Z,yes", lines[1])
+		assert.Equal(t, "12412346,Jose Rizal,,'=HYPERLINK(1),2026-11-05T13:05:00Z,,no", lines[2])
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCSVCell(t *testing.T) {
+	tests := map[string]string{
+		"Maria Santos":       "Maria Santos",
+		"":                   "",
+		"=1+2":               "'=1+2",
+		"+639171234567":      "'+639171234567",
+		"-2":                 "'-2",
+		"@SUM(A1:A2)":        "'@SUM(A1:A2)",
+		"\t=1":               "'\t=1",
+		"\r=1":               "'\r=1",
+		"maria=@dlsu.edu.ph": "maria=@dlsu.edu.ph",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, csvCell(in), in)
+	}
+}
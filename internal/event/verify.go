@@ -0,0 +1,80 @@
+package event
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// registrationVerifyTTL is how long the link in a registration verification email works
+const registrationVerifyTTL = 24 * time.Hour
+
+// studentEmailDomain is the domain public registrations must use, so a verified email is a real student's
+const studentEmailDomain = "@dlsu.edu.ph"
+
+// isStudentEmail reports whether an email is a DLSU student email
+func isStudentEmail(email string) bool {
+	return strings.HasSuffix(strings.ToLower(strings.TrimSpace(email)), studentEmailDomain)
+}
+
+// ErrInvalidVerificationToken is returned when a verification token is malformed, expired or its signature does not match
+var ErrInvalidVerificationToken = errors.New("invalid verification token")
+
+// verificationKey derives the registration verification signing key from the server secret,
+// so verification tokens can never be mistaken for check-in tokens
+func verificationKey(secret string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("event-registration-verify"))
+	return mac.Sum(nil)
+}
+
+// SignVerificationToken returns the token of a registration verification link: "<event_id>.<student_id>.<expires>.<signature>".
+// The signature also covers the email the link is sent to, so the link stops working once the registration is replaced.
+func SignVerificationToken(secret string, eventID, studentID int32, email string, expires time.Time) string {
+	payload := fmt.Sprintf("%d.%d.%d", eventID, studentID, expires.Unix())
+	mac := hmac.New(sha256.New, verificationKey(secret))
+	mac.Write([]byte(payload + "." + email))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// parseVerificationToken returns the event and student of a verification token without checking it.
+// The registration's email is needed for that, see checkVerificationToken.
+func parseVerificationToken(token string) (eventID, studentID int32, expires time.Time, err error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 4 {
+		return 0, 0, time.Time{}, ErrInvalidVerificationToken
+	}
+
+	event, err := strconv.ParseInt(parts[0], 10, 32)
+	if err != nil {
+		return 0, 0, time.Time{}, ErrInvalidVerificationToken
+	}
+	student, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil {
+		return 0, 0, time.Time{}, ErrInvalidVerificationToken
+	}
+	unix, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return 0, 0, time.Time{}, ErrInvalidVerificationToken
+	}
+
+	return int32(event), int32(student), time.Unix(unix, 0), nil
+}
+
+// checkVerificationToken checks that a token was issued for the registration's email and has not expired
+func checkVerificationToken(secret, token, email string, now time.Time) error {
+	eventID, studentID, expires, err := parseVerificationToken(token)
+	if err != nil {
+		return err
+	}
+	expected := SignVerificationToken(secret, eventID, studentID, email, expires)
+	if !hmac.Equal([]byte(expected), []byte(token)) || !now.Before(expires) {
+		return ErrInvalidVerificationToken
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package graph
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// defaultListSize is how many items a list field without a first argument is assumed to return
+const defaultListSize = 50
+
+// queryCost estimates how many objects an operation loads and how deeply it nests.
+// Each object field costs 1, times the size of the lists it is in: list fields are assumed
+// to return first items if they take a first argument, or defaultListSize otherwise.
+// Scalar fields are free, and so is introspection (fields starting with __).
+type queryCost struct {
+	schema    graphql.Schema
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]any
+	visiting  map[string]bool // fragments being expanded, to stop at cycles (rejected by validation later)
+}
+
+// analyze returns the cost and depth of the operation that will run,
+// or zeros if there is no such operation (graphql.Do reports that)
+func analyze(schema graphql.Schema, doc *ast.Document, operationName string, variables map[string]any) (cost, depth int) {
+	qc := &queryCost{
+		schema:    schema,
+		fragments: map[string]*ast.FragmentDefinition{},
+		variables: variables,
+		visiting:  map[string]bool{},
+	}
+	var op *ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.FragmentDefinition:
+			qc.fragments[def.Name.Value] = def
+		case *ast.OperationDefinition:
+			if operationName == "" || (def.Name != nil && def.Name.Value == operationName) {
+				op = def
+			}
+		}
+	}
+	if op == nil || op.Operation != ast.OperationTypeQuery {
+		return 0, 0
+	}
+	return qc.selectionSet(op.SelectionSet, qc.schema.QueryType())
+}
+
+func (qc *queryCost) selectionSet(set *ast.SelectionSet, parent *graphql.Object) (cost, depth int) {
+	if set == nil || parent == nil {
+		return 0, 0
+	}
+	for _, sel := range set.Selections {
+		var c, d int
+		switch sel := sel.(type) {
+		case *ast.Field:
+			c, d = qc.field(sel, parent)
+		case *ast.InlineFragment:
+			c, d = qc.selectionSet(sel.SelectionSet, qc.fragmentType(sel.TypeCondition, parent))
+		case *ast.FragmentSpread:
+			frag := qc.fragments[sel.Name.Value]
+			if frag == nil || qc.visiting[sel.Name.Value] {
+				continue
+			}
+			qc.visiting[sel.Name.Value] = true
+			c, d = qc.selectionSet(frag.SelectionSet, qc.fragmentType(frag.TypeCondition, parent))
+			delete(qc.visiting, sel.Name.Value)
+		}
+		cost += c
+		depth = max(depth, d)
+	}
+	return cost, depth
+}
+
+func (qc *queryCost) field(f *ast.Field, parent *graphql.Object) (cost, depth int) {
+	if strings.HasPrefix(f.Name.Value, "__") {
+		return 0, 0
+	}
+	def := parent.Fields()[f.Name.Value]
+	if def == nil {
+		return 0, 1 // unknown fields are rejected by validation
+	}
+
+	size := 1
+	t := def.Type
+	if nn, ok := t.(*graphql.NonNull); ok {
+		t = nn.OfType
+	}
+	if _, ok := t.(*graphql.List); ok {
+		size = qc.listSize(f)
+	}
+	obj, ok := graphql.GetNamed(def.Type).(*graphql.Object)
+	if !ok {
+		return 0, 1
+	}
+	childCost, childDepth := qc.selectionSet(f.SelectionSet, obj)
+	return 1 + size*childCost, 1 + childDepth
+}
+
+// listSize is the first argument of a list field, or defaultListSize
+func (qc *queryCost) listSize(f *ast.Field) int {
+	for _, arg := range f.Arguments {
+		if arg.Name.Value != "first" {
+			continue
+		}
+		switch v := arg.Value.(type) {
+		case *ast.IntValue:
+			if n, err := strconv.Atoi(v.Value); err == nil && n > 0 {
+				return n
+			}
+		case *ast.Variable:
+			switch n := qc.variables[v.Name.Value].(type) {
+			case float64: // JSON numbers
+				if n > 0 {
+					return int(n)
+				}
+			case int:
+				if n > 0 {
+					return n
+				}
+			}
+		}
+	}
+	return defaultListSize
+}
+
+// fragmentType is the type a fragment applies to (the parent if it has no type condition)
+func (qc *queryCost) fragmentType(cond *ast.Named, parent *graphql.Object) *graphql.Object {
+	if cond == nil {
+		return parent
+	}
+	obj, _ := qc.schema.Type(cond.Name.Value).(*graphql.Object)
+	return obj
+}
+
+// costError is the error returned for queries over the limits
+func costError(cost, depth, maxCost, maxDepth int) error {
+	if depth > maxDepth {
+		return fmt.Errorf("query depth %d exceeds the limit of %d", depth, maxDepth)
+	}
+	if cost > maxCost {
+		return fmt.Errorf("query cost %d exceeds the limit of %d", cost, maxCost)
+	}
+	return nil
+}
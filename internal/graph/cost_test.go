@@ -0,0 +1,69 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyze(t *testing.T) {
+	schema, err := newSchema()
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		query     string
+		operation string
+		variables map[string]any
+		cost      int
+		depth     int
+	}{
+		{name: "scalars are free", query: `{ committees { id name } }`, cost: 1, depth: 2},
+		{
+			name:  "nested lists multiply",
+			query: `{ committees { members { fullName position { name } } } }`,
+			cost:  1 + defaultListSize*(1+defaultListSize*1),
+			depth: 4,
+		},
+		{name: "first argument", query: `{ members(first: 10) { committee { name } } }`, cost: 11, depth: 3},
+		{
+			name:      "first variable",
+			query:     `query Page($n: Int) { members(first: $n) { house { name } } }`,
+			variables: map[string]any{"n": float64(20)},
+			cost:      21,
+			depth:     3,
+		},
+		{
+			name:  "fragments",
+			query: `{ divisions { ...D } } fragment D on Division { committees { id } head { ... on Member { id } } }`,
+			cost:  1 + defaultListSize*2,
+			depth: 3,
+		},
+		{name: "fragment cycles stop", query: `{ divisions { ...D } } fragment D on Division { ...D }`, cost: 1, depth: 1},
+		{name: "introspection is free", query: `{ __schema { types { name fields { name } } } }`, cost: 0, depth: 0},
+		{
+			name:      "named operation",
+			query:     `query A { committees { id } } query B { committees { head { id } } }`,
+			operation: "B",
+			cost:      1 + defaultListSize,
+			depth:     3,
+		},
+		{name: "unknown operation", query: `query A { committees { id } }`, operation: "B"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := parser.Parse(parser.ParseParams{Source: tt.query})
+			assert.NoError(t, err)
+			cost, depth := analyze(schema, doc, tt.operation, tt.variables)
+			assert.Equal(t, tt.cost, cost)
+			assert.Equal(t, tt.depth, depth)
+		})
+	}
+}
+
+func TestCostError(t *testing.T) {
+	assert.NoError(t, costError(100, 3, 100, 3))
+	assert.EqualError(t, costError(101, 3, 100, 3), "query cost 101 exceeds the limit of 100")
+	assert.EqualError(t, costError(101, 4, 100, 3), "query depth 4 exceeds the limit of 3")
+}
@@ -0,0 +1,118 @@
+package graph
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// Handler serves the GraphQL endpoint
+type Handler struct {
+	dbService   database.Service
+	rbacService *auth.RBACService
+	schema      graphql.Schema
+	maxCost     int
+	maxDepth    int
+}
+
+func NewHandler(cfg *config.Config, dbService database.Service, rbacService *auth.RBACService) *Handler {
+	schema, err := newSchema()
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid GraphQL schema")
+	}
+	return &Handler{
+		dbService:   dbService,
+		rbacService: rbacService,
+		schema:      schema,
+		maxCost:     cfg.GraphQLMaxCost,
+		maxDepth:    cfg.GraphQLMaxDepth,
+	}
+}
+
+// Request is a GraphQL request
+type Request struct {
+	Query         string         `json:"query" example:"{ committees { id name head { fullName } } }"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// GraphQLHandler godoc
+// @Summary Query the member/committee/division graph
+// @Description GraphQL endpoint for members, committees, divisions, positions, houses and roles and how they relate.
+// @Description Fields need the same scopes and roles as their REST counterparts: members and member profile fields need members:read,
+// @Description committees and divisions need committees:read, positions and houses need a session or Google ID token, and roles need the ADMIN role.
+// @Description Queries whose estimated cost or depth is over the configured limits are rejected with 400.
+// @Description GET takes the query, operationName and variables (JSON) as query parameters.
+// @Tags graphql
+// @Accept json
+// @Produce json
+// @Param request body Request true "GraphQL request"
+// @Success 200 {object} map[string]interface{} "GraphQL result (data and errors)"
+// @Failure 400 {object} map[string]interface{} "Invalid query or over the cost limits (errors)"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Security BearerAuth
+// @Router /graphql [post]
+func (h *Handler) GraphQLHandler(c echo.Context) error {
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return helpers.ErrUnauthorized(c, "")
+	}
+
+	var req Request
+	if c.Request().Method == http.MethodGet {
+		req.Query = c.QueryParam("query")
+		req.OperationName = c.QueryParam("operationName")
+		if vars := c.QueryParam("variables"); vars != "" {
+			if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+				return helpers.ErrBadRequest(c, "Invalid variables")
+			}
+		}
+	} else if err := c.Bind(&req); err != nil {
+		return helpers.ErrBadRequest(c, "Invalid request body")
+	}
+	if req.Query == "" {
+		return helpers.ErrBadRequest(c, "Missing query")
+	}
+
+	// syntax errors are reported by graphql.Do
+	doc, err := parser.Parse(parser.ParseParams{Source: req.Query})
+	if err == nil {
+		cost, depth := analyze(h.schema, doc, req.OperationName, req.Variables)
+		if err := costError(cost, depth, h.maxCost, h.maxDepth); err != nil {
+			log.Warn().Int("cost", cost).Int("depth", depth).Int32("member_id", principal.MemberID).Msg("GraphQL query rejected")
+			return c.JSON(http.StatusBadRequest, &graphql.Result{Errors: []gqlerrors.FormattedError{gqlerrors.NewFormattedError(err.Error())}})
+		}
+	}
+
+	q := repository.New(h.dbService.GetConnection())
+	ctx := withRequest(c.Request().Context(), &request{
+		principal: principal,
+		rbac:      h.rbacService,
+		q:         q,
+		loaders:   newLoaders(q),
+	})
+	result := graphql.Do(graphql.Params{
+		Schema:         h.schema,
+		RequestString:  req.Query,
+		OperationName:  req.OperationName,
+		VariableValues: req.Variables,
+		Context:        ctx,
+	})
+
+	// queries that could not run at all (syntax and validation errors, which have no path) are bad requests
+	if result.Data == nil && result.HasErrors() && len(result.Errors[0].Path) == 0 {
+		return c.JSON(http.StatusBadRequest, result)
+	}
+	return c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,280 @@
+package graph
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+)
+
+// mockDBService is a mock implementation of the database.Service interface.
+type mockDBService struct {
+	db *sql.DB
+}
+
+func (m *mockDBService) Health() map[string]string {
+	return nil
+}
+
+func (m *mockDBService) Close() error {
+	return nil
+}
+
+func (m *mockDBService) GetConnection() *sql.DB {
+	return m.db
+}
+
+var memberColumns = []string{
+	"id", "full_name", "nickname", "email", "telegram", "position_id", "committee_id", "college",
+	"program", "discord", "interests", "contact_number", "fb_link", "house_id", "image_url",
+}
+
+var (
+	sessionPrincipal = &auth.Principal{MemberID: 12312345, Email: "juan_delacruz@dlsu.edu.ph", Method: auth.AuthMethodSession}
+	apiKeyPrincipal  = &auth.Principal{MemberID: 12312345, Email: "juan_delacruz@dlsu.edu.ph", Method: auth.AuthMethodAPIKey, Scopes: []string{auth.ScopeCommitteesRead}}
+)
+
+func newTestHandler(db *sql.DB) *Handler {
+	dbService := &mockDBService{db: db}
+	return NewHandler(&config.Config{GraphQLMaxCost: 10000, GraphQLMaxDepth: 10}, dbService, auth.NewRBACService(dbService))
+}
+
+type graphResponse struct {
+	Data   map[string]any `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+		Path    []any  `json:"path"`
+	} `json:"errors"`
+}
+
+func doQuery(t *testing.T, h *Handler, principal *auth.Principal, body string) (*httptest.ResponseRecorder, graphResponse) {
+	t.Helper()
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	if principal != nil {
+		auth.SetPrincipal(c, principal)
+	}
+
+	assert.NoError(t, h.GraphQLHandler(c))
+	var resp graphResponse
+	if rec.Code != http.StatusUnauthorized {
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	}
+	return rec, resp
+}
+
+func TestGraphQLHandler(t *testing.T) {
+	t.Run("success - relationships are loaded in batches", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+		mock.MatchExpectationsInOrder(false)
+
+		mock.ExpectQuery("SELECT (.+) FROM committees ORDER BY committee_name").
+			WillReturnRows(sqlmock.NewRows([]string{"committee_id", "committee_name", "committee_head", "division_id"}).
+				AddRow("RND", "Research and Development", 12312345, "INT").
+				AddRow("TND", "Training and Development", nil, "INT"))
+		// one query for both committees' members and one for the heads
+		mock.ExpectQuery(`SELECT (.+) FROM members WHERE committee_id IN \(\?,\?\)`).
+			WithArgs(sql.NullString{String: "RND", Valid: true}, sql.NullString{String: "TND", Valid: true}).
+			WillReturnRows(sqlmock.NewRows(memberColumns).
+				AddRow(12312345, "Juan Dela Cruz", "Juan", "juan_delacruz@dlsu.edu.ph", nil, "VP", "RND", nil, nil, nil, nil, "09171234567", nil, nil, nil).
+				AddRow(12312346, "Maria Santos", nil, "maria_santos@dlsu.edu.ph", nil, "MEM", "TND", nil, nil, nil, nil, nil, nil, nil, nil))
+		mock.ExpectQuery(`SELECT (.+) FROM members WHERE id IN \(\?\)`).
+			WithArgs(int32(12312345)).
+			WillReturnRows(sqlmock.NewRows(memberColumns).
+				AddRow(12312345, "Juan Dela Cruz", "Juan", "juan_delacruz@dlsu.edu.ph", nil, "VP", "RND", nil, nil, nil, nil, "09171234567", nil, nil, nil))
+		// the positions of all members, across committees, in one query
+		mock.ExpectQuery(`SELECT (.+) FROM positions WHERE position_id IN \(\?,\?\)`).
+			WithArgs("VP", "MEM").
+			WillReturnRows(sqlmock.NewRows([]string{"position_id", "position_name", "position_rank"}).
+				AddRow("VP", "Vice President", 5).
+				AddRow("MEM", "Member", 1))
+
+		rec, resp := doQuery(t, newTestHandler(db), sessionPrincipal,
+			`{"query": "{ committees { id head { fullName } members { fullName contactNumber position { name } } } }"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, resp.Errors)
+		committees := resp.Data["committees"].([]any)
+		assert.Len(t, committees, 2)
+		rnd := committees[0].(map[string]any)
+		assert.Equal(t, "RND", rnd["id"])
+		assert.Equal(t, "Juan Dela Cruz", rnd["head"].(map[string]any)["fullName"])
+		member := rnd["members"].([]any)[0].(map[string]any)
+		assert.Equal(t, "09171234567", member["contactNumber"])
+		assert.Equal(t, "Vice President", member["position"].(map[string]any)["name"])
+		tnd := committees[1].(map[string]any)
+		assert.Nil(t, tnd["head"])
+		assert.Equal(t, "Maria Santos", tnd["members"].([]any)[0].(map[string]any)["fullName"])
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("scopes - profile fields need members:read", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM committees WHERE committee_id IN").
+			WithArgs("RND").
+			WillReturnRows(sqlmock.NewRows([]string{"committee_id", "committee_name", "committee_head", "division_id"}).
+				AddRow("RND", "Research and Development", nil, nil))
+		mock.ExpectQuery("SELECT (.+) FROM members WHERE committee_id IN").
+			WillReturnRows(sqlmock.NewRows(memberColumns).
+				AddRow(12312345, "Juan Dela Cruz", nil, "juan_delacruz@dlsu.edu.ph", nil, "VP", "RND", nil, nil, nil, nil, "09171234567", nil, nil, nil))
+
+		rec, resp := doQuery(t, newTestHandler(db), apiKeyPrincipal,
+			`{"query": "{ committee(id: \"RND\") { members { fullName contactNumber } } }"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		member := resp.Data["committee"].(map[string]any)["members"].([]any)[0].(map[string]any)
+		assert.Equal(t, "Juan Dela Cruz", member["fullName"])
+		assert.Nil(t, member["contactNumber"])
+		if assert.Len(t, resp.Errors, 1) {
+			assert.Equal(t, errMembersRead.Error(), resp.Errors[0].Message)
+			assert.Equal(t, []any{"committee", "members", float64(0), "contactNumber"}, resp.Errors[0].Path)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("scopes - members need members:read", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		rec, resp := doQuery(t, newTestHandler(db), apiKeyPrincipal, `{"query": "{ members { id } }"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		if assert.Len(t, resp.Errors, 1) {
+			assert.Equal(t, errMembersRead.Error(), resp.Errors[0].Message)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("roles - admin only", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT EXISTS").
+			WithArgs(int32(12312345)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+		rec, resp := doQuery(t, newTestHandler(db), sessionPrincipal, `{"query": "{ roles { id } }"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		if assert.Len(t, resp.Errors, 1) {
+			assert.Equal(t, errAdmin.Error(), resp.Errors[0].Message)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("roles - not for API keys", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		_, resp := doQuery(t, newTestHandler(db), &auth.Principal{MemberID: 12312345, Method: auth.AuthMethodAPIKey, Scopes: []string{auth.ScopeAdmin}},
+			`{"query": "{ roles { id } }"}`)
+		if assert.Len(t, resp.Errors, 1) {
+			assert.Equal(t, errInteractive.Error(), resp.Errors[0].Message)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("member page with variables", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM members WHERE id > (.+) ORDER BY id LIMIT").
+			WithArgs(int32(12312340), int32(2)).
+			WillReturnRows(sqlmock.NewRows(memberColumns).
+				AddRow(12312345, "Juan Dela Cruz", nil, "juan_delacruz@dlsu.edu.ph", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil))
+
+		rec, resp := doQuery(t, newTestHandler(db), sessionPrincipal,
+			`{"query": "query Page($after: Int) { members(first: 2, after: $after) { id committee { id } } }", "variables": {"after": 12312340}}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, resp.Errors)
+		assert.Equal(t, []any{map[string]any{"id": float64(12312345), "committee": nil}}, resp.Data["members"])
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - over the cost limit", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		h := newTestHandler(db)
+		h.maxCost = 50
+		rec, resp := doQuery(t, h, sessionPrincipal, `{"query": "{ committees { members { id } } }"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		if assert.Len(t, resp.Errors, 1) {
+			assert.Equal(t, "query cost 51 exceeds the limit of 50", resp.Errors[0].Message)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - invalid query", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		rec, resp := doQuery(t, newTestHandler(db), sessionPrincipal, `{"query": "{ committees { budget } }"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.NotEmpty(t, resp.Errors)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - missing query", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		rec, _ := doQuery(t, newTestHandler(db), sessionPrincipal, `{}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("error - unauthorized", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		rec, _ := doQuery(t, newTestHandler(db), nil, `{"query": "{ committees { id } }"}`)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestGraphQLHandlerGet(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM divisions WHERE division_id IN").
+		WithArgs("INT").
+		WillReturnRows(sqlmock.NewRows([]string{"division_id", "division_name", "division_head"}).AddRow("INT", "Internals", nil))
+
+	q := url.Values{}
+	q.Set("query", "query D($id: String!) { division(id: $id) { name } }")
+	q.Set("variables", `{"id": "INT"}`)
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/graphql?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	auth.SetPrincipal(c, apiKeyPrincipal)
+
+	if assert.NoError(t, newTestHandler(db).GraphQLHandler(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"data": {"division": {"name": "Internals"}}}`, rec.Body.String())
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,156 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// loader batches lookups by key: keys requested while resolving one level of a query
+// are fetched with a single query when the first of their thunks is called.
+// Loaders live for one request and are not safe for concurrent use
+// (graphql-go resolves queries on a single goroutine).
+type loader[K comparable, V any] struct {
+	fetch   func(ctx context.Context, keys []K) (map[K]V, error)
+	pending []K
+	queued  map[K]bool
+	results map[K]V
+	errs    map[K]error
+}
+
+func newLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:   fetch,
+		queued:  map[K]bool{},
+		results: map[K]V{},
+		errs:    map[K]error{},
+	}
+}
+
+// load queues a key and returns a thunk for its value.
+// The value is the zero value if nothing was found for the key.
+func (l *loader[K, V]) load(ctx context.Context, key K) func() (V, error) {
+	if !l.queued[key] {
+		l.queued[key] = true
+		l.pending = append(l.pending, key)
+	}
+	return func() (V, error) {
+		if l.pending != nil {
+			l.flush(ctx)
+		}
+		return l.results[key], l.errs[key]
+	}
+}
+
+// flush fetches the pending keys
+func (l *loader[K, V]) flush(ctx context.Context) {
+	keys := l.pending
+	l.pending = nil
+	results, err := l.fetch(ctx, keys)
+	err = logInternal(err, "failed to load a GraphQL relationship")
+	for _, k := range keys {
+		if err != nil {
+			l.errs[k] = err
+			continue
+		}
+		l.results[k] = results[k]
+	}
+}
+
+// loaders are the per-request loaders, one per relationship
+type loaders struct {
+	members              *loader[int32, *repository.Member]
+	membersByCommittee   *loader[string, []repository.Member]
+	membersByHouse       *loader[int32, []repository.Member]
+	committees           *loader[string, *repository.Committee]
+	committeesByDivision *loader[string, []repository.Committee]
+	divisions            *loader[string, *repository.Division]
+	positions            *loader[string, *repository.Position]
+	houses               *loader[int32, *repository.House]
+	rolesByMember        *loader[int32, []repository.Role]
+}
+
+func newLoaders(q *repository.Queries) *loaders {
+	return &loaders{
+		members: newLoader(func(ctx context.Context, ids []int32) (map[int32]*repository.Member, error) {
+			rows, err := q.ListMembersByIDs(ctx, ids)
+			return byKey(rows, err, func(m repository.Member) int32 { return m.ID })
+		}),
+		membersByCommittee: newLoader(func(ctx context.Context, ids []string) (map[string][]repository.Member, error) {
+			rows, err := q.ListMembersByCommitteeIDs(ctx, nullStrings(ids))
+			return groupBy(rows, err, func(m repository.Member) string { return m.CommitteeID.String })
+		}),
+		membersByHouse: newLoader(func(ctx context.Context, ids []int32) (map[int32][]repository.Member, error) {
+			nullIDs := make([]sql.NullInt32, len(ids))
+			for i, id := range ids {
+				nullIDs[i] = sql.NullInt32{Int32: id, Valid: true}
+			}
+			rows, err := q.ListMembersByHouseIDs(ctx, nullIDs)
+			return groupBy(rows, err, func(m repository.Member) int32 { return m.HouseID.Int32 })
+		}),
+		committees: newLoader(func(ctx context.Context, ids []string) (map[string]*repository.Committee, error) {
+			rows, err := q.ListCommitteesByIDs(ctx, ids)
+			return byKey(rows, err, func(c repository.Committee) string { return c.CommitteeID })
+		}),
+		committeesByDivision: newLoader(func(ctx context.Context, ids []string) (map[string][]repository.Committee, error) {
+			rows, err := q.ListCommitteesByDivisionIDs(ctx, nullStrings(ids))
+			return groupBy(rows, err, func(c repository.Committee) string { return c.DivisionID.String })
+		}),
+		divisions: newLoader(func(ctx context.Context, ids []string) (map[string]*repository.Division, error) {
+			rows, err := q.ListDivisionsByIDs(ctx, ids)
+			return byKey(rows, err, func(d repository.Division) string { return d.DivisionID })
+		}),
+		positions: newLoader(func(ctx context.Context, ids []string) (map[string]*repository.Position, error) {
+			rows, err := q.ListPositionsByIDs(ctx, ids)
+			return byKey(rows, err, func(p repository.Position) string { return p.PositionID })
+		}),
+		houses: newLoader(func(ctx context.Context, ids []int32) (map[int32]*repository.House, error) {
+			rows, err := q.ListHousesByIDs(ctx, ids)
+			return byKey(rows, err, func(h repository.House) int32 { return h.ID })
+		}),
+		rolesByMember: newLoader(func(ctx context.Context, ids []int32) (map[int32][]repository.Role, error) {
+			rows, err := q.ListRolesByMemberIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			roles := make(map[int32][]repository.Role)
+			for _, r := range rows {
+				roles[r.MemberID] = append(roles[r.MemberID], repository.Role{ID: r.ID, Name: r.Name, Description: r.Description})
+			}
+			return roles, nil
+		}),
+	}
+}
+
+// byKey indexes rows by a unique key
+func byKey[K comparable, V any](rows []V, err error, key func(V) K) (map[K]*V, error) {
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[K]*V, len(rows))
+	for i := range rows {
+		out[key(rows[i])] = &rows[i]
+	}
+	return out, nil
+}
+
+// groupBy groups rows by a key, keeping their order
+func groupBy[K comparable, V any](rows []V, err error, key func(V) K) (map[K][]V, error) {
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[K][]V)
+	for _, r := range rows {
+		out[key(r)] = append(out[key(r)], r)
+	}
+	return out, nil
+}
+
+func nullStrings(s []string) []sql.NullString {
+	out := make([]sql.NullString, len(s))
+	for i, v := range s {
+		out[i] = sql.NullString{String: v, Valid: true}
+	}
+	return out
+}
@@ -0,0 +1,52 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoader(t *testing.T) {
+	t.Run("batches keys queued before the first thunk is called", func(t *testing.T) {
+		var batches [][]int32
+		l := newLoader(func(_ context.Context, keys []int32) (map[int32]string, error) {
+			batches = append(batches, keys)
+			out := map[int32]string{}
+			for _, k := range keys {
+				if k != 3 {
+					out[k] = "member"
+				}
+			}
+			return out, nil
+		})
+
+		first := l.load(t.Context(), 1)
+		second := l.load(t.Context(), 2)
+		again := l.load(t.Context(), 1)
+		v, err := first()
+		assert.NoError(t, err)
+		assert.Equal(t, "member", v)
+		_, _ = second()
+		_, _ = again()
+		assert.Equal(t, [][]int32{{1, 2}}, batches)
+
+		// keys queued later are fetched in the next batch, loaded keys are not fetched again
+		missing := l.load(t.Context(), 3)
+		cached := l.load(t.Context(), 2)
+		v, err = missing()
+		assert.NoError(t, err)
+		assert.Empty(t, v)
+		_, _ = cached()
+		assert.Equal(t, [][]int32{{1, 2}, {3}}, batches)
+	})
+
+	t.Run("errors are hidden from clients", func(t *testing.T) {
+		l := newLoader(func(_ context.Context, keys []int32) (map[int32]string, error) {
+			return nil, errors.New("connection refused")
+		})
+		_, err := l.load(t.Context(), 1)()
+		assert.ErrorIs(t, err, errInternal)
+	})
+}
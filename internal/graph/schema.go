@@ -0,0 +1,426 @@
+package graph
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+const (
+	defaultMembersPage = 50
+	maxMembersPage     = 100
+)
+
+// requirement is what a field needs from the principal, the same as its REST counterpart
+type requirement int
+
+const (
+	needNothing        requirement = iota
+	needMembersRead                // GET /members
+	needCommitteesRead             // GET /committees, /divisions
+	needInteractive                // GET /positions, /houses (members signed in directly)
+	needAdmin                      // GET /roles (members with the ADMIN role)
+)
+
+var (
+	errMembersRead    = errors.New("forbidden: requires the members:read scope")
+	errCommitteesRead = errors.New("forbidden: requires the committees:read scope")
+	errInteractive    = errors.New("forbidden: only available to members signed in with a session or Google ID token")
+	errAdmin          = errors.New("forbidden: requires the ADMIN role")
+	errInternal       = errors.New("internal error")
+)
+
+// request is the per-request state resolvers get from the context
+type request struct {
+	principal *auth.Principal
+	rbac      *auth.RBACService
+	q         *repository.Queries
+	loaders   *loaders
+	admin     *bool // checked once per request
+}
+
+type requestKey struct{}
+
+func withRequest(ctx context.Context, r *request) context.Context {
+	return context.WithValue(ctx, requestKey{}, r)
+}
+
+func requestFrom(ctx context.Context) *request {
+	return ctx.Value(requestKey{}).(*request)
+}
+
+// allow checks a requirement against the principal
+func (r *request) allow(ctx context.Context, need requirement) error {
+	switch need {
+	case needMembersRead:
+		if !r.principal.HasScope(auth.ScopeMembersRead) {
+			return errMembersRead
+		}
+	case needCommitteesRead:
+		if !r.principal.HasScope(auth.ScopeCommitteesRead) {
+			return errCommitteesRead
+		}
+	case needInteractive:
+		if !r.principal.IsInteractive() {
+			return errInteractive
+		}
+	case needAdmin:
+		if !r.principal.IsInteractive() {
+			return errInteractive
+		}
+		if r.admin == nil {
+			isAdmin := r.rbac.IsAdmin(ctx, r.principal.MemberID)
+			r.admin = &isAdmin
+		}
+		if !*r.admin {
+			return errAdmin
+		}
+	}
+	return nil
+}
+
+// guard resolves a field only if the principal meets its requirement
+func guard(need requirement, resolve graphql.FieldResolveFn) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		if err := requestFrom(p.Context).allow(p.Context, need); err != nil {
+			return nil, err
+		}
+		return resolve(p)
+	}
+}
+
+// one turns a loader thunk for a single row into a resolver result (nil if not found)
+func one[V any](thunk func() (*V, error)) func() (any, error) {
+	return func() (any, error) {
+		v, err := thunk()
+		if err != nil || v == nil {
+			return nil, err
+		}
+		return *v, nil
+	}
+}
+
+// many turns a loader thunk for a list of rows into a resolver result
+func many[V any](thunk func() ([]V, error)) func() (any, error) {
+	return func() (any, error) {
+		v, err := thunk()
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			v = []V{}
+		}
+		return v, nil
+	}
+}
+
+// logInternal logs an error and hides it from the client
+func logInternal(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+	log.Error().Err(err).Msg(msg)
+	return errInternal
+}
+
+func nullString(s sql.NullString) any {
+	if !s.Valid {
+		return nil
+	}
+	return s.String
+}
+
+// newSchema builds the schema of the member/committee/division graph.
+// Field sources are repository models; relationships are resolved through the request's loaders.
+func newSchema() (graphql.Schema, error) {
+	var memberType, committeeType, divisionType *graphql.Object
+
+	positionType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Position",
+		Description: "A position in the organization; a higher rank has more authority",
+		Fields: graphql.Fields{
+			"id":   field(graphql.NewNonNull(graphql.String), func(p repository.Position) any { return p.PositionID }),
+			"name": field(graphql.NewNonNull(graphql.String), func(p repository.Position) any { return p.PositionName }),
+			"rank": field(graphql.NewNonNull(graphql.Int), func(p repository.Position) any { return p.PositionRank }),
+		},
+	})
+
+	roleType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Role",
+		Description: "An RBAC role",
+		Fields: graphql.Fields{
+			"id":          field(graphql.NewNonNull(graphql.String), func(r repository.Role) any { return r.ID }),
+			"name":        field(graphql.NewNonNull(graphql.String), func(r repository.Role) any { return r.Name }),
+			"description": field(graphql.String, func(r repository.Role) any { return nullString(r.Description) }),
+		},
+	})
+
+	houseType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "House",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":          field(graphql.NewNonNull(graphql.Int), func(h repository.House) any { return h.ID }),
+				"name":        field(graphql.String, func(h repository.House) any { return nullString(h.Name) }),
+				"description": field(graphql.String, func(h repository.House) any { return nullString(h.Description) }),
+				"members": {
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(memberType))),
+					Resolve: guard(needInteractive, func(p graphql.ResolveParams) (any, error) {
+						h := p.Source.(repository.House)
+						return many(requestFrom(p.Context).loaders.membersByHouse.load(p.Context, h.ID)), nil
+					}),
+				},
+			}
+		}),
+	})
+
+	memberType = graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Member",
+		Description: "An LSCS member. Profile fields need the members:read scope, like GET /members.",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":       memberField(graphql.NewNonNull(graphql.Int), needNothing, func(m repository.Member) any { return m.ID }),
+				"fullName": memberField(graphql.NewNonNull(graphql.String), needNothing, func(m repository.Member) any { return m.FullName }),
+				"nickname": memberField(graphql.String, needNothing, func(m repository.Member) any { return nullString(m.Nickname) }),
+				"email":    memberField(graphql.NewNonNull(graphql.String), needNothing, func(m repository.Member) any { return m.Email }),
+				"imageUrl": memberField(graphql.String, needNothing, func(m repository.Member) any { return nullString(m.ImageUrl) }),
+
+				"telegram":      memberField(graphql.String, needMembersRead, func(m repository.Member) any { return nullString(m.Telegram) }),
+				"discord":       memberField(graphql.String, needMembersRead, func(m repository.Member) any { return nullString(m.Discord) }),
+				"contactNumber": memberField(graphql.String, needMembersRead, func(m repository.Member) any { return nullString(m.ContactNumber) }),
+				"fbLink":        memberField(graphql.String, needMembersRead, func(m repository.Member) any { return nullString(m.FbLink) }),
+				"interests":     memberField(graphql.String, needMembersRead, func(m repository.Member) any { return nullString(m.Interests) }),
+				"college":       memberField(graphql.String, needMembersRead, func(m repository.Member) any { return nullString(m.College) }),
+				"program":       memberField(graphql.String, needMembersRead, func(m repository.Member) any { return nullString(m.Program) }),
+
+				"position": {
+					Type: positionType,
+					Resolve: guard(needMembersRead, func(p graphql.ResolveParams) (any, error) {
+						m := p.Source.(repository.Member)
+						if !m.PositionID.Valid {
+							return nil, nil
+						}
+						return one(requestFrom(p.Context).loaders.positions.load(p.Context, m.PositionID.String)), nil
+					}),
+				},
+				"committee": {
+					Type: committeeType,
+					Resolve: guard(needMembersRead, func(p graphql.ResolveParams) (any, error) {
+						m := p.Source.(repository.Member)
+						if !m.CommitteeID.Valid {
+							return nil, nil
+						}
+						return one(requestFrom(p.Context).loaders.committees.load(p.Context, m.CommitteeID.String)), nil
+					}),
+				},
+				"house": {
+					Type: houseType,
+					Resolve: guard(needMembersRead, func(p graphql.ResolveParams) (any, error) {
+						m := p.Source.(repository.Member)
+						if !m.HouseID.Valid {
+							return nil, nil
+						}
+						return one(requestFrom(p.Context).loaders.houses.load(p.Context, m.HouseID.Int32)), nil
+					}),
+				},
+				"roles": {
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(roleType))),
+					Description: "Admin only",
+					Resolve: guard(needAdmin, func(p graphql.ResolveParams) (any, error) {
+						m := p.Source.(repository.Member)
+						return many(requestFrom(p.Context).loaders.rolesByMember.load(p.Context, m.ID)), nil
+					}),
+				},
+			}
+		}),
+	})
+
+	committeeType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Committee",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":   field(graphql.NewNonNull(graphql.String), func(c repository.Committee) any { return c.CommitteeID }),
+				"name": field(graphql.NewNonNull(graphql.String), func(c repository.Committee) any { return c.CommitteeName }),
+				"head": {
+					Type: memberType,
+					Resolve: guard(needCommitteesRead, func(p graphql.ResolveParams) (any, error) {
+						c := p.Source.(repository.Committee)
+						if !c.CommitteeHead.Valid {
+							return nil, nil
+						}
+						return one(requestFrom(p.Context).loaders.members.load(p.Context, c.CommitteeHead.Int32)), nil
+					}),
+				},
+				"division": {
+					Type: divisionType,
+					Resolve: guard(needCommitteesRead, func(p graphql.ResolveParams) (any, error) {
+						c := p.Source.(repository.Committee)
+						if !c.DivisionID.Valid {
+							return nil, nil
+						}
+						return one(requestFrom(p.Context).loaders.divisions.load(p.Context, c.DivisionID.String)), nil
+					}),
+				},
+				"members": {
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(memberType))),
+					Resolve: guard(needCommitteesRead, func(p graphql.ResolveParams) (any, error) {
+						c := p.Source.(repository.Committee)
+						return many(requestFrom(p.Context).loaders.membersByCommittee.load(p.Context, c.CommitteeID)), nil
+					}),
+				},
+			}
+		}),
+	})
+
+	divisionType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Division",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":   field(graphql.NewNonNull(graphql.String), func(d repository.Division) any { return d.DivisionID }),
+				"name": field(graphql.NewNonNull(graphql.String), func(d repository.Division) any { return d.DivisionName }),
+				"head": {
+					Type: memberType,
+					Resolve: guard(needCommitteesRead, func(p graphql.ResolveParams) (any, error) {
+						d := p.Source.(repository.Division)
+						if !d.DivisionHead.Valid {
+							return nil, nil
+						}
+						return one(requestFrom(p.Context).loaders.members.load(p.Context, d.DivisionHead.Int32)), nil
+					}),
+				},
+				"committees": {
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(committeeType))),
+					Resolve: guard(needCommitteesRead, func(p graphql.ResolveParams) (any, error) {
+						d := p.Source.(repository.Division)
+						return many(requestFrom(p.Context).loaders.committeesByDivision.load(p.Context, d.DivisionID)), nil
+					}),
+				},
+			}
+		}),
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"members": {
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(memberType))),
+				Description: "Members ordered by ID; pass the last ID as after to get the next page",
+				Args: graphql.FieldConfigArgument{
+					"first": {Type: graphql.Int, DefaultValue: defaultMembersPage, Description: fmt.Sprintf("At most %d", maxMembersPage)},
+					"after": {Type: graphql.Int, DefaultValue: 0},
+				},
+				Resolve: guard(needMembersRead, func(p graphql.ResolveParams) (any, error) {
+					first, _ := p.Args["first"].(int)
+					after, _ := p.Args["after"].(int)
+					if first < 1 || first > maxMembersPage {
+						return nil, fmt.Errorf("first must be between 1 and %d", maxMembersPage)
+					}
+					members, err := requestFrom(p.Context).q.ListMembersPage(p.Context, repository.ListMembersPageParams{
+						ID:    int32(after),
+						Limit: int32(first),
+					})
+					if members == nil {
+						members = []repository.Member{}
+					}
+					return members, logInternal(err, "failed to list members")
+				}),
+			},
+			"member": {
+				Type: memberType,
+				Args: graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.Int)}},
+				Resolve: guard(needMembersRead, func(p graphql.ResolveParams) (any, error) {
+					id, _ := p.Args["id"].(int)
+					return one(requestFrom(p.Context).loaders.members.load(p.Context, int32(id))), nil
+				}),
+			},
+			"committees": {
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(committeeType))),
+				Resolve: guard(needCommitteesRead, func(p graphql.ResolveParams) (any, error) {
+					committees, err := requestFrom(p.Context).q.ListCommittees(p.Context)
+					if committees == nil {
+						committees = []repository.Committee{}
+					}
+					return committees, logInternal(err, "failed to list committees")
+				}),
+			},
+			"committee": {
+				Type: committeeType,
+				Args: graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.String)}},
+				Resolve: guard(needCommitteesRead, func(p graphql.ResolveParams) (any, error) {
+					id, _ := p.Args["id"].(string)
+					return one(requestFrom(p.Context).loaders.committees.load(p.Context, id)), nil
+				}),
+			},
+			"divisions": {
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(divisionType))),
+				Resolve: guard(needCommitteesRead, func(p graphql.ResolveParams) (any, error) {
+					divisions, err := requestFrom(p.Context).q.ListDivisions(p.Context)
+					if divisions == nil {
+						divisions = []repository.Division{}
+					}
+					return divisions, logInternal(err, "failed to list divisions")
+				}),
+			},
+			"division": {
+				Type: divisionType,
+				Args: graphql.FieldConfigArgument{"id": {Type: graphql.NewNonNull(graphql.String)}},
+				Resolve: guard(needCommitteesRead, func(p graphql.ResolveParams) (any, error) {
+					id, _ := p.Args["id"].(string)
+					return one(requestFrom(p.Context).loaders.divisions.load(p.Context, id)), nil
+				}),
+			},
+			"positions": {
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(positionType))),
+				Resolve: guard(needInteractive, func(p graphql.ResolveParams) (any, error) {
+					positions, err := requestFrom(p.Context).q.ListPositions(p.Context)
+					if positions == nil {
+						positions = []repository.Position{}
+					}
+					return positions, logInternal(err, "failed to list positions")
+				}),
+			},
+			"houses": {
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(houseType))),
+				Resolve: guard(needInteractive, func(p graphql.ResolveParams) (any, error) {
+					rows, err := requestFrom(p.Context).q.ListHouses(p.Context)
+					houses := make([]repository.House, len(rows))
+					for i, h := range rows {
+						houses[i] = repository.House{ID: h.ID, Name: h.Name, Description: h.Description}
+					}
+					return houses, logInternal(err, "failed to list houses")
+				}),
+			},
+			"roles": {
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(roleType))),
+				Resolve: guard(needAdmin, func(p graphql.ResolveParams) (any, error) {
+					roles, err := requestFrom(p.Context).q.GetAllRoles(p.Context)
+					if roles == nil {
+						roles = []repository.Role{}
+					}
+					return roles, logInternal(err, "failed to list roles")
+				}),
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+func memberField(typ graphql.Output, need requirement, value func(repository.Member) any) *graphql.Field {
+	return &graphql.Field{Type: typ, Resolve: guard(need, func(p graphql.ResolveParams) (any, error) {
+		return value(p.Source.(repository.Member)), nil
+	})}
+}
+
+// field resolves a field from its source model
+func field[T any](typ graphql.Output, value func(T) any) *graphql.Field {
+	return &graphql.Field{Type: typ, Resolve: func(p graphql.ResolveParams) (any, error) {
+		return value(p.Source.(T)), nil
+	}}
+}
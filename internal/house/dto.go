@@ -0,0 +1,151 @@
+package house
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// HouseRequest represents the request body for creating or replacing a house
+type HouseRequest struct {
+	Name        string  `json:"name" validate:"required,max=255" example:"Gryffindor"`
+	Description *string `json:"description,omitempty" example:"Brave at heart"`
+}
+
+// PointsRequest represents the request body for awarding (positive) or deducting (negative) house points.
+// The entry belongs to term_id if given, else to the linked event's term, else to the current term.
+type PointsRequest struct {
+	Points  int32  `json:"points" validate:"required,min=-1000,max=1000" example:"50"`
+	Reason  string `json:"reason" validate:"required,max=255" example:"Won the general assembly quiz bee"`
+	EventID *int32 `json:"event_id,omitempty" validate:"omitempty,gt=0" example:"1"`
+	TermID  *int32 `json:"term_id,omitempty" validate:"omitempty,gt=0" example:"1"`
+}
+
+// HouseResponse represents a house
+type HouseResponse struct {
+	ID          int32                  `json:"id" example:"1"`
+	Name        string                 `json:"name" example:"Gryffindor"`
+	Description helpers.NullableString `json:"description"`
+	MemberCount *int64                 `json:"member_count,omitempty" example:"42"`
+}
+
+// ListHousesResponse is the response for GET /houses
+type ListHousesResponse struct {
+	Houses []HouseResponse `json:"houses"`
+}
+
+// HouseMemberResponse represents a member of a house
+type HouseMemberResponse struct {
+	ID          int32                  `json:"id" example:"12312345"`
+	FullName    string                 `json:"full_name" example:"Juan Dela Cruz"`
+	Nickname    helpers.NullableString `json:"nickname"`
+	Email       string                 `json:"email" example:"juan_delacruz@dlsu.edu.ph"`
+	PositionID  helpers.NullableString `json:"position_id"`
+	CommitteeID helpers.NullableString `json:"committee_id"`
+	ImageURL    helpers.NullableString `json:"image_url"`
+}
+
+// ListHouseMembersResponse is the response for GET /houses/{id}/members
+type ListHouseMembersResponse struct {
+	House   HouseResponse         `json:"house"`
+	Members []HouseMemberResponse `json:"members"`
+}
+
+// MemberResponse represents the officer who awarded points
+type MemberResponse struct {
+	ID       int32  `json:"id" example:"12312345"`
+	FullName string `json:"full_name" example:"Juan Dela Cruz"`
+}
+
+// PointsResponse represents a house points ledger entry
+type PointsResponse struct {
+	ID        int32                  `json:"id" example:"1"`
+	HouseID   int32                  `json:"house_id" example:"1"`
+	TermID    int32                  `json:"term_id" example:"1"`
+	Points    int32                  `json:"points" example:"50"`
+	Reason    string                 `json:"reason" example:"Won the general assembly quiz bee"`
+	AwardedBy *MemberResponse        `json:"awarded_by,omitempty"`
+	EventID   *int32                 `json:"event_id,omitempty" example:"1"`
+	EventName helpers.NullableString `json:"event_name"`
+	CreatedAt *time.Time             `json:"created_at,omitempty"`
+}
+
+// ListPointsResponse is the response for GET /houses/{id}/points.
+// TermID is omitted when all terms are listed.
+type ListPointsResponse struct {
+	TermID  *int32           `json:"term_id,omitempty" example:"1"`
+	Total   int64            `json:"total" example:"120"`
+	Entries []PointsResponse `json:"entries"`
+}
+
+// StandingResponse represents a house on the leaderboard. Houses with equal points share a rank.
+type StandingResponse struct {
+	Rank    int    `json:"rank" example:"1"`
+	HouseID int32  `json:"house_id" example:"1"`
+	Name    string `json:"name" example:"Gryffindor"`
+	Points  int64  `json:"points" example:"120"`
+}
+
+// LeaderboardResponse is the response for GET /houses/leaderboard.
+// TermID is omitted when points of all terms are counted.
+type LeaderboardResponse struct {
+	TermID    *int32             `json:"term_id,omitempty" example:"1"`
+	Standings []StandingResponse `json:"standings"`
+}
+
+func toHouseResponse(h repository.House) HouseResponse {
+	return HouseResponse{
+		ID:          h.ID,
+		Name:        h.Name.String,
+		Description: helpers.NullableString{NullString: h.Description},
+	}
+}
+
+func toPointsResponse(p repository.GetHousePointsRow) PointsResponse {
+	resp := PointsResponse{
+		ID:        p.ID,
+		HouseID:   p.HouseID,
+		TermID:    p.TermID,
+		Points:    p.Points,
+		Reason:    p.Reason,
+		EventName: helpers.NullableString{NullString: p.EventName},
+	}
+	if p.AwardedBy.Valid {
+		resp.AwardedBy = &MemberResponse{ID: p.AwardedBy.Int32, FullName: p.AwardedByName.String}
+	}
+	if p.EventID.Valid {
+		resp.EventID = &p.EventID.Int32
+	}
+	if p.CreatedAt.Valid {
+		resp.CreatedAt = &p.CreatedAt.Time
+	}
+	return resp
+}
+
+// rankStandings assigns competition ranks ("1, 1, 3") to leaderboard rows already sorted by points
+func rankStandings(rows []repository.GetHouseLeaderboardRow) []StandingResponse {
+	standings := make([]StandingResponse, 0, len(rows))
+	for i, r := range rows {
+		rank := i + 1
+		if i > 0 && r.Points == rows[i-1].Points {
+			rank = standings[i-1].Rank
+		}
+		standings = append(standings, StandingResponse{
+			Rank:    rank,
+			HouseID: r.ID,
+			Name:    r.Name.String,
+			Points:  r.Points,
+		})
+	}
+	return standings
+}
+
+// toNullString converts an optional string to sql.NullString
+func toNullString(s *string) sql.NullString {
+	if s == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *s, Valid: true}
+}
@@ -0,0 +1,523 @@
+package house
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+	"github.com/dlsu-lscs/lscs-core-api/internal/term"
+)
+
+// Handler manages houses, their members and the house points ledger.
+// House changes are restricted to admins and awarding points to officers by the routes.
+type Handler struct {
+	dbService database.Service
+	terms     *term.Resolver
+}
+
+func NewHandler(dbService database.Service) *Handler {
+	return &Handler{
+		dbService: dbService,
+		terms:     term.NewResolver(dbService),
+	}
+}
+
+// ListHousesHandler lists the houses
+// @Summary List houses
+// @Description List the houses with their member counts
+// @Tags houses
+// @Produce json
+// @Success 200 {object} ListHousesResponse "List of houses"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /houses [get]
+func (h *Handler) ListHousesHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	houses, err := q.ListHouses(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list houses")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	resp := ListHousesResponse{Houses: make([]HouseResponse, 0, len(houses))}
+	for _, house := range houses {
+		r := toHouseResponse(repository.House{ID: house.ID, Name: house.Name, Description: house.Description})
+		r.MemberCount = &house.MemberCount
+		resp.Houses = append(resp.Houses, r)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetHouseHandler returns a house
+// @Summary Get house
+// @Description Get a house by ID
+// @Tags houses
+// @Produce json
+// @Param id path int true "House ID"
+// @Success 200 {object} HouseResponse "House"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid house ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "House not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /houses/{id} [get]
+func (h *Handler) GetHouseHandler(c echo.Context) error {
+	id, err := parseHouseID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid house ID"})
+	}
+	return h.respondWithHouse(c, http.StatusOK, id)
+}
+
+// ListHouseMembersHandler lists the members of a house
+// @Summary List house members
+// @Description List the members of a house
+// @Tags houses
+// @Produce json
+// @Param id path int true "House ID"
+// @Success 200 {object} ListHouseMembersResponse "House members"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid house ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "House not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /houses/{id}/members [get]
+func (h *Handler) ListHouseMembersHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	id, err := parseHouseID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid house ID"})
+	}
+
+	house, err := q.GetHouse(ctx, id)
+	if err != nil {
+		return h.houseError(c, err, id)
+	}
+
+	members, err := q.ListHouseMembers(ctx, sql.NullInt32{Int32: id, Valid: true})
+	if err != nil {
+		log.Error().Err(err).Int32("house_id", id).Msg("failed to list house members")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	resp := ListHouseMembersResponse{
+		House:   toHouseResponse(house),
+		Members: make([]HouseMemberResponse, 0, len(members)),
+	}
+	for _, m := range members {
+		resp.Members = append(resp.Members, HouseMemberResponse{
+			ID:          m.ID,
+			FullName:    m.FullName,
+			Nickname:    helpers.NullableString{NullString: m.Nickname},
+			Email:       m.Email,
+			PositionID:  helpers.NullableString{NullString: m.PositionID},
+			CommitteeID: helpers.NullableString{NullString: m.CommitteeID},
+			ImageURL:    helpers.NullableString{NullString: m.ImageUrl},
+		})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CreateHouseHandler creates a house
+// @Summary Create house
+// @Description Create a house. Admin only.
+// @Tags houses
+// @Accept json
+// @Produce json
+// @Param request body HouseRequest true "House"
+// @Success 201 {object} HouseResponse "Created house"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 409 {object} helpers.ErrorResponse "House name already taken"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /houses [post]
+func (h *Handler) CreateHouseHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	var req HouseRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	id, err := q.CreateHouse(c.Request().Context(), repository.CreateHouseParams{
+		Name:        sql.NullString{String: req.Name, Valid: true},
+		Description: toNullString(req.Description),
+	})
+	if err != nil {
+		if helpers.IsDuplicateEntry(err) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": "House name already taken"})
+		}
+		log.Error().Err(err).Msg("failed to create house")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().Int64("house_id", id).Int32("created_by", principalID(c)).Msg("house created")
+
+	return h.respondWithHouse(c, http.StatusCreated, int32(id))
+}
+
+// UpdateHouseHandler replaces a house
+// @Summary Update house
+// @Description Replace a house's name and description. Admin only.
+// @Tags houses
+// @Accept json
+// @Produce json
+// @Param id path int true "House ID"
+// @Param request body HouseRequest true "House"
+// @Success 200 {object} HouseResponse "Updated house"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "House not found"
+// @Failure 409 {object} helpers.ErrorResponse "House name already taken"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /houses/{id} [put]
+func (h *Handler) UpdateHouseHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	id, err := parseHouseID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid house ID"})
+	}
+
+	var req HouseRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	if _, err := q.GetHouse(ctx, id); err != nil {
+		return h.houseError(c, err, id)
+	}
+
+	if err := q.UpdateHouse(ctx, repository.UpdateHouseParams{
+		Name:        sql.NullString{String: req.Name, Valid: true},
+		Description: toNullString(req.Description),
+		ID:          id,
+	}); err != nil {
+		if helpers.IsDuplicateEntry(err) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": "House name already taken"})
+		}
+		log.Error().Err(err).Int32("house_id", id).Msg("failed to update house")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().Int32("house_id", id).Int32("updated_by", principalID(c)).Msg("house updated")
+
+	return h.respondWithHouse(c, http.StatusOK, id)
+}
+
+// DeleteHouseHandler deletes a house
+// @Summary Delete house
+// @Description Delete a house. Its members are left without a house. Houses with points cannot be deleted. Admin only.
+// @Tags houses
+// @Produce json
+// @Param id path int true "House ID"
+// @Success 200 {object} map[string]string "House deleted"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid house ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "House not found"
+// @Failure 409 {object} helpers.ErrorResponse "House has points"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /houses/{id} [delete]
+func (h *Handler) DeleteHouseHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	id, err := parseHouseID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid house ID"})
+	}
+
+	rows, err := q.DeleteHouse(c.Request().Context(), id)
+	if err != nil {
+		if helpers.IsForeignKeyViolation(err) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": "House has points and cannot be deleted"})
+		}
+		log.Error().Err(err).Int32("house_id", id).Msg("failed to delete house")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	if rows == 0 {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "House not found"})
+	}
+
+	log.Info().Int32("house_id", id).Int32("deleted_by", principalID(c)).Msg("house deleted")
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "House deleted successfully"})
+}
+
+// ListPointsHandler lists the points ledger of a house
+// @Summary List house points
+// @Description List the points awarded to and deducted from a house, newest first. Only entries of the current term are listed unless term_id is given; term_id=all lists every term.
+// @Tags houses
+// @Produce json
+// @Param id path int true "House ID"
+// @Param term_id query string false "Term ID, \"current\" (default) or \"all\""
+// @Success 200 {object} ListPointsResponse "Points ledger"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid house or term ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "House not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /houses/{id}/points [get]
+func (h *Handler) ListPointsHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	id, err := parseHouseID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid house ID"})
+	}
+	if _, err := q.GetHouse(ctx, id); err != nil {
+		return h.houseError(c, err, id)
+	}
+
+	resp := ListPointsResponse{Entries: []PointsResponse{}}
+	termID, all, err := h.terms.Resolve(ctx, c.QueryParam("term_id"))
+	switch {
+	case errors.Is(err, term.ErrInvalidTerm):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid term ID"})
+	case errors.Is(err, term.ErrNoTerm):
+		// no terms yet, so no points either
+		return c.JSON(http.StatusOK, resp)
+	case err != nil:
+		log.Error().Err(err).Msg("failed to resolve term")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	var entries []repository.ListHousePointsRow
+	if all {
+		var rows []repository.ListHousePointsAllTermsRow
+		rows, err = q.ListHousePointsAllTerms(ctx, id)
+		for _, r := range rows {
+			entries = append(entries, repository.ListHousePointsRow(r))
+		}
+	} else {
+		resp.TermID = &termID
+		entries, err = q.ListHousePoints(ctx, repository.ListHousePointsParams{HouseID: id, TermID: termID})
+	}
+	if err != nil {
+		log.Error().Err(err).Int32("house_id", id).Msg("failed to list house points")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	for _, e := range entries {
+		resp.Total += int64(e.Points)
+		resp.Entries = append(resp.Entries, toPointsResponse(repository.GetHousePointsRow(e)))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// AwardPointsHandler adds an entry to the points ledger of a house
+// @Summary Award or deduct house points
+// @Description Award (positive points) or deduct (negative points) house points with a reason, optionally linked to an event. The entry belongs to term_id if given, else to the event's term, else to the current term. Officers (AVP and up) only.
+// @Tags houses
+// @Accept json
+// @Produce json
+// @Param id path int true "House ID"
+// @Param request body PointsRequest true "Points"
+// @Success 201 {object} PointsResponse "Created ledger entry"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Insufficient position level"
+// @Failure 404 {object} helpers.ErrorResponse "House not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /houses/{id}/points [post]
+func (h *Handler) AwardPointsHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	id, err := parseHouseID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid house ID"})
+	}
+
+	var req PointsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	req.Reason = strings.TrimSpace(req.Reason)
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	if _, err := q.GetHouse(ctx, id); err != nil {
+		return h.houseError(c, err, id)
+	}
+
+	var termID int32
+	switch {
+	case req.TermID != nil:
+		termID = *req.TermID
+	case req.EventID != nil:
+		event, err := q.GetEventById(ctx, *req.EventID)
+		if err == sql.ErrNoRows {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Unknown event"})
+		}
+		if err != nil {
+			log.Error().Err(err).Int32("event_id", *req.EventID).Msg("failed to get event")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+		}
+		termID = event.TermID
+	default:
+		current, err := h.terms.Current(ctx)
+		if errors.Is(err, term.ErrNoTerm) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "There is no current term, term_id is required"})
+		}
+		if err != nil {
+			log.Error().Err(err).Msg("failed to resolve current term")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+		}
+		termID = current.ID
+	}
+
+	var eventID sql.NullInt32
+	if req.EventID != nil {
+		eventID = sql.NullInt32{Int32: *req.EventID, Valid: true}
+	}
+
+	entryID, err := q.CreateHousePoints(ctx, repository.CreateHousePointsParams{
+		HouseID:   id,
+		TermID:    termID,
+		Points:    req.Points,
+		Reason:    req.Reason,
+		AwardedBy: sql.NullInt32{Int32: principal.MemberID, Valid: true},
+		EventID:   eventID,
+	})
+	if err != nil {
+		if helpers.IsForeignKeyViolation(err) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Unknown term or event"})
+		}
+		log.Error().Err(err).Int32("house_id", id).Msg("failed to create house points")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().
+		Int64("entry_id", entryID).
+		Int32("house_id", id).
+		Int32("term_id", termID).
+		Int32("points", req.Points).
+		Int32("awarded_by", principal.MemberID).
+		Msg("house points recorded")
+
+	entry, err := q.GetHousePoints(ctx, int32(entryID))
+	if err != nil {
+		log.Error().Err(err).Int64("entry_id", entryID).Msg("failed to get house points")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return c.JSON(http.StatusCreated, toPointsResponse(entry))
+}
+
+// GetLeaderboardHandler returns the house standings
+// @Summary Get house leaderboard
+// @Description Rank the houses by points in a term. Defaults to the current term; term_id=all counts the points of every term.
+// @Tags houses
+// @Produce json
+// @Param term_id query string false "Term ID, \"current\" (default) or \"all\""
+// @Success 200 {object} LeaderboardResponse "House standings"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid term ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /houses/leaderboard [get]
+func (h *Handler) GetLeaderboardHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	termID, all, err := h.terms.Resolve(ctx, c.QueryParam("term_id"))
+	switch {
+	case errors.Is(err, term.ErrInvalidTerm):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid term ID"})
+	case errors.Is(err, term.ErrNoTerm):
+		// no terms yet, every house has 0 points
+		all = true
+	case err != nil:
+		log.Error().Err(err).Msg("failed to resolve term")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	var resp LeaderboardResponse
+	var rows []repository.GetHouseLeaderboardRow
+	if all {
+		var allRows []repository.GetHouseLeaderboardAllTermsRow
+		allRows, err = q.GetHouseLeaderboardAllTerms(ctx)
+		for _, r := range allRows {
+			rows = append(rows, repository.GetHouseLeaderboardRow(r))
+		}
+	} else {
+		resp.TermID = &termID
+		rows, err = q.GetHouseLeaderboard(ctx, termID)
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("failed to get house leaderboard")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	resp.Standings = rankStandings(rows)
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *Handler) respondWithHouse(c echo.Context, status int, id int32) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	house, err := q.GetHouse(c.Request().Context(), id)
+	if err != nil {
+		return h.houseError(c, err, id)
+	}
+	return c.JSON(status, toHouseResponse(house))
+}
+
+func (h *Handler) houseError(c echo.Context, err error, id int32) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "House not found"})
+	}
+	log.Error().Err(err).Int32("house_id", id).Msg("failed to get house")
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+}
+
+func principalID(c echo.Context) int32 {
+	if principal, ok := auth.GetPrincipal(c); ok {
+		return principal.MemberID
+	}
+	return 0
+}
+
+func parseHouseID(c echo.Context) (int32, error) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	return int32(id), err
+}
@@ -0,0 +1,211 @@
+package house
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// mockDBService is a mock implementation of the database.Service interface.
+type mockDBService struct {
+	db *sql.DB
+}
+
+func (m *mockDBService) Health() map[string]string {
+	return nil
+}
+
+func (m *mockDBService) Close() error {
+	return nil
+}
+
+func (m *mockDBService) GetConnection() *sql.DB {
+	return m.db
+}
+
+var (
+	houseColumns  = []string{"id", "name", "description"}
+	termColumns   = []string{"id", "term", "start_year", "end_year", "start_date", "end_date", "is_active"}
+	pointsColumns = []string{
+		"id", "house_id", "term_id", "points", "reason", "awarded_by", "event_id", "created_at",
+		"awarded_by_name", "event_name",
+	}
+)
+
+func newHandler(db *sql.DB) *Handler {
+	return NewHandler(&mockDBService{db: db})
+}
+
+func expectHouse(mock sqlmock.Sqlmock, id int32) {
+	mock.ExpectQuery("SELECT (.+) FROM houses WHERE id = ?").
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows(houseColumns).AddRow(id, "Gryffindor", nil))
+}
+
+func expectActiveTerm(mock sqlmock.Sqlmock, id int32) {
+	mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").
+		WillReturnRows(sqlmock.NewRows(termColumns).AddRow(id, 1, 2025, 2026, nil, nil, true))
+}
+
+func TestRankStandings(t *testing.T) {
+	standings := rankStandings([]repository.GetHouseLeaderboardRow{
+		{ID: 1, Points: 120},
+		{ID: 2, Points: 80},
+		{ID: 3, Points: 80},
+		{ID: 4, Points: 0},
+	})
+
+	ranks := make([]int, 0, len(standings))
+	for _, s := range standings {
+		ranks = append(ranks, s.Rank)
+	}
+	assert.Equal(t, []int{1, 2, 2, 4}, ranks)
+}
+
+func TestAwardPointsHandler(t *testing.T) {
+	newContext := func(body string) (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/houses/1/points", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues("1")
+		auth.SetPrincipal(c, &auth.Principal{MemberID: 7, Method: auth.AuthMethodSession})
+		return c, rec
+	}
+
+	t.Run("success - deduction in the current term", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectHouse(mock, 1)
+		expectActiveTerm(mock, 3)
+		mock.ExpectExec("INSERT INTO house_points").
+			WithArgs(int32(1), int32(3), int32(-20), "Late for the general assembly",
+				sql.NullInt32{Int32: 7, Valid: true}, sql.NullInt32{}).
+			WillReturnResult(sqlmock.NewResult(5, 1))
+		mock.ExpectQuery("SELECT (.+) FROM house_points p").
+			WithArgs(int32(5)).
+			WillReturnRows(sqlmock.NewRows(pointsColumns).
+				AddRow(5, 1, 3, -20, "Late for the general assembly", 7, nil, time.Now(), "Officer", nil))
+
+		c, rec := newContext(`{"points":-20,"reason":" Late for the general assembly "}`)
+
+		if assert.NoError(t, newHandler(db).AwardPointsHandler(c)) {
+			assert.Equal(t, http.StatusCreated, rec.Code)
+			var resp PointsResponse
+			json.Unmarshal(rec.Body.Bytes(), &resp)
+			assert.Equal(t, int32(-20), resp.Points)
+			assert.Equal(t, int32(3), resp.TermID)
+			if assert.NotNil(t, resp.AwardedBy) {
+				assert.Equal(t, int32(7), resp.AwardedBy.ID)
+			}
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - zero points", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		c, rec := newContext(`{"points":0,"reason":"Nothing"}`)
+
+		if assert.NoError(t, newHandler(db).AwardPointsHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("fail - unknown event", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectHouse(mock, 1)
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(9)).
+			WillReturnError(sql.ErrNoRows)
+
+		c, rec := newContext(`{"points":10,"reason":"Most attendees","event_id":9}`)
+
+		if assert.NoError(t, newHandler(db).AwardPointsHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGetLeaderboardHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	expectActiveTerm(mock, 3)
+	mock.ExpectQuery("SELECT (.+) FROM houses h").
+		WithArgs(int32(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "points"}).
+			AddRow(2, "Ravenclaw", 90).
+			AddRow(1, "Gryffindor", 40))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/houses/leaderboard", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, newHandler(db).GetLeaderboardHandler(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp LeaderboardResponse
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		if assert.NotNil(t, resp.TermID) {
+			assert.Equal(t, int32(3), *resp.TermID)
+		}
+		if assert.Len(t, resp.Standings, 2) {
+			assert.Equal(t, "Ravenclaw", resp.Standings[0].Name)
+			assert.Equal(t, 1, resp.Standings[0].Rank)
+		}
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListPointsHandlerAllTerms(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	expectHouse(mock, 1)
+	mock.ExpectQuery("SELECT (.+) FROM house_points p").
+		WithArgs(int32(1)).
+		WillReturnRows(sqlmock.NewRows(pointsColumns).
+			AddRow(2, 1, 3, 50, "Quiz bee", 7, 4, time.Now(), "Officer", "GA").
+			AddRow(1, 1, 2, -10, "Late", 7, nil, time.Now(), "Officer", nil))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/houses/1/points?term_id=all", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	if assert.NoError(t, newHandler(db).ListPointsHandler(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp ListPointsResponse
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.Nil(t, resp.TermID)
+		assert.Equal(t, int64(40), resp.Total)
+		assert.Len(t, resp.Entries, 2)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
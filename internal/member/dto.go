@@ -1,6 +1,8 @@
 package member
 
 import (
+	"database/sql"
+
 	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
 	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
 )
@@ -131,3 +133,40 @@ type UpdateMemberRequest struct {
 	FbLink        *string `json:"fb_link" validate:"omitempty,max=255"`
 	ImageURL      *string `json:"image_url" validate:"omitempty,max=512"`
 }
+
+// setFields returns the JSON names of the fields set in the request, in the order of UpdateMemberRequest's fields
+func (r *UpdateMemberRequest) setFields() []string {
+	var fields []string
+	for _, f := range []struct {
+		name string
+		set  bool
+	}{
+		{"full_name", r.FullName != nil},
+		{"nickname", r.Nickname != nil},
+		{"email", r.Email != nil},
+		{"position_id", r.PositionID != nil},
+		{"committee_id", r.CommitteeID != nil},
+		{"college", r.College != nil},
+		{"program", r.Program != nil},
+		{"house_id", r.HouseID != nil},
+		{"telegram", r.Telegram != nil},
+		{"discord", r.Discord != nil},
+		{"interests", r.Interests != nil},
+		{"contact_number", r.ContactNumber != nil},
+		{"fb_link", r.FbLink != nil},
+		{"image_url", r.ImageURL != nil},
+	} {
+		if f.set {
+			fields = append(fields, f.name)
+		}
+	}
+	return fields
+}
+
+// nullableID converts a nullable ID column to a pointer, nil for NULL
+func nullableID(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
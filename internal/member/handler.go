@@ -2,14 +2,18 @@ package member
 
 import (
 	"database/sql"
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
 
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
 	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
 	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+	"github.com/dlsu-lscs/lscs-core-api/internal/term"
+	"github.com/dlsu-lscs/lscs-core-api/internal/webhook"
 )
 
 // GetMemberInfo retrieves detailed member information by email
@@ -101,10 +105,13 @@ func (h *Handler) GetMemberInfoByID(c echo.Context) error {
 
 // GetAllMembersHandler lists all members
 // @Summary List all members
-// @Description Get a list of all LSCS members with basic information
+// @Description Get a list of the LSCS members of the current term with basic information. For a past term (term_id), the members archived when it was rolled over are listed, with the position and committee they had then.
 // @Tags members
 // @Produce json
+// @Param term_id query string false "Term ID or \"current\" (default)"
 // @Success 200 {array} MemberResponse "List of members"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid term ID"
+// @Failure 404 {object} helpers.ErrorResponse "Term not found, not started or its roster not archived"
 // @Failure 500 {object} helpers.ErrorResponse "Internal server error"
 // @Security BearerAuth
 // @Router /members [get]
@@ -113,7 +120,21 @@ func (h *Handler) GetAllMembersHandler(c echo.Context) error {
 	dbconn := h.dbService.GetConnection()
 	queries := repository.New(dbconn)
 
-	members, err := queries.ListMembers(ctx)
+	termID, live, ok, err := h.membershipTerm(c)
+	if !ok {
+		return err
+	}
+
+	var members []repository.ListMembersRow
+	if live {
+		members, err = queries.ListMembers(ctx)
+	} else {
+		var archived []repository.ListTermMembersRow
+		archived, err = queries.ListTermMembers(ctx, termID)
+		for _, m := range archived {
+			members = append(members, repository.ListMembersRow(m))
+		}
+	}
 	if err != nil {
 		log.Error().Err(err).Msg("failed to list members")
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to list members"})
@@ -129,14 +150,15 @@ func (h *Handler) GetAllMembersHandler(c echo.Context) error {
 
 // CheckEmailHandler checks if an email belongs to an LSCS member
 // @Summary Check email membership
-// @Description Verify if an email address belongs to an LSCS member
+// @Description Verify if an email address belongs to an LSCS member of the current term, or of a past term (term_id)
 // @Tags members
 // @Accept json
 // @Produce json
 // @Param request body EmailRequest true "Email Request"
+// @Param term_id query string false "Term ID or \"current\" (default)"
 // @Success 200 {object} map[string]interface{} "Member exists"
 // @Failure 400 {object} helpers.ErrorResponse "Invalid request"
-// @Failure 404 {object} helpers.ErrorResponse "Member not found"
+// @Failure 404 {object} helpers.ErrorResponse "Member or term not found"
 // @Failure 500 {object} helpers.ErrorResponse "Internal server error"
 // @Security BearerAuth
 // @Router /check-email [post]
@@ -147,10 +169,20 @@ func (h *Handler) CheckEmailHandler(c echo.Context) error {
 		return err
 	}
 
+	termID, live, ok, err := h.membershipTerm(c)
+	if !ok {
+		return err
+	}
+
 	ctx := c.Request().Context()
 	dbconn := h.dbService.GetConnection()
 	queries := repository.New(dbconn)
-	memberEmail, err := queries.CheckEmailIfMember(ctx, req.Email)
+	var memberEmail string
+	if live {
+		memberEmail, err = queries.CheckEmailIfMember(ctx, req.Email)
+	} else {
+		memberEmail, err = queries.CheckEmailIfTermMember(ctx, repository.CheckEmailIfTermMemberParams{TermID: termID, Email: req.Email})
+	}
 	if err != nil {
 		if err == sql.ErrNoRows {
 			response := map[string]string{
@@ -174,14 +206,15 @@ func (h *Handler) CheckEmailHandler(c echo.Context) error {
 
 // CheckIDIfMember checks if an ID belongs to an LSCS member
 // @Summary Check ID membership
-// @Description Verify if a student ID belongs to an LSCS member
+// @Description Verify if a student ID belongs to an LSCS member of the current term, or of a past term (term_id)
 // @Tags members
 // @Accept json
 // @Produce json
 // @Param request body IdRequest true "ID Request"
+// @Param term_id query string false "Term ID or \"current\" (default)"
 // @Success 200 {object} map[string]interface{} "Member exists"
 // @Failure 400 {object} helpers.ErrorResponse "Invalid request"
-// @Failure 404 {object} helpers.ErrorResponse "Member not found"
+// @Failure 404 {object} helpers.ErrorResponse "Member or term not found"
 // @Failure 500 {object} helpers.ErrorResponse "Internal server error"
 // @Security BearerAuth
 // @Router /check-id [post]
@@ -192,9 +225,20 @@ func (h *Handler) CheckIDIfMember(c echo.Context) error {
 		return err
 	}
 
+	termID, live, ok, err := h.membershipTerm(c)
+	if !ok {
+		return err
+	}
+
+	ctx := c.Request().Context()
 	dbconn := h.dbService.GetConnection()
 	q := repository.New(dbconn)
-	id, err := q.CheckIdIfMember(c.Request().Context(), int32(req.Id))
+	var id int32
+	if live {
+		id, err = q.CheckIdIfMember(ctx, int32(req.Id))
+	} else {
+		id, err = q.CheckIdIfTermMember(ctx, repository.CheckIdIfTermMemberParams{TermID: termID, MemberID: int32(req.Id)})
+	}
 	if err != nil {
 		if err == sql.ErrNoRows {
 			response := map[string]any{
@@ -231,12 +275,12 @@ func (h *Handler) GetMeHandler(c echo.Context) error {
 	dbconn := h.dbService.GetConnection()
 	q := repository.New(dbconn)
 
-	email, ok := c.Get("user_email").(string)
-	if !ok || email == "" {
+	principal, ok := auth.GetPrincipal(c)
+	if !ok || principal.Email == "" {
 		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
 	}
 
-	memberInfo, err := q.GetMemberInfo(ctx, email)
+	memberInfo, err := q.GetMemberInfo(ctx, principal.Email)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return c.JSON(http.StatusNotFound, map[string]string{"error": "Member not found"})
@@ -268,22 +312,13 @@ func (h *Handler) UpdateMeHandler(c echo.Context) error {
 	dbconn := h.dbService.GetConnection()
 	q := repository.New(dbconn)
 
-	email, ok := c.Get("user_email").(string)
-	if !ok || email == "" {
+	// the principal always refers to an existing member
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
 		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
 	}
 
-	// get member ID first
-	member, err := q.GetMemberInfo(ctx, email)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return c.JSON(http.StatusNotFound, map[string]string{"error": "Member not found"})
-		}
-		log.Error().Err(err).Msg("error getting member")
-		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
-	}
-
-	memberID := member.ID
+	memberID := principal.MemberID
 
 	// bind and validate request
 	req := new(UpdateSelfRequest)
@@ -335,7 +370,7 @@ func (h *Handler) UpdateMeHandler(c echo.Context) error {
 	}
 
 	// execute update
-	err = q.UpdateMemberSelf(ctx, repository.UpdateMemberSelfParams{
+	err := q.UpdateMemberSelf(ctx, repository.UpdateMemberSelfParams{
 		Nickname:      nickname,
 		Telegram:      telegram,
 		Discord:       discord,
@@ -359,6 +394,7 @@ func (h *Handler) UpdateMeHandler(c echo.Context) error {
 	}
 
 	response := toFullInfoMemberResponse(repository.GetMemberInfoRow(updatedMember))
+	h.events.Publish(ctx, webhook.EventMemberUpdated, response)
 
 	return c.JSON(http.StatusOK, response)
 }
@@ -410,9 +446,10 @@ func (h *Handler) GetMemberByIDHandler(c echo.Context) error {
 // @Param id path int true "Member ID"
 // @Param request body UpdateMemberRequest true "Update request"
 // @Success 200 {object} FullInfoMemberResponse "Updated profile"
-// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request or unknown position"
 // @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
 // @Failure 403 {object} helpers.ErrorResponse "Forbidden - cannot edit this member"
+// @Failure 403 {object} auth.StepUpRequiredResponse "Recent re-authentication required (changing another member's email)"
 // @Failure 500 {object} helpers.ErrorResponse "Internal server error"
 // @Security SessionAuth
 // @Router /members/{id} [put]
@@ -421,11 +458,12 @@ func (h *Handler) UpdateMemberByIDHandler(c echo.Context) error {
 	dbconn := h.dbService.GetConnection()
 	q := repository.New(dbconn)
 
-	// get actor ID from context
-	actorID, ok := c.Get("user_id").(int32)
+	// get actor ID from the authenticated principal
+	principal, ok := auth.GetPrincipal(c)
 	if !ok {
 		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
 	}
+	actorID := principal.MemberID
 
 	// get target member ID from path
 	idStr := c.Param("id")
@@ -435,7 +473,7 @@ func (h *Handler) UpdateMemberByIDHandler(c echo.Context) error {
 	}
 
 	// check if target member exists
-	_, err = q.GetMemberInfoById(ctx, int32(targetID))
+	target, err := q.GetMemberInfoById(ctx, int32(targetID))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return c.JSON(http.StatusNotFound, map[string]string{"error": "Member not found"})
@@ -450,6 +488,22 @@ func (h *Handler) UpdateMemberByIDHandler(c echo.Context) error {
 		return err
 	}
 
+	// the email is what another member logs in with, so changing it needs a fresh login
+	if req.Email != nil && *req.Email != target.Email && int32(targetID) != actorID && !principal.RecentlyAuthenticated {
+		return auth.StepUpRequired(c, principal, "change_member_email")
+	}
+
+	// positions define the authority hierarchy, so only existing positions can be assigned
+	if req.PositionID != nil {
+		if _, err := q.GetPosition(ctx, *req.PositionID); err != nil {
+			if err == sql.ErrNoRows {
+				return c.JSON(http.StatusBadRequest, map[string]string{"error": "Unknown position"})
+			}
+			log.Error().Err(err).Str("position_id", *req.PositionID).Msg("error getting position")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+		}
+	}
+
 	// prepare update values (only set non-nil fields)
 	// FullName and Email are required strings, use existing value if not provided
 	fullName := ""
@@ -574,6 +628,39 @@ func (h *Handler) UpdateMemberByIDHandler(c echo.Context) error {
 	}
 
 	response := toFullInfoMemberResponse(repository.GetMemberInfoRow(updatedMember))
+	h.events.Publish(ctx, webhook.EventMemberUpdated, response)
+	if updatedMember.PositionID != target.PositionID {
+		h.events.Publish(ctx, webhook.EventMemberPositionChanged, webhook.MemberPositionChangedData{
+			MemberID:           updatedMember.ID,
+			PreviousPositionID: nullableID(target.PositionID),
+			PositionID:         nullableID(updatedMember.PositionID),
+		})
+	}
+	// let the member know when someone else edited their profile
+	if fields := req.setFields(); h.notifier != nil && int32(targetID) != actorID && len(fields) > 0 {
+		h.notifier.NotifyMemberUpdated(ctx, int32(targetID), actorID, fields)
+	}
 
 	return c.JSON(http.StatusOK, response)
 }
+
+// membershipTerm resolves the term_id query parameter of membership queries. live is true when the
+// current membership applies, otherwise the membership archived for termID does.
+// If ok is false, the error response was already written.
+func (h *Handler) membershipTerm(c echo.Context) (termID int32, live bool, ok bool, err error) {
+	t, live, err := h.terms.Membership(c.Request().Context(), c.QueryParam("term_id"))
+	switch {
+	case errors.Is(err, term.ErrInvalidTerm):
+		return 0, false, false, c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid term ID"})
+	case errors.Is(err, sql.ErrNoRows):
+		return 0, false, false, c.JSON(http.StatusNotFound, map[string]string{"error": "Term not found"})
+	case errors.Is(err, term.ErrTermNotStarted):
+		return 0, false, false, c.JSON(http.StatusNotFound, map[string]string{"error": "Term has not started"})
+	case errors.Is(err, term.ErrRosterNotArchived):
+		return 0, false, false, c.JSON(http.StatusNotFound, map[string]string{"error": "Term roster not archived"})
+	case err != nil:
+		log.Error().Err(err).Msg("failed to resolve term")
+		return 0, false, false, c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	return t.ID, live, true, nil
+}
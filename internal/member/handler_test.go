@@ -11,6 +11,8 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
 )
 
 // mockDBService is a mock implementation of the database.Service interface.
@@ -90,7 +92,7 @@ func TestGetMemberInfo(t *testing.T) {
 		mock.ExpectQuery("SELECT (.+) FROM members m").WithArgs(reqBody.Email).WillReturnRows(rows)
 
 		dbService := &mockDBService{db: db}
-		h := NewHandler(dbService)
+		h := NewHandler(dbService, nil)
 
 		if assert.NoError(t, h.GetMemberInfo(c)) {
 			assert.Equal(t, http.StatusOK, rec.Code)
@@ -113,7 +115,7 @@ func TestGetMemberInfo(t *testing.T) {
 		mock.ExpectQuery("SELECT (.+) FROM members m").WithArgs(reqBody.Email).WillReturnError(sql.ErrNoRows)
 
 		dbService := &mockDBService{db: db}
-		h := NewHandler(dbService)
+		h := NewHandler(dbService, nil)
 
 		if assert.NoError(t, h.GetMemberInfo(c)) {
 			assert.Equal(t, http.StatusNotFound, rec.Code)
@@ -134,7 +136,7 @@ func TestGetMemberInfo(t *testing.T) {
 		defer db.Close()
 
 		dbService := &mockDBService{db: db}
-		h := NewHandler(dbService)
+		h := NewHandler(dbService, nil)
 
 		if assert.NoError(t, h.GetMemberInfo(c)) {
 			assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -160,7 +162,7 @@ func TestGetMemberInfoByID(t *testing.T) {
 		mock.ExpectQuery("SELECT (.+) FROM members m").WithArgs(int32(reqBody.Id)).WillReturnRows(rows)
 
 		dbService := &mockDBService{db: db}
-		h := NewHandler(dbService)
+		h := NewHandler(dbService, nil)
 
 		if assert.NoError(t, h.GetMemberInfoByID(c)) {
 			assert.Equal(t, http.StatusOK, rec.Code)
@@ -183,7 +185,7 @@ func TestGetMemberInfoByID(t *testing.T) {
 		mock.ExpectQuery("SELECT (.+) FROM members m").WithArgs(int32(reqBody.Id)).WillReturnError(sql.ErrNoRows)
 
 		dbService := &mockDBService{db: db}
-		h := NewHandler(dbService)
+		h := NewHandler(dbService, nil)
 
 		if assert.NoError(t, h.GetMemberInfoByID(c)) {
 			assert.Equal(t, http.StatusNotFound, rec.Code)
@@ -204,7 +206,7 @@ func TestGetMemberInfoByID(t *testing.T) {
 		defer db.Close()
 
 		dbService := &mockDBService{db: db}
-		h := NewHandler(dbService)
+		h := NewHandler(dbService, nil)
 
 		if assert.NoError(t, h.GetMemberInfoByID(c)) {
 			assert.Equal(t, http.StatusBadRequest, rec.Code)
@@ -234,7 +236,7 @@ func TestGetAllMembersHandler(t *testing.T) {
 	mock.ExpectQuery("SELECT (.+) FROM members m").WillReturnRows(rows)
 
 	dbService := &mockDBService{db: db}
-	h := NewHandler(dbService)
+	h := NewHandler(dbService, nil)
 
 	if assert.NoError(t, h.GetAllMembersHandler(c)) {
 		assert.Equal(t, http.StatusOK, rec.Code)
@@ -258,7 +260,7 @@ func TestCheckEmailHandler(t *testing.T) {
 		mock.ExpectQuery("SELECT email FROM members WHERE email = ?").WithArgs(reqBody.Email).WillReturnRows(sqlmock.NewRows([]string{"email"}).AddRow(reqBody.Email))
 
 		dbService := &mockDBService{db: db}
-		h := NewHandler(dbService)
+		h := NewHandler(dbService, nil)
 
 		if assert.NoError(t, h.CheckEmailHandler(c)) {
 			assert.Equal(t, http.StatusOK, rec.Code)
@@ -281,7 +283,7 @@ func TestCheckEmailHandler(t *testing.T) {
 		mock.ExpectQuery("SELECT email FROM members WHERE email = ?").WithArgs(reqBody.Email).WillReturnError(sql.ErrNoRows)
 
 		dbService := &mockDBService{db: db}
-		h := NewHandler(dbService)
+		h := NewHandler(dbService, nil)
 
 		if assert.NoError(t, h.CheckEmailHandler(c)) {
 			assert.Equal(t, http.StatusNotFound, rec.Code)
@@ -306,7 +308,7 @@ func TestCheckIDIfMember(t *testing.T) {
 		mock.ExpectQuery("SELECT id FROM members WHERE id = ?").WithArgs(int32(reqBody.Id)).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(reqBody.Id))
 
 		dbService := &mockDBService{db: db}
-		h := NewHandler(dbService)
+		h := NewHandler(dbService, nil)
 
 		if assert.NoError(t, h.CheckIDIfMember(c)) {
 			assert.Equal(t, http.StatusOK, rec.Code)
@@ -329,10 +331,129 @@ func TestCheckIDIfMember(t *testing.T) {
 		mock.ExpectQuery("SELECT id FROM members WHERE id = ?").WithArgs(int32(reqBody.Id)).WillReturnError(sql.ErrNoRows)
 
 		dbService := &mockDBService{db: db}
-		h := NewHandler(dbService)
+		h := NewHandler(dbService, nil)
 
 		if assert.NoError(t, h.CheckIDIfMember(c)) {
 			assert.Equal(t, http.StatusNotFound, rec.Code)
 		}
 	})
+
+	t.Run("member of a past term", func(t *testing.T) {
+		e := echo.New()
+		reqBody := IdRequest{Id: 123}
+		jsonBody, _ := json.Marshal(reqBody)
+		req := httptest.NewRequest(http.MethodPost, "/check-id?term_id=1", bytes.NewReader(jsonBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		termColumns := []string{"id", "term", "start_year", "end_year", "start_date", "end_date", "is_active"}
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(termColumns).AddRow(1, 3, 2024, 2025, nil, nil, false))
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").
+			WillReturnRows(sqlmock.NewRows(termColumns).AddRow(2, 1, 2025, 2026, nil, nil, true))
+		mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM term_rosters").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectQuery("SELECT member_id FROM term_rosters WHERE term_id = \\? AND member_id = \\?").
+			WithArgs(int32(1), int32(reqBody.Id)).
+			WillReturnRows(sqlmock.NewRows([]string{"member_id"}).AddRow(reqBody.Id))
+
+		dbService := &mockDBService{db: db}
+		h := NewHandler(dbService, nil)
+
+		if assert.NoError(t, h.CheckIDIfMember(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("past term that was never rolled over from", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/check-id?term_id=1", bytes.NewReader([]byte(`{"id":123}`)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		termColumns := []string{"id", "term", "start_year", "end_year", "start_date", "end_date", "is_active"}
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(termColumns).AddRow(1, 3, 2024, 2025, nil, nil, false))
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").
+			WillReturnRows(sqlmock.NewRows(termColumns).AddRow(2, 1, 2025, 2026, nil, nil, true))
+		mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM term_rosters").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+		h := NewHandler(&mockDBService{db: db}, nil)
+
+		// no archived roster to check against, rather than "not a member"
+		if assert.NoError(t, h.CheckIDIfMember(c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+			assert.Contains(t, rec.Body.String(), "Term roster not archived")
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	for _, termID := range []string{"last", "all"} {
+		t.Run("invalid term "+termID, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/check-id?term_id="+termID, bytes.NewReader([]byte(`{"id":123}`)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			h := NewHandler(&mockDBService{}, nil)
+
+			if assert.NoError(t, h.CheckIDIfMember(c)) {
+				assert.Equal(t, http.StatusBadRequest, rec.Code)
+			}
+		})
+	}
+}
+
+func TestUpdateMemberByIDHandlerUnknownPosition(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPut, "/members/123", bytes.NewReader([]byte(`{"position_id":"COS"}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("123")
+	auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM members m").
+		WithArgs(int32(123)).
+		WillReturnRows(createMemberInfoByIdRow(123, "test@dlsu.edu.ph", "Test User"))
+	mock.ExpectQuery("SELECT (.+) FROM positions WHERE position_id = ?").
+		WithArgs("COS").
+		WillReturnError(sql.ErrNoRows)
+
+	h := NewHandler(&mockDBService{db: db}, nil)
+
+	if assert.NoError(t, h.UpdateMemberByIDHandler(c)) {
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Unknown position")
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateMemberRequestSetFields(t *testing.T) {
+	name, houseID := "Juan Dela Cruz", 2
+	req := UpdateMemberRequest{HouseID: &houseID, FullName: &name}
+	assert.Equal(t, []string{"full_name", "house_id"}, req.setFields())
+	assert.Empty(t, (&UpdateMemberRequest{}).setFields())
 }
@@ -1,16 +1,32 @@
 package member
 
 import (
+	"context"
+
 	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/term"
+	"github.com/dlsu-lscs/lscs-core-api/internal/webhook"
 )
 
+// UpdateNotifier is told when a member's profile is edited by someone else.
+// fields are the JSON names of the fields that were set.
+type UpdateNotifier interface {
+	NotifyMemberUpdated(ctx context.Context, memberID, editorID int32, fields []string)
+}
+
 type Handler struct {
 	dbService database.Service
+	events    *webhook.Publisher
+	notifier  UpdateNotifier // may be nil
+	terms     *term.Resolver
 }
 
-func NewHandler(dbService database.Service) *Handler {
+func NewHandler(dbService database.Service, notifier UpdateNotifier) *Handler {
 	return &Handler{
 		dbService: dbService,
+		events:    webhook.NewPublisher(dbService),
+		notifier:  notifier,
+		terms:     term.NewResolver(dbService),
 	}
 
 }
@@ -0,0 +1,98 @@
+package middlewares
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// APIKeyAuthenticator authenticates requests carrying an API key JWT as a Bearer token.
+// The key must still exist in api_keys, so revoked keys are rejected.
+type APIKeyAuthenticator struct {
+	jwtSecret []byte
+	dbService database.Service
+}
+
+// NewAPIKeyAuthenticator creates a new API key authenticator
+func NewAPIKeyAuthenticator(cfg *config.Config, dbService database.Service) *APIKeyAuthenticator {
+	return &APIKeyAuthenticator{
+		jwtSecret: []byte(cfg.JWTSecret),
+		dbService: dbService,
+	}
+}
+
+// Authenticate validates the API key and returns the key owner's principal
+func (a *APIKeyAuthenticator) Authenticate(c echo.Context) (*auth.Principal, error) {
+	tokenString, ok := bearerToken(c)
+	if !ok {
+		return nil, auth.ErrNoCredentials
+	}
+	return a.AuthenticateToken(c.Request().Context(), tokenString)
+}
+
+// AuthenticateToken validates an API key taken from anywhere (e.g. gRPC metadata)
+// and returns the key owner's principal
+func (a *APIKeyAuthenticator) AuthenticateToken(ctx context.Context, tokenString string) (*auth.Principal, error) {
+	claims := new(auth.JwtCustomClaims)
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		return a.jwtSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		// tokens not signed by us (e.g. Google ID tokens) are left to other authenticators
+		if errors.Is(err, jwt.ErrTokenSignatureInvalid) || errors.Is(err, jwt.ErrTokenUnverifiable) || errors.Is(err, jwt.ErrTokenMalformed) {
+			return nil, auth.ErrNoCredentials
+		}
+		log.Debug().Err(err).Msg("invalid API key")
+		return nil, errors.New("Invalid or expired API key")
+	}
+
+	// client credentials tokens share the signing key but carry no email
+	if claims.Email == "" {
+		return nil, auth.ErrNoCredentials
+	}
+
+	q := repository.New(a.dbService.GetConnection())
+
+	hash := sha256.Sum256([]byte(tokenString))
+	key, err := q.GetAPIKeyInfo(ctx, hex.EncodeToString(hash[:]))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.Warn().Str("email", claims.Email).Msg("API key not found or revoked")
+			return nil, errors.New("API key has been revoked")
+		}
+		log.Error().Err(err).Msg("failed to look up API key")
+		return nil, errors.New("Unauthorized")
+	}
+
+	member, err := q.GetMemberAuthInfo(ctx, key.MemberEmail)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			log.Error().Err(err).Str("email", key.MemberEmail).Msg("failed to get API key owner")
+		}
+		return nil, errors.New("Unauthorized")
+	}
+
+	scopes := append([]string{}, auth.DefaultAPIKeyScopes...)
+	if key.IsAdmin {
+		scopes = append(scopes, auth.ScopeAdmin)
+	}
+
+	return &auth.Principal{
+		MemberID: member.ID,
+		Email:    key.MemberEmail,
+		Method:   auth.AuthMethodAPIKey,
+		KeyID:    key.ApiKeyID,
+		Scopes:   scopes,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package middlewares
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+)
+
+// Authenticator resolves the principal behind a request for one auth method.
+// It returns auth.ErrNoCredentials when the request does not carry credentials
+// for that method; any other error rejects the request.
+type Authenticator interface {
+	Authenticate(c echo.Context) (*auth.Principal, error)
+}
+
+// Authenticate tries each authenticator in order and stores the first resolved
+// principal in the request context. Requests that match none are rejected.
+func Authenticate(authenticators ...Authenticator) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, err := resolvePrincipal(c, authenticators)
+			if err != nil {
+				if errors.Is(err, auth.ErrNoCredentials) {
+					return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+				}
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": err.Error()})
+			}
+
+			auth.SetPrincipal(c, principal)
+			return next(c)
+		}
+	}
+}
+
+// OptionalAuthenticate behaves like Authenticate but lets the request proceed
+// without a principal when no authenticator succeeds.
+func OptionalAuthenticate(authenticators ...Authenticator) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if principal, err := resolvePrincipal(c, authenticators); err == nil {
+				auth.SetPrincipal(c, principal)
+			}
+			return next(c)
+		}
+	}
+}
+
+func resolvePrincipal(c echo.Context, authenticators []Authenticator) (*auth.Principal, error) {
+	for _, a := range authenticators {
+		principal, err := a.Authenticate(c)
+		if errors.Is(err, auth.ErrNoCredentials) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return principal, nil
+	}
+	return nil, auth.ErrNoCredentials
+}
+
+// bearerToken extracts the token from an "Authorization: Bearer <token>" header
+func bearerToken(c echo.Context) (string, bool) {
+	authHeader := c.Request().Header.Get(echo.HeaderAuthorization)
+	const prefix = "Bearer "
+	if len(authHeader) <= len(prefix) || authHeader[:len(prefix)] != prefix {
+		return "", false
+	}
+	return authHeader[len(prefix):], true
+}
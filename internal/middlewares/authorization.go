@@ -15,11 +15,12 @@ import (
 func RequireAdmin(rbacService *auth.RBACService) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			memberID, ok := c.Get("user_id").(int32)
+			principal, ok := auth.GetPrincipal(c)
 			if !ok {
-				log.Error().Msg("RequireAdmin: user_id not found in context")
+				log.Error().Msg("RequireAdmin: principal not found in context")
 				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
 			}
+			memberID := principal.MemberID
 
 			if !rbacService.IsAdmin(c.Request().Context(), memberID) {
 				log.Warn().Int32("member_id", memberID).Msg("admin access denied")
@@ -35,11 +36,12 @@ func RequireAdmin(rbacService *auth.RBACService) echo.MiddlewareFunc {
 func RequireRole(rbacService *auth.RBACService, roleID string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			memberID, ok := c.Get("user_id").(int32)
+			principal, ok := auth.GetPrincipal(c)
 			if !ok {
-				log.Error().Msg("RequireRole: user_id not found in context")
+				log.Error().Msg("RequireRole: principal not found in context")
 				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
 			}
+			memberID := principal.MemberID
 
 			if !rbacService.HasRole(c.Request().Context(), memberID, roleID) {
 				log.Warn().Int32("member_id", memberID).Str("role", roleID).Msg("role access denied")
@@ -55,11 +57,12 @@ func RequireRole(rbacService *auth.RBACService, roleID string) echo.MiddlewareFu
 func RequirePosition(dbService database.Service, minPosition string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			memberID, ok := c.Get("user_id").(int32)
+			principal, ok := auth.GetPrincipal(c)
 			if !ok {
-				log.Error().Msg("RequirePosition: user_id not found in context")
+				log.Error().Msg("RequirePosition: principal not found in context")
 				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
 			}
+			memberID := principal.MemberID
 
 			// get member position from db
 			member, err := getMemberByID(c, dbService, memberID)
@@ -85,11 +88,12 @@ func RequirePosition(dbService database.Service, minPosition string) echo.Middle
 func RequireAPIKeyAccess(rbacService *auth.RBACService) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			memberID, ok := c.Get("user_id").(int32)
+			principal, ok := auth.GetPrincipal(c)
 			if !ok {
-				log.Error().Msg("RequireAPIKeyAccess: user_id not found in context")
+				log.Error().Msg("RequireAPIKeyAccess: principal not found in context")
 				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
 			}
+			memberID := principal.MemberID
 
 			if !rbacService.CanAccessAPIKeyManagement(c.Request().Context(), memberID) {
 				log.Warn().Int32("member_id", memberID).Msg("API key access denied")
@@ -103,17 +107,21 @@ func RequireAPIKeyAccess(rbacService *auth.RBACService) echo.MiddlewareFunc {
 
 // RequireAPIAccess middleware enforces JWT API route access.
 // Requires: RND committee member (any position) OR AVP+ position (any committee).
-// Uses email from context (set by JWTEmailMiddleware) and RBACService for authorization.
+// Uses the principal's email and RBACService for authorization.
+// OAuth2 clients are not members; their access is limited by scopes only (see RequireScope).
 func RequireAPIAccess(rbacService *auth.RBACService) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			email, ok := c.Get("user_email").(string)
-			if !ok || email == "" {
-				log.Error().Msg("RequireAPIAccess: user_email not found in context")
+			principal, ok := auth.GetPrincipal(c)
+			if ok && principal.IsClient() {
+				return next(c)
+			}
+			if !ok || principal.Email == "" {
+				log.Error().Msg("RequireAPIAccess: principal not found in context")
 				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
 			}
 
-			if !rbacService.CanAccessAPIByEmail(c.Request().Context(), email) {
+			if !rbacService.CanAccessAPIByEmail(c.Request().Context(), principal.Email) {
 				return c.JSON(http.StatusForbidden, map[string]string{"error": "Insufficient privileges"})
 			}
 
@@ -127,11 +135,12 @@ func RequireAPIAccess(rbacService *auth.RBACService) echo.MiddlewareFunc {
 func RequireCanEditMember(rbacService *auth.RBACService) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			actorID, ok := c.Get("user_id").(int32)
+			principal, ok := auth.GetPrincipal(c)
 			if !ok {
-				log.Error().Msg("RequireCanEditMember: user_id not found in context")
+				log.Error().Msg("RequireCanEditMember: principal not found in context")
 				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
 			}
+			actorID := principal.MemberID
 
 			targetIDStr := c.Param("id")
 			targetID, err := strconv.ParseInt(targetIDStr, 10, 32)
@@ -160,11 +169,12 @@ func RequireCanEditMember(rbacService *auth.RBACService) echo.MiddlewareFunc {
 func RequireAdminOrSelf(rbacService *auth.RBACService) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			actorID, ok := c.Get("user_id").(int32)
+			principal, ok := auth.GetPrincipal(c)
 			if !ok {
-				log.Error().Msg("RequireAdminOrSelf: user_id not found in context")
+				log.Error().Msg("RequireAdminOrSelf: principal not found in context")
 				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
 			}
+			actorID := principal.MemberID
 
 			targetIDStr := c.Param("id")
 			targetID, err := strconv.ParseInt(targetIDStr, 10, 32)
@@ -193,6 +203,31 @@ func RequireAdminOrSelf(rbacService *auth.RBACService) echo.MiddlewareFunc {
 	}
 }
 
+// RequireScope middleware ensures the principal was granted a scope.
+// Only API key principals carry scopes; members acting directly are checked by RBAC instead.
+func RequireScope(scope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, ok := auth.GetPrincipal(c)
+			if !ok {
+				log.Error().Msg("RequireScope: principal not found in context")
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+			}
+
+			if !principal.HasScope(scope) {
+				log.Warn().
+					Int32("member_id", principal.MemberID).
+					Str("method", string(principal.Method)).
+					Str("scope", scope).
+					Msg("scope access denied")
+				return c.JSON(http.StatusForbidden, map[string]string{"error": "Insufficient scope"})
+			}
+
+			return next(c)
+		}
+	}
+}
+
 // helper to get member by ID using repository
 func getMemberByID(c echo.Context, dbService database.Service, memberID int32) (*memberInfo, error) {
 	ctx := c.Request().Context()
@@ -237,3 +272,24 @@ func (ns *nullableString) Scan(value interface{}) error {
 	}
 	return nil
 }
+
+// RequireStepUp middleware ensures the member logged in with Google recently
+// (see STEP_UP_MAX_AGE) before performing a sensitive action.
+// It should be used AFTER Authenticate so the principal is available in context.
+func RequireStepUp(action string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, ok := auth.GetPrincipal(c)
+			if !ok {
+				log.Error().Msg("RequireStepUp: principal not found in context")
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+			}
+
+			if !principal.RecentlyAuthenticated {
+				return auth.StepUpRequired(c, principal, action)
+			}
+
+			return next(c)
+		}
+	}
+}
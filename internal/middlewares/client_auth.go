@@ -0,0 +1,76 @@
+package middlewares
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// ClientCredentialsAuthenticator authenticates requests carrying an access token
+// issued by the OAuth2 token endpoint as a Bearer token.
+// The client must still be registered, so deleted clients are rejected.
+type ClientCredentialsAuthenticator struct {
+	jwtSecret []byte
+	dbService database.Service
+}
+
+// NewClientCredentialsAuthenticator creates a new client credentials authenticator
+func NewClientCredentialsAuthenticator(cfg *config.Config, dbService database.Service) *ClientCredentialsAuthenticator {
+	return &ClientCredentialsAuthenticator{
+		jwtSecret: []byte(cfg.JWTSecret),
+		dbService: dbService,
+	}
+}
+
+// Authenticate validates the access token and returns the client's principal
+func (a *ClientCredentialsAuthenticator) Authenticate(c echo.Context) (*auth.Principal, error) {
+	tokenString, ok := bearerToken(c)
+	if !ok {
+		return nil, auth.ErrNoCredentials
+	}
+
+	claims := new(auth.ClientClaims)
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		return a.jwtSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenSignatureInvalid) || errors.Is(err, jwt.ErrTokenUnverifiable) || errors.Is(err, jwt.ErrTokenMalformed) {
+			return nil, auth.ErrNoCredentials
+		}
+		// API keys are also signed by us; only claim tokens that name a client
+		if claims.ClientID == "" {
+			return nil, auth.ErrNoCredentials
+		}
+		log.Debug().Err(err).Str("client_id", claims.ClientID).Msg("invalid client access token")
+		return nil, errors.New("Invalid or expired access token")
+	}
+
+	// API key JWTs carry no client ID
+	if claims.ClientID == "" {
+		return nil, auth.ErrNoCredentials
+	}
+
+	q := repository.New(a.dbService.GetConnection())
+	if _, err := q.GetOAuthClient(c.Request().Context(), claims.ClientID); err != nil {
+		if err == sql.ErrNoRows {
+			log.Warn().Str("client_id", claims.ClientID).Msg("OAuth client not found or deleted")
+			return nil, errors.New("Client has been revoked")
+		}
+		log.Error().Err(err).Str("client_id", claims.ClientID).Msg("failed to look up OAuth client")
+		return nil, errors.New("Unauthorized")
+	}
+
+	return &auth.Principal{
+		Method:   auth.AuthMethodClient,
+		ClientID: claims.ClientID,
+		Scopes:   auth.ParseScopes(claims.Scope),
+	}, nil
+}
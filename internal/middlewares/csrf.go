@@ -0,0 +1,48 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+)
+
+// CSRFMiddleware validates the X-CSRF-Token header on mutating session requests.
+// It should be used AFTER Authenticate so the principal is available in context.
+// Safe methods (GET, HEAD, OPTIONS) and requests authenticated with a Bearer token
+// (API keys, Google ID tokens) are exempt, since those are not sent automatically by the browser.
+func CSRFMiddleware(cfg *config.Config) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			switch c.Request().Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				return next(c)
+			}
+
+			principal, ok := auth.GetPrincipal(c)
+			if !ok {
+				log.Error().Msg("CSRFMiddleware: principal not found in context")
+				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+			}
+
+			// bearer-authenticated calls do not rely on cookies
+			if principal.Method != auth.AuthMethodSession {
+				return next(c)
+			}
+
+			token := c.Request().Header.Get(auth.CSRFHeaderName)
+			if !auth.ValidateCSRFToken(cfg, principal.SessionID, token) {
+				log.Warn().
+					Str("path", c.Request().URL.Path).
+					Str("method", c.Request().Method).
+					Msg("CSRF token missing or invalid")
+				return c.JSON(http.StatusForbidden, map[string]string{"error": "Invalid or missing CSRF token"})
+			}
+
+			return next(c)
+		}
+	}
+}
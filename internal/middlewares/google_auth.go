@@ -1,51 +1,79 @@
 package middlewares
 
 import (
-	"context"
-	"net/http"
-	"strings"
+	"database/sql"
+	"errors"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/api/idtoken"
 
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
 	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
 )
 
-// GoogleAuthMiddleware validates Google ID tokens and extracts user email
-func GoogleAuthMiddleware(cfg *config.Config) echo.MiddlewareFunc {
-	return func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(c echo.Context) error {
-			authHeader := c.Request().Header.Get("Authorization")
-			if authHeader == "" {
-				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Authorization header is required"})
-			}
-
-			parts := strings.Split(authHeader, " ")
-			if len(parts) != 2 || parts[0] != "Bearer" {
-				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Invalid Authorization header format"})
-			}
-
-			tokenString := parts[1]
-			audience := cfg.GoogleClientID
-			if audience == "" {
-				log.Error().Msg("GOOGLE_CLIENT_ID not configured")
-				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
-			}
-
-			payload, err := idtoken.Validate(context.Background(), tokenString, audience)
-			if err != nil {
-				log.Error().Err(err).Msg("failed to validate google token")
-				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Invalid ID token"})
-			}
-
-			email, ok := payload.Claims["email"].(string)
-			if !ok || email == "" {
-				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Email not found in token"})
-			}
-
-			c.Set("user_email", email)
-			return next(c)
+// GoogleAuthenticator authenticates requests carrying a Google ID token as a Bearer token.
+// The token's email must belong to an LSCS member.
+type GoogleAuthenticator struct {
+	cfg       *config.Config
+	dbService database.Service
+}
+
+// NewGoogleAuthenticator creates a new Google ID token authenticator
+func NewGoogleAuthenticator(cfg *config.Config, dbService database.Service) *GoogleAuthenticator {
+	return &GoogleAuthenticator{cfg: cfg, dbService: dbService}
+}
+
+// Authenticate validates the Google ID token and returns the member's principal
+func (a *GoogleAuthenticator) Authenticate(c echo.Context) (*auth.Principal, error) {
+	tokenString, ok := bearerToken(c)
+	if !ok {
+		return nil, auth.ErrNoCredentials
+	}
+
+	audience := a.cfg.GoogleClientID
+	if audience == "" {
+		log.Error().Msg("GOOGLE_CLIENT_ID not configured")
+		return nil, auth.ErrNoCredentials
+	}
+
+	ctx := c.Request().Context()
+	payload, err := idtoken.Validate(ctx, tokenString, audience)
+	if err != nil {
+		log.Debug().Err(err).Msg("failed to validate google token")
+		return nil, errors.New("Invalid ID token")
+	}
+
+	email, ok := payload.Claims["email"].(string)
+	if !ok || email == "" {
+		return nil, errors.New("Email not found in token")
+	}
+
+	q := repository.New(a.dbService.GetConnection())
+	member, err := q.GetMemberAuthInfo(ctx, email)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			log.Warn().Str("email", email).Msg("non-member presented google ID token")
+			return nil, errors.New("Not an LSCS member")
 		}
+		log.Error().Err(err).Str("email", email).Msg("failed to get member auth info")
+		return nil, errors.New("Unauthorized")
 	}
+
+	// auth_time is only present when the token was minted by an explicit login
+	var authTime time.Time
+	if v, ok := payload.Claims["auth_time"].(float64); ok {
+		authTime = time.Unix(int64(v), 0)
+	}
+
+	return &auth.Principal{
+		MemberID:              member.ID,
+		Email:                 email,
+		Method:                auth.AuthMethodGoogle,
+		AuthTime:              authTime,
+		RecentlyAuthenticated: auth.IsRecentAuth(authTime, a.cfg.StepUpMaxAge),
+	}, nil
 }
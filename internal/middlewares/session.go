@@ -1,7 +1,7 @@
 package middlewares
 
 import (
-	"net/http"
+	"errors"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -13,82 +13,74 @@ import (
 
 const sessionCookieName = "session_id"
 
-// SessionMiddleware validates session cookies and populates request context with user info.
+// SessionAuthenticator authenticates requests using the web UI session cookie.
 // It also implements sliding expiration for active sessions.
-func SessionMiddleware(sessionService auth.SessionService, cfg *config.Config) echo.MiddlewareFunc {
-	return func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(c echo.Context) error {
-			cookie, err := c.Cookie(sessionCookieName)
-			if err != nil || cookie.Value == "" {
-				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
-			}
-
-			sessionID := cookie.Value
-			session, err := sessionService.GetSession(c.Request().Context(), sessionID)
-			if err != nil {
-				log.Debug().Err(err).Str("session_id", sessionID[:8]+"...").Msg("invalid session")
-				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Session expired or invalid"})
-			}
+type SessionAuthenticator struct {
+	sessionService auth.SessionService
+	cfg            *config.Config
+}
 
-			// set user info in context
-			c.Set("user_id", session.MemberID)
-			c.Set("user_email", session.Email)
-			c.Set("session_id", session.ID)
+// NewSessionAuthenticator creates a new session cookie authenticator
+func NewSessionAuthenticator(sessionService auth.SessionService, cfg *config.Config) *SessionAuthenticator {
+	return &SessionAuthenticator{sessionService: sessionService, cfg: cfg}
+}
 
-			// implement sliding expiration
-			duration := time.Duration(cfg.SessionDuration) * time.Second
-			if sessionService.ShouldExtendSession(session, duration) {
-				if err := sessionService.ExtendSession(c.Request().Context(), sessionID, duration); err != nil {
-					log.Warn().Err(err).Str("session_id", sessionID[:8]+"...").Msg("failed to extend session")
-				} else {
-					log.Debug().Str("session_id", sessionID[:8]+"...").Msg("session extended")
-				}
-			}
+// Authenticate validates the session cookie and returns the session's principal
+func (a *SessionAuthenticator) Authenticate(c echo.Context) (*auth.Principal, error) {
+	cookie, err := c.Cookie(sessionCookieName)
+	if err != nil || cookie.Value == "" {
+		return nil, auth.ErrNoCredentials
+	}
 
-			// update last activity (fire and forget)
-			go func() {
-				if err := sessionService.UpdateActivity(c.Request().Context(), sessionID); err != nil {
-					log.Debug().Err(err).Msg("failed to update session activity")
-				}
-			}()
+	sessionID := cookie.Value
+	session, err := a.sessionService.GetSession(c.Request().Context(), sessionID)
+	if err != nil {
+		log.Debug().Err(err).Str("session_id", truncateID(sessionID)).Msg("invalid session")
+		return nil, errors.New("Session expired or invalid")
+	}
 
-			return next(c)
+	// implement sliding expiration
+	duration := time.Duration(a.cfg.SessionDuration) * time.Second
+	if a.sessionService.ShouldExtendSession(session, duration) {
+		if err := a.sessionService.ExtendSession(c.Request().Context(), sessionID, duration); err != nil {
+			log.Warn().Err(err).Str("session_id", truncateID(sessionID)).Msg("failed to extend session")
+		} else {
+			log.Debug().Str("session_id", truncateID(sessionID)).Msg("session extended")
 		}
 	}
+
+	// update last activity (fire and forget)
+	go func() {
+		if err := a.sessionService.UpdateActivity(c.Request().Context(), sessionID); err != nil {
+			log.Debug().Err(err).Msg("failed to update session activity")
+		}
+	}()
+
+	return &auth.Principal{
+		MemberID:              session.MemberID,
+		Email:                 session.Email,
+		Method:                auth.AuthMethodSession,
+		SessionID:             session.ID,
+		AuthTime:              session.AuthTime,
+		RecentlyAuthenticated: auth.IsRecentAuth(session.AuthTime, a.cfg.StepUpMaxAge),
+	}, nil
+}
+
+// SessionMiddleware validates session cookies and populates request context with the principal.
+func SessionMiddleware(sessionService auth.SessionService, cfg *config.Config) echo.MiddlewareFunc {
+	return Authenticate(NewSessionAuthenticator(sessionService, cfg))
 }
 
 // OptionalSessionMiddleware attempts to validate session but allows request to proceed even if no session exists.
 // Useful for endpoints that have different behavior for authenticated vs unauthenticated users.
 func OptionalSessionMiddleware(sessionService auth.SessionService, cfg *config.Config) echo.MiddlewareFunc {
-	return func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(c echo.Context) error {
-			cookie, err := c.Cookie(sessionCookieName)
-			if err != nil || cookie.Value == "" {
-				// no session, but allow request to proceed
-				return next(c)
-			}
-
-			sessionID := cookie.Value
-			session, err := sessionService.GetSession(c.Request().Context(), sessionID)
-			if err != nil {
-				// invalid session, but allow request to proceed
-				return next(c)
-			}
-
-			// set user info in context
-			c.Set("user_id", session.MemberID)
-			c.Set("user_email", session.Email)
-			c.Set("session_id", session.ID)
-
-			// implement sliding expiration
-			duration := time.Duration(cfg.SessionDuration) * time.Second
-			if sessionService.ShouldExtendSession(session, duration) {
-				if err := sessionService.ExtendSession(c.Request().Context(), sessionID, duration); err != nil {
-					log.Warn().Err(err).Msg("failed to extend session")
-				}
-			}
+	return OptionalAuthenticate(NewSessionAuthenticator(sessionService, cfg))
+}
 
-			return next(c)
-		}
+// truncateID shortens a secret identifier for logging
+func truncateID(id string) string {
+	if len(id) <= 8 {
+		return id
 	}
+	return id[:8] + "..."
 }
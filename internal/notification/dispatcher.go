@@ -0,0 +1,170 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// Email statuses (email_outbox.status)
+const (
+	StatusPending = "PENDING"
+	StatusSent    = "SENT"
+	StatusFailed  = "FAILED"
+)
+
+const (
+	// batchSize is the maximum number of emails sent per run
+	batchSize = 50
+	// baseRetryDelay is the delay before the first retry; each retry doubles it
+	baseRetryDelay = time.Minute
+	// maxRetryDelay caps the delay between retries
+	maxRetryDelay = 6 * time.Hour
+	// sendTimeout bounds how long an email may stay claimed by one instance
+	sendTimeout = 2 * time.Minute
+	// maxErrorLength is how much of an error is stored
+	maxErrorLength = 1000
+)
+
+// Dispatcher sends queued emails and retries failed ones with exponential backoff.
+// Emails are claimed before they are sent, so several instances can run it.
+type Dispatcher struct {
+	dbService   database.Service
+	sender      Sender
+	maxAttempts int
+}
+
+func NewDispatcher(dbService database.Service, sender Sender, maxAttempts int) *Dispatcher {
+	return &Dispatcher{dbService: dbService, sender: sender, maxAttempts: max(maxAttempts, 1)}
+}
+
+// StartDispatcher starts a background goroutine that sends due emails every interval
+func StartDispatcher(ctx context.Context, dbService database.Service, cfg *config.Config) {
+	d := NewDispatcher(dbService, NewSenderFromConfig(cfg), cfg.EmailMaxAttempts)
+	go func() {
+		ticker := time.NewTicker(cfg.EmailDispatchInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("email dispatcher stopped")
+				return
+			case <-ticker.C:
+				if _, err := d.Run(ctx, time.Now()); err != nil {
+					log.Error().Err(err).Msg("email dispatch failed")
+				}
+			}
+		}
+	}()
+}
+
+// Run sends the emails that are due and returns how many were attempted
+func (d *Dispatcher) Run(ctx context.Context, now time.Time) (int, error) {
+	q := repository.New(d.dbService.GetConnection())
+
+	due, err := q.ListDueOutboxEmails(ctx, repository.ListDueOutboxEmailsParams{
+		NextAttemptAt: now,
+		LockedUntil:   sql.NullTime{Time: now, Valid: true},
+		Limit:         batchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("list due emails: %w", err)
+	}
+
+	attempted := 0
+	var errs []error
+	for _, email := range due {
+		claimed, err := q.ClaimOutboxEmail(ctx, repository.ClaimOutboxEmailParams{
+			LockedUntil:   sql.NullTime{Time: now.Add(sendTimeout), Valid: true},
+			ID:            email.ID,
+			LockedUntil_2: sql.NullTime{Time: now, Valid: true},
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if claimed == 0 {
+			continue // another instance is sending it
+		}
+
+		attempted++
+		if err := d.deliver(ctx, q, email, now); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return attempted, errors.Join(errs...)
+}
+
+// deliver sends an email once and records the result
+func (d *Dispatcher) deliver(ctx context.Context, q *repository.Queries, email repository.ListDueOutboxEmailsRow, now time.Time) error {
+	sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+	sendErr := d.sender.Send(sendCtx, Message{
+		To:      email.Recipient,
+		Subject: email.Subject,
+		Text:    email.TextBody,
+		HTML:    email.HtmlBody,
+	})
+
+	attempt := email.Attempts + 1
+	result := repository.FinishOutboxEmailAttemptParams{
+		Status:        StatusSent,
+		Attempts:      attempt,
+		NextAttemptAt: now,
+		SentAt:        sql.NullTime{Time: now, Valid: true},
+		ID:            email.ID,
+	}
+	if sendErr != nil {
+		message := sendErr.Error()
+		if len(message) > maxErrorLength {
+			message = message[:maxErrorLength]
+		}
+		result.LastError = sql.NullString{String: message, Valid: true}
+		result.SentAt = sql.NullTime{}
+
+		if int(attempt) >= d.maxAttempts {
+			result.Status = StatusFailed
+		} else {
+			result.Status = StatusPending
+			result.NextAttemptAt = now.Add(RetryDelay(int(attempt)))
+		}
+	}
+
+	if err := q.FinishOutboxEmailAttempt(ctx, result); err != nil {
+		return fmt.Errorf("record email %d: %w", email.ID, err)
+	}
+
+	entry := log.Info()
+	if sendErr != nil {
+		entry = log.Warn().Err(sendErr)
+	}
+	entry.
+		Int32("email_id", email.ID).
+		Str("type", email.Type).
+		Int32("attempt", attempt).
+		Str("status", result.Status).
+		Msg("email send attempted")
+	return nil
+}
+
+// RetryDelay returns the delay after a failed attempt: 1 minute after the first, doubling up to 6 hours
+func RetryDelay(attempt int) time.Duration {
+	delay := baseRetryDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= maxRetryDelay {
+			return maxRetryDelay
+		}
+	}
+	return delay
+}
@@ -0,0 +1,160 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSender records the emails it is asked to send
+type fakeSender struct {
+	sent []Message
+	err  error
+}
+
+func (s *fakeSender) Send(ctx context.Context, msg Message) error {
+	s.sent = append(s.sent, msg)
+	return s.err
+}
+
+var dueColumns = []string{"id", "recipient", "type", "subject", "text_body", "html_body", "attempts"}
+
+// expectEmail expects a due email to be claimed and its result to be recorded
+func expectEmail(mock sqlmock.Sqlmock, attempts int32, status string, nextAttemptAt any) {
+	mock.ExpectQuery("SELECT (.+) FROM email_outbox").
+		WillReturnRows(sqlmock.NewRows(dueColumns).
+			AddRow(7, "juan_delacruz@dlsu.edu.ph", TypeRoleGranted, "You were granted the Administrator role", "Hi Juan,", "<p>Hi Juan,</p>", attempts))
+	mock.ExpectExec("UPDATE email_outbox SET locked_until").
+		WithArgs(sqlmock.AnyArg(), int32(7), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE email_outbox SET status").
+		WithArgs(status, attempts+1, nextAttemptAt, sqlmock.AnyArg(), sqlmock.AnyArg(), int32(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func TestDispatcherRun(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectEmail(mock, 0, StatusSent, testNow)
+
+		sender := &fakeSender{}
+		attempted, err := NewDispatcher(&mockDBService{db: db}, sender, 3).Run(t.Context(), testNow)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, attempted)
+		if assert.Len(t, sender.sent, 1) {
+			assert.Equal(t, "juan_delacruz@dlsu.edu.ph", sender.sent[0].To)
+			assert.Equal(t, "<p>Hi Juan,</p>", sender.sent[0].HTML)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("failure - retried with backoff", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectEmail(mock, 1, StatusPending, testNow.Add(2*time.Minute))
+
+		sender := &fakeSender{err: errors.New("421 try again later")}
+		_, err = NewDispatcher(&mockDBService{db: db}, sender, 3).Run(t.Context(), testNow)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("failure - gives up after the last attempt", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectEmail(mock, 2, StatusFailed, testNow)
+
+		sender := &fakeSender{err: errors.New("550 no such user")}
+		_, err = NewDispatcher(&mockDBService{db: db}, sender, 3).Run(t.Context(), testNow)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("skipped - claimed by another instance", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM email_outbox").
+			WillReturnRows(sqlmock.NewRows(dueColumns).AddRow(7, "juan_delacruz@dlsu.edu.ph", TypeRoleGranted, "s", "t", "h", 0))
+		mock.ExpectExec("UPDATE email_outbox SET locked_until").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		sender := &fakeSender{}
+		attempted, err := NewDispatcher(&mockDBService{db: db}, sender, 3).Run(t.Context(), testNow)
+		assert.NoError(t, err)
+		assert.Zero(t, attempted)
+		assert.Empty(t, sender.sent)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, time.Minute, RetryDelay(1))
+	assert.Equal(t, 4*time.Minute, RetryDelay(3))
+	assert.Equal(t, 6*time.Hour, RetryDelay(20))
+}
+
+func TestFileSender(t *testing.T) {
+	dir := t.TempDir()
+	sender := &FileSender{dir: dir, from: "LSCS Core <no-reply@dlsu-lscs.org>"}
+
+	err := sender.Send(t.Context(), Message{
+		To:      "juan_delacruz@dlsu.edu.ph",
+		Subject: "Overdue: pre-acts of Café Night",
+		Text:    "Hi Juan,\n",
+		HTML:    "<p>Hi Juan,</p>",
+	})
+	assert.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*juan_delacruz@dlsu.edu.ph.eml"))
+	assert.NoError(t, err)
+	if !assert.Len(t, files, 1) {
+		return
+	}
+	f, err := os.Open(files[0])
+	assert.NoError(t, err)
+	defer f.Close()
+
+	msg, err := mail.ReadMessage(f)
+	assert.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Overdue: pre-acts of Café Night", subject)
+	assert.Equal(t, "juan_delacruz@dlsu.edu.ph", msg.Header.Get("To"))
+	assert.Contains(t, msg.Header.Get("Message-ID"), "@dlsu-lscs.org>")
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	var bodies []string
+	parts := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := parts.NextPart()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		body, _ := io.ReadAll(part)
+		bodies = append(bodies, part.Header.Get("Content-Type")+": "+string(body))
+	}
+	assert.Equal(t, []string{"text/plain; charset=utf-8: Hi Juan,\r\n", "text/html; charset=utf-8: <p>Hi Juan,</p>"}, bodies)
+}
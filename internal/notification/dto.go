@@ -0,0 +1,74 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// PreferenceResponse is a member's preference for a notification type
+type PreferenceResponse struct {
+	TypeInfo
+	Email bool `json:"email" example:"true"`
+}
+
+// PreferencesResponse is the response for the GET /notifications/preferences endpoint
+type PreferencesResponse struct {
+	Preferences []PreferenceResponse `json:"preferences"`
+}
+
+// PreferenceRequest sets whether a notification type is emailed
+type PreferenceRequest struct {
+	Type  string `json:"type" validate:"required" example:"role_granted"`
+	Email *bool  `json:"email" validate:"required" example:"false"`
+}
+
+// UpdatePreferencesRequest represents the request body for updating notification preferences.
+// Types that are left out keep their current preference.
+type UpdatePreferencesRequest struct {
+	Preferences []PreferenceRequest `json:"preferences" validate:"required,min=1,dive"`
+}
+
+// NotificationResponse is an in-app notification
+type NotificationResponse struct {
+	ID        int32                  `json:"id" example:"1"`
+	Type      string                 `json:"type" example:"role_granted"`
+	Title     string                 `json:"title" example:"You were granted the Administrator role"`
+	Body      string                 `json:"body" example:"Full access to the admin endpoints"`
+	Link      helpers.NullableString `json:"link" example:"/events/42/tracker"`
+	Read      bool                   `json:"read" example:"false"`
+	ReadAt    *time.Time             `json:"read_at,omitempty"`
+	CreatedAt *time.Time             `json:"created_at,omitempty"`
+}
+
+func toNotificationResponse(n repository.Notification) NotificationResponse {
+	resp := NotificationResponse{
+		ID:    n.ID,
+		Type:  n.Type,
+		Title: n.Title,
+		Body:  n.Body,
+		Link:  helpers.NullableString{NullString: n.Link},
+		Read:  n.ReadAt.Valid,
+	}
+	if n.ReadAt.Valid {
+		resp.ReadAt = &n.ReadAt.Time
+	}
+	if n.CreatedAt.Valid {
+		resp.CreatedAt = &n.CreatedAt.Time
+	}
+	return resp
+}
+
+// NotificationsResponse is the response for the GET /notifications endpoint
+type NotificationsResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	UnreadCount   int64                  `json:"unread_count" example:"3"`
+	// NextBefore is the before parameter of the next page, omitted on the last page
+	NextBefore *int32 `json:"next_before,omitempty" example:"21"`
+}
+
+// UnreadCountResponse is the number of unread notifications of a member
+type UnreadCountResponse struct {
+	UnreadCount int64 `json:"unread_count" example:"0"`
+}
@@ -0,0 +1,125 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+	"github.com/dlsu-lscs/lscs-core-api/internal/scheduler"
+)
+
+// KeyExpiryJobName identifies the API key expiry warning job in scheduled_jobs
+const KeyExpiryJobName = "api_key_expiry_warnings"
+
+// KeyExpiryWarner warns API key owners before their keys expire
+type KeyExpiryWarner struct {
+	dbService database.Service
+	notifier  *Notifier
+	days      []int // ascending
+}
+
+func NewKeyExpiryWarner(dbService database.Service, notifier *Notifier, days []int) *KeyExpiryWarner {
+	days = slices.Clone(days)
+	slices.Sort(days)
+	return &KeyExpiryWarner{dbService: dbService, notifier: notifier, days: days}
+}
+
+// StartKeyExpiryJob checks for expiring API keys every hour
+func StartKeyExpiryJob(ctx context.Context, dbService database.Service, cfg *config.Config, notifier *Notifier) {
+	w := NewKeyExpiryWarner(dbService, notifier, cfg.KeyExpiryWarningDays)
+	if len(w.days) == 0 {
+		return
+	}
+	scheduler.Start(ctx, dbService, scheduler.Job{
+		Name:     KeyExpiryJobName,
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			return w.Run(ctx, time.Now())
+		},
+	})
+}
+
+// Run queues a warning (email and in-app) for each key that crossed a warning threshold.
+// Each key is warned at most once per threshold; a key first seen past several thresholds only gets the nearest one.
+func (w *KeyExpiryWarner) Run(ctx context.Context, now time.Time) error {
+	if len(w.days) == 0 {
+		return nil
+	}
+	q := repository.New(w.dbService.GetConnection())
+
+	keys, err := q.ListAPIKeysExpiringBetween(ctx, repository.ListAPIKeysExpiringBetweenParams{
+		ExpiresAt:   sql.NullTime{Time: now, Valid: true},
+		ExpiresAt_2: sql.NullTime{Time: now.AddDate(0, 0, w.days[len(w.days)-1]), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("list expiring api keys: %w", err)
+	}
+
+	for _, key := range keys {
+		daysLeft := int(math.Ceil(key.ExpiresAt.Time.Sub(now).Hours() / 24))
+		threshold, ok := w.threshold(daysLeft)
+		if !ok {
+			continue
+		}
+
+		dedupeKey := fmt.Sprintf("%s:%d:%d:%d", TypeKeyExpiring, key.ApiKeyID, key.ExpiresAt.Time.Unix(), threshold)
+		w.notifier.addToInbox(ctx, InboxItem{
+			Type:      TypeKeyExpiring,
+			MemberID:  key.MemberID,
+			Title:     keyExpiringTitle(key.Project.String, daysLeft),
+			Body:      fmt.Sprintf("Create a new key and switch your project to it before %s.", key.ExpiresAt.Time.Format("January 2, 2006")),
+			Link:      "/api-keys",
+			DedupeKey: dedupeKey,
+		})
+
+		queued, err := w.notifier.Queue(ctx, Notification{
+			Type:     TypeKeyExpiring,
+			MemberID: key.MemberID,
+			Data: KeyExpiringData{
+				KeyID:     key.ApiKeyID,
+				Project:   key.Project.String,
+				ExpiresAt: key.ExpiresAt.Time,
+				DaysLeft:  daysLeft,
+			},
+			DedupeKey: dedupeKey,
+		})
+		if err != nil {
+			log.Error().Err(err).Int32("api_key_id", key.ApiKeyID).Msg("failed to queue api key expiry warning")
+			continue
+		}
+		if queued {
+			log.Info().Int32("api_key_id", key.ApiKeyID).Int("days_left", daysLeft).Msg("api key expiry warning queued")
+		}
+	}
+	return nil
+}
+
+// threshold returns the nearest warning threshold a key with daysLeft has crossed
+func (w *KeyExpiryWarner) threshold(daysLeft int) (int, bool) {
+	for _, d := range w.days {
+		if daysLeft <= d {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// keyExpiringTitle is the in-app title of a key expiry warning, worded like the email's subject
+func keyExpiringTitle(project string, daysLeft int) string {
+	title := "Your API key"
+	if project != "" {
+		title += " for " + project
+	}
+	if daysLeft == 1 {
+		return title + " expires in 1 day"
+	}
+	return fmt.Sprintf("%s expires in %d days", title, daysLeft)
+}
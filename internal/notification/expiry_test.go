@@ -0,0 +1,73 @@
+package notification
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyExpiryWarnerRun(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	soon := testNow.Add(2*24*time.Hour + time.Hour) // 3 days left: the 3-day warning
+	later := testNow.Add(10 * 24 * time.Hour)       // 10 days left: the 14-day warning
+
+	mock.ExpectQuery("SELECT (.+) FROM api_keys k").
+		WithArgs(testNow, testNow.AddDate(0, 0, 14)).
+		WillReturnRows(sqlmock.NewRows([]string{"api_key_id", "project", "expires_at", "member_id"}).
+			AddRow(3, "Org Website", soon, 12312345).
+			AddRow(4, nil, later, 12312346))
+
+	dedupeKey := fmt.Sprintf("key_expiring:3:%d:3", soon.Unix())
+	mock.ExpectExec("INSERT IGNORE INTO notifications").
+		WithArgs(int32(12312345), TypeKeyExpiring, "Your API key for Org Website expires in 3 days", sqlmock.AnyArg(), "/api-keys", dedupeKey).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT email_enabled FROM notification_preferences").
+		WithArgs(int32(12312345), TypeKeyExpiring).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery("SELECT id, full_name, email FROM members").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "email"}).AddRow(12312345, "Juan Dela Cruz", "juan_delacruz@dlsu.edu.ph"))
+	mock.ExpectExec("INSERT IGNORE INTO email_outbox").
+		WithArgs(int32(12312345), "juan_delacruz@dlsu.edu.ph", TypeKeyExpiring, "Your API key for Org Website expires in 3 days",
+			sqlmock.AnyArg(), sqlmock.AnyArg(), dedupeKey, testNow).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// the second key's owner opted out of emails, but still gets the in-app warning
+	mock.ExpectExec("INSERT IGNORE INTO notifications").
+		WithArgs(int32(12312346), TypeKeyExpiring, "Your API key expires in 10 days", sqlmock.AnyArg(), "/api-keys", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectQuery("SELECT email_enabled FROM notification_preferences").
+		WithArgs(int32(12312346), TypeKeyExpiring).
+		WillReturnRows(sqlmock.NewRows([]string{"email_enabled"}).AddRow(false))
+
+	w := NewKeyExpiryWarner(&mockDBService{db: db}, newTestNotifier(db), []int{14, 3})
+	assert.NoError(t, w.Run(t.Context(), testNow))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestKeyExpiryThreshold(t *testing.T) {
+	w := NewKeyExpiryWarner(nil, nil, []int{14, 3, 1})
+
+	tests := []struct {
+		daysLeft  int
+		threshold int
+		ok        bool
+	}{
+		{15, 0, false},
+		{14, 14, true},
+		{4, 14, true},
+		{3, 3, true},
+		{1, 1, true},
+	}
+	for _, tt := range tests {
+		threshold, ok := w.threshold(tt.daysLeft)
+		assert.Equal(t, tt.ok, ok, "days left %d", tt.daysLeft)
+		assert.Equal(t, tt.threshold, threshold, "days left %d", tt.daysLeft)
+	}
+}
@@ -0,0 +1,299 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+const (
+	defaultNotificationLimit = 20
+	maxNotificationLimit     = 100
+)
+
+// Handler serves members' in-app notifications and lets them choose which notifications they get by email
+type Handler struct {
+	dbService    database.Service
+	broker       *Broker
+	pollInterval time.Duration // how often open streams check for notifications added by other instances
+	now          func() time.Time
+}
+
+func NewHandler(cfg *config.Config, dbService database.Service, broker *Broker) *Handler {
+	return &Handler{
+		dbService:    dbService,
+		broker:       broker,
+		pollInterval: cfg.NotificationStreamPollInterval,
+		now:          time.Now,
+	}
+}
+
+// ListNotificationsHandler godoc
+// @Summary List my notifications
+// @Description Lists the authenticated member's in-app notifications, newest first, with their unread count. Pass next_before as before to get the next page.
+// @Tags notifications
+// @Produce json
+// @Param unread query bool false "Only unread notifications"
+// @Param before query int false "Only notifications older than this ID"
+// @Param limit query int false "Maximum number of notifications (default 20, max 100)"
+// @Success 200 {object} NotificationsResponse "Notifications"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid query parameter"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /notifications [get]
+func (h *Handler) ListNotificationsHandler(c echo.Context) error {
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return helpers.ErrUnauthorized(c, "")
+	}
+
+	before := int64(math.MaxInt32)
+	if raw := c.QueryParam("before"); raw != "" {
+		var err error
+		before, err = strconv.ParseInt(raw, 10, 32)
+		if err != nil || before <= 0 {
+			return helpers.ErrBadRequest(c, "Invalid before")
+		}
+	}
+	limit := defaultNotificationLimit
+	if raw := c.QueryParam("limit"); raw != "" {
+		var err error
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit <= 0 || limit > maxNotificationLimit {
+			return helpers.ErrBadRequest(c, "Invalid limit")
+		}
+	}
+
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	var notifications []repository.Notification
+	var err error
+	if c.QueryParam("unread") == "true" {
+		notifications, err = q.ListUnreadNotifications(ctx, repository.ListUnreadNotificationsParams{
+			MemberID: principal.MemberID,
+			ID:       int32(before),
+			Limit:    int32(limit),
+		})
+	} else {
+		notifications, err = q.ListNotifications(ctx, repository.ListNotificationsParams{
+			MemberID: principal.MemberID,
+			ID:       int32(before),
+			Limit:    int32(limit),
+		})
+	}
+	if err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to list notifications")
+		return helpers.ErrInternal(c, "")
+	}
+
+	unread, err := q.CountUnreadNotifications(ctx, principal.MemberID)
+	if err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to count unread notifications")
+		return helpers.ErrInternal(c, "")
+	}
+
+	resp := NotificationsResponse{
+		Notifications: make([]NotificationResponse, 0, len(notifications)),
+		UnreadCount:   unread,
+	}
+	for _, n := range notifications {
+		resp.Notifications = append(resp.Notifications, toNotificationResponse(n))
+	}
+	if len(notifications) == limit {
+		resp.NextBefore = &notifications[len(notifications)-1].ID
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// MarkReadHandler godoc
+// @Summary Mark a notification as read
+// @Description Marks one of the authenticated member's notifications as read. Marking a read notification again keeps its original read time.
+// @Tags notifications
+// @Produce json
+// @Param id path int true "Notification ID"
+// @Success 200 {object} NotificationResponse "Notification"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid notification ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "Notification not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /notifications/{id}/read [post]
+func (h *Handler) MarkReadHandler(c echo.Context) error {
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return helpers.ErrUnauthorized(c, "")
+	}
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return helpers.ErrBadRequest(c, "Invalid notification ID")
+	}
+
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+	marked, err := q.MarkNotificationRead(ctx, repository.MarkNotificationReadParams{
+		ReadAt:   sql.NullTime{Time: h.now(), Valid: true},
+		ID:       int32(id),
+		MemberID: principal.MemberID,
+	})
+	if err != nil {
+		log.Error().Err(err).Int64("notification_id", id).Msg("failed to mark notification as read")
+		return helpers.ErrInternal(c, "")
+	}
+
+	notification, err := q.GetNotification(ctx, repository.GetNotificationParams{ID: int32(id), MemberID: principal.MemberID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return helpers.ErrNotFound(c, "Notification not found")
+		}
+		log.Error().Err(err).Int64("notification_id", id).Msg("failed to get notification")
+		return helpers.ErrInternal(c, "")
+	}
+	if marked > 0 {
+		h.broker.Publish(principal.MemberID)
+	}
+	return c.JSON(http.StatusOK, toNotificationResponse(notification))
+}
+
+// MarkAllReadHandler godoc
+// @Summary Mark all my notifications as read
+// @Description Marks every unread notification of the authenticated member as read.
+// @Tags notifications
+// @Produce json
+// @Success 200 {object} UnreadCountResponse "Unread count (0)"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /notifications/read-all [post]
+func (h *Handler) MarkAllReadHandler(c echo.Context) error {
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return helpers.ErrUnauthorized(c, "")
+	}
+
+	q := repository.New(h.dbService.GetConnection())
+	marked, err := q.MarkAllNotificationsRead(c.Request().Context(), repository.MarkAllNotificationsReadParams{
+		ReadAt:   sql.NullTime{Time: h.now(), Valid: true},
+		MemberID: principal.MemberID,
+	})
+	if err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to mark notifications as read")
+		return helpers.ErrInternal(c, "")
+	}
+	if marked > 0 {
+		h.broker.Publish(principal.MemberID)
+	}
+	return c.JSON(http.StatusOK, UnreadCountResponse{UnreadCount: 0})
+}
+
+// GetPreferencesHandler godoc
+// @Summary Get my notification preferences
+// @Description Lists every notification type and whether the authenticated member gets it by email. Types are emailed unless opted out of.
+// @Tags notifications
+// @Produce json
+// @Success 200 {object} PreferencesResponse "Notification preferences"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /notifications/preferences [get]
+func (h *Handler) GetPreferencesHandler(c echo.Context) error {
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return helpers.ErrUnauthorized(c, "")
+	}
+
+	resp, err := h.preferences(c.Request().Context(), principal.MemberID)
+	if err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to list notification preferences")
+		return helpers.ErrInternal(c, "")
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// UpdatePreferencesHandler godoc
+// @Summary Update my notification preferences
+// @Description Turns emails of notification types on or off for the authenticated member. Types that are left out keep their current preference.
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param request body UpdatePreferencesRequest true "Preferences to change"
+// @Success 200 {object} PreferencesResponse "Notification preferences"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request or unknown notification type"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /notifications/preferences [put]
+func (h *Handler) UpdatePreferencesHandler(c echo.Context) error {
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return helpers.ErrUnauthorized(c, "")
+	}
+
+	var req UpdatePreferencesRequest
+	if err := c.Bind(&req); err != nil {
+		return helpers.ErrBadRequest(c, "Invalid request format")
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+	for _, p := range req.Preferences {
+		if !IsType(p.Type) {
+			return helpers.ErrBadRequest(c, "Unknown notification type: "+p.Type)
+		}
+	}
+
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+	for _, p := range req.Preferences {
+		if err := q.SetNotificationPreference(ctx, repository.SetNotificationPreferenceParams{
+			MemberID:     principal.MemberID,
+			Type:         p.Type,
+			EmailEnabled: *p.Email,
+		}); err != nil {
+			log.Error().Err(err).Int32("member_id", principal.MemberID).Str("type", p.Type).Msg("failed to set notification preference")
+			return helpers.ErrInternal(c, "")
+		}
+	}
+
+	resp, err := h.preferences(ctx, principal.MemberID)
+	if err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to list notification preferences")
+		return helpers.ErrInternal(c, "")
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// preferences lists every type with the member's preference, defaulting to enabled
+func (h *Handler) preferences(ctx context.Context, memberID int32) (PreferencesResponse, error) {
+	q := repository.New(h.dbService.GetConnection())
+	saved, err := q.ListNotificationPreferences(ctx, memberID)
+	if err != nil {
+		return PreferencesResponse{}, err
+	}
+
+	enabled := make(map[string]bool, len(saved))
+	for _, p := range saved {
+		enabled[p.Type] = p.EmailEnabled
+	}
+
+	resp := PreferencesResponse{Preferences: make([]PreferenceResponse, 0, len(Types))}
+	for _, info := range Types {
+		email, ok := enabled[info.Type]
+		resp.Preferences = append(resp.Preferences, PreferenceResponse{TypeInfo: info, Email: !ok || email})
+	}
+	return resp, nil
+}
@@ -0,0 +1,291 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+)
+
+var preferenceColumns = []string{"member_id", "type", "email_enabled", "updated_at"}
+
+func newPreferencesContext(method, body string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, "/notifications/preferences", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	auth.SetPrincipal(c, &auth.Principal{MemberID: 12312345, Method: auth.AuthMethodSession})
+	return c, rec
+}
+
+func TestGetPreferencesHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM notification_preferences WHERE member_id = ?").
+		WithArgs(int32(12312345)).
+		WillReturnRows(sqlmock.NewRows(preferenceColumns).AddRow(12312345, TypeRoleGranted, false, nil))
+
+	h := NewHandler(&config.Config{}, &mockDBService{db: db}, nil)
+	c, rec := newPreferencesContext(http.MethodGet, "")
+	if assert.NoError(t, h.GetPreferencesHandler(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp PreferencesResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		if assert.Len(t, resp.Preferences, len(Types)) {
+			for _, p := range resp.Preferences {
+				assert.Equal(t, p.Type != TypeRoleGranted, p.Email, p.Type)
+			}
+		}
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePreferencesHandler(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("INSERT INTO notification_preferences").
+			WithArgs(int32(12312345), TypeKeyExpiring, false).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT (.+) FROM notification_preferences WHERE member_id = ?").
+			WillReturnRows(sqlmock.NewRows(preferenceColumns).AddRow(12312345, TypeKeyExpiring, false, nil))
+
+		h := NewHandler(&config.Config{}, &mockDBService{db: db}, nil)
+		c, rec := newPreferencesContext(http.MethodPut, `{"preferences":[{"type":"key_expiring","email":false}]}`)
+		if assert.NoError(t, h.UpdatePreferencesHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - unknown type", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		h := NewHandler(&config.Config{}, &mockDBService{db: db}, nil)
+		c, rec := newPreferencesContext(http.MethodPut, `{"preferences":[{"type":"newsletter","email":false}]}`)
+		if assert.NoError(t, h.UpdatePreferencesHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - missing email flag", func(t *testing.T) {
+		h := NewHandler(&config.Config{}, nil, nil)
+		c, rec := newPreferencesContext(http.MethodPut, `{"preferences":[{"type":"key_expiring"}]}`)
+		if assert.NoError(t, h.UpdatePreferencesHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
+
+var notificationColumns = []string{"id", "member_id", "type", "title", "body", "link", "dedupe_key", "read_at", "created_at"}
+
+func newNotificationsContext(method, target string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, target, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	auth.SetPrincipal(c, &auth.Principal{MemberID: 12312345, Method: auth.AuthMethodSession})
+	return c, rec
+}
+
+func TestListNotificationsHandler(t *testing.T) {
+	t.Run("success - first page", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM notifications WHERE member_id = \\? AND id < \\? ORDER BY").
+			WithArgs(int32(12312345), int32(math.MaxInt32), int32(2)).
+			WillReturnRows(sqlmock.NewRows(notificationColumns).
+				AddRow(9, 12312345, TypeRoleGranted, "You were granted the Administrator role", "", nil, nil, nil, testNow).
+				AddRow(7, 12312345, TypeTrackerReminder, "Due in 3 days: finance pre-acts of Intro to Go Workshop", "", "/events/42/tracker", nil, testNow, testNow))
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM notifications").
+			WithArgs(int32(12312345)).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		h := NewHandler(&config.Config{}, &mockDBService{db: db}, nil)
+		c, rec := newNotificationsContext(http.MethodGet, "/notifications?limit=2")
+		if assert.NoError(t, h.ListNotificationsHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var resp map[string]any
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, float64(1), resp["unread_count"])
+			assert.Equal(t, float64(7), resp["next_before"])
+			notifications := resp["notifications"].([]any)
+			if assert.Len(t, notifications, 2) {
+				assert.Equal(t, false, notifications[0].(map[string]any)["read"])
+				assert.Equal(t, true, notifications[1].(map[string]any)["read"])
+				assert.Equal(t, "/events/42/tracker", notifications[1].(map[string]any)["link"])
+			}
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - unread, last page", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM notifications WHERE member_id = \\? AND id < \\? AND read_at IS NULL").
+			WithArgs(int32(12312345), int32(7), int32(defaultNotificationLimit)).
+			WillReturnRows(sqlmock.NewRows(notificationColumns).
+				AddRow(3, 12312345, TypeRoleGranted, "You were granted the Member role", "", nil, nil, nil, testNow))
+		mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM notifications").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		h := NewHandler(&config.Config{}, &mockDBService{db: db}, nil)
+		c, rec := newNotificationsContext(http.MethodGet, "/notifications?unread=true&before=7")
+		if assert.NoError(t, h.ListNotificationsHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.NotContains(t, rec.Body.String(), "next_before")
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - invalid limit", func(t *testing.T) {
+		h := NewHandler(&config.Config{}, nil, nil)
+		c, rec := newNotificationsContext(http.MethodGet, "/notifications?limit=500")
+		if assert.NoError(t, h.ListNotificationsHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
+
+func TestMarkReadHandler(t *testing.T) {
+	t.Run("success - wakes streams", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("UPDATE notifications SET read_at = \\? WHERE id = \\?").
+			WithArgs(testNow, int32(9), int32(12312345)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT (.+) FROM notifications WHERE id = \\? AND member_id = \\?").
+			WithArgs(int32(9), int32(12312345)).
+			WillReturnRows(sqlmock.NewRows(notificationColumns).
+				AddRow(9, 12312345, TypeRoleGranted, "You were granted the Administrator role", "", nil, nil, testNow, testNow))
+
+		broker := NewBroker()
+		wake, unsubscribe := broker.Subscribe(12312345)
+		defer unsubscribe()
+
+		h := NewHandler(&config.Config{}, &mockDBService{db: db}, broker)
+		h.now = func() time.Time { return testNow }
+		c, rec := newNotificationsContext(http.MethodPost, "/notifications/9/read")
+		c.SetParamNames("id")
+		c.SetParamValues("9")
+		if assert.NoError(t, h.MarkReadHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Contains(t, rec.Body.String(), `"read":true`)
+		}
+		assert.Len(t, wake, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - someone else's notification", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("UPDATE notifications SET read_at").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT (.+) FROM notifications WHERE id = \\? AND member_id = \\?").
+			WillReturnRows(sqlmock.NewRows(notificationColumns))
+
+		h := NewHandler(&config.Config{}, &mockDBService{db: db}, nil)
+		c, rec := newNotificationsContext(http.MethodPost, "/notifications/10/read")
+		c.SetParamNames("id")
+		c.SetParamValues("10")
+		if assert.NoError(t, h.MarkReadHandler(c)) {
+			assert.Equal(t, http.StatusNotFound, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestMarkAllReadHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec("UPDATE notifications SET read_at = \\? WHERE member_id = \\?").
+		WithArgs(sqlmock.AnyArg(), int32(12312345)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	h := NewHandler(&config.Config{}, &mockDBService{db: db}, nil)
+	c, rec := newNotificationsContext(http.MethodPost, "/notifications/read-all")
+	if assert.NoError(t, h.MarkAllReadHandler(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"unread_count":0}`, rec.Body.String())
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStreamHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	// a reconnecting client gets what it missed since its Last-Event-ID
+	mock.ExpectQuery("SELECT (.+) FROM notifications WHERE member_id = \\? AND id > \\?").
+		WithArgs(int32(12312345), int32(7), int32(streamBatch)).
+		WillReturnRows(sqlmock.NewRows(notificationColumns).
+			AddRow(9, 12312345, TypeRoleGranted, "You were granted the Administrator role", "", nil, nil, nil, testNow))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM notifications").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	// then waits to be woken
+	mock.ExpectQuery("SELECT (.+) FROM notifications WHERE member_id = \\? AND id > \\?").
+		WithArgs(int32(12312345), int32(9), int32(streamBatch)).
+		WillReturnRows(sqlmock.NewRows(notificationColumns))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM notifications").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	broker := NewBroker()
+	h := NewHandler(&config.Config{NotificationStreamPollInterval: time.Hour}, &mockDBService{db: db}, broker)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	c, rec := newNotificationsContext(http.MethodGet, "/notifications/stream")
+	c.SetRequest(c.Request().WithContext(ctx))
+	c.Request().Header.Set("Last-Event-ID", "7")
+
+	done := make(chan error)
+	go func() { done <- h.StreamHandler(c) }()
+
+	// wake the stream once it has subscribed, then close it once it has caught up
+	assert.Eventually(t, func() bool {
+		broker.mu.Lock()
+		defer broker.mu.Unlock()
+		return len(broker.subs[12312345]) == 1
+	}, time.Second, time.Millisecond)
+	broker.Publish(12312345)
+	assert.Eventually(t, func() bool { return mock.ExpectationsWereMet() == nil }, time.Second, time.Millisecond)
+	cancel()
+	assert.NoError(t, <-done)
+
+	assert.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+	body := rec.Body.String()
+	assert.Contains(t, body, "retry: 5000\n\n")
+	assert.Contains(t, body, "id: 9\nevent: notification\ndata: {\"id\":9,")
+	assert.Contains(t, body, "event: unread_count\ndata: {\"unread_count\":1}\n\n")
+	assert.Contains(t, body, "event: unread_count\ndata: {\"unread_count\":0}\n\n")
+}
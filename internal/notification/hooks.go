@@ -0,0 +1,154 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/event"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+	"github.com/dlsu-lscs/lscs-core-api/internal/tracker"
+)
+
+// stageNames are the tracker stages as they read in notifications
+var stageNames = map[tracker.Stage]string{
+	tracker.StagePreacts:     "documentation pre-acts",
+	tracker.StagePostacts:    "documentation post-acts",
+	tracker.StageFinPreacts:  "finance pre-acts",
+	tracker.StageFinPostacts: "finance post-acts",
+}
+
+// fieldNames are the profile fields as they read in notifications, where they don't read well with underscores replaced
+var fieldNames = map[string]string{
+	"position_id":  "position",
+	"committee_id": "committee",
+	"house_id":     "house",
+	"fb_link":      "Facebook link",
+	"image_url":    "photo",
+}
+
+// NotifyRoleGranted tells a member about a role they were granted, by email and in-app (auth.RoleNotifier)
+func (n *Notifier) NotifyRoleGranted(ctx context.Context, memberID int32, role repository.Role) {
+	n.Notify(ctx, Notification{
+		Type:     TypeRoleGranted,
+		MemberID: memberID,
+		Data:     RoleGrantedData{RoleID: role.ID, RoleName: role.Name, Description: role.Description.String},
+	})
+	n.addToInbox(ctx, InboxItem{
+		Type:     TypeRoleGranted,
+		MemberID: memberID,
+		Title:    fmt.Sprintf("You were granted the %s role", role.Name),
+		Body:     role.Description.String,
+	})
+}
+
+// NotifyMemberUpdated tells a member that someone else edited their profile, in-app only (member.UpdateNotifier).
+// fields are the JSON names of the fields that were set.
+func (n *Notifier) NotifyMemberUpdated(ctx context.Context, memberID, editorID int32, fields []string) {
+	editor := "An officer"
+	q := repository.New(n.dbService.GetConnection())
+	if contact, err := q.GetMemberContact(ctx, editorID); err == nil {
+		editor = contact.FullName
+	}
+
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = fieldNames[f]
+		if names[i] == "" {
+			names[i] = strings.ReplaceAll(f, "_", " ")
+		}
+	}
+	n.addToInbox(ctx, InboxItem{
+		Type:     TypeProfileUpdated,
+		MemberID: memberID,
+		Title:    "Your profile was updated",
+		Body:     fmt.Sprintf("%s updated your %s.", editor, joinAnd(names)),
+		Link:     "/profile",
+	})
+}
+
+// NotifyRegistrationPending emails the verification link of a public event registration (event.RegistrationNotifier).
+// It is queued even for addresses that opted out of other emails, since the registration can't be completed without it.
+func (n *Notifier) NotifyRegistrationPending(ctx context.Context, r event.PendingRegistration) error {
+	_, err := n.Queue(ctx, Notification{
+		Type:  TypeEventRegistration,
+		Email: r.Email,
+		Name:  r.StudentName,
+		Data: EventRegistrationData{
+			EventID:   r.EventID,
+			EventName: r.EventName,
+			Token:     r.Token,
+			ExpiresAt: r.ExpiresAt,
+		},
+	})
+	return err
+}
+
+// NotifyDeadline logs tracker reminders, adds them to the inboxes of the event's heads
+// and emails them overdue notices, up to TRACKER_OVERDUE_NOTICE_DAYS after the deadline (tracker.Notifier).
+func (n *Notifier) NotifyDeadline(ctx context.Context, r tracker.Reminder) error {
+	if err := (tracker.LogNotifier{}).NotifyDeadline(ctx, r); err != nil {
+		return err
+	}
+
+	stage := stageNames[r.Stage]
+	if stage == "" {
+		stage = string(r.Stage)
+	}
+	for _, head := range r.Recipients {
+		if err := n.deadlineInboxItem(ctx, r, stage, head.MemberID); err != nil {
+			return err
+		}
+		if !r.Overdue || n.now().After(r.Deadline.AddDate(0, 0, n.overdueNoticeDays)) {
+			continue
+		}
+		if _, err := n.Queue(ctx, Notification{
+			Type:     TypeTrackerOverdue,
+			MemberID: head.MemberID,
+			Email:    head.Email,
+			Name:     head.FullName,
+			Data: TrackerOverdueData{
+				EventID:   r.EventID,
+				EventName: r.EventName,
+				Stage:     stage,
+				Status:    r.Status,
+				Deadline:  r.Deadline,
+			},
+			DedupeKey: fmt.Sprintf("%s:%d:%s:%d:%d", TypeTrackerOverdue, r.EventID, r.Stage, r.Deadline.Unix(), head.MemberID),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deadlineInboxItem adds a tracker reminder or overdue notice to a head's inbox
+func (n *Notifier) deadlineInboxItem(ctx context.Context, r tracker.Reminder, stage string, memberID int32) error {
+	item := InboxItem{
+		Type:     TypeTrackerReminder,
+		MemberID: memberID,
+		Title:    fmt.Sprintf("Due in %d days: %s of %s", r.DaysBefore, stage, r.EventName),
+		Body:     fmt.Sprintf("The %s of %s are due on %s.", stage, r.EventName, r.Deadline.Format("January 2, 2006")),
+		Link:     fmt.Sprintf("/events/%d/tracker", r.EventID),
+	}
+	if r.DaysBefore == 1 {
+		item.Title = fmt.Sprintf("Due tomorrow: %s of %s", stage, r.EventName)
+	}
+	if r.Overdue {
+		item.Type = TypeTrackerOverdue
+		item.Title = fmt.Sprintf("Overdue: %s of %s", stage, r.EventName)
+		item.Body = fmt.Sprintf("The %s of %s were due on %s.", stage, r.EventName, r.Deadline.Format("January 2, 2006"))
+	}
+	item.DedupeKey = fmt.Sprintf("%s:%d:%s:%d:%d:%d", item.Type, r.EventID, r.Stage, r.Deadline.Unix(), r.DaysBefore, memberID)
+
+	_, err := n.AddToInbox(ctx, item)
+	return err
+}
+
+// joinAnd joins words as in "a, b and c"
+func joinAnd(words []string) string {
+	if len(words) < 2 {
+		return strings.Join(words, "")
+	}
+	return strings.Join(words[:len(words)-1], ", ") + " and " + words[len(words)-1]
+}
@@ -0,0 +1,102 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// InboxItem is an in-app notification
+type InboxItem struct {
+	Type     string
+	MemberID int32
+	Title    string
+	Body     string
+	Link     string // path in the web UI, e.g. /events/42/tracker
+	// DedupeKey, if set, keeps the same notification from being added twice
+	DedupeKey string
+}
+
+// AddToInbox adds an in-app notification and wakes the member's open streams.
+// Unlike emails, in-app notifications can't be opted out of.
+// It returns false if a notification with the same dedupe key was already added.
+func (n *Notifier) AddToInbox(ctx context.Context, item InboxItem) (bool, error) {
+	q := repository.New(n.dbService.GetConnection())
+	added, err := q.CreateNotification(ctx, repository.CreateNotificationParams{
+		MemberID:  item.MemberID,
+		Type:      item.Type,
+		Title:     item.Title,
+		Body:      item.Body,
+		Link:      sql.NullString{String: item.Link, Valid: item.Link != ""},
+		DedupeKey: sql.NullString{String: item.DedupeKey, Valid: item.DedupeKey != ""},
+	})
+	if err != nil {
+		return false, fmt.Errorf("add notification: %w", err)
+	}
+	if added == 0 {
+		return false, nil
+	}
+
+	n.broker.Publish(item.MemberID)
+	return true, nil
+}
+
+// addToInbox adds an in-app notification, logging failures like Notify
+func (n *Notifier) addToInbox(ctx context.Context, item InboxItem) {
+	if _, err := n.AddToInbox(ctx, item); err != nil {
+		log.Error().Err(err).Str("type", item.Type).Int32("member_id", item.MemberID).Msg("failed to add in-app notification")
+	}
+}
+
+// Broker wakes a member's open notification streams when their inbox changes.
+// It only reaches streams on this instance; streams also poll for changes made by other instances.
+// A nil Broker does nothing.
+type Broker struct {
+	mu   sync.Mutex
+	subs map[int32]map[chan struct{}]struct{}
+}
+
+func NewBroker() *Broker {
+	return &Broker{subs: make(map[int32]map[chan struct{}]struct{})}
+}
+
+// Subscribe returns a channel that receives a value when the member's inbox changes, and a function to unsubscribe.
+// Changes made while the previous one is being handled are coalesced.
+func (b *Broker) Subscribe(memberID int32) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	b.mu.Lock()
+	if b.subs[memberID] == nil {
+		b.subs[memberID] = make(map[chan struct{}]struct{})
+	}
+	b.subs[memberID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		delete(b.subs[memberID], ch)
+		if len(b.subs[memberID]) == 0 {
+			delete(b.subs, memberID)
+		}
+		b.mu.Unlock()
+	}
+}
+
+// Publish wakes the member's open streams
+func (b *Broker) Publish(memberID int32) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subs[memberID] {
+		select {
+		case ch <- struct{}{}:
+		default: // already woken
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroker(t *testing.T) {
+	b := NewBroker()
+	wake, unsubscribe := b.Subscribe(12312345)
+	other, unsubscribeOther := b.Subscribe(12312346)
+	defer unsubscribeOther()
+
+	// changes made before the stream handles the first are coalesced
+	b.Publish(12312345)
+	b.Publish(12312345)
+	assert.Len(t, wake, 1)
+	<-wake
+	assert.Empty(t, other)
+
+	unsubscribe()
+	b.Publish(12312345)
+	assert.Empty(t, wake)
+	assert.NotContains(t, b.subs, int32(12312345))
+
+	// a nil broker does nothing
+	var nilBroker *Broker
+	nilBroker.Publish(12312345)
+}
+
+func TestAddToInbox(t *testing.T) {
+	t.Run("success - wakes streams", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("INSERT IGNORE INTO notifications").
+			WithArgs(int32(12312345), TypeProfileUpdated, "Your profile was updated", "Juan Dela Cruz updated your nickname.", "/profile", nil).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		n := newTestNotifier(db)
+		n.broker = NewBroker()
+		wake, unsubscribe := n.broker.Subscribe(12312345)
+		defer unsubscribe()
+
+		added, err := n.AddToInbox(t.Context(), InboxItem{
+			Type:     TypeProfileUpdated,
+			MemberID: 12312345,
+			Title:    "Your profile was updated",
+			Body:     "Juan Dela Cruz updated your nickname.",
+			Link:     "/profile",
+		})
+		assert.NoError(t, err)
+		assert.True(t, added)
+		assert.Len(t, wake, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("skipped - already added", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("INSERT IGNORE INTO notifications").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		n := newTestNotifier(db)
+		n.broker = NewBroker()
+		wake, unsubscribe := n.broker.Subscribe(12312345)
+		defer unsubscribe()
+
+		added, err := n.AddToInbox(t.Context(), InboxItem{Type: TypeTrackerReminder, MemberID: 12312345, DedupeKey: "tracker_reminder:42"})
+		assert.NoError(t, err)
+		assert.False(t, added)
+		assert.Empty(t, wake)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNotifyMemberUpdated(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT id, full_name, email FROM members").
+		WithArgs(int32(12312346)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "email"}).AddRow(12312346, "Maria Santos", "maria_santos@dlsu.edu.ph"))
+	mock.ExpectExec("INSERT IGNORE INTO notifications").
+		WithArgs(int32(12312345), TypeProfileUpdated, "Your profile was updated", "Maria Santos updated your position, nickname and contact number.", "/profile", nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	newTestNotifier(db).NotifyMemberUpdated(t.Context(), 12312345, 12312346, []string{"position_id", "nickname", "contact_number"})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,139 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// Notification is an email to queue
+type Notification struct {
+	Type string
+	// MemberID is the recipient. Their preferences are checked and, unless Email is set, their email is used.
+	// It may be 0 for recipients who aren't members yet, in which case Email and Name are required.
+	MemberID int32
+	Email    string
+	Name     string
+	Data     any // the type's data, e.g. RoleGrantedData
+	// DedupeKey, if set, keeps the same notification from being queued twice
+	DedupeKey string
+}
+
+// Notifier renders notifications and queues them in the email outbox, and adds in-app notifications.
+// Emails are sent by the dispatcher, so notifying never waits on the mail server.
+type Notifier struct {
+	dbService database.Service
+	broker    *Broker // may be nil
+	appURL    string
+	// overdue tracker notices are only emailed this many days after a deadline,
+	// the same cutoff the deadline engine applies to the notices themselves
+	overdueNoticeDays int
+	now               func() time.Time
+}
+
+func NewNotifier(dbService database.Service, cfg *config.Config, broker *Broker) *Notifier {
+	return &Notifier{
+		dbService:         dbService,
+		broker:            broker,
+		appURL:            strings.TrimSuffix(cfg.FrontendURL(), "/"),
+		overdueNoticeDays: cfg.TrackerOverdueNoticeDays,
+		now:               time.Now,
+	}
+}
+
+// Notify queues a notification. Failures are logged rather than returned:
+// the change that caused the notification has already been saved.
+func (n *Notifier) Notify(ctx context.Context, notification Notification) {
+	if _, err := n.Queue(ctx, notification); err != nil {
+		log.Error().Err(err).Str("type", notification.Type).Int32("member_id", notification.MemberID).Msg("failed to queue notification")
+	}
+}
+
+// Queue renders and queues a notification. It returns false if it wasn't queued
+// because the member opted out of the type or it was already queued (same dedupe key).
+func (n *Notifier) Queue(ctx context.Context, notification Notification) (bool, error) {
+	if !isEmailType(notification.Type) {
+		return false, fmt.Errorf("unknown notification type %q", notification.Type)
+	}
+	q := repository.New(n.dbService.GetConnection())
+
+	email, name := notification.Email, notification.Name
+	if notification.MemberID != 0 {
+		enabled, err := emailEnabled(ctx, q, notification.MemberID, notification.Type)
+		if err != nil {
+			return false, err
+		}
+		if !enabled {
+			return false, nil
+		}
+
+		if email == "" || name == "" {
+			member, err := q.GetMemberContact(ctx, notification.MemberID)
+			if err != nil {
+				return false, fmt.Errorf("get member contact: %w", err)
+			}
+			email = member.Email
+			if name == "" {
+				name = member.FullName
+			}
+		}
+	}
+	if email == "" {
+		return false, errors.New("notification has no recipient")
+	}
+
+	subject, text, html, err := defaultRenderer.render(notification.Type, templateData{
+		Name:     name,
+		AppURL:   n.appURL,
+		Data:     notification.Data,
+		Required: slices.Contains(requiredTypes, notification.Type),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	queued, err := q.CreateOutboxEmail(ctx, repository.CreateOutboxEmailParams{
+		MemberID:      sql.NullInt32{Int32: notification.MemberID, Valid: notification.MemberID != 0},
+		Recipient:     email,
+		Type:          notification.Type,
+		Subject:       subject,
+		TextBody:      text,
+		HtmlBody:      html,
+		DedupeKey:     sql.NullString{String: notification.DedupeKey, Valid: notification.DedupeKey != ""},
+		NextAttemptAt: n.now(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("queue email: %w", err)
+	}
+	if queued == 0 {
+		return false, nil
+	}
+
+	log.Debug().Str("type", notification.Type).Int32("member_id", notification.MemberID).Msg("notification email queued")
+	return true, nil
+}
+
+// emailEnabled reports whether a member gets emails of a type; types are enabled unless opted out of
+func emailEnabled(ctx context.Context, q *repository.Queries, memberID int32, notificationType string) (bool, error) {
+	enabled, err := q.GetNotificationPreference(ctx, repository.GetNotificationPreferenceParams{
+		MemberID: memberID,
+		Type:     notificationType,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return true, nil
+		}
+		return false, fmt.Errorf("get notification preference: %w", err)
+	}
+	return enabled, nil
+}
@@ -0,0 +1,235 @@
+package notification
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+	"github.com/dlsu-lscs/lscs-core-api/internal/tracker"
+)
+
+// mockDBService is a mock implementation of the database.Service interface.
+type mockDBService struct {
+	db *sql.DB
+}
+
+func (m *mockDBService) Health() map[string]string {
+	return nil
+}
+
+func (m *mockDBService) Close() error {
+	return nil
+}
+
+func (m *mockDBService) GetConnection() *sql.DB {
+	return m.db
+}
+
+var testNow = time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+
+func newTestNotifier(db *sql.DB) *Notifier {
+	n := NewNotifier(&mockDBService{db: db}, &config.Config{AllowedOrigins: []string{"https://core.lscs.org/"}, TrackerOverdueNoticeDays: 7}, nil)
+	n.now = func() time.Time { return testNow }
+	return n
+}
+
+func TestRender(t *testing.T) {
+	data := map[string]any{
+		TypeKeyExpiring:    KeyExpiringData{KeyID: 3, Project: "Org Website", ExpiresAt: testNow.AddDate(0, 0, 3), DaysLeft: 3},
+		TypeRoleGranted:    RoleGrantedData{RoleID: "ADMIN", RoleName: "Administrator"},
+		TypeTrackerOverdue: TrackerOverdueData{EventID: 42, EventName: "Intro to Go Workshop", Stage: "finance pre-acts", Status: "INIT", Deadline: testNow},
+	}
+
+	for _, info := range Types {
+		t.Run(info.Type, func(t *testing.T) {
+			subject, text, html, err := defaultRenderer.render(info.Type, templateData{Name: "Juan", AppURL: "https://core.lscs.org", Data: data[info.Type]})
+			assert.NoError(t, err)
+			assert.NotEmpty(t, subject)
+			assert.NotContains(t, subject, "\n")
+			assert.True(t, strings.HasPrefix(text, "Hi Juan,"))
+			assert.Contains(t, html, "<p>Hi Juan,</p>")
+			assert.Contains(t, html, "https://core.lscs.org/settings/notifications")
+		})
+	}
+
+	t.Run(TypeEventRegistration, func(t *testing.T) {
+		data := EventRegistrationData{EventID: 42, EventName: "Intro to Go Workshop", Token: "42.12412345.1793952000.sig", ExpiresAt: testNow.Add(24 * time.Hour)}
+		subject, text, html, err := defaultRenderer.render(TypeEventRegistration, templateData{Name: "Maria", AppURL: "https://core.lscs.org", Data: data, Required: true})
+		assert.NoError(t, err)
+		assert.Equal(t, "Confirm your registration for Intro to Go Workshop", subject)
+		assert.Contains(t, text, "https://core.lscs.org/events/42/register/verify?token=42.12412345.1793952000.sig")
+		assert.Contains(t, html, "https://core.lscs.org/events/42/register/verify?token=42.12412345.1793952000.sig")
+		assert.NotContains(t, html, "/settings/notifications")
+	})
+
+	t.Run("html is escaped", func(t *testing.T) {
+		_, text, html, err := defaultRenderer.render(TypeRoleGranted, templateData{
+			Name: "<b>Juan</b>",
+			Data: RoleGrantedData{RoleID: "ADMIN", RoleName: "Administrator"},
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, text, "Hi <b>Juan</b>,")
+		assert.Contains(t, html, "Hi &lt;b&gt;Juan&lt;/b&gt;,")
+	})
+
+	t.Run("subject reads naturally", func(t *testing.T) {
+		subject, _, _, err := defaultRenderer.render(TypeKeyExpiring, templateData{Data: KeyExpiringData{Project: "Org Website", DaysLeft: 1}})
+		assert.NoError(t, err)
+		assert.Equal(t, "Your API key for Org Website expires in 1 day", subject)
+	})
+
+	t.Run("fail - unknown type", func(t *testing.T) {
+		_, _, _, err := defaultRenderer.render("member_deleted", templateData{})
+		assert.Error(t, err)
+	})
+}
+
+func TestQueue(t *testing.T) {
+	t.Run("success - looks up the member", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT email_enabled FROM notification_preferences").
+			WithArgs(int32(12312345), TypeRoleGranted).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery("SELECT id, full_name, email FROM members").
+			WithArgs(int32(12312345)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "email"}).AddRow(12312345, "Juan Dela Cruz", "juan_delacruz@dlsu.edu.ph"))
+		mock.ExpectExec("INSERT IGNORE INTO email_outbox").
+			WithArgs(int32(12312345), "juan_delacruz@dlsu.edu.ph", TypeRoleGranted, "You were granted the Administrator role",
+				sqlmock.AnyArg(), sqlmock.AnyArg(), nil, testNow).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		queued, err := newTestNotifier(db).Queue(t.Context(), Notification{
+			Type:     TypeRoleGranted,
+			MemberID: 12312345,
+			Data:     RoleGrantedData{RoleID: "ADMIN", RoleName: "Administrator"},
+		})
+		assert.NoError(t, err)
+		assert.True(t, queued)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("skipped - member opted out", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT email_enabled FROM notification_preferences").
+			WillReturnRows(sqlmock.NewRows([]string{"email_enabled"}).AddRow(false))
+
+		queued, err := newTestNotifier(db).Queue(t.Context(), Notification{
+			Type:     TypeRoleGranted,
+			MemberID: 12312345,
+			Data:     RoleGrantedData{RoleID: "ADMIN", RoleName: "Administrator"},
+		})
+		assert.NoError(t, err)
+		assert.False(t, queued)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("skipped - already queued", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("INSERT IGNORE INTO email_outbox").
+			WithArgs(nil, "maria_santos@dlsu.edu.ph", TypeEventRegistration, sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg(), "event_registration:42:12412345", testNow).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		queued, err := newTestNotifier(db).Queue(t.Context(), Notification{
+			Type:      TypeEventRegistration,
+			Email:     "maria_santos@dlsu.edu.ph",
+			Name:      "Maria Santos",
+			Data:      EventRegistrationData{EventID: 42, EventName: "Intro to Go Workshop", Token: "42.12412345.1793952000.sig", ExpiresAt: testNow.Add(24 * time.Hour)},
+			DedupeKey: "event_registration:42:12412345",
+		})
+		assert.NoError(t, err)
+		assert.False(t, queued)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - no recipient", func(t *testing.T) {
+		_, err := newTestNotifier(nil).Queue(t.Context(), Notification{Type: TypeEventRegistration})
+		assert.Error(t, err)
+	})
+}
+
+func TestNotifyDeadline(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	deadline := testNow.AddDate(0, 0, -1)
+	mock.ExpectExec("INSERT IGNORE INTO notifications").
+		WithArgs(int32(12312345), TypeTrackerOverdue, "Overdue: finance pre-acts of Intro to Go Workshop", sqlmock.AnyArg(),
+			"/events/42/tracker", fmt.Sprintf("tracker_overdue:42:fin_preacts:%d:0:12312345", deadline.Unix())).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("SELECT email_enabled FROM notification_preferences").
+		WithArgs(int32(12312345), TypeTrackerOverdue).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec("INSERT IGNORE INTO email_outbox").
+		WithArgs(int32(12312345), "juan_delacruz@dlsu.edu.ph", TypeTrackerOverdue, "Overdue: finance pre-acts of Intro to Go Workshop",
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), testNow).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	n := newTestNotifier(db)
+	reminder := tracker.Reminder{
+		EventID:    42,
+		EventName:  "Intro to Go Workshop",
+		Stage:      tracker.StageFinPreacts,
+		Status:     "INIT",
+		Deadline:   deadline,
+		Overdue:    true,
+		Recipients: []tracker.HeadResponse{{MemberID: 12312345, FullName: "Juan Dela Cruz", Email: "juan_delacruz@dlsu.edu.ph"}},
+	}
+	assert.NoError(t, n.NotifyDeadline(t.Context(), reminder))
+
+	// reminders before the deadline aren't emailed
+	mock.ExpectExec("INSERT IGNORE INTO notifications").
+		WithArgs(int32(12312345), TypeTrackerReminder, "Due in 3 days: finance pre-acts of Intro to Go Workshop", sqlmock.AnyArg(),
+			"/events/42/tracker", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+
+	reminder.Overdue = false
+	reminder.DaysBefore = 3
+	assert.NoError(t, n.NotifyDeadline(t.Context(), reminder))
+
+	// overdue notices for deadlines that passed long ago only go to the inbox
+	old := testNow.AddDate(0, 0, -30)
+	mock.ExpectExec("INSERT IGNORE INTO notifications").
+		WithArgs(int32(12312345), TypeTrackerOverdue, "Overdue: finance pre-acts of Intro to Go Workshop", sqlmock.AnyArg(),
+			"/events/42/tracker", fmt.Sprintf("tracker_overdue:42:fin_preacts:%d:0:12312345", old.Unix())).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+
+	reminder.Overdue = true
+	reminder.DaysBefore = 0
+	reminder.Deadline = old
+	assert.NoError(t, n.NotifyDeadline(t.Context(), reminder))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNotifyRoleGranted(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	// failures are logged, not returned
+	mock.ExpectQuery("SELECT email_enabled FROM notification_preferences").
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectExec("INSERT IGNORE INTO notifications").
+		WithArgs(int32(12312345), TypeRoleGranted, "You were granted the Administrator role", "", nil, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	newTestNotifier(db).NotifyRoleGranted(t.Context(), 12312345, repository.Role{ID: "ADMIN", Name: "Administrator"})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,188 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+const (
+	// streamHeartbeat keeps proxies from closing idle streams
+	streamHeartbeat = 25 * time.Second
+	// streamMaxAge closes streams so reconnecting clients are authenticated again (EventSource reconnects by itself)
+	streamMaxAge = 30 * time.Minute
+	// streamRetry is how long clients wait before reconnecting, in milliseconds
+	streamRetry = 5000
+	streamBatch = 50
+)
+
+// StreamHandler godoc
+// @Summary Stream my notifications
+// @Description Server-Sent Events stream of the authenticated member's in-app notifications.
+// @Description A "notification" event (with the notification ID as the event ID) is sent for each new notification,
+// @Description and an "unread_count" event when connecting and whenever the unread count changes.
+// @Description Reconnecting clients send Last-Event-ID and get the notifications they missed.
+// @Tags notifications
+// @Produce text/event-stream
+// @Param Last-Event-ID header int false "ID of the last notification received"
+// @Success 200 {object} NotificationResponse "Event stream"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /notifications/stream [get]
+func (h *Handler) StreamHandler(c echo.Context) error {
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return helpers.ErrUnauthorized(c, "")
+	}
+	ctx := c.Request().Context()
+
+	// subscribe before reading so nothing added in between is missed
+	wake, unsubscribe := h.broker.Subscribe(principal.MemberID)
+	defer unsubscribe()
+
+	s := &stream{
+		q:        repository.New(h.dbService.GetConnection()),
+		res:      c.Response(),
+		memberID: principal.MemberID,
+		unread:   -1,
+	}
+	var err error
+	s.lastID, err = s.start(ctx, c.Request().Header.Get("Last-Event-ID"))
+	if err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to start notification stream")
+		return helpers.ErrInternal(c, "")
+	}
+
+	// the stream outlives the server's write timeout
+	if err := http.NewResponseController(c.Response()).SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		log.Warn().Err(err).Msg("failed to clear the write deadline of a notification stream")
+	}
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentType, "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("X-Accel-Buffering", "no") // nginx would buffer the stream otherwise
+	c.Response().WriteHeader(http.StatusOK)
+	fmt.Fprintf(c.Response(), "retry: %d\n\n", streamRetry)
+
+	poll := time.NewTicker(h.pollInterval)
+	defer poll.Stop()
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+	maxAge := time.NewTimer(streamMaxAge)
+	defer maxAge.Stop()
+
+	for {
+		if err := s.send(ctx); err != nil {
+			if ctx.Err() == nil {
+				log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("notification stream failed")
+			}
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-maxAge.C:
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(c.Response(), ": ping\n\n"); err != nil {
+				return nil
+			}
+			c.Response().Flush()
+		case <-wake:
+		case <-poll.C:
+		}
+	}
+}
+
+// stream is an open notification stream
+type stream struct {
+	q        *repository.Queries
+	res      *echo.Response
+	memberID int32
+	lastID   int32 // last notification sent
+	unread   int64 // last unread count sent, -1 before the first
+}
+
+// start returns the ID after which notifications are sent: the client's Last-Event-ID,
+// or the member's newest notification for new clients (which list their notifications first)
+func (s *stream) start(ctx context.Context, lastEventID string) (int32, error) {
+	if id, err := strconv.ParseInt(lastEventID, 10, 32); err == nil && id >= 0 {
+		return int32(id), nil
+	}
+	newest, err := s.q.ListNotifications(ctx, repository.ListNotificationsParams{
+		MemberID: s.memberID,
+		ID:       math.MaxInt32,
+		Limit:    1,
+	})
+	if err != nil || len(newest) == 0 {
+		return 0, err
+	}
+	return newest[0].ID, nil
+}
+
+// send writes the notifications added since the last send and the unread count if it changed
+func (s *stream) send(ctx context.Context) error {
+	for {
+		notifications, err := s.q.ListNotificationsAfter(ctx, repository.ListNotificationsAfterParams{
+			MemberID: s.memberID,
+			ID:       s.lastID,
+			Limit:    streamBatch,
+		})
+		if err != nil {
+			return fmt.Errorf("list new notifications: %w", err)
+		}
+		for _, n := range notifications {
+			if err := s.event(strconv.Itoa(int(n.ID)), "notification", toNotificationResponse(n)); err != nil {
+				return err
+			}
+			s.lastID = n.ID
+		}
+		if len(notifications) < streamBatch {
+			break
+		}
+	}
+
+	unread, err := s.q.CountUnreadNotifications(ctx, s.memberID)
+	if err != nil {
+		return fmt.Errorf("count unread notifications: %w", err)
+	}
+	if unread != s.unread {
+		if err := s.event("", "unread_count", UnreadCountResponse{UnreadCount: unread}); err != nil {
+			return err
+		}
+		s.unread = unread
+	}
+
+	s.res.Flush()
+	return nil
+}
+
+// event writes a Server-Sent Event with a JSON payload
+func (s *stream) event(id, name string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		if _, err := fmt.Fprintf(s.res, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(s.res, "event: %s\ndata: %s\n\n", name, payload)
+	return err
+}
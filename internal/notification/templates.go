@@ -0,0 +1,86 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// templateData is what the templates are executed with
+type templateData struct {
+	Name     string // recipient's name
+	AppURL   string // web UI base URL, without a trailing slash
+	Data     any    // the type's data, e.g. RoleGrantedData
+	Required bool   // the type can't be opted out of, so there are no settings to link to
+}
+
+// renderer renders a notification type's subject, text and HTML bodies.
+// Each type's template file defines "subject", "text" and "html"; the HTML body is wrapped in layout.html.
+type renderer struct {
+	text map[string]*texttemplate.Template
+	html map[string]*htmltemplate.Template
+}
+
+func newRenderer() (*renderer, error) {
+	r := &renderer{text: map[string]*texttemplate.Template{}, html: map[string]*htmltemplate.Template{}}
+	types := append([]string(nil), requiredTypes...)
+	for _, info := range Types {
+		types = append(types, info.Type)
+	}
+	for _, t := range types {
+		file := "templates/" + t + ".tmpl"
+
+		text, err := texttemplate.New(t).Option("missingkey=error").ParseFS(templateFS, file)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", file, err)
+		}
+		html, err := htmltemplate.New(t).Option("missingkey=error").ParseFS(templateFS, "templates/layout.html", file)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", file, err)
+		}
+		r.text[t] = text
+		r.html[t] = html
+	}
+	return r, nil
+}
+
+// defaultRenderer holds the embedded templates; they are parsed once and a parse error is a bug
+var defaultRenderer = func() *renderer {
+	r, err := newRenderer()
+	if err != nil {
+		panic(err)
+	}
+	return r
+}()
+
+// render returns the subject, text body and HTML body of a notification
+func (r *renderer) render(notificationType string, data templateData) (subject, text, html string, err error) {
+	textTmpl, ok := r.text[notificationType]
+	if !ok {
+		return "", "", "", fmt.Errorf("unknown notification type %q", notificationType)
+	}
+
+	var buf bytes.Buffer
+	if err := textTmpl.ExecuteTemplate(&buf, "subject", data); err != nil {
+		return "", "", "", fmt.Errorf("render %s subject: %w", notificationType, err)
+	}
+	subject = strings.Join(strings.Fields(buf.String()), " ")
+
+	buf.Reset()
+	if err := textTmpl.ExecuteTemplate(&buf, "text", data); err != nil {
+		return "", "", "", fmt.Errorf("render %s text: %w", notificationType, err)
+	}
+	text = strings.TrimSpace(buf.String()) + "\n"
+
+	buf.Reset()
+	if err := r.html[notificationType].ExecuteTemplate(&buf, "layout", data); err != nil {
+		return "", "", "", fmt.Errorf("render %s html: %w", notificationType, err)
+	}
+	return subject, text, buf.String(), nil
+}
@@ -0,0 +1,82 @@
+package notification
+
+import (
+	"slices"
+	"time"
+)
+
+// Notification types. Each has an email template in templates/<type>.tmpl.
+const (
+	TypeKeyExpiring    = "key_expiring"
+	TypeRoleGranted    = "role_granted"
+	TypeTrackerOverdue = "tracker_overdue"
+)
+
+// Types that are only shown in the in-app inbox
+const (
+	TypeProfileUpdated  = "profile_updated"
+	TypeTrackerReminder = "tracker_reminder"
+)
+
+// Types that are always emailed: they go to people who may not be members, so there are no preferences to check
+const (
+	TypeEventRegistration = "event_registration"
+)
+
+// requiredTypes are the email types members can't opt out of
+var requiredTypes = []string{TypeEventRegistration}
+
+// Types lists the notification types members can opt out of emails of, with a description for the preferences UI
+var Types = []TypeInfo{
+	{Type: TypeKeyExpiring, Description: "One of your API keys is about to expire"},
+	{Type: TypeRoleGranted, Description: "You were granted a role"},
+	{Type: TypeTrackerOverdue, Description: "Documentation or finance requirements of your event are overdue"},
+}
+
+// TypeInfo describes a notification type
+type TypeInfo struct {
+	Type        string `json:"type" example:"role_granted"`
+	Description string `json:"description" example:"You were granted a role"`
+}
+
+// IsType reports whether t is a known notification type
+func IsType(t string) bool {
+	return slices.ContainsFunc(Types, func(info TypeInfo) bool { return info.Type == t })
+}
+
+// isEmailType reports whether t is a notification type with an email template
+func isEmailType(t string) bool {
+	return IsType(t) || slices.Contains(requiredTypes, t)
+}
+
+// KeyExpiringData is the template data of TypeKeyExpiring
+type KeyExpiringData struct {
+	KeyID     int32
+	Project   string
+	ExpiresAt time.Time
+	DaysLeft  int
+}
+
+// RoleGrantedData is the template data of TypeRoleGranted
+type RoleGrantedData struct {
+	RoleID      string
+	RoleName    string
+	Description string
+}
+
+// TrackerOverdueData is the template data of TypeTrackerOverdue
+type TrackerOverdueData struct {
+	EventID   int32
+	EventName string
+	Stage     string
+	Status    string
+	Deadline  time.Time
+}
+
+// EventRegistrationData is the template data of TypeEventRegistration
+type EventRegistrationData struct {
+	EventID   int32
+	EventName string
+	Token     string
+	ExpiresAt time.Time
+}
@@ -0,0 +1,60 @@
+package orgchart
+
+import "github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+
+// Node types
+const (
+	NodeMember    = "member"
+	NodeDivision  = "division"
+	NodeCommittee = "committee"
+)
+
+// Roles of the people in a reporting chain
+const (
+	RoleExecutive     = "executive"
+	RoleDivisionHead  = "division_head"
+	RoleCommitteeHead = "committee_head"
+)
+
+// PersonResponse is a member placed on the org chart
+type PersonResponse struct {
+	MemberID     int32                  `json:"member_id" example:"12312345"`
+	FullName     string                 `json:"full_name" example:"Juan Dela Cruz"`
+	Nickname     helpers.NullableString `json:"nickname"`
+	ImageURL     helpers.NullableString `json:"image_url"`
+	PositionID   string                 `json:"position_id,omitempty" example:"VP"`
+	PositionName string                 `json:"position_name,omitempty" example:"Vice President"`
+}
+
+// Node is a node of the org chart: a member, or a division or committee with its head.
+// Children report to the node (or to its head).
+type Node struct {
+	Type     string          `json:"type" example:"committee"`
+	ID       string          `json:"id" example:"RND"`
+	Name     string          `json:"name" example:"Research and Development"`
+	Member   *PersonResponse `json:"member,omitempty"`
+	Head     *PersonResponse `json:"head,omitempty"`
+	Children []*Node         `json:"children"`
+}
+
+// OrgChartResponse is the response for the GET /org-chart endpoint
+type OrgChartResponse struct {
+	TermID   int32   `json:"term_id" example:"3"`
+	Archived bool    `json:"archived" example:"false"`
+	Roots    []*Node `json:"roots"`
+}
+
+// ChainEntryResponse is a person in a member's reporting chain
+type ChainEntryResponse struct {
+	PersonResponse
+	Role   string `json:"role" example:"committee_head"`
+	UnitID string `json:"unit_id,omitempty" example:"RND"`
+}
+
+// ChainResponse is the response for the GET /members/{id}/chain endpoint.
+// The chain starts with the member's direct superior and ends at the top of the organization.
+type ChainResponse struct {
+	MemberID int32                `json:"member_id" example:"12312345"`
+	TermID   int32                `json:"term_id" example:"3"`
+	Chain    []ChainEntryResponse `json:"chain"`
+}
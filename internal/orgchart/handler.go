@@ -0,0 +1,110 @@
+package orgchart
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/term"
+)
+
+// Output formats of the org chart
+const (
+	FormatJSON = "json"
+	FormatDOT  = "dot"
+	FormatSVG  = "svg"
+)
+
+// Handler serves the org chart and reporting chains
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(dbService database.Service) *Handler {
+	return &Handler{service: NewService(dbService)}
+}
+
+// OrgChartHandler godoc
+// @Summary Get org chart
+// @Description Retrieves the organization as a tree: PRES → EVP → divisions (with their heads) → committees (with their heads) → committee members. Heads that are not assigned are the highest-ranked officer of the division or committee. Past terms use the roster and position ranks archived at rollover.
+// @Tags committees
+// @Produce json
+// @Produce text/vnd.graphviz
+// @Produce image/svg+xml
+// @Param term_id query string false "Term ID or \"current\" (default)"
+// @Param format query string false "Output format: json (default), dot (Graphviz) or svg"
+// @Success 200 {object} OrgChartResponse "Org chart"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid term or format"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "Term not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /org-chart [get]
+func (h *Handler) OrgChartHandler(c echo.Context) error {
+	format := c.QueryParam("format")
+	switch format {
+	case "", FormatJSON, FormatDOT, FormatSVG:
+	default:
+		return helpers.ErrBadRequest(c, "Invalid format, expected json, dot or svg")
+	}
+
+	chart, err := h.service.Build(c.Request().Context(), c.QueryParam("term_id"))
+	if err != nil {
+		return buildError(c, err)
+	}
+
+	switch format {
+	case FormatDOT:
+		return c.Blob(http.StatusOK, "text/vnd.graphviz; charset=utf-8", []byte(renderDOT(chart)))
+	case FormatSVG:
+		return c.Blob(http.StatusOK, "image/svg+xml; charset=utf-8", []byte(renderSVG(chart)))
+	}
+	return c.JSON(http.StatusOK, chart)
+}
+
+// ChainHandler godoc
+// @Summary Get reporting chain
+// @Description Retrieves a member's reporting chain on the org chart, from the direct superior (committee head) up to the president
+// @Tags members
+// @Produce json
+// @Param id path int true "Member ID"
+// @Param term_id query string false "Term ID or \"current\" (default)"
+// @Success 200 {object} ChainResponse "Reporting chain"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid member ID or term"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "Member not on the org chart or term not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security BearerAuth
+// @Router /members/{id}/chain [get]
+func (h *Handler) ChainHandler(c echo.Context) error {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		return helpers.ErrBadRequest(c, "Invalid member ID")
+	}
+
+	resp, ok, err := h.service.Chain(c.Request().Context(), int32(id), c.QueryParam("term_id"))
+	if err != nil {
+		return buildError(c, err)
+	}
+	if !ok {
+		return helpers.ErrNotFound(c, "Member is not on the org chart")
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func buildError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, term.ErrInvalidTerm):
+		return helpers.ErrBadRequest(c, "Invalid term ID")
+	case errors.Is(err, term.ErrNoTerm):
+		return helpers.ErrNotFound(c, "Term not found")
+	}
+	log.Error().Err(err).Msg("failed to build org chart")
+	return helpers.ErrInternal(c, "")
+}
@@ -0,0 +1,129 @@
+package orgchart
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockDBService is a mock implementation of the database.Service interface.
+type mockDBService struct {
+	db *sql.DB
+}
+
+func (m *mockDBService) Health() map[string]string {
+	return nil
+}
+
+func (m *mockDBService) Close() error {
+	return nil
+}
+
+func (m *mockDBService) GetConnection() *sql.DB {
+	return m.db
+}
+
+var termColumns = []string{"id", "term", "start_year", "end_year", "start_date", "end_date", "is_active"}
+
+func expectCurrentChart(mock sqlmock.Sqlmock) {
+	for i := 0; i < 2; i++ {
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").
+			WillReturnRows(sqlmock.NewRows(termColumns).AddRow(3, 1, 2025, 2026, nil, nil, true))
+	}
+	mock.ExpectQuery("SELECT (.+) FROM divisions d").
+		WillReturnRows(sqlmock.NewRows([]string{"division_id", "division_name", "division_head", "head_name"}).
+			AddRow("INT", "Internals", nil, nil))
+	mock.ExpectQuery("SELECT (.+) FROM committees c").
+		WillReturnRows(sqlmock.NewRows([]string{"committee_id", "committee_name", "committee_head", "division_id", "head_name"}).
+			AddRow("RND", "Research and Development", 5, "INT", "Committee Head"))
+	mock.ExpectQuery("SELECT (.+) FROM positions ORDER BY").
+		WillReturnRows(sqlmock.NewRows([]string{"position_id", "position_name", "position_rank"}).
+			AddRow("PRES", "President", 7).
+			AddRow("VP", "Vice President", 5))
+	mock.ExpectQuery("SELECT (.+) FROM members m WHERE").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "nickname", "image_url", "position_id", "committee_id"}).
+			AddRow(1, "Member", nil, nil, "MEM", "RND").
+			AddRow(2, "President", nil, nil, "PRES", nil).
+			AddRow(3, "Vice President", nil, nil, "VP", "RND").
+			AddRow(5, "Committee Head", nil, nil, "CT", "RND"))
+}
+
+func TestChainHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	expectCurrentChart(mock)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/members/1/chain", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	if assert.NoError(t, NewHandler(&mockDBService{db: db}).ChainHandler(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp struct {
+			TermID int32 `json:"term_id"`
+			Chain  []struct {
+				MemberID int32  `json:"member_id"`
+				Role     string `json:"role"`
+				UnitID   string `json:"unit_id"`
+			} `json:"chain"`
+		}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.Equal(t, int32(3), resp.TermID)
+		if assert.Len(t, resp.Chain, 3) {
+			assert.Equal(t, int32(5), resp.Chain[0].MemberID)
+			assert.Equal(t, RoleCommitteeHead, resp.Chain[0].Role)
+			assert.Equal(t, "RND", resp.Chain[0].UnitID)
+			assert.Equal(t, RoleDivisionHead, resp.Chain[1].Role)
+			assert.Equal(t, int32(2), resp.Chain[2].MemberID)
+		}
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOrgChartHandler(t *testing.T) {
+	t.Run("success - svg", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectCurrentChart(mock)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/org-chart?format=svg", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, NewHandler(&mockDBService{db: db}).OrgChartHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Contains(t, rec.Header().Get(echo.HeaderContentType), "image/svg+xml")
+			assert.Contains(t, rec.Body.String(), "Research and Development")
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - all terms", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/org-chart?term_id=all", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		if assert.NoError(t, NewHandler(&mockDBService{db: db}).OrgChartHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package orgchart
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// SVG layout, in pixels
+const (
+	boxWidth   = 200
+	boxHeight  = 44
+	hGap       = 16
+	vGap       = 40
+	margin     = 16
+	lineHeight = 16
+)
+
+// renderDOT renders the org chart as a Graphviz digraph
+func renderDOT(chart *OrgChartResponse) string {
+	var b strings.Builder
+	b.WriteString("digraph orgchart {\n")
+	b.WriteString("\trankdir=TB;\n")
+	b.WriteString("\tnode [shape=box, style=rounded, fontname=\"Helvetica\"];\n")
+
+	var walk func(parent *Node, nodes []*Node)
+	walk = func(parent *Node, nodes []*Node) {
+		for _, n := range nodes {
+			attrs := ""
+			if n.Type != NodeMember {
+				attrs = `, style="rounded,filled", fillcolor="#eef2f7"`
+			}
+			fmt.Fprintf(&b, "\t%s [label=%s%s];\n", dotQuote(dotID(n)), dotQuote(strings.Join(nodeLabel(n), "\n")), attrs)
+			if parent != nil {
+				fmt.Fprintf(&b, "\t%s -> %s;\n", dotQuote(dotID(parent)), dotQuote(dotID(n)))
+			}
+			walk(n, n.Children)
+		}
+	}
+	walk(nil, chart.Roots)
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// renderSVG renders the org chart as a top-down tree. Leaves are laid out left to right
+// and each parent is centered over its children.
+func renderSVG(chart *OrgChartResponse) string {
+	type box struct {
+		node  *Node
+		x, y  float64
+		child []int
+	}
+	var boxes []box
+	slots, depth := 0, 0
+
+	var place func(n *Node, level int) int
+	place = func(n *Node, level int) int {
+		if level > depth {
+			depth = level
+		}
+		b := box{node: n, y: float64(margin + level*(boxHeight+vGap))}
+		for _, c := range n.Children {
+			b.child = append(b.child, place(c, level+1))
+		}
+		if len(b.child) == 0 {
+			b.x = float64(margin + slots*(boxWidth+hGap))
+			slots++
+		} else {
+			b.x = (boxes[b.child[0]].x + boxes[b.child[len(b.child)-1]].x) / 2
+		}
+		boxes = append(boxes, b)
+		return len(boxes) - 1
+	}
+	for _, root := range chart.Roots {
+		place(root, 0)
+	}
+
+	width, height := 2*margin, 2*margin
+	if slots > 0 {
+		width += slots*(boxWidth+hGap) - hGap
+		height += (depth+1)*(boxHeight+vGap) - vGap
+	}
+
+	var s strings.Builder
+	fmt.Fprintf(&s, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="Helvetica, Arial, sans-serif" font-size="12">`+"\n",
+		width, height, width, height)
+
+	for _, b := range boxes {
+		for _, i := range b.child {
+			c := boxes[i]
+			mid := b.y + boxHeight + vGap/2
+			fmt.Fprintf(&s, `<path d="M%.1f %.1f V%.1f H%.1f V%.1f" fill="none" stroke="#8a94a6"/>`+"\n",
+				b.x+boxWidth/2, b.y+boxHeight, mid, c.x+boxWidth/2, c.y)
+		}
+	}
+
+	for _, b := range boxes {
+		fill := "#ffffff"
+		if b.node.Type != NodeMember {
+			fill = "#eef2f7"
+		}
+		fmt.Fprintf(&s, `<rect x="%.1f" y="%.1f" width="%d" height="%d" rx="6" fill="%s" stroke="#8a94a6"/>`+"\n",
+			b.x, b.y, boxWidth, boxHeight, fill)
+
+		lines := nodeLabel(b.node)
+		top := b.y + boxHeight/2 - float64(len(lines)-1)*lineHeight/2
+		for i, line := range lines {
+			weight := ""
+			if i == 0 {
+				weight = ` font-weight="bold"`
+			}
+			fmt.Fprintf(&s, `<text x="%.1f" y="%.1f" text-anchor="middle" dominant-baseline="middle"%s>%s</text>`+"\n",
+				b.x+boxWidth/2, top+float64(i*lineHeight), weight, html.EscapeString(line))
+		}
+	}
+
+	s.WriteString("</svg>\n")
+	return s.String()
+}
+
+// nodeLabel returns the lines shown for a node: the name, then the position or the unit's head
+func nodeLabel(n *Node) []string {
+	if n.Type == NodeMember {
+		position := n.Member.PositionName
+		if position == "" {
+			position = n.Member.PositionID
+		}
+		if position == "" {
+			return []string{n.Name}
+		}
+		return []string{n.Name, position}
+	}
+
+	if n.Head == nil {
+		return []string{n.Name, "No head"}
+	}
+	return []string{n.Name, n.Head.FullName}
+}
+
+func dotID(n *Node) string {
+	return n.Type + ":" + n.ID
+}
+
+// dotQuote quotes a DOT string, keeping line breaks as \n escapes
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,104 @@
+package orgchart
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+	"github.com/dlsu-lscs/lscs-core-api/internal/term"
+)
+
+// Service builds the org chart of a term from divisions, committees, positions and members
+type Service struct {
+	dbService database.Service
+	terms     *term.Resolver
+}
+
+func NewService(dbService database.Service) *Service {
+	return &Service{dbService: dbService, terms: term.NewResolver(dbService)}
+}
+
+// Build returns the org chart of a term. termParam is a term ID, or "" / "current" for the current term.
+// Returns term.ErrInvalidTerm for "all" or a malformed term, and term.ErrNoTerm if the term does not exist.
+func (s *Service) Build(ctx context.Context, termParam string) (*OrgChartResponse, error) {
+	q := repository.New(s.dbService.GetConnection())
+
+	termID, all, err := s.terms.Resolve(ctx, termParam)
+	if err != nil {
+		return nil, err
+	}
+	if all {
+		return nil, term.ErrInvalidTerm
+	}
+
+	current, err := s.terms.Current(ctx)
+	if err != nil {
+		return nil, err
+	}
+	archived := termID != current.ID
+	if archived {
+		if _, err := q.GetTerm(ctx, termID); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, term.ErrNoTerm
+			}
+			return nil, fmt.Errorf("get term: %w", err)
+		}
+	}
+
+	d := orgData{positions: map[string]string{}, assignedHeads: !archived}
+
+	if d.divisions, err = q.ListDivisionsWithHeads(ctx); err != nil {
+		return nil, fmt.Errorf("list divisions: %w", err)
+	}
+	if d.committees, err = q.ListCommitteesWithHeads(ctx); err != nil {
+		return nil, fmt.Errorf("list committees: %w", err)
+	}
+
+	positions, err := q.ListPositions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list positions: %w", err)
+	}
+	for _, p := range positions {
+		d.positions[p.PositionID] = p.PositionName
+	}
+
+	if archived {
+		ranks, err := q.ListTermPositionRanks(ctx, termID)
+		if err != nil {
+			return nil, fmt.Errorf("list term ranks: %w", err)
+		}
+		d.ranks = make(map[string]int, len(ranks))
+		for _, r := range ranks {
+			d.ranks[r.PositionID] = int(r.PositionRank)
+		}
+
+		roster, err := q.ListOrgChartRoster(ctx, termID)
+		if err != nil {
+			return nil, fmt.Errorf("list term roster: %w", err)
+		}
+		for _, r := range roster {
+			d.members = append(d.members, repository.ListOrgChartMembersRow(r))
+		}
+	} else if d.members, err = q.ListOrgChartMembers(ctx); err != nil {
+		return nil, fmt.Errorf("list members: %w", err)
+	}
+
+	return &OrgChartResponse{TermID: termID, Archived: archived, Roots: buildTree(d)}, nil
+}
+
+// Chain returns the reporting chain of a member in a term, nearest superior first.
+// ok is false if the member is not on the term's org chart.
+func (s *Service) Chain(ctx context.Context, memberID int32, termParam string) (resp *ChainResponse, ok bool, err error) {
+	chart, err := s.Build(ctx, termParam)
+	if err != nil {
+		return nil, false, err
+	}
+
+	superiors, ok := chain(chart.Roots, memberID)
+	if !ok {
+		return nil, false, nil
+	}
+	return &ChainResponse{MemberID: memberID, TermID: chart.TermID, Chain: superiors}, true, nil
+}
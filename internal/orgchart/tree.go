@@ -0,0 +1,257 @@
+package orgchart
+
+import (
+	"database/sql"
+	"sort"
+	"strconv"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// orgData is what the org chart is built from. Members and ranks of past terms come from the archive.
+type orgData struct {
+	divisions  []repository.ListDivisionsWithHeadsRow
+	committees []repository.ListCommitteesWithHeadsRow
+	members    []repository.ListOrgChartMembersRow
+	positions  map[string]string
+	// ranks is the position hierarchy of a past term, archived at rollover.
+	// Positions it does not have, and the current term, use the ranks of the positions.
+	ranks map[string]int
+	// assignedHeads uses the committee and division heads as currently assigned.
+	// They are not archived, so past terms derive all heads from positions.
+	assignedHeads bool
+}
+
+// buildTree arranges the organization as PRES → EVP → divisions (headed by their VP) →
+// committees (headed by their committee head) → committee members.
+// Executives are officers without a committee, each rank reporting to the rank above.
+// Heads that are not assigned are derived: the highest-ranked officer of the unit.
+func buildTree(d orgData) []*Node {
+	level := func(positionID string) int {
+		if r, ok := d.ranks[positionID]; ok {
+			return r
+		}
+		return auth.GetPositionLevel(positionID)
+	}
+	memberLevel := level("MEM")
+	rank := func(m repository.ListOrgChartMembersRow) int {
+		return level(m.PositionID.String)
+	}
+
+	members := append([]repository.ListOrgChartMembersRow(nil), d.members...)
+	sort.SliceStable(members, func(i, j int) bool { return rank(members[i]) > rank(members[j]) })
+
+	known := map[string]bool{}
+	for _, cm := range d.committees {
+		known[cm.CommitteeID] = true
+	}
+
+	people := map[int32]*PersonResponse{}
+	byCommittee := map[string][]repository.ListOrgChartMembersRow{}
+	var executives []repository.ListOrgChartMembersRow
+	for _, m := range members {
+		people[m.ID] = toPersonResponse(m, d.positions)
+		switch {
+		case known[m.CommitteeID.String]:
+			byCommittee[m.CommitteeID.String] = append(byCommittee[m.CommitteeID.String], m)
+		case rank(m) > memberLevel:
+			executives = append(executives, m)
+		}
+	}
+
+	// placed tracks heads so they are not listed again as committee members
+	placed := map[int32]bool{}
+	assigned := func(head sql.NullInt32) *PersonResponse {
+		if !d.assignedHeads || !head.Valid || placed[head.Int32] {
+			return nil
+		}
+		return people[head.Int32]
+	}
+	derived := func(candidates []repository.ListOrgChartMembersRow) *PersonResponse {
+		for _, m := range candidates {
+			if rank(m) > memberLevel && !placed[m.ID] {
+				return people[m.ID]
+			}
+		}
+		return nil
+	}
+
+	divisionHeads := map[string]*PersonResponse{}
+	for _, div := range d.divisions {
+		head := assigned(div.DivisionHead)
+		if head == nil {
+			var candidates []repository.ListOrgChartMembersRow
+			for _, cm := range d.committees {
+				if cm.DivisionID.String == div.DivisionID {
+					candidates = append(candidates, byCommittee[cm.CommitteeID]...)
+				}
+			}
+			sort.SliceStable(candidates, func(i, j int) bool { return rank(candidates[i]) > rank(candidates[j]) })
+			head = derived(candidates)
+		}
+		if head != nil {
+			placed[head.MemberID] = true
+			divisionHeads[div.DivisionID] = head
+		}
+	}
+
+	byDivision := map[string][]*Node{}
+	var unassigned []*Node
+	for _, cm := range d.committees {
+		head := assigned(cm.CommitteeHead)
+		if head == nil {
+			head = derived(byCommittee[cm.CommitteeID])
+		}
+		if head != nil {
+			placed[head.MemberID] = true
+		}
+
+		node := &Node{Type: NodeCommittee, ID: cm.CommitteeID, Name: cm.CommitteeName, Head: head, Children: []*Node{}}
+		for _, m := range byCommittee[cm.CommitteeID] {
+			if !placed[m.ID] {
+				node.Children = append(node.Children, memberNode(people[m.ID]))
+			}
+		}
+
+		if cm.DivisionID.Valid {
+			byDivision[cm.DivisionID.String] = append(byDivision[cm.DivisionID.String], node)
+		} else {
+			unassigned = append(unassigned, node)
+		}
+	}
+
+	units := make([]*Node, 0, len(d.divisions)+len(unassigned))
+	for _, div := range d.divisions {
+		node := &Node{
+			Type:     NodeDivision,
+			ID:       div.DivisionID,
+			Name:     div.DivisionName,
+			Head:     divisionHeads[div.DivisionID],
+			Children: byDivision[div.DivisionID],
+		}
+		if node.Children == nil {
+			node.Children = []*Node{}
+		}
+		units = append(units, node)
+	}
+	units = append(units, unassigned...)
+
+	roots := []*Node{}
+	var parent *Node
+	for i := 0; i < len(executives); {
+		level := rank(executives[i])
+		var first *Node
+		for ; i < len(executives) && rank(executives[i]) == level; i++ {
+			node := memberNode(people[executives[i].ID])
+			if parent == nil {
+				roots = append(roots, node)
+			} else {
+				parent.Children = append(parent.Children, node)
+			}
+			if first == nil {
+				first = node
+			}
+		}
+		parent = first
+	}
+
+	if parent == nil {
+		return append(roots, units...)
+	}
+	parent.Children = append(parent.Children, units...)
+	return roots
+}
+
+// chain returns the superiors of a member, nearest first.
+// ok is false if the member is not on the chart.
+func chain(roots []*Node, memberID int32) (superiors []ChainEntryResponse, ok bool) {
+	var path []ChainEntryResponse
+
+	var walk func(nodes []*Node) bool
+	walk = func(nodes []*Node) bool {
+		for _, n := range nodes {
+			self := n.Member
+			if self == nil {
+				self = n.Head
+			}
+			if self != nil && self.MemberID == memberID {
+				return true
+			}
+
+			entry, superior := n.superior()
+			if superior {
+				path = append(path, entry)
+			}
+			if walk(n.Children) {
+				return true
+			}
+			if superior {
+				path = path[:len(path)-1]
+			}
+		}
+		return false
+	}
+
+	if !walk(roots) {
+		return nil, false
+	}
+
+	superiors = make([]ChainEntryResponse, 0, len(path))
+	for i := len(path) - 1; i >= 0; i-- {
+		superiors = append(superiors, path[i])
+	}
+	return superiors, true
+}
+
+// Find returns the node of the given type and ID, or nil if it is not on the chart
+func (r *OrgChartResponse) Find(nodeType, id string) *Node {
+	var find func(nodes []*Node) *Node
+	find = func(nodes []*Node) *Node {
+		for _, n := range nodes {
+			if n.Type == nodeType && n.ID == id {
+				return n
+			}
+			if found := find(n.Children); found != nil {
+				return found
+			}
+		}
+		return nil
+	}
+	return find(r.Roots)
+}
+
+// superior returns the person the node's children report to
+func (n *Node) superior() (ChainEntryResponse, bool) {
+	switch {
+	case n.Type == NodeMember && n.Member != nil:
+		return ChainEntryResponse{PersonResponse: *n.Member, Role: RoleExecutive}, true
+	case n.Type == NodeDivision && n.Head != nil:
+		return ChainEntryResponse{PersonResponse: *n.Head, Role: RoleDivisionHead, UnitID: n.ID}, true
+	case n.Type == NodeCommittee && n.Head != nil:
+		return ChainEntryResponse{PersonResponse: *n.Head, Role: RoleCommitteeHead, UnitID: n.ID}, true
+	}
+	return ChainEntryResponse{}, false
+}
+
+func memberNode(p *PersonResponse) *Node {
+	return &Node{
+		Type:     NodeMember,
+		ID:       strconv.FormatInt(int64(p.MemberID), 10),
+		Name:     p.FullName,
+		Member:   p,
+		Children: []*Node{},
+	}
+}
+
+func toPersonResponse(m repository.ListOrgChartMembersRow, positions map[string]string) *PersonResponse {
+	return &PersonResponse{
+		MemberID:     m.ID,
+		FullName:     m.FullName,
+		Nickname:     helpers.NullableString{NullString: m.Nickname},
+		ImageURL:     helpers.NullableString{NullString: m.ImageUrl},
+		PositionID:   m.PositionID.String,
+		PositionName: positions[m.PositionID.String],
+	}
+}
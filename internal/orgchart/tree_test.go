@@ -0,0 +1,171 @@
+package orgchart
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+func valid(s string) sql.NullString { return sql.NullString{String: s, Valid: true} }
+
+func member(id int32, name, position, committee string) repository.ListOrgChartMembersRow {
+	m := repository.ListOrgChartMembersRow{ID: id, FullName: name}
+	if position != "" {
+		m.PositionID = valid(position)
+	}
+	if committee != "" {
+		m.CommitteeID = valid(committee)
+	}
+	return m
+}
+
+func testData(assignedHeads bool) orgData {
+	return orgData{
+		divisions: []repository.ListDivisionsWithHeadsRow{{DivisionID: "INT", DivisionName: "Internals"}},
+		committees: []repository.ListCommitteesWithHeadsRow{
+			{CommitteeID: "RND", CommitteeName: "Research and Development", DivisionID: valid("INT"),
+				CommitteeHead: sql.NullInt32{Int32: 5, Valid: true}},
+			{CommitteeID: "TMP", CommitteeName: "Temporary"},
+		},
+		members: []repository.ListOrgChartMembersRow{
+			member(1, "Member", "MEM", "RND"),
+			member(2, "President", "PRES", ""),
+			member(3, "Vice President", "VP", "RND"),
+			member(4, "Executive Vice President", "EVP", ""),
+			member(5, "Committee Trainee", "CT", "RND"),
+			member(6, "Assistant Vice President", "AVP", "RND"),
+		},
+		positions:     map[string]string{"PRES": "President", "VP": "Vice President"},
+		assignedHeads: assignedHeads,
+	}
+}
+
+func TestBuildTree(t *testing.T) {
+	roots := buildTree(testData(true))
+
+	if !assert.Len(t, roots, 1) {
+		return
+	}
+	pres := roots[0]
+	assert.Equal(t, int32(2), pres.Member.MemberID)
+	assert.Equal(t, "President", pres.Member.PositionName)
+	if !assert.Len(t, pres.Children, 1) {
+		return
+	}
+	evp := pres.Children[0]
+	assert.Equal(t, int32(4), evp.Member.MemberID)
+	if !assert.Len(t, evp.Children, 2) {
+		return
+	}
+
+	division := evp.Children[0]
+	assert.Equal(t, NodeDivision, division.Type)
+	if assert.NotNil(t, division.Head) {
+		assert.Equal(t, int32(3), division.Head.MemberID)
+	}
+	if assert.Len(t, division.Children, 1) {
+		rnd := division.Children[0]
+		// the assigned head is used even though the AVP ranks higher
+		if assert.NotNil(t, rnd.Head) {
+			assert.Equal(t, int32(5), rnd.Head.MemberID)
+		}
+		var ids []string
+		for _, n := range rnd.Children {
+			ids = append(ids, n.ID)
+		}
+		assert.Equal(t, []string{"6", "1"}, ids)
+	}
+
+	unassigned := evp.Children[1]
+	assert.Equal(t, "TMP", unassigned.ID)
+	assert.Nil(t, unassigned.Head)
+	assert.Empty(t, unassigned.Children)
+}
+
+func TestBuildTreeDerivedHeads(t *testing.T) {
+	roots := buildTree(testData(false))
+
+	rnd := roots[0].Children[0].Children[0].Children[0]
+	if assert.NotNil(t, rnd.Head) {
+		assert.Equal(t, int32(6), rnd.Head.MemberID)
+	}
+	assert.Len(t, rnd.Children, 2)
+}
+
+func TestBuildTreeTermRanks(t *testing.T) {
+	// in this past term trainees outranked AVPs
+	d := testData(false)
+	d.ranks = map[string]int{"CT": 4, "AVP": 3}
+	roots := buildTree(d)
+
+	rnd := roots[0].Children[0].Children[0].Children[0]
+	if assert.NotNil(t, rnd.Head) {
+		assert.Equal(t, int32(5), rnd.Head.MemberID)
+	}
+	var ids []string
+	for _, n := range rnd.Children {
+		ids = append(ids, n.ID)
+	}
+	assert.Equal(t, []string{"6", "1"}, ids)
+}
+
+func TestChain(t *testing.T) {
+	roots := buildTree(testData(true))
+
+	superiors, ok := chain(roots, 1)
+	if assert.True(t, ok) {
+		var got []string
+		for _, s := range superiors {
+			got = append(got, s.Role+":"+s.FullName)
+		}
+		assert.Equal(t, []string{
+			"committee_head:Committee Trainee",
+			"division_head:Vice President",
+			"executive:Executive Vice President",
+			"executive:President",
+		}, got)
+	}
+
+	superiors, ok = chain(roots, 3)
+	if assert.True(t, ok) {
+		assert.Len(t, superiors, 2)
+	}
+
+	superiors, ok = chain(roots, 2)
+	assert.True(t, ok)
+	assert.Empty(t, superiors)
+
+	_, ok = chain(roots, 99)
+	assert.False(t, ok)
+}
+
+func TestFind(t *testing.T) {
+	chart := &OrgChartResponse{Roots: buildTree(testData(true))}
+
+	if rnd := chart.Find(NodeCommittee, "RND"); assert.NotNil(t, rnd) {
+		assert.Equal(t, int32(5), rnd.Head.MemberID)
+	}
+	if division := chart.Find(NodeDivision, "INT"); assert.NotNil(t, division) {
+		assert.Equal(t, int32(3), division.Head.MemberID)
+	}
+	assert.Nil(t, chart.Find(NodeDivision, "RND"))
+	assert.Nil(t, chart.Find(NodeCommittee, "XYZ"))
+}
+
+func TestRender(t *testing.T) {
+	chart := &OrgChartResponse{TermID: 1, Roots: buildTree(testData(true))}
+
+	dot := renderDOT(chart)
+	assert.True(t, strings.HasPrefix(dot, "digraph orgchart {"))
+	assert.Contains(t, dot, `"member:2" -> "member:4";`)
+	assert.Contains(t, dot, `"committee:RND" [label="Research and Development\nCommittee Trainee"`)
+
+	svg := renderSVG(chart)
+	assert.True(t, strings.HasPrefix(svg, "<svg"))
+	assert.Equal(t, 7, strings.Count(svg, "<rect"))
+	assert.Equal(t, 6, strings.Count(svg, "<path"))
+}
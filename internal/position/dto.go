@@ -0,0 +1,36 @@
+package position
+
+import "github.com/dlsu-lscs/lscs-core-api/internal/repository"
+
+// CreatePositionRequest represents the request body for creating a position
+type CreatePositionRequest struct {
+	PositionID   string `json:"position_id" validate:"required,max=10,alphanum" example:"COS"`
+	PositionName string `json:"position_name" validate:"required,max=100" example:"Chief of Staff"`
+	Rank         int32  `json:"rank" validate:"gte=0,lte=100" example:"6"`
+}
+
+// UpdatePositionRequest represents the request body for renaming or re-ranking a position
+type UpdatePositionRequest struct {
+	PositionName string `json:"position_name" validate:"required,max=100" example:"Chief of Staff"`
+	Rank         int32  `json:"rank" validate:"gte=0,lte=100" example:"6"`
+}
+
+// PositionResponse represents a position. Higher ranks have more authority; rank 0 has none.
+type PositionResponse struct {
+	PositionID   string `json:"position_id" example:"VP"`
+	PositionName string `json:"position_name" example:"Vice President"`
+	Rank         int32  `json:"rank" example:"5"`
+}
+
+// ListPositionsResponse is the response for GET /positions, highest rank first
+type ListPositionsResponse struct {
+	Positions []PositionResponse `json:"positions"`
+}
+
+func toPositionResponse(p repository.Position) PositionResponse {
+	return PositionResponse{
+		PositionID:   p.PositionID,
+		PositionName: p.PositionName,
+		Rank:         p.PositionRank,
+	}
+}
@@ -0,0 +1,256 @@
+package position
+
+import (
+	"database/sql"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// Handler manages positions and their ranks. Ranks are the position hierarchy used for
+// authorization, so changes reload the cached hierarchy. Changes are restricted to admins by the routes.
+type Handler struct {
+	dbService database.Service
+}
+
+func NewHandler(dbService database.Service) *Handler {
+	return &Handler{dbService: dbService}
+}
+
+// ListPositionsHandler lists the positions
+// @Summary List positions
+// @Description List the positions, highest rank first
+// @Tags positions
+// @Produce json
+// @Success 200 {object} ListPositionsResponse "List of positions"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /positions [get]
+func (h *Handler) ListPositionsHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	positions, err := q.ListPositions(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list positions")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	resp := ListPositionsResponse{Positions: make([]PositionResponse, 0, len(positions))}
+	for _, p := range positions {
+		resp.Positions = append(resp.Positions, toPositionResponse(p))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetPositionHandler returns a position
+// @Summary Get position
+// @Description Get a position by ID
+// @Tags positions
+// @Produce json
+// @Param id path string true "Position ID"
+// @Success 200 {object} PositionResponse "Position"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "Position not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /positions/{id} [get]
+func (h *Handler) GetPositionHandler(c echo.Context) error {
+	return h.respondWithPosition(c, http.StatusOK, normalizeID(c.Param("id")))
+}
+
+// CreatePositionHandler creates a position
+// @Summary Create position
+// @Description Create a position with its rank in the hierarchy. Admin only.
+// @Tags positions
+// @Accept json
+// @Produce json
+// @Param request body CreatePositionRequest true "Position"
+// @Success 201 {object} PositionResponse "Created position"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 409 {object} helpers.ErrorResponse "Position already exists"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /positions [post]
+func (h *Handler) CreatePositionHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	var req CreatePositionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	req.PositionID = normalizeID(req.PositionID)
+	req.PositionName = strings.TrimSpace(req.PositionName)
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	if err := q.CreatePosition(ctx, repository.CreatePositionParams{
+		PositionID:   req.PositionID,
+		PositionName: req.PositionName,
+		PositionRank: req.Rank,
+	}); err != nil {
+		if helpers.IsDuplicateEntry(err) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": "Position already exists"})
+		}
+		log.Error().Err(err).Msg("failed to create position")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	h.reloadLevels(c)
+	log.Info().
+		Str("position_id", req.PositionID).
+		Int32("rank", req.Rank).
+		Int32("created_by", principalID(c)).
+		Msg("position created")
+
+	return h.respondWithPosition(c, http.StatusCreated, req.PositionID)
+}
+
+// UpdatePositionHandler renames or re-ranks a position
+// @Summary Update position
+// @Description Rename a position or change its rank in the hierarchy. Admin only.
+// @Tags positions
+// @Accept json
+// @Produce json
+// @Param id path string true "Position ID"
+// @Param request body UpdatePositionRequest true "Position"
+// @Success 200 {object} PositionResponse "Updated position"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Position not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /positions/{id} [put]
+func (h *Handler) UpdatePositionHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+	id := normalizeID(c.Param("id"))
+
+	var req UpdatePositionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	req.PositionName = strings.TrimSpace(req.PositionName)
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	existing, err := q.GetPosition(ctx, id)
+	if err != nil {
+		return positionError(c, err, id)
+	}
+
+	if err := q.UpdatePosition(ctx, repository.UpdatePositionParams{
+		PositionName: req.PositionName,
+		PositionRank: req.Rank,
+		PositionID:   id,
+	}); err != nil {
+		log.Error().Err(err).Str("position_id", id).Msg("failed to update position")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	h.reloadLevels(c)
+	log.Info().
+		Str("position_id", id).
+		Int32("previous_rank", existing.PositionRank).
+		Int32("rank", req.Rank).
+		Int32("updated_by", principalID(c)).
+		Msg("position updated")
+
+	return h.respondWithPosition(c, http.StatusOK, id)
+}
+
+// DeletePositionHandler deletes a position
+// @Summary Delete position
+// @Description Delete a position. Positions still held by members cannot be deleted. Admin only.
+// @Tags positions
+// @Produce json
+// @Param id path string true "Position ID"
+// @Success 200 {object} map[string]string "Position deleted"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Position not found"
+// @Failure 409 {object} helpers.ErrorResponse "Position is held by members"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /positions/{id} [delete]
+func (h *Handler) DeletePositionHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+	id := normalizeID(c.Param("id"))
+
+	// members.position_id is set to NULL on delete, which would silently demote them
+	holders, err := q.CountMembersWithPosition(ctx, sql.NullString{String: id, Valid: true})
+	if err != nil {
+		log.Error().Err(err).Str("position_id", id).Msg("failed to count position holders")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	if holders > 0 {
+		return c.JSON(http.StatusConflict, map[string]string{"error": "Position is held by members and cannot be deleted"})
+	}
+
+	rows, err := q.DeletePosition(ctx, id)
+	if err != nil {
+		log.Error().Err(err).Str("position_id", id).Msg("failed to delete position")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	if rows == 0 {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Position not found"})
+	}
+
+	h.reloadLevels(c)
+	log.Info().Str("position_id", id).Int32("deleted_by", principalID(c)).Msg("position deleted")
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Position deleted successfully"})
+}
+
+// reloadLevels refreshes the cached hierarchy. A failure is only logged:
+// the change is saved and the refresh job retries.
+func (h *Handler) reloadLevels(c echo.Context) {
+	if err := auth.LoadPositionLevels(c.Request().Context(), h.dbService); err != nil {
+		log.Error().Err(err).Msg("failed to reload position hierarchy")
+	}
+}
+
+func (h *Handler) respondWithPosition(c echo.Context, status int, id string) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	p, err := q.GetPosition(c.Request().Context(), id)
+	if err != nil {
+		return positionError(c, err, id)
+	}
+	return c.JSON(status, toPositionResponse(p))
+}
+
+func positionError(c echo.Context, err error, id string) error {
+	if err == sql.ErrNoRows {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Position not found"})
+	}
+	log.Error().Err(err).Str("position_id", id).Msg("failed to get position")
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+}
+
+// normalizeID returns position IDs the way they are stored (e.g. "VP")
+func normalizeID(id string) string {
+	return strings.ToUpper(strings.TrimSpace(id))
+}
+
+func principalID(c echo.Context) int32 {
+	if principal, ok := auth.GetPrincipal(c); ok {
+		return principal.MemberID
+	}
+	return 0
+}
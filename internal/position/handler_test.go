@@ -0,0 +1,94 @@
+package position
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+)
+
+// mockDBService is a mock implementation of the database.Service interface.
+type mockDBService struct {
+	db *sql.DB
+}
+
+func (m *mockDBService) Health() map[string]string {
+	return nil
+}
+
+func (m *mockDBService) Close() error {
+	return nil
+}
+
+func (m *mockDBService) GetConnection() *sql.DB {
+	return m.db
+}
+
+var positionColumns = []string{"position_id", "position_name", "position_rank"}
+
+func TestCreatePositionHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec("INSERT INTO positions").
+		WithArgs("COS", "Chief of Staff", int32(6)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// the cached hierarchy is reloaded
+	mock.ExpectQuery("SELECT (.+) FROM positions ORDER BY").
+		WillReturnRows(sqlmock.NewRows(positionColumns).
+			AddRow("PRES", "President", 7).
+			AddRow("COS", "Chief of Staff", 6).
+			AddRow("EVP", "Executive Vice President", 6).
+			AddRow("VP", "Vice President", 5).
+			AddRow("AVP", "Assistant Vice President", 4).
+			AddRow("CT", "Committee Trainee", 3).
+			AddRow("JO", "Junior Officer", 2).
+			AddRow("MEM", "Member", 1))
+	mock.ExpectQuery("SELECT (.+) FROM positions WHERE position_id = ?").
+		WithArgs("COS").
+		WillReturnRows(sqlmock.NewRows(positionColumns).AddRow("COS", "Chief of Staff", 6))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/positions",
+		strings.NewReader(`{"position_id":"cos","position_name":"Chief of Staff","rank":6}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if assert.NoError(t, NewHandler(&mockDBService{db: db}).CreatePositionHandler(c)) {
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Equal(t, 6, auth.GetPositionLevel("COS"))
+		assert.True(t, auth.IsHigherPosition("COS", "VP"))
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeletePositionHandlerHeld(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM members WHERE position_id = \\?").
+		WithArgs(sql.NullString{String: "VP", Valid: true}).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodDelete, "/positions/vp", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("vp")
+
+	if assert.NoError(t, NewHandler(&mockDBService{db: db}).DeletePositionHandler(c)) {
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,204 @@
+package publicity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// PubRequestRequest represents the request body for submitting or replacing a publicity request
+type PubRequestRequest struct {
+	PubType     *string   `json:"pub_type,omitempty" validate:"omitempty,max=255" example:"Event poster"`
+	PubDriveID  *string   `json:"pub_drive_id,omitempty" validate:"omitempty,max=255" example:"1AbCdEfGh"`
+	PostingDate time.Time `json:"posting_date" validate:"required" example:"2026-10-28T18:00:00+08:00"`
+	PubDetails  string    `json:"pub_details" validate:"required" example:"Teaser for the workshop, use the RND color palette"`
+	PubContent  string    `json:"pub_content" example:"Learn Go in one afternoon!"`
+	Caption     string    `json:"caption" example:"Join us this Friday for Intro to Go!"`
+	OPANumbers  string    `json:"opa_numbers" validate:"max=255" example:"OPA-2526-0123"`
+	// ForPosting is false for materials that are not posted on the org's pages (default true)
+	ForPosting *bool   `json:"for_posting,omitempty" example:"true"`
+	Dimensions *string `json:"dimensions,omitempty" validate:"omitempty,max=255" example:"1080x1350"`
+}
+
+// TransitionRequest represents the request body for changing the status of a publicity request
+type TransitionRequest struct {
+	Status  string  `json:"status" validate:"required,max=50" example:"APPROVED"`
+	Comment *string `json:"comment,omitempty" validate:"omitempty,max=2000" example:"Looks good!"`
+}
+
+// AssignPubHeadRequest represents the request body for assigning the publicity head of a request.
+// 0 unassigns the current head.
+type AssignPubHeadRequest struct {
+	MemberID int32 `json:"member_id" validate:"gte=0" example:"12312345"`
+}
+
+// CommentRequest represents the request body for commenting on a publicity request
+type CommentRequest struct {
+	Body string `json:"body" validate:"required,max=2000" example:"Can we use the other logo?"`
+}
+
+// MemberResponse represents the requester or publicity head of a request
+type MemberResponse struct {
+	ID       int32  `json:"id" example:"12312345"`
+	FullName string `json:"full_name" example:"Juan Dela Cruz"`
+}
+
+// CommentResponse represents a comment or status change of a publicity request
+type CommentResponse struct {
+	ID         int32                  `json:"id" example:"1"`
+	Member     *MemberResponse        `json:"member,omitempty"`
+	Body       helpers.NullableString `json:"body"`
+	FromStatus helpers.NullableString `json:"from_status"`
+	ToStatus   helpers.NullableString `json:"to_status"`
+	CreatedAt  *time.Time             `json:"created_at,omitempty"`
+}
+
+// PubRequestResponse represents a publicity request.
+// Comments are only included when fetching a single request.
+type PubRequestResponse struct {
+	ID          int32                  `json:"id" example:"1"`
+	EventID     *int32                 `json:"event_id,omitempty" example:"1"`
+	EventName   helpers.NullableString `json:"event_name"`
+	CommitteeID helpers.NullableString `json:"committee_id"`
+	PubType     helpers.NullableString `json:"pub_type"`
+	PubDriveID  string                 `json:"pub_drive_id" example:"1AbCdEfGh"`
+	Status      string                 `json:"status" example:"FOR_REVIEW"`
+	PostingDate time.Time              `json:"posting_date" example:"2026-10-28T18:00:00+08:00"`
+	PubDetails  string                 `json:"pub_details" example:"Teaser for the workshop, use the RND color palette"`
+	PubContent  string                 `json:"pub_content" example:"Learn Go in one afternoon!"`
+	Caption     string                 `json:"caption" example:"Join us this Friday for Intro to Go!"`
+	OPANumbers  string                 `json:"opa_numbers" example:"OPA-2526-0123"`
+	ForPosting  bool                   `json:"for_posting" example:"true"`
+	Dimensions  helpers.NullableString `json:"dimensions"`
+	Requester   *MemberResponse        `json:"requester,omitempty"`
+	PubHead     *MemberResponse        `json:"pub_head,omitempty"`
+	CreatedAt   time.Time              `json:"created_at"`
+	UpdatedAt   *time.Time             `json:"updated_at,omitempty"`
+	Next        []Transition           `json:"next"`
+	Comments    []CommentResponse      `json:"comments,omitempty"`
+}
+
+// ListPubRequestsResponse is the response for the publicity request list endpoints
+type ListPubRequestsResponse struct {
+	PubRequests []PubRequestResponse `json:"pub_requests"`
+}
+
+// ListCommentsResponse is the response for the GET /pub-requests/:id/comments endpoint
+type ListCommentsResponse struct {
+	Comments []CommentResponse `json:"comments"`
+}
+
+// StatusResponse represents a status of the publicity workflow
+type StatusResponse struct {
+	ID   string `json:"id" example:"FOR_REVIEW"`
+	Name string `json:"name" example:"For review"`
+}
+
+// WorkflowResponse is the response for the GET /pub-requests/workflow endpoint
+type WorkflowResponse struct {
+	Initial     string           `json:"initial" example:"PENDING"`
+	Statuses    []StatusResponse `json:"statuses"`
+	Transitions []Transition     `json:"transitions"`
+}
+
+// CalendarItem represents a publicity request scheduled on a calendar day
+type CalendarItem struct {
+	ID          int32                  `json:"id" example:"1"`
+	EventID     *int32                 `json:"event_id,omitempty" example:"1"`
+	EventName   helpers.NullableString `json:"event_name"`
+	CommitteeID helpers.NullableString `json:"committee_id"`
+	PubType     helpers.NullableString `json:"pub_type"`
+	Status      string                 `json:"status" example:"APPROVED"`
+	PostingDate time.Time              `json:"posting_date" example:"2026-10-28T18:00:00+08:00"`
+	PubHead     *MemberResponse        `json:"pub_head,omitempty"`
+}
+
+// CalendarDay lists the publicity requests to be posted on a day
+type CalendarDay struct {
+	Date  string         `json:"date" example:"2026-10-28"`
+	Items []CalendarItem `json:"items"`
+}
+
+// CalendarResponse is the response for the GET /pub-requests/calendar endpoint
+type CalendarResponse struct {
+	From time.Time     `json:"from"`
+	To   time.Time     `json:"to"`
+	Days []CalendarDay `json:"days"`
+}
+
+func toPubRequestResponse(r repository.GetPubRequestRow) PubRequestResponse {
+	status := requestStatus(r)
+	resp := PubRequestResponse{
+		ID:          r.ID,
+		EventName:   helpers.NullableString{NullString: r.EventName},
+		CommitteeID: helpers.NullableString{NullString: r.CommitteeID},
+		PubType:     helpers.NullableString{NullString: r.PubType},
+		PubDriveID:  r.PubDriveID,
+		Status:      status,
+		PostingDate: r.PostingDate,
+		PubDetails:  r.PubDetails,
+		PubContent:  r.PubContent,
+		Caption:     r.Caption,
+		OPANumbers:  r.OpaNumbers,
+		ForPosting:  r.ForPosting,
+		Dimensions:  helpers.NullableString{NullString: r.Dimensions},
+		Requester:   toMemberResponse(r.RequesterID, r.RequesterName),
+		PubHead:     toMemberResponse(r.PubHead, r.PubHeadName),
+		CreatedAt:   r.CreatedAt,
+		Next:        Next(status),
+	}
+	if r.EventID.Valid {
+		resp.EventID = &r.EventID.Int32
+	}
+	if r.UpdatedAt.Valid {
+		resp.UpdatedAt = &r.UpdatedAt.Time
+	}
+	return resp
+}
+
+func toCalendarItem(r repository.GetPubRequestRow) CalendarItem {
+	item := CalendarItem{
+		ID:          r.ID,
+		EventName:   helpers.NullableString{NullString: r.EventName},
+		CommitteeID: helpers.NullableString{NullString: r.CommitteeID},
+		PubType:     helpers.NullableString{NullString: r.PubType},
+		Status:      requestStatus(r),
+		PostingDate: r.PostingDate,
+		PubHead:     toMemberResponse(r.PubHead, r.PubHeadName),
+	}
+	if r.EventID.Valid {
+		item.EventID = &r.EventID.Int32
+	}
+	return item
+}
+
+func toCommentResponse(c repository.ListPubRequestCommentsRow) CommentResponse {
+	resp := CommentResponse{
+		ID:         c.ID,
+		Member:     toMemberResponse(c.MemberID, c.MemberName),
+		Body:       helpers.NullableString{NullString: c.Body},
+		FromStatus: helpers.NullableString{NullString: c.FromStatus},
+		ToStatus:   helpers.NullableString{NullString: c.ToStatus},
+	}
+	if c.CreatedAt.Valid {
+		resp.CreatedAt = &c.CreatedAt.Time
+	}
+	return resp
+}
+
+func toMemberResponse(id sql.NullInt32, name sql.NullString) *MemberResponse {
+	if !id.Valid {
+		return nil
+	}
+	return &MemberResponse{ID: id.Int32, FullName: name.String}
+}
+
+// toNullString converts an optional string to sql.NullString
+func toNullString(s *string) sql.NullString {
+	if s == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *s, Valid: true}
+}
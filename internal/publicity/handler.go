@@ -0,0 +1,830 @@
+package publicity
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+const (
+	// defaultCalendarDays is the range of the calendar when no end date is given
+	defaultCalendarDays = 30
+	// maxCalendarDays is the longest range the calendar can be requested for
+	maxCalendarDays = 366
+)
+
+// Handler exposes publicity requests.
+//
+// Any member can submit a request for an event. The Publicity committee claims it (its member becomes
+// the request's publicity head), produces the material and posts it once the requester (or an organizer
+// of the event) approves it. Managers of the Publicity committee can assign heads and make every transition.
+type Handler struct {
+	cfg         *config.Config
+	dbService   database.Service
+	rbacService *auth.RBACService
+	service     *Service
+}
+
+func NewHandler(cfg *config.Config, dbService database.Service, rbacService *auth.RBACService) *Handler {
+	return &Handler{
+		cfg:         cfg,
+		dbService:   dbService,
+		rbacService: rbacService,
+		service:     NewService(dbService),
+	}
+}
+
+// CreatePubRequestHandler submits a publicity request for an event
+// @Summary Submit publicity request
+// @Description Submit a publicity request for an event. The request starts as PENDING until the Publicity committee claims it.
+// @Tags publicity
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body PubRequestRequest true "Publicity request"
+// @Success 201 {object} PubRequestResponse "Created publicity request"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/pub-requests [post]
+func (h *Handler) CreatePubRequestHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	var req PubRequestRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	eventID, err := parseID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid event ID"})
+	}
+	if _, err := q.GetEventById(ctx, eventID); err != nil {
+		if err == sql.ErrNoRows {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "Event not found"})
+		}
+		log.Error().Err(err).Int32("event_id", eventID).Msg("failed to get event")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	forPosting := true
+	if req.ForPosting != nil {
+		forPosting = *req.ForPosting
+	}
+	var driveID string
+	if req.PubDriveID != nil {
+		driveID = *req.PubDriveID
+	}
+	if driveID == "" {
+		// default to the event's publicity folder, once its Drive folders are created
+		if folders, err := q.GetEventDriveFolders(ctx, eventID); err == nil && folders.PubFolderID.Valid {
+			driveID = folders.PubFolderID.String
+		}
+	}
+
+	id, err := q.CreatePubRequest(ctx, repository.CreatePubRequestParams{
+		EventID:     sql.NullInt32{Int32: eventID, Valid: true},
+		PubType:     toNullString(req.PubType),
+		PubDriveID:  driveID,
+		PubStatus:   sql.NullString{String: StatusPending, Valid: true},
+		PostingDate: req.PostingDate,
+		PubDetails:  req.PubDetails,
+		PubContent:  req.PubContent,
+		Caption:     req.Caption,
+		OpaNumbers:  req.OPANumbers,
+		ForPosting:  forPosting,
+		CreatedAt:   time.Now(),
+		RequesterID: sql.NullInt32{Int32: principal.MemberID, Valid: true},
+		Dimensions:  toNullString(req.Dimensions),
+	})
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", eventID).Msg("failed to create publicity request")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Error creating publicity request"})
+	}
+
+	created, err := q.GetPubRequest(ctx, int32(id))
+	if err != nil {
+		log.Error().Err(err).Int64("pub_request_id", id).Msg("failed to get created publicity request")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().
+		Int64("pub_request_id", id).
+		Int32("event_id", eventID).
+		Int32("requester_id", principal.MemberID).
+		Msg("publicity request submitted")
+
+	return c.JSON(http.StatusCreated, toPubRequestResponse(created))
+}
+
+// ListEventPubRequestsHandler lists the publicity requests of an event
+// @Summary List event publicity requests
+// @Description List the publicity requests of an event, latest posting date first
+// @Tags publicity
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} ListPubRequestsResponse "Publicity requests"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid event ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/pub-requests [get]
+func (h *Handler) ListEventPubRequestsHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	eventID, err := parseID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid event ID"})
+	}
+
+	rows, err := q.ListPubRequestsByEvent(c.Request().Context(), sql.NullInt32{Int32: eventID, Valid: true})
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", eventID).Msg("failed to list publicity requests")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	response := make([]PubRequestResponse, 0, len(rows))
+	for _, r := range rows {
+		response = append(response, toPubRequestResponse(repository.GetPubRequestRow(r)))
+	}
+
+	return c.JSON(http.StatusOK, ListPubRequestsResponse{PubRequests: response})
+}
+
+// ListPubRequestsHandler lists publicity requests
+// @Summary List publicity requests
+// @Description List publicity requests, latest posting date first, optionally filtered by status, publicity head or committee of the event
+// @Tags publicity
+// @Produce json
+// @Param status query string false "Filter by status"
+// @Param pub_head query int false "Filter by publicity head (member ID)"
+// @Param committee_id query string false "Filter by committee of the event"
+// @Success 200 {object} ListPubRequestsResponse "Publicity requests"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid filter"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /pub-requests [get]
+func (h *Handler) ListPubRequestsHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	status := c.QueryParam("status")
+	committeeID := c.QueryParam("committee_id")
+	var pubHead int32
+	if raw := c.QueryParam("pub_head"); raw != "" {
+		id, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid publicity head ID"})
+		}
+		pubHead = int32(id)
+	}
+
+	rows, err := q.ListPubRequests(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list publicity requests")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	response := []PubRequestResponse{}
+	for _, r := range rows {
+		row := repository.GetPubRequestRow(r)
+		if status != "" && requestStatus(row) != status {
+			continue
+		}
+		if pubHead != 0 && (!row.PubHead.Valid || row.PubHead.Int32 != pubHead) {
+			continue
+		}
+		if committeeID != "" && row.CommitteeID.String != committeeID {
+			continue
+		}
+		response = append(response, toPubRequestResponse(row))
+	}
+
+	return c.JSON(http.StatusOK, ListPubRequestsResponse{PubRequests: response})
+}
+
+// GetWorkflowHandler describes the publicity request workflow
+// @Summary Get publicity workflow
+// @Description List the statuses of publicity requests and the allowed transitions, with the role that can make each one (publicity or requester)
+// @Tags publicity
+// @Produce json
+// @Success 200 {object} WorkflowResponse "Publicity workflow"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /pub-requests/workflow [get]
+func (h *Handler) GetWorkflowHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	statuses, err := q.ListPubReqStatuses(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list publicity request statuses")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	names := make(map[string]string, len(statuses))
+	for _, s := range statuses {
+		names[s.ID] = s.Name
+	}
+
+	resp := WorkflowResponse{Initial: StatusPending, Transitions: Transitions}
+	for _, id := range []string{
+		StatusPending, StatusInProgress, StatusForReview, StatusForRevision,
+		StatusApproved, StatusPosted, StatusCancelled,
+	} {
+		resp.Statuses = append(resp.Statuses, StatusResponse{ID: id, Name: names[id]})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetCalendarHandler lists upcoming postings by day
+// @Summary Get publicity calendar
+// @Description List the publicity requests to be posted between two dates (excluding cancelled requests and materials not for posting), grouped by posting day. Defaults to the next 30 days.
+// @Tags publicity
+// @Produce json
+// @Param from query string false "Start date (YYYY-MM-DD or RFC 3339), defaults to today"
+// @Param to query string false "End date, exclusive (YYYY-MM-DD or RFC 3339), defaults to 30 days after the start"
+// @Success 200 {object} CalendarResponse "Postings by day"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid date range"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /pub-requests/calendar [get]
+func (h *Handler) GetCalendarHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	now := time.Now()
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if raw := c.QueryParam("from"); raw != "" {
+		t, err := parseDate(raw)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid from date"})
+		}
+		from = t
+	}
+	to := from.AddDate(0, 0, defaultCalendarDays)
+	if raw := c.QueryParam("to"); raw != "" {
+		t, err := parseDate(raw)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid to date"})
+		}
+		to = t
+	}
+	if !to.After(from) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "to must be after from"})
+	}
+	if to.After(from.AddDate(0, 0, maxCalendarDays)) {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": fmt.Sprintf("Date range cannot be longer than %d days", maxCalendarDays),
+		})
+	}
+
+	rows, err := q.ListPubRequestsPostingBetween(c.Request().Context(), repository.ListPubRequestsPostingBetweenParams{
+		PostingDate:   from,
+		PostingDate_2: to,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list publicity calendar")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	// rows are ordered by posting date, so days are appended in order
+	resp := CalendarResponse{From: from, To: to, Days: []CalendarDay{}}
+	for _, r := range rows {
+		item := toCalendarItem(repository.GetPubRequestRow(r))
+		date := item.PostingDate.In(from.Location()).Format(time.DateOnly)
+		if n := len(resp.Days); n == 0 || resp.Days[n-1].Date != date {
+			resp.Days = append(resp.Days, CalendarDay{Date: date})
+		}
+		last := &resp.Days[len(resp.Days)-1]
+		last.Items = append(last.Items, item)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetPubRequestHandler returns a publicity request with its comments
+// @Summary Get publicity request
+// @Description Get a publicity request, its allowed next statuses and its comments and status changes
+// @Tags publicity
+// @Produce json
+// @Param id path int true "Publicity request ID"
+// @Success 200 {object} PubRequestResponse "Publicity request"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid publicity request ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "Publicity request not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /pub-requests/{id} [get]
+func (h *Handler) GetPubRequestHandler(c echo.Context) error {
+	req, ok, err := h.getPubRequest(c)
+	if !ok {
+		return err
+	}
+	return h.respondWithPubRequest(c, req)
+}
+
+// UpdatePubRequestHandler replaces the details of a publicity request
+// @Summary Update publicity request
+// @Description Replace the details of a publicity request. Allowed for the requester, organizers of the event and the Publicity committee until the request is posted or cancelled.
+// @Tags publicity
+// @Accept json
+// @Produce json
+// @Param id path int true "Publicity request ID"
+// @Param request body PubRequestRequest true "Publicity request"
+// @Success 200 {object} PubRequestResponse "Updated publicity request"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not allowed to edit this request"
+// @Failure 404 {object} helpers.ErrorResponse "Publicity request not found"
+// @Failure 409 {object} helpers.ErrorResponse "Request already posted or cancelled"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /pub-requests/{id} [put]
+func (h *Handler) UpdatePubRequestHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	var body PubRequestRequest
+	if err := c.Bind(&body); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&body); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	req, ok, err := h.getPubRequest(c)
+	if !ok {
+		return err
+	}
+
+	acc := h.getAccess(ctx, principal, req)
+	if !acc.can(RoleRequester) && !acc.can(RolePublicity) {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Only the requester, event organizers and Publicity can edit this request"})
+	}
+	if IsFinal(requestStatus(req)) {
+		return c.JSON(http.StatusConflict, map[string]string{"error": "Posted or cancelled requests cannot be edited"})
+	}
+
+	forPosting := true
+	if body.ForPosting != nil {
+		forPosting = *body.ForPosting
+	}
+	var driveID string
+	if body.PubDriveID != nil {
+		driveID = *body.PubDriveID
+	}
+
+	if err := q.UpdatePubRequest(ctx, repository.UpdatePubRequestParams{
+		PubType:     toNullString(body.PubType),
+		PubDriveID:  driveID,
+		PostingDate: body.PostingDate,
+		PubDetails:  body.PubDetails,
+		PubContent:  body.PubContent,
+		Caption:     body.Caption,
+		OpaNumbers:  body.OPANumbers,
+		ForPosting:  forPosting,
+		Dimensions:  toNullString(body.Dimensions),
+		ID:          req.ID,
+	}); err != nil {
+		log.Error().Err(err).Int32("pub_request_id", req.ID).Msg("failed to update publicity request")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Error updating publicity request"})
+	}
+
+	log.Info().
+		Int32("pub_request_id", req.ID).
+		Int32("updated_by", principal.MemberID).
+		Msg("publicity request updated")
+
+	updated, err := q.GetPubRequest(ctx, req.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("pub_request_id", req.ID).Msg("failed to get publicity request")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	return h.respondWithPubRequest(c, updated)
+}
+
+// ClaimPubRequestHandler makes the current member the publicity head of a request
+// @Summary Claim publicity request
+// @Description Become the publicity head of a request that has none. Pending requests move to IN_PROGRESS. Only members of the Publicity committee can claim requests.
+// @Tags publicity
+// @Produce json
+// @Param id path int true "Publicity request ID"
+// @Success 200 {object} PubRequestResponse "Claimed publicity request"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid publicity request ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not a member of the Publicity committee"
+// @Failure 404 {object} helpers.ErrorResponse "Publicity request not found"
+// @Failure 409 {object} helpers.ErrorResponse "Already claimed by someone else, or posted or cancelled"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /pub-requests/{id}/claim [post]
+func (h *Handler) ClaimPubRequestHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	req, ok, err := h.getPubRequest(c)
+	if !ok {
+		return err
+	}
+
+	acc := h.getAccess(ctx, principal, req)
+	if !acc.can(RolePublicity) {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Only members of the Publicity committee can claim requests"})
+	}
+	if IsFinal(requestStatus(req)) {
+		return c.JSON(http.StatusConflict, map[string]string{"error": "Posted or cancelled requests cannot be claimed"})
+	}
+
+	claimed, err := h.service.Claim(ctx, req.ID, principal.MemberID)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrAlreadyClaimed):
+			return c.JSON(http.StatusConflict, map[string]string{"error": "Request already has a publicity head"})
+		case errors.Is(err, ErrStatusChanged):
+			return c.JSON(http.StatusConflict, map[string]string{"error": "Status was changed by someone else, reload and try again"})
+		}
+		log.Error().Err(err).Int32("pub_request_id", req.ID).Msg("failed to claim publicity request")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().
+		Int32("pub_request_id", req.ID).
+		Int32("pub_head", principal.MemberID).
+		Msg("publicity request claimed")
+
+	return h.respondWithPubRequest(c, claimed)
+}
+
+// AssignPubHeadHandler assigns the publicity head of a request
+// @Summary Assign publicity head
+// @Description Assign a member of the Publicity committee as the publicity head of a request, or unassign it with member_id 0. Only managers of the Publicity committee can assign heads.
+// @Tags publicity
+// @Accept json
+// @Produce json
+// @Param id path int true "Publicity request ID"
+// @Param request body AssignPubHeadRequest true "Publicity head"
+// @Success 200 {object} PubRequestResponse "Updated publicity request"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request or member not in the Publicity committee"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not a manager of the Publicity committee"
+// @Failure 404 {object} helpers.ErrorResponse "Publicity request or member not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /pub-requests/{id}/pub-head [put]
+func (h *Handler) AssignPubHeadHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	var body AssignPubHeadRequest
+	if err := c.Bind(&body); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&body); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	req, ok, err := h.getPubRequest(c)
+	if !ok {
+		return err
+	}
+
+	if !h.getAccess(ctx, principal, req).manager {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Only managers of the Publicity committee can assign publicity heads"})
+	}
+
+	if body.MemberID != 0 {
+		member, err := q.GetMemberInfoById(ctx, body.MemberID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return c.JSON(http.StatusNotFound, map[string]string{"error": "Member not found"})
+			}
+			log.Error().Err(err).Int32("member_id", body.MemberID).Msg("failed to get member")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+		}
+		if member.CommitteeID.String != h.cfg.PubCommitteeID {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Member is not in the Publicity committee"})
+		}
+	}
+
+	if err := q.UpdatePubRequestHead(ctx, repository.UpdatePubRequestHeadParams{
+		PubHead: sql.NullInt32{Int32: body.MemberID, Valid: body.MemberID != 0},
+		ID:      req.ID,
+	}); err != nil {
+		log.Error().Err(err).Int32("pub_request_id", req.ID).Msg("failed to assign publicity head")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().
+		Int32("pub_request_id", req.ID).
+		Int32("pub_head", body.MemberID).
+		Int32("assigned_by", principal.MemberID).
+		Msg("publicity head assigned")
+
+	updated, err := q.GetPubRequest(ctx, req.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("pub_request_id", req.ID).Msg("failed to get publicity request")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	return h.respondWithPubRequest(c, updated)
+}
+
+// TransitionHandler changes the status of a publicity request
+// @Summary Change publicity request status
+// @Description Move a publicity request to a new status with an optional comment. Only the transitions of the workflow are allowed (see GET /pub-requests/workflow): Publicity makes the publicity transitions, and the requester and event organizers the requester ones. Work can only start once the request has a publicity head.
+// @Tags publicity
+// @Accept json
+// @Produce json
+// @Param id path int true "Publicity request ID"
+// @Param request body TransitionRequest true "New status and comment"
+// @Success 200 {object} PubRequestResponse "Updated publicity request"
+// @Failure 400 {object} helpers.ErrorResponse "Transition not allowed"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not allowed to make this transition"
+// @Failure 404 {object} helpers.ErrorResponse "Publicity request not found"
+// @Failure 409 {object} helpers.ErrorResponse "No publicity head or status changed concurrently"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /pub-requests/{id}/transitions [post]
+func (h *Handler) TransitionHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	var body TransitionRequest
+	if err := c.Bind(&body); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&body); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	req, ok, err := h.getPubRequest(c)
+	if !ok {
+		return err
+	}
+
+	updated, err := h.service.Advance(ctx, TransitionInput{
+		RequestID: req.ID,
+		To:        body.Status,
+		Access:    h.getAccess(ctx, principal, req),
+		ActorID:   principal.MemberID,
+		Comment:   toNullString(body.Comment),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidTransition):
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": fmt.Sprintf("Cannot move request from %s to %s", requestStatus(updated), body.Status),
+			})
+		case errors.Is(err, ErrRoleRequired):
+			who := "Publicity"
+			if transition, _ := Find(requestStatus(updated), body.Status); transition.By == RoleRequester {
+				who = "the requester and event organizers"
+			}
+			return c.JSON(http.StatusForbidden, map[string]string{
+				"error": fmt.Sprintf("Only %s can move the request to %s", who, body.Status),
+			})
+		case errors.Is(err, ErrNotClaimed):
+			return c.JSON(http.StatusConflict, map[string]string{"error": "Claim or assign the request before starting work on it"})
+		case errors.Is(err, ErrStatusChanged):
+			return c.JSON(http.StatusConflict, map[string]string{"error": "Status was changed by someone else, reload and try again"})
+		}
+		log.Error().Err(err).Int32("pub_request_id", req.ID).Msg("failed to change publicity request status")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().
+		Int32("pub_request_id", req.ID).
+		Str("status", body.Status).
+		Int32("changed_by", principal.MemberID).
+		Msg("publicity request status changed")
+
+	return h.respondWithPubRequest(c, updated)
+}
+
+// ListCommentsHandler lists the comments and status changes of a publicity request
+// @Summary List publicity request comments
+// @Description List the comments and status changes of a publicity request, oldest first
+// @Tags publicity
+// @Produce json
+// @Param id path int true "Publicity request ID"
+// @Success 200 {object} ListCommentsResponse "Comments"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid publicity request ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /pub-requests/{id}/comments [get]
+func (h *Handler) ListCommentsHandler(c echo.Context) error {
+	id, err := parseID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid publicity request ID"})
+	}
+
+	comments, err := h.listComments(c.Request().Context(), id)
+	if err != nil {
+		log.Error().Err(err).Int32("pub_request_id", id).Msg("failed to list publicity request comments")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return c.JSON(http.StatusOK, ListCommentsResponse{Comments: comments})
+}
+
+// AddCommentHandler comments on a publicity request
+// @Summary Comment on publicity request
+// @Description Add a comment to a publicity request. Allowed for the requester, organizers of the event and the Publicity committee.
+// @Tags publicity
+// @Accept json
+// @Produce json
+// @Param id path int true "Publicity request ID"
+// @Param request body CommentRequest true "Comment"
+// @Success 201 {object} ListCommentsResponse "Comments"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not allowed to comment on this request"
+// @Failure 404 {object} helpers.ErrorResponse "Publicity request not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /pub-requests/{id}/comments [post]
+func (h *Handler) AddCommentHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	var body CommentRequest
+	if err := c.Bind(&body); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&body); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	req, ok, err := h.getPubRequest(c)
+	if !ok {
+		return err
+	}
+
+	acc := h.getAccess(ctx, principal, req)
+	if !acc.can(RoleRequester) && !acc.can(RolePublicity) {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Only the requester, event organizers and Publicity can comment on this request"})
+	}
+
+	if _, err := q.CreatePubRequestComment(ctx, repository.CreatePubRequestCommentParams{
+		PubRequestID: req.ID,
+		MemberID:     sql.NullInt32{Int32: principal.MemberID, Valid: true},
+		Body:         sql.NullString{String: body.Body, Valid: true},
+	}); err != nil {
+		log.Error().Err(err).Int32("pub_request_id", req.ID).Msg("failed to add publicity request comment")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	comments, err := h.listComments(ctx, req.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("pub_request_id", req.ID).Msg("failed to list publicity request comments")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return c.JSON(http.StatusCreated, ListCommentsResponse{Comments: comments})
+}
+
+// getAccess determines what a member can do with a publicity request
+func (h *Handler) getAccess(ctx context.Context, principal *auth.Principal, req repository.GetPubRequestRow) access {
+	q := repository.New(h.dbService.GetConnection())
+	actorID := principal.MemberID
+
+	committees := []string{h.cfg.PubCommitteeID}
+	if req.CommitteeID.Valid {
+		committees = append(committees, req.CommitteeID.String)
+	}
+	managed := h.rbacService.CanManageCommittees(ctx, actorID, committees...)
+
+	acc := access{manager: managed[h.cfg.PubCommitteeID]}
+	acc.requester = (req.RequesterID.Valid && req.RequesterID.Int32 == actorID) ||
+		(req.CommitteeID.Valid && managed[req.CommitteeID.String])
+
+	if !acc.requester && req.EventID.Valid {
+		isHead, err := q.IsEventHead(ctx, repository.IsEventHeadParams{EventID: req.EventID.Int32, MemberID: actorID})
+		if err != nil {
+			log.Error().Err(err).Int32("event_id", req.EventID.Int32).Msg("failed to check event head")
+		}
+		acc.requester = isHead
+	}
+
+	acc.publicity = acc.manager
+	if !acc.publicity {
+		member, err := q.GetMemberInfoById(ctx, actorID)
+		if err != nil {
+			log.Error().Err(err).Int32("member_id", actorID).Msg("failed to get member committee")
+		}
+		acc.publicity = member.CommitteeID.Valid && member.CommitteeID.String == h.cfg.PubCommitteeID
+	}
+
+	return acc
+}
+
+// getPubRequest loads the publicity request in the "id" path parameter.
+// If ok is false, the error response was already written.
+func (h *Handler) getPubRequest(c echo.Context) (repository.GetPubRequestRow, bool, error) {
+	q := repository.New(h.dbService.GetConnection())
+
+	id, err := parseID(c)
+	if err != nil {
+		return repository.GetPubRequestRow{}, false, c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid publicity request ID"})
+	}
+
+	req, err := q.GetPubRequest(c.Request().Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return req, false, c.JSON(http.StatusNotFound, map[string]string{"error": "Publicity request not found"})
+		}
+		log.Error().Err(err).Int32("pub_request_id", id).Msg("failed to get publicity request")
+		return req, false, c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return req, true, nil
+}
+
+// respondWithPubRequest writes a publicity request with its comments
+func (h *Handler) respondWithPubRequest(c echo.Context, req repository.GetPubRequestRow) error {
+	comments, err := h.listComments(c.Request().Context(), req.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("pub_request_id", req.ID).Msg("failed to list publicity request comments")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	response := toPubRequestResponse(req)
+	response.Comments = comments
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h *Handler) listComments(ctx context.Context, requestID int32) ([]CommentResponse, error) {
+	q := repository.New(h.dbService.GetConnection())
+
+	rows, err := q.ListPubRequestComments(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	comments := make([]CommentResponse, 0, len(rows))
+	for _, r := range rows {
+		comments = append(comments, toCommentResponse(r))
+	}
+	return comments, nil
+}
+
+// parseDate parses a calendar bound, either a date (local midnight) or an RFC 3339 time
+func parseDate(s string) (time.Time, error) {
+	if t, err := time.ParseInLocation(time.DateOnly, s, time.Local); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+func parseID(c echo.Context) (int32, error) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	return int32(id), err
+}
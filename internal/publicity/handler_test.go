@@ -0,0 +1,332 @@
+package publicity
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+)
+
+// mockDBService is a mock implementation of the database.Service interface.
+type mockDBService struct {
+	db *sql.DB
+}
+
+func (m *mockDBService) Health() map[string]string {
+	return nil
+}
+
+func (m *mockDBService) Close() error {
+	return nil
+}
+
+func (m *mockDBService) GetConnection() *sql.DB {
+	return m.db
+}
+
+var pubRequestColumns = []string{
+	"id", "event_id", "pub_head", "pub_type", "pub_drive_id", "pub_status", "posting_date",
+	"pub_details", "pub_content", "caption", "opa_numbers", "for_posting", "created_at",
+	"requester_id", "dimensions", "updated_at",
+	"event_name", "committee_id", "requester_name", "pub_head_name",
+}
+
+// pubRequestRow returns a request of event 1 (RND) submitted by member 2; pubHead 0 means unassigned
+func pubRequestRow(id int32, status string, pubHead int32, postingDate time.Time) []driver.Value {
+	var head, headName any
+	if pubHead != 0 {
+		head, headName = pubHead, "Pub Head"
+	}
+	return []driver.Value{
+		id, 1, head, "Event poster", "", status, postingDate,
+		"Teaser for the workshop", "", "", "", true, time.Now(),
+		2, nil, nil,
+		"Intro to Go Workshop", "RND", "Juan Dela Cruz", headName,
+	}
+}
+
+// expectMemberInfo mocks the member lookup of the RBAC and committee checks
+func expectMemberInfo(mock sqlmock.Sqlmock, memberID int32, position, committeeID string) {
+	mock.ExpectQuery("SELECT (.+) FROM members m").
+		WithArgs(memberID).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "email", "full_name", "nickname", "image_url",
+			"committee_id", "committee_name",
+			"division_id", "division_name",
+			"position_id", "position_name",
+			"house_name",
+			"contact_number", "college", "program",
+			"interests", "discord", "fb_link", "telegram",
+		}).AddRow(
+			memberID, "test@dlsu.edu.ph", "Test User", nil, nil,
+			committeeID, nil,
+			nil, nil,
+			position, nil,
+			nil,
+			nil, nil, nil,
+			nil, nil, nil, nil,
+		))
+}
+
+// expectAccess mocks the access lookups of a non-admin member who is not a manager of the event's committee
+func expectAccess(mock sqlmock.Sqlmock, memberID int32, position, committeeID string, requester bool) {
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(memberID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	expectMemberInfo(mock, memberID, position, committeeID)
+	if !requester {
+		mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM event_heads").
+			WithArgs(int32(1), memberID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	}
+	if !(committeeID == "PUB" && auth.GetPositionLevel(position) >= auth.GetPositionLevel("AVP")) {
+		expectMemberInfo(mock, memberID, position, committeeID)
+	}
+}
+
+func newHandler(db *sql.DB) *Handler {
+	dbService := &mockDBService{db: db}
+	cfg := &config.Config{PubCommitteeID: "PUB"}
+	return NewHandler(cfg, dbService, auth.NewRBACService(dbService))
+}
+
+func newContext(method, target, body string, memberID int32) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	auth.SetPrincipal(c, &auth.Principal{MemberID: memberID, Method: auth.AuthMethodSession})
+	return c, rec
+}
+
+var commentColumns = []string{"id", "pub_request_id", "member_id", "body", "from_status", "to_status", "created_at", "member_name"}
+
+func TestTransitionHandler(t *testing.T) {
+	posting := time.Date(2026, 10, 28, 18, 0, 0, 0, time.UTC)
+
+	t.Run("success - requester approves", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM pub_requests p").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(pubRequestColumns).AddRow(pubRequestRow(1, StatusForReview, 3, posting)...))
+		expectAccess(mock, 2, "MEM", "RND", true)
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT (.+) FROM pub_requests p").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(pubRequestColumns).AddRow(pubRequestRow(1, StatusForReview, 3, posting)...))
+		mock.ExpectExec("UPDATE pub_requests SET pub_status").
+			WithArgs(StatusApproved, int32(1), StatusForReview).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO pub_request_comments").
+			WithArgs(int32(1), int32(2), "Looks good!", StatusForReview, StatusApproved).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery("SELECT (.+) FROM pub_requests p").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(pubRequestColumns).AddRow(pubRequestRow(1, StatusApproved, 3, posting)...))
+		mock.ExpectCommit()
+		mock.ExpectQuery("SELECT (.+) FROM pub_request_comments c").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(commentColumns).
+				AddRow(1, 1, 2, "Looks good!", StatusForReview, StatusApproved, time.Now(), "Juan Dela Cruz"))
+
+		c, rec := newContext(http.MethodPost, "/pub-requests/1/transitions", `{"status":"APPROVED","comment":"Looks good!"}`, 2)
+		if assert.NoError(t, newHandler(db).TransitionHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var resp PubRequestResponse
+			json.Unmarshal(rec.Body.Bytes(), &resp)
+			assert.Equal(t, StatusApproved, resp.Status)
+			assert.Equal(t, []Transition{
+				{From: StatusApproved, To: StatusPosted, By: RolePublicity},
+				{From: StatusApproved, To: StatusCancelled, By: RoleRequester},
+			}, resp.Next)
+			assert.Len(t, resp.Comments, 1)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - publicity member cannot approve", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM pub_requests p").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(pubRequestColumns).AddRow(pubRequestRow(1, StatusForReview, 3, posting)...))
+		expectAccess(mock, 3, "MEM", "PUB", false)
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT (.+) FROM pub_requests p").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(pubRequestColumns).AddRow(pubRequestRow(1, StatusForReview, 3, posting)...))
+		mock.ExpectRollback()
+
+		c, rec := newContext(http.MethodPost, "/pub-requests/1/transitions", `{"status":"APPROVED"}`, 3)
+		if assert.NoError(t, newHandler(db).TransitionHandler(c)) {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - work cannot start without a publicity head", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM pub_requests p").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(pubRequestColumns).AddRow(pubRequestRow(1, StatusPending, 0, posting)...))
+		expectAccess(mock, 3, "MEM", "PUB", false)
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT (.+) FROM pub_requests p").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(pubRequestColumns).AddRow(pubRequestRow(1, StatusPending, 0, posting)...))
+		mock.ExpectRollback()
+
+		c, rec := newContext(http.MethodPost, "/pub-requests/1/transitions", `{"status":"IN_PROGRESS"}`, 3)
+		if assert.NoError(t, newHandler(db).TransitionHandler(c)) {
+			assert.Equal(t, http.StatusConflict, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestClaimPubRequestHandler(t *testing.T) {
+	posting := time.Date(2026, 10, 28, 18, 0, 0, 0, time.UTC)
+
+	t.Run("success - publicity member claims a pending request", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM pub_requests p").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(pubRequestColumns).AddRow(pubRequestRow(1, StatusPending, 0, posting)...))
+		expectAccess(mock, 3, "MEM", "PUB", false)
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT (.+) FROM pub_requests p").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(pubRequestColumns).AddRow(pubRequestRow(1, StatusPending, 0, posting)...))
+		mock.ExpectExec("UPDATE pub_requests SET pub_head").
+			WithArgs(int32(3), int32(1)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE pub_requests SET pub_status").
+			WithArgs(StatusInProgress, int32(1), StatusPending).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO pub_request_comments").
+			WithArgs(int32(1), int32(3), nil, StatusPending, StatusInProgress).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery("SELECT (.+) FROM pub_requests p").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(pubRequestColumns).AddRow(pubRequestRow(1, StatusInProgress, 3, posting)...))
+		mock.ExpectCommit()
+		mock.ExpectQuery("SELECT (.+) FROM pub_request_comments c").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(commentColumns))
+
+		c, rec := newContext(http.MethodPost, "/pub-requests/1/claim", "", 3)
+		if assert.NoError(t, newHandler(db).ClaimPubRequestHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var resp PubRequestResponse
+			json.Unmarshal(rec.Body.Bytes(), &resp)
+			assert.Equal(t, StatusInProgress, resp.Status)
+			if assert.NotNil(t, resp.PubHead) {
+				assert.Equal(t, int32(3), resp.PubHead.ID)
+			}
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - request claimed by someone else", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM pub_requests p").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(pubRequestColumns).AddRow(pubRequestRow(1, StatusInProgress, 4, posting)...))
+		expectAccess(mock, 3, "MEM", "PUB", false)
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT (.+) FROM pub_requests p").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(pubRequestColumns).AddRow(pubRequestRow(1, StatusInProgress, 4, posting)...))
+		mock.ExpectExec("UPDATE pub_requests SET pub_head").
+			WithArgs(int32(3), int32(1)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		c, rec := newContext(http.MethodPost, "/pub-requests/1/claim", "", 3)
+		if assert.NoError(t, newHandler(db).ClaimPubRequestHandler(c)) {
+			assert.Equal(t, http.StatusConflict, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - not a publicity member", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM pub_requests p").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(pubRequestColumns).AddRow(pubRequestRow(1, StatusPending, 0, posting)...))
+		expectAccess(mock, 2, "MEM", "RND", true)
+
+		c, rec := newContext(http.MethodPost, "/pub-requests/1/claim", "", 2)
+		if assert.NoError(t, newHandler(db).ClaimPubRequestHandler(c)) {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGetCalendarHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+	to := time.Date(2026, 11, 1, 0, 0, 0, 0, time.Local)
+	mock.ExpectQuery("SELECT (.+) FROM pub_requests p").
+		WithArgs(from, to).
+		WillReturnRows(sqlmock.NewRows(pubRequestColumns).
+			AddRow(pubRequestRow(1, StatusApproved, 3, time.Date(2026, 10, 28, 9, 0, 0, 0, time.Local))...).
+			AddRow(pubRequestRow(2, StatusInProgress, 3, time.Date(2026, 10, 28, 18, 0, 0, 0, time.Local))...).
+			AddRow(pubRequestRow(3, StatusPending, 0, time.Date(2026, 10, 30, 12, 0, 0, 0, time.Local))...))
+
+	c, rec := newContext(http.MethodGet, "/pub-requests/calendar?from=2026-10-01&to=2026-11-01", "", 2)
+	if assert.NoError(t, newHandler(db).GetCalendarHandler(c)) {
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp CalendarResponse
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		if assert.Len(t, resp.Days, 2) {
+			assert.Equal(t, "2026-10-28", resp.Days[0].Date)
+			assert.Len(t, resp.Days[0].Items, 2)
+			assert.Equal(t, "2026-10-30", resp.Days[1].Date)
+			assert.Nil(t, resp.Days[1].Items[0].PubHead)
+		}
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	t.Run("fail - range too long", func(t *testing.T) {
+		c, rec := newContext(http.MethodGet, "/pub-requests/calendar?from=2026-01-01&to=2028-01-01", "", 2)
+		if assert.NoError(t, newHandler(db).GetCalendarHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
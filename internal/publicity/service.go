@@ -0,0 +1,177 @@
+package publicity
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+var (
+	// ErrInvalidTransition is returned when the request cannot move from its current status to the requested one
+	ErrInvalidTransition = errors.New("status transition not allowed")
+	// ErrRoleRequired is returned when a transition is requested by someone who does not have its role
+	ErrRoleRequired = errors.New("transition can only be made by another role")
+	// ErrNotClaimed is returned when work on a request is started before it has a publicity head
+	ErrNotClaimed = errors.New("request has no publicity head")
+	// ErrAlreadyClaimed is returned when claiming a request that already has another publicity head
+	ErrAlreadyClaimed = errors.New("request already has a publicity head")
+	// ErrStatusChanged is returned when the status was changed by someone else while the transition was applied
+	ErrStatusChanged = errors.New("status was changed concurrently")
+)
+
+// access describes what a member can do with a publicity request
+type access struct {
+	requester bool // the requester, or an organizer of the request's event
+	publicity bool // a member of the Publicity committee
+	manager   bool // a manager of the Publicity committee
+}
+
+// can reports whether the member may make transitions of the given role
+func (a access) can(role Role) bool {
+	switch role {
+	case RolePublicity:
+		return a.manager || a.publicity
+	case RoleRequester:
+		return a.manager || a.requester
+	}
+	return false
+}
+
+// Service applies status changes to publicity requests
+type Service struct {
+	dbService database.Service
+}
+
+func NewService(dbService database.Service) *Service {
+	return &Service{dbService: dbService}
+}
+
+// TransitionInput describes a requested status change
+type TransitionInput struct {
+	RequestID int32
+	To        string
+	Access    access
+	ActorID   int32
+	Comment   sql.NullString
+}
+
+// Advance moves a request to a new status, following the workflow,
+// and records the change (with its comment) in the request's comments
+func (s *Service) Advance(ctx context.Context, in TransitionInput) (repository.GetPubRequestRow, error) {
+	db := s.dbService.GetConnection()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return repository.GetPubRequestRow{}, err
+	}
+	defer tx.Rollback()
+	qtx := repository.New(db).WithTx(tx)
+
+	req, err := qtx.GetPubRequest(ctx, in.RequestID)
+	if err != nil {
+		return req, err
+	}
+
+	from := requestStatus(req)
+	transition, ok := Find(from, in.To)
+	if !ok {
+		return req, ErrInvalidTransition
+	}
+	if !in.Access.can(transition.By) {
+		return req, ErrRoleRequired
+	}
+	if in.To == StatusInProgress && !req.PubHead.Valid {
+		return req, ErrNotClaimed
+	}
+
+	if err := setStatus(ctx, qtx, req.ID, from, in.To, in.ActorID, in.Comment); err != nil {
+		return req, err
+	}
+
+	req, err = qtx.GetPubRequest(ctx, in.RequestID)
+	if err != nil {
+		return req, err
+	}
+
+	return req, tx.Commit()
+}
+
+// Claim makes a member the publicity head of a request and starts work on it if it is pending.
+// Claiming a request the member already heads has no effect.
+func (s *Service) Claim(ctx context.Context, requestID, memberID int32) (repository.GetPubRequestRow, error) {
+	db := s.dbService.GetConnection()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return repository.GetPubRequestRow{}, err
+	}
+	defer tx.Rollback()
+	qtx := repository.New(db).WithTx(tx)
+
+	req, err := qtx.GetPubRequest(ctx, requestID)
+	if err != nil {
+		return req, err
+	}
+	if req.PubHead.Valid && req.PubHead.Int32 == memberID {
+		return req, nil
+	}
+
+	claimed, err := qtx.ClaimPubRequest(ctx, repository.ClaimPubRequestParams{
+		PubHead: sql.NullInt32{Int32: memberID, Valid: true},
+		ID:      requestID,
+	})
+	if err != nil {
+		return req, err
+	}
+	if claimed == 0 {
+		return req, ErrAlreadyClaimed
+	}
+
+	if from := requestStatus(req); from == StatusPending {
+		if err := setStatus(ctx, qtx, req.ID, from, StatusInProgress, memberID, sql.NullString{}); err != nil {
+			return req, err
+		}
+	}
+
+	req, err = qtx.GetPubRequest(ctx, requestID)
+	if err != nil {
+		return req, err
+	}
+
+	return req, tx.Commit()
+}
+
+// setStatus changes the status of a request if it is still from, and records the change
+func setStatus(ctx context.Context, q *repository.Queries, requestID int32, from, to string, actorID int32, comment sql.NullString) error {
+	rows, err := q.UpdatePubRequestStatus(ctx, repository.UpdatePubRequestStatusParams{
+		PubStatus:   sql.NullString{String: to, Valid: true},
+		ID:          requestID,
+		PubStatus_2: sql.NullString{String: from, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrStatusChanged
+	}
+
+	_, err = q.CreatePubRequestComment(ctx, repository.CreatePubRequestCommentParams{
+		PubRequestID: requestID,
+		MemberID:     sql.NullInt32{Int32: actorID, Valid: true},
+		Body:         comment,
+		FromStatus:   sql.NullString{String: from, Valid: true},
+		ToStatus:     sql.NullString{String: to, Valid: true},
+	})
+	return err
+}
+
+// requestStatus returns the current status of a request; requests without one are pending
+func requestStatus(r repository.GetPubRequestRow) string {
+	if !r.PubStatus.Valid || r.PubStatus.String == "" {
+		return StatusPending
+	}
+	return r.PubStatus.String
+}
@@ -0,0 +1,73 @@
+package publicity
+
+// Status IDs (pub_req_status table)
+const (
+	StatusPending     = "PENDING"
+	StatusInProgress  = "IN_PROGRESS"
+	StatusForReview   = "FOR_REVIEW"
+	StatusForRevision = "FOR_REVISION"
+	StatusApproved    = "APPROVED"
+	StatusPosted      = "POSTED"
+	StatusCancelled   = "CANCELLED"
+)
+
+// Role is the side of a publicity request allowed to make a transition
+type Role string
+
+const (
+	// RolePublicity is the Publicity committee, which produces and posts the material
+	RolePublicity Role = "publicity"
+	// RoleRequester is the requester and the organizers of the request's event, who review the material
+	RoleRequester Role = "requester"
+)
+
+// Transition is an allowed status change of a publicity request.
+// Managers of the Publicity committee can make every transition.
+type Transition struct {
+	From string `json:"from" example:"FOR_REVIEW"`
+	To   string `json:"to" example:"APPROVED"`
+	By   Role   `json:"by" example:"requester"`
+}
+
+// Transitions is the publicity request workflow: Publicity claims a request and submits the material
+// for review, the requester approves it or asks for revisions, then Publicity posts it.
+// Requesters can cancel a request until it is posted.
+var Transitions = []Transition{
+	{From: StatusPending, To: StatusInProgress, By: RolePublicity},
+	{From: StatusInProgress, To: StatusForReview, By: RolePublicity},
+	{From: StatusForReview, To: StatusForRevision, By: RoleRequester},
+	{From: StatusForReview, To: StatusApproved, By: RoleRequester},
+	{From: StatusForRevision, To: StatusForReview, By: RolePublicity},
+	{From: StatusApproved, To: StatusPosted, By: RolePublicity},
+	{From: StatusPending, To: StatusCancelled, By: RoleRequester},
+	{From: StatusInProgress, To: StatusCancelled, By: RoleRequester},
+	{From: StatusForReview, To: StatusCancelled, By: RoleRequester},
+	{From: StatusForRevision, To: StatusCancelled, By: RoleRequester},
+	{From: StatusApproved, To: StatusCancelled, By: RoleRequester},
+}
+
+// Next returns the transitions allowed from a status
+func Next(from string) []Transition {
+	next := []Transition{}
+	for _, t := range Transitions {
+		if t.From == from {
+			next = append(next, t)
+		}
+	}
+	return next
+}
+
+// Find returns the transition from one status to another, if it is allowed
+func Find(from, to string) (Transition, bool) {
+	for _, t := range Transitions {
+		if t.From == from && t.To == to {
+			return t, true
+		}
+	}
+	return Transition{}, false
+}
+
+// IsFinal reports whether a request in this status can no longer change
+func IsFinal(status string) bool {
+	return status == StatusPosted || status == StatusCancelled
+}
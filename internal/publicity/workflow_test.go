@@ -0,0 +1,36 @@
+package publicity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflow(t *testing.T) {
+	t.Run("requests can be cancelled until they are final", func(t *testing.T) {
+		for _, status := range []string{StatusPending, StatusInProgress, StatusForReview, StatusForRevision, StatusApproved} {
+			transition, ok := Find(status, StatusCancelled)
+			assert.True(t, ok, status)
+			assert.Equal(t, RoleRequester, transition.By, status)
+		}
+	})
+
+	t.Run("final statuses have no transitions", func(t *testing.T) {
+		assert.Empty(t, Next(StatusPosted))
+		assert.Empty(t, Next(StatusCancelled))
+	})
+
+	t.Run("only the requester reviews", func(t *testing.T) {
+		for _, next := range Next(StatusForReview) {
+			assert.Equal(t, RoleRequester, next.By, next.To)
+		}
+	})
+
+	t.Run("access", func(t *testing.T) {
+		assert.True(t, access{publicity: true}.can(RolePublicity))
+		assert.False(t, access{publicity: true}.can(RoleRequester))
+		assert.True(t, access{requester: true}.can(RoleRequester))
+		assert.True(t, access{manager: true}.can(RoleRequester))
+		assert.True(t, access{manager: true}.can(RolePublicity))
+	})
+}
@@ -6,55 +6,9 @@ package repository
 
 import (
 	"database/sql"
-	"database/sql/driver"
-	"fmt"
 	"time"
 )
 
-type FileStatusesStatus string
-
-const (
-	FileStatusesStatusDRAFTING FileStatusesStatus = "DRAFTING"
-	FileStatusesStatusDONE     FileStatusesStatus = "DONE"
-	FileStatusesStatusREVISE   FileStatusesStatus = "REVISE"
-	FileStatusesStatusAPPROVED FileStatusesStatus = "APPROVED"
-)
-
-func (e *FileStatusesStatus) Scan(src interface{}) error {
-	switch s := src.(type) {
-	case []byte:
-		*e = FileStatusesStatus(s)
-	case string:
-		*e = FileStatusesStatus(s)
-	default:
-		return fmt.Errorf("unsupported scan type for FileStatusesStatus: %T", src)
-	}
-	return nil
-}
-
-type NullFileStatusesStatus struct {
-	FileStatusesStatus FileStatusesStatus
-	Valid              bool // Valid is true if FileStatusesStatus is not NULL
-}
-
-// Scan implements the Scanner interface.
-func (ns *NullFileStatusesStatus) Scan(value interface{}) error {
-	if value == nil {
-		ns.FileStatusesStatus, ns.Valid = "", false
-		return nil
-	}
-	ns.Valid = true
-	return ns.FileStatusesStatus.Scan(value)
-}
-
-// Value implements the driver Valuer interface.
-func (ns NullFileStatusesStatus) Value() (driver.Value, error) {
-	if !ns.Valid {
-		return nil, nil
-	}
-	return string(ns.FileStatusesStatus), nil
-}
-
 type ApiKey struct {
 	ApiKeyID      int32
 	MemberEmail   string
@@ -67,6 +21,13 @@ type ApiKey struct {
 	ExpiresAt     sql.NullTime
 }
 
+type CalendarFeedToken struct {
+	MemberID   int32
+	TokenHash  string
+	CreatedAt  sql.NullTime
+	LastUsedAt sql.NullTime
+}
+
 type Committee struct {
 	CommitteeID   string
 	CommitteeName string
@@ -74,6 +35,13 @@ type Committee struct {
 	DivisionID    sql.NullString
 }
 
+type DiscordLink struct {
+	MemberID        int32
+	DiscordUserID   string
+	DiscordUsername sql.NullString
+	LinkedAt        sql.NullTime
+}
+
 type Division struct {
 	DivisionID   string
 	DivisionName string
@@ -89,6 +57,26 @@ type DocumentTemplate struct {
 	ID       int32
 	DocTitle string
 	DocKey   string
+	DocOrder int32
+	Required bool
+}
+
+type EmailOutbox struct {
+	ID            int32
+	MemberID      sql.NullInt32
+	Recipient     string
+	Type          string
+	Subject       string
+	TextBody      string
+	HtmlBody      string
+	DedupeKey     sql.NullString
+	Status        string
+	Attempts      int32
+	NextAttemptAt time.Time
+	LockedUntil   sql.NullTime
+	LastError     sql.NullString
+	SentAt        sql.NullTime
+	CreatedAt     sql.NullTime
 }
 
 type Event struct {
@@ -124,17 +112,40 @@ type EventDocuHead struct {
 	MemberID int32
 }
 
+type EventDriveFolder struct {
+	EventID       int32
+	Status        string
+	RootFolderID  sql.NullString
+	DocuFolderID  sql.NullString
+	FinFolderID   sql.NullString
+	PubFolderID   sql.NullString
+	Folders       sql.NullString
+	Attempts      int32
+	NextAttemptAt sql.NullTime
+	LastError     sql.NullString
+	ProvisionedAt sql.NullTime
+	CreatedAt     sql.NullTime
+}
+
 type EventDuration struct {
 	ID   int32
 	Name string
 }
 
 type EventFile struct {
-	ID         int32
-	EventID    int32
-	FileKey    string
-	FileOrder  int32
-	FileStatus int32
+	ID           int32
+	EventID      int32
+	FileKey      sql.NullString
+	FileOrder    int32
+	FileStatus   string
+	Title        string
+	TemplateID   sql.NullInt32
+	ContentType  sql.NullString
+	UploadedBy   sql.NullInt32
+	UploadedAt   sql.NullTime
+	ReviewerNote sql.NullString
+	ReviewedBy   sql.NullInt32
+	ReviewedAt   sql.NullTime
 }
 
 type EventHead struct {
@@ -153,6 +164,10 @@ type EventParticipant struct {
 	StudentName  sql.NullString
 	StudentEmail sql.NullString
 	Notes        sql.NullString
+	RegisteredAt sql.NullTime
+	CheckedInAt  sql.NullTime
+	CheckedInBy  sql.NullInt32
+	VerifiedAt   sql.NullTime
 }
 
 type EventTracker struct {
@@ -175,8 +190,8 @@ type EventType struct {
 }
 
 type FileStatus struct {
-	FileKey string
-	Status  NullFileStatusesStatus
+	ID    string
+	Title string
 }
 
 type FinProcess struct {
@@ -201,6 +216,17 @@ type House struct {
 	Description sql.NullString
 }
 
+type HousePoint struct {
+	ID        int32
+	HouseID   int32
+	TermID    int32
+	Points    int32
+	Reason    string
+	AwardedBy sql.NullInt32
+	EventID   sql.NullInt32
+	CreatedAt sql.NullTime
+}
+
 type Member struct {
 	ID            int32
 	FullName      string
@@ -226,9 +252,51 @@ type MemberRole struct {
 	GrantedAt sql.NullTime
 }
 
+type Notification struct {
+	ID        int32
+	MemberID  int32
+	Type      string
+	Title     string
+	Body      string
+	Link      sql.NullString
+	DedupeKey sql.NullString
+	ReadAt    sql.NullTime
+	CreatedAt sql.NullTime
+}
+
+type NotificationPreference struct {
+	MemberID     int32
+	Type         string
+	EmailEnabled bool
+	UpdatedAt    sql.NullTime
+}
+
+type OauthClient struct {
+	ClientID         string
+	ClientSecretHash string
+	Name             string
+	Scopes           string
+	CreatedBy        sql.NullInt32
+	CreatedAt        sql.NullTime
+	RedirectUris     sql.NullString
+}
+
+type OidcAuthorizationCode struct {
+	CodeHash      string
+	ClientID      string
+	MemberID      int32
+	RedirectUri   string
+	Scope         string
+	Nonce         sql.NullString
+	CodeChallenge string
+	ExpiresAt     time.Time
+	CreatedAt     sql.NullTime
+}
+
 type Position struct {
 	PositionID   string
 	PositionName string
+	PositionRank int32
 }
 
 type ProgressStatus struct {
@@ -257,6 +325,17 @@ type PubRequest struct {
 	CreatedAt   time.Time
 	RequesterID sql.NullInt32
 	Dimensions  sql.NullString
+	UpdatedAt   sql.NullTime
+}
+
+type PubRequestComment struct {
+	ID           int32
+	PubRequestID int32
+	MemberID     sql.NullInt32
+	Body         sql.NullString
+	FromStatus   sql.NullString
+	ToStatus     sql.NullString
+	CreatedAt    sql.NullTime
 }
 
 type Role struct {
@@ -265,6 +344,14 @@ type Role struct {
 	Description sql.NullString
 }
 
+type ScheduledJob struct {
+	Name        string
+	LastRunAt   sql.NullTime
+	LastStatus  sql.NullString
+	LastError   sql.NullString
+	LockedUntil sql.NullTime
+}
+
 type Session struct {
 	ID           string
 	MemberID     int32
@@ -273,6 +360,21 @@ type Session struct {
 	LastActivity sql.NullTime
 	UserAgent    sql.NullString
 	IpAddress    sql.NullString
+	AuthTime     sql.NullTime
+}
+
+type TelegramLink struct {
+	MemberID         int32
+	TelegramUserID   int64
+	TelegramUsername sql.NullString
+	LinkedAt         sql.NullTime
+}
+
+type TelegramLinkCode struct {
+	CodeHash  string
+	MemberID  int32
+	ExpiresAt time.Time
+	CreatedAt sql.NullTime
 }
 
 type Term struct {
@@ -280,4 +382,79 @@ type Term struct {
 	Term      int32
 	StartYear int32
 	EndYear   int32
+	StartDate sql.NullTime
+	EndDate   sql.NullTime
+	IsActive  bool
+}
+
+type TermPositionRank struct {
+	TermID       int32
+	PositionID   string
+	PositionRank int32
+}
+
+type TermRoster struct {
+	TermID      int32
+	MemberID    int32
+	PositionID  sql.NullString
+	CommitteeID sql.NullString
+	ArchivedAt  sql.NullTime
+}
+
+type TrackerReminder struct {
+	ID         int32
+	EventID    int32
+	Stage      string
+	Kind       string
+	DaysBefore int32
+	Deadline   time.Time
+	SentAt     sql.NullTime
+}
+
+type TrackerStatusHistory struct {
+	ID         int32
+	EventID    int32
+	Stage      string
+	FromStatus string
+	ToStatus   string
+	ChangedBy  sql.NullInt32
+	Note       sql.NullString
+	ChangedAt  sql.NullTime
+}
+
+type WebhookDelivery struct {
+	ID             int32
+	EndpointID     int32
+	EventID        string
+	EventType      string
+	Payload        string
+	Status         string
+	Attempts       int32
+	NextAttemptAt  time.Time
+	LockedUntil    sql.NullTime
+	LastStatusCode sql.NullInt32
+	LastError      sql.NullString
+	DeliveredAt    sql.NullTime
+	ReplayOf       sql.NullInt32
+	CreatedAt      sql.NullTime
+}
+
+type WebhookDeliveryAttempt struct {
+	ID          int32
+	DeliveryID  int32
+	Attempt     int32
+	StatusCode  sql.NullInt32
+	Error       sql.NullString
+	DurationMs  int32
+	AttemptedAt sql.NullTime
+}
+
+type WebhookEndpoint struct {
+	ID          int32
+	Url         string
+	Description sql.NullString
+	Secret      string
+	Events      string
+	IsActive    bool
+	CreatedAt   sql.NullTime
 }
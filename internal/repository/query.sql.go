@@ -8,9 +8,97 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 )
 
+const acquireScheduledJob = `-- name: AcquireScheduledJob :execrows
+UPDATE scheduled_jobs SET locked_until = ?
+WHERE name = ?
+  AND (locked_until IS NULL OR locked_until < ?)
+  AND (last_run_at IS NULL OR last_run_at <= ?)
+`
+
+type AcquireScheduledJobParams struct {
+	LockedUntil   sql.NullTime
+	Name          string
+	LockedUntil_2 sql.NullTime
+	LastRunAt     sql.NullTime
+}
+
+// locks the job if it is not running and its last run is older than the given time
+func (q *Queries) AcquireScheduledJob(ctx context.Context, arg AcquireScheduledJobParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, acquireScheduledJob,
+		arg.LockedUntil,
+		arg.Name,
+		arg.LockedUntil_2,
+		arg.LastRunAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const addEventDocuHead = `-- name: AddEventDocuHead :exec
+INSERT INTO event_docu_head (event_id, member_id) VALUES (?, ?)
+`
+
+type AddEventDocuHeadParams struct {
+	EventID  int32
+	MemberID int32
+}
+
+func (q *Queries) AddEventDocuHead(ctx context.Context, arg AddEventDocuHeadParams) error {
+	_, err := q.db.ExecContext(ctx, addEventDocuHead, arg.EventID, arg.MemberID)
+	return err
+}
+
+const addEventHead = `-- name: AddEventHead :exec
+INSERT INTO event_heads (event_id, member_id) VALUES (?, ?)
+`
+
+type AddEventHeadParams struct {
+	EventID  int32
+	MemberID int32
+}
+
+func (q *Queries) AddEventHead(ctx context.Context, arg AddEventHeadParams) error {
+	_, err := q.db.ExecContext(ctx, addEventHead, arg.EventID, arg.MemberID)
+	return err
+}
+
+const addFinProcess = `-- name: AddFinProcess :execlastid
+INSERT INTO fin_processes (tracker_id, process) VALUES (?, ?)
+`
+
+type AddFinProcessParams struct {
+	TrackerID int32
+	Process   string
+}
+
+func (q *Queries) AddFinProcess(ctx context.Context, arg AddFinProcessParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, addFinProcess, arg.TrackerID, arg.Process)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const archiveTermPositionRanks = `-- name: ArchiveTermPositionRanks :execrows
+INSERT IGNORE INTO term_position_ranks (term_id, position_id, position_rank)
+SELECT t.id, p.position_id, p.position_rank FROM terms t CROSS JOIN positions p WHERE t.id = ?
+`
+
+// keeps the ranks of a term archived (and possibly edited) before
+func (q *Queries) ArchiveTermPositionRanks(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, archiveTermPositionRanks, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const checkAllowedOriginExists = `-- name: CheckAllowedOriginExists :one
 SELECT EXISTS(SELECT 1 FROM api_keys WHERE allowed_origin = ? AND is_dev = false)
 `
@@ -32,6 +120,33 @@ func (q *Queries) CheckEmailIfMember(ctx context.Context, email string) (string,
 	return email, err
 }
 
+const checkEmailIfTermMember = `-- name: CheckEmailIfTermMember :one
+SELECT m.email FROM term_rosters r JOIN members m ON m.id = r.member_id WHERE r.term_id = ? AND m.email = ?
+`
+
+type CheckEmailIfTermMemberParams struct {
+	TermID int32
+	Email  string
+}
+
+func (q *Queries) CheckEmailIfTermMember(ctx context.Context, arg CheckEmailIfTermMemberParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, checkEmailIfTermMember, arg.TermID, arg.Email)
+	var email string
+	err := row.Scan(&email)
+	return email, err
+}
+
+const checkEventTypeExists = `-- name: CheckEventTypeExists :one
+SELECT EXISTS(SELECT 1 FROM event_types WHERE name = ?)
+`
+
+func (q *Queries) CheckEventTypeExists(ctx context.Context, name string) (bool, error) {
+	row := q.db.QueryRowContext(ctx, checkEventTypeExists, name)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const checkIdIfMember = `-- name: CheckIdIfMember :one
 SELECT id FROM members WHERE id = ?
 `
@@ -42,6 +157,109 @@ func (q *Queries) CheckIdIfMember(ctx context.Context, id int32) (int32, error)
 	return id, err
 }
 
+const checkIdIfTermMember = `-- name: CheckIdIfTermMember :one
+SELECT member_id FROM term_rosters WHERE term_id = ? AND member_id = ?
+`
+
+type CheckIdIfTermMemberParams struct {
+	TermID   int32
+	MemberID int32
+}
+
+func (q *Queries) CheckIdIfTermMember(ctx context.Context, arg CheckIdIfTermMemberParams) (int32, error) {
+	row := q.db.QueryRowContext(ctx, checkIdIfTermMember, arg.TermID, arg.MemberID)
+	var member_id int32
+	err := row.Scan(&member_id)
+	return member_id, err
+}
+
+const checkInEventParticipant = `-- name: CheckInEventParticipant :execrows
+UPDATE event_participants SET checked_in_at = NOW(), checked_in_by = ?
+WHERE event_id = ? AND student_id = ? AND checked_in_at IS NULL
+`
+
+type CheckInEventParticipantParams struct {
+	CheckedInBy sql.NullInt32
+	EventID     int32
+	StudentID   int32
+}
+
+// only the first scan records attendance
+func (q *Queries) CheckInEventParticipant(ctx context.Context, arg CheckInEventParticipantParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, checkInEventParticipant, arg.CheckedInBy, arg.EventID, arg.StudentID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const claimOutboxEmail = `-- name: ClaimOutboxEmail :execrows
+UPDATE email_outbox SET locked_until = ?
+WHERE id = ? AND status = 'PENDING' AND (locked_until IS NULL OR locked_until < ?)
+`
+
+type ClaimOutboxEmailParams struct {
+	LockedUntil   sql.NullTime
+	ID            int32
+	LockedUntil_2 sql.NullTime
+}
+
+// returns 0 if another instance claimed the email first
+func (q *Queries) ClaimOutboxEmail(ctx context.Context, arg ClaimOutboxEmailParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, claimOutboxEmail, arg.LockedUntil, arg.ID, arg.LockedUntil_2)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const claimPubRequest = `-- name: ClaimPubRequest :execrows
+UPDATE pub_requests SET pub_head = ? WHERE id = ? AND pub_head IS NULL
+`
+
+type ClaimPubRequestParams struct {
+	PubHead sql.NullInt32
+	ID      int32
+}
+
+// returns 0 if the request already has a publicity head
+func (q *Queries) ClaimPubRequest(ctx context.Context, arg ClaimPubRequestParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, claimPubRequest, arg.PubHead, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const claimWebhookDelivery = `-- name: ClaimWebhookDelivery :execrows
+UPDATE webhook_deliveries SET locked_until = ?
+WHERE id = ? AND status = 'PENDING' AND (locked_until IS NULL OR locked_until < ?)
+`
+
+type ClaimWebhookDeliveryParams struct {
+	LockedUntil   sql.NullTime
+	ID            int32
+	LockedUntil_2 sql.NullTime
+}
+
+// returns 0 if another instance claimed the delivery first
+func (q *Queries) ClaimWebhookDelivery(ctx context.Context, arg ClaimWebhookDeliveryParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, claimWebhookDelivery, arg.LockedUntil, arg.ID, arg.LockedUntil_2)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const cleanupExpiredAuthorizationCodes = `-- name: CleanupExpiredAuthorizationCodes :exec
+DELETE FROM oidc_authorization_codes WHERE expires_at < NOW()
+`
+
+func (q *Queries) CleanupExpiredAuthorizationCodes(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, cleanupExpiredAuthorizationCodes)
+	return err
+}
+
 const cleanupExpiredSessions = `-- name: CleanupExpiredSessions :exec
 DELETE FROM sessions WHERE expires_at < NOW()
 `
@@ -51,174 +269,6036 @@ func (q *Queries) CleanupExpiredSessions(ctx context.Context) error {
 	return err
 }
 
-const createSession = `-- name: CreateSession :exec
+const clearActiveTerm = `-- name: ClearActiveTerm :exec
+UPDATE terms SET is_active = FALSE WHERE is_active = TRUE
+`
 
-INSERT INTO sessions (id, member_id, expires_at, user_agent, ip_address)
-VALUES (?, ?, ?, ?, ?)
+func (q *Queries) ClearActiveTerm(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, clearActiveTerm)
+	return err
+}
+
+const countMembersWithPosition = `-- name: CountMembersWithPosition :one
+SELECT COUNT(*) FROM members WHERE position_id = ?
 `
 
-type CreateSessionParams struct {
-	ID        string
-	MemberID  int32
-	ExpiresAt time.Time
-	UserAgent sql.NullString
-	IpAddress sql.NullString
+func (q *Queries) CountMembersWithPosition(ctx context.Context, positionID sql.NullString) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countMembersWithPosition, positionID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
-// Session queries for web UI authentication
-func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) error {
-	_, err := q.db.ExecContext(ctx, createSession,
-		arg.ID,
+const countUnreadNotifications = `-- name: CountUnreadNotifications :one
+SELECT COUNT(*) FROM notifications WHERE member_id = ? AND read_at IS NULL
+`
+
+func (q *Queries) CountUnreadNotifications(ctx context.Context, memberID int32) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUnreadNotifications, memberID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAuthorizationCode = `-- name: CreateAuthorizationCode :exec
+
+INSERT INTO oidc_authorization_codes (code_hash, client_id, member_id, redirect_uri, scope, nonce, code_challenge, expires_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateAuthorizationCodeParams struct {
+	CodeHash      string
+	ClientID      string
+	MemberID      int32
+	RedirectUri   string
+	Scope         string
+	Nonce         sql.NullString
+	CodeChallenge string
+	ExpiresAt     time.Time
+}
+
+// OIDC provider queries
+func (q *Queries) CreateAuthorizationCode(ctx context.Context, arg CreateAuthorizationCodeParams) error {
+	_, err := q.db.ExecContext(ctx, createAuthorizationCode,
+		arg.CodeHash,
+		arg.ClientID,
 		arg.MemberID,
+		arg.RedirectUri,
+		arg.Scope,
+		arg.Nonce,
+		arg.CodeChallenge,
 		arg.ExpiresAt,
-		arg.UserAgent,
-		arg.IpAddress,
 	)
 	return err
 }
 
-const deleteAPIKey = `-- name: DeleteAPIKey :exec
-DELETE FROM api_keys WHERE member_email = ? LIMIT 1
+const createCalendarFeedToken = `-- name: CreateCalendarFeedToken :exec
+INSERT INTO calendar_feed_tokens (member_id, token_hash) VALUES (?, ?)
 `
 
-func (q *Queries) DeleteAPIKey(ctx context.Context, memberEmail string) error {
-	_, err := q.db.ExecContext(ctx, deleteAPIKey, memberEmail)
+type CreateCalendarFeedTokenParams struct {
+	MemberID  int32
+	TokenHash string
+}
+
+func (q *Queries) CreateCalendarFeedToken(ctx context.Context, arg CreateCalendarFeedTokenParams) error {
+	_, err := q.db.ExecContext(ctx, createCalendarFeedToken, arg.MemberID, arg.TokenHash)
 	return err
 }
 
-const deleteAPIKeyById = `-- name: DeleteAPIKeyById :exec
-DELETE FROM api_keys WHERE api_key_id = ? AND member_email = ?
+const createCommittee = `-- name: CreateCommittee :exec
+INSERT INTO committees (committee_id, committee_name, division_id) VALUES (?, ?, ?)
 `
 
-type DeleteAPIKeyByIdParams struct {
-	ApiKeyID    int32
-	MemberEmail string
+type CreateCommitteeParams struct {
+	CommitteeID   string
+	CommitteeName string
+	DivisionID    sql.NullString
 }
 
-func (q *Queries) DeleteAPIKeyById(ctx context.Context, arg DeleteAPIKeyByIdParams) error {
-	_, err := q.db.ExecContext(ctx, deleteAPIKeyById, arg.ApiKeyID, arg.MemberEmail)
+func (q *Queries) CreateCommittee(ctx context.Context, arg CreateCommitteeParams) error {
+	_, err := q.db.ExecContext(ctx, createCommittee, arg.CommitteeID, arg.CommitteeName, arg.DivisionID)
 	return err
 }
 
-const deleteAllSessionsForMember = `-- name: DeleteAllSessionsForMember :exec
-DELETE FROM sessions WHERE member_id = ?
+const createDivision = `-- name: CreateDivision :exec
+INSERT INTO divisions (division_id, division_name) VALUES (?, ?)
 `
 
-func (q *Queries) DeleteAllSessionsForMember(ctx context.Context, memberID int32) error {
-	_, err := q.db.ExecContext(ctx, deleteAllSessionsForMember, memberID)
+type CreateDivisionParams struct {
+	DivisionID   string
+	DivisionName string
+}
+
+func (q *Queries) CreateDivision(ctx context.Context, arg CreateDivisionParams) error {
+	_, err := q.db.ExecContext(ctx, createDivision, arg.DivisionID, arg.DivisionName)
 	return err
 }
 
-const deleteSession = `-- name: DeleteSession :exec
-DELETE FROM sessions WHERE id = ?
+const createEvent = `-- name: CreateEvent :execlastid
+INSERT INTO events (arn, name, committee_id, type, nature_id, term_id, duration_id, brief_description,
+                    goals, objectives, strategies, measures, budget_allocation, venue, docu_head, fin_head)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
-func (q *Queries) DeleteSession(ctx context.Context, id string) error {
-	_, err := q.db.ExecContext(ctx, deleteSession, id)
+type CreateEventParams struct {
+	Arn              string
+	Name             string
+	CommitteeID      string
+	Type             sql.NullString
+	NatureID         int32
+	TermID           int32
+	DurationID       sql.NullInt32
+	BriefDescription sql.NullString
+	Goals            sql.NullString
+	Objectives       sql.NullString
+	Strategies       sql.NullString
+	Measures         sql.NullString
+	BudgetAllocation sql.NullString
+	Venue            sql.NullString
+	DocuHead         sql.NullInt32
+	FinHead          sql.NullInt32
+}
+
+func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createEvent,
+		arg.Arn,
+		arg.Name,
+		arg.CommitteeID,
+		arg.Type,
+		arg.NatureID,
+		arg.TermID,
+		arg.DurationID,
+		arg.BriefDescription,
+		arg.Goals,
+		arg.Objectives,
+		arg.Strategies,
+		arg.Measures,
+		arg.BudgetAllocation,
+		arg.Venue,
+		arg.DocuHead,
+		arg.FinHead,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const createEventDate = `-- name: CreateEventDate :exec
+INSERT INTO event_dates (event_id, start_time, end_time) VALUES (?, ?, ?)
+`
+
+type CreateEventDateParams struct {
+	EventID   int32
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+func (q *Queries) CreateEventDate(ctx context.Context, arg CreateEventDateParams) error {
+	_, err := q.db.ExecContext(ctx, createEventDate, arg.EventID, arg.StartTime, arg.EndTime)
 	return err
 }
 
-const extendSession = `-- name: ExtendSession :exec
-UPDATE sessions SET expires_at = ?, last_activity = NOW() WHERE id = ?
+const createEventDriveFolders = `-- name: CreateEventDriveFolders :exec
+INSERT IGNORE INTO event_drive_folders (event_id, next_attempt_at) VALUES (?, ?)
 `
 
-type ExtendSessionParams struct {
-	ExpiresAt time.Time
-	ID        string
+type CreateEventDriveFoldersParams struct {
+	EventID       int32
+	NextAttemptAt sql.NullTime
 }
 
-func (q *Queries) ExtendSession(ctx context.Context, arg ExtendSessionParams) error {
-	_, err := q.db.ExecContext(ctx, extendSession, arg.ExpiresAt, arg.ID)
+func (q *Queries) CreateEventDriveFolders(ctx context.Context, arg CreateEventDriveFoldersParams) error {
+	_, err := q.db.ExecContext(ctx, createEventDriveFolders, arg.EventID, arg.NextAttemptAt)
 	return err
 }
 
-const getAPIKeyInfo = `-- name: GetAPIKeyInfo :one
-SELECT api_key_id, member_email, api_key_hash, project, allowed_origin, is_dev, is_admin, created_at, expires_at FROM api_keys WHERE api_key_hash = ?
+const createEventFile = `-- name: CreateEventFile :execlastid
+INSERT INTO event_files (event_id, file_key, file_order, file_status, title, content_type, uploaded_by, uploaded_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 `
 
-func (q *Queries) GetAPIKeyInfo(ctx context.Context, apiKeyHash string) (ApiKey, error) {
-	row := q.db.QueryRowContext(ctx, getAPIKeyInfo, apiKeyHash)
-	var i ApiKey
-	err := row.Scan(
-		&i.ApiKeyID,
-		&i.MemberEmail,
-		&i.ApiKeyHash,
-		&i.Project,
-		&i.AllowedOrigin,
-		&i.IsDev,
-		&i.IsAdmin,
-		&i.CreatedAt,
-		&i.ExpiresAt,
+type CreateEventFileParams struct {
+	EventID     int32
+	FileKey     sql.NullString
+	FileOrder   int32
+	FileStatus  string
+	Title       string
+	ContentType sql.NullString
+	UploadedBy  sql.NullInt32
+	UploadedAt  sql.NullTime
+}
+
+func (q *Queries) CreateEventFile(ctx context.Context, arg CreateEventFileParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createEventFile,
+		arg.EventID,
+		arg.FileKey,
+		arg.FileOrder,
+		arg.FileStatus,
+		arg.Title,
+		arg.ContentType,
+		arg.UploadedBy,
+		arg.UploadedAt,
 	)
-	return i, err
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
 }
 
-const getAPIKeyInfoWithEmail = `-- name: GetAPIKeyInfoWithEmail :one
-SELECT api_key_id, member_email, api_key_hash, project, allowed_origin, is_dev, is_admin, created_at, expires_at FROM api_keys WHERE member_email = ?
+const createEventParticipant = `-- name: CreateEventParticipant :exec
+INSERT INTO event_participants (event_id, student_id, student_name, student_email, notes, verified_at)
+VALUES (?, ?, ?, ?, ?, ?)
 `
 
-func (q *Queries) GetAPIKeyInfoWithEmail(ctx context.Context, memberEmail string) (ApiKey, error) {
-	row := q.db.QueryRowContext(ctx, getAPIKeyInfoWithEmail, memberEmail)
-	var i ApiKey
-	err := row.Scan(
-		&i.ApiKeyID,
-		&i.MemberEmail,
-		&i.ApiKeyHash,
-		&i.Project,
-		&i.AllowedOrigin,
-		&i.IsDev,
-		&i.IsAdmin,
-		&i.CreatedAt,
-		&i.ExpiresAt,
+type CreateEventParticipantParams struct {
+	EventID      int32
+	StudentID    int32
+	StudentName  sql.NullString
+	StudentEmail sql.NullString
+	Notes        sql.NullString
+	VerifiedAt   sql.NullTime
+}
+
+// verified_at stays NULL for public registrations until their email is verified
+func (q *Queries) CreateEventParticipant(ctx context.Context, arg CreateEventParticipantParams) error {
+	_, err := q.db.ExecContext(ctx, createEventParticipant,
+		arg.EventID,
+		arg.StudentID,
+		arg.StudentName,
+		arg.StudentEmail,
+		arg.Notes,
+		arg.VerifiedAt,
 	)
-	return i, err
+	return err
 }
 
-const getAllAPIKeyHashes = `-- name: GetAllAPIKeyHashes :many
-SELECT api_key_hash FROM api_keys
+const createEventTracker = `-- name: CreateEventTracker :exec
+INSERT IGNORE INTO event_trackers (event_id) VALUES (?)
 `
 
-func (q *Queries) GetAllAPIKeyHashes(ctx context.Context) ([]string, error) {
-	rows, err := q.db.QueryContext(ctx, getAllAPIKeyHashes)
+func (q *Queries) CreateEventTracker(ctx context.Context, eventID int32) error {
+	_, err := q.db.ExecContext(ctx, createEventTracker, eventID)
+	return err
+}
+
+const createHouse = `-- name: CreateHouse :execlastid
+INSERT INTO houses (name, description) VALUES (?, ?)
+`
+
+type CreateHouseParams struct {
+	Name        sql.NullString
+	Description sql.NullString
+}
+
+func (q *Queries) CreateHouse(ctx context.Context, arg CreateHouseParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createHouse, arg.Name, arg.Description)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []string
-	for rows.Next() {
-		var api_key_hash string
-		if err := rows.Scan(&api_key_hash); err != nil {
-			return nil, err
-		}
-		items = append(items, api_key_hash)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+	return result.LastInsertId()
+}
+
+const createHousePoints = `-- name: CreateHousePoints :execlastid
+INSERT INTO house_points (house_id, term_id, points, reason, awarded_by, event_id) VALUES (?, ?, ?, ?, ?, ?)
+`
+
+type CreateHousePointsParams struct {
+	HouseID   int32
+	TermID    int32
+	Points    int32
+	Reason    string
+	AwardedBy sql.NullInt32
+	EventID   sql.NullInt32
+}
+
+func (q *Queries) CreateHousePoints(ctx context.Context, arg CreateHousePointsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createHousePoints,
+		arg.HouseID,
+		arg.TermID,
+		arg.Points,
+		arg.Reason,
+		arg.AwardedBy,
+		arg.EventID,
+	)
+	if err != nil {
+		return 0, err
 	}
-	return items, nil
+	return result.LastInsertId()
 }
 
-const getAllCommittees = `-- name: GetAllCommittees :many
-SELECT c.committee_id, c.committee_name, c.committee_head, c.division_id FROM committees c
+const createNotification = `-- name: CreateNotification :execrows
+
+INSERT IGNORE INTO notifications (member_id, type, title, body, link, dedupe_key)
+VALUES (?, ?, ?, ?, ?, ?)
 `
 
-func (q *Queries) GetAllCommittees(ctx context.Context) ([]Committee, error) {
-	rows, err := q.db.QueryContext(ctx, getAllCommittees)
+type CreateNotificationParams struct {
+	MemberID  int32
+	Type      string
+	Title     string
+	Body      string
+	Link      sql.NullString
+	DedupeKey sql.NullString
+}
+
+// In-app notification queries
+// returns 0 if a notification with the same dedupe key was already added
+func (q *Queries) CreateNotification(ctx context.Context, arg CreateNotificationParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createNotification,
+		arg.MemberID,
+		arg.Type,
+		arg.Title,
+		arg.Body,
+		arg.Link,
+		arg.DedupeKey,
+	)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	defer rows.Close()
+	return result.RowsAffected()
+}
+
+const createOAuthClient = `-- name: CreateOAuthClient :exec
+
+INSERT INTO oauth_clients (client_id, client_secret_hash, name, scopes, redirect_uris, created_by)
+VALUES (?, ?, ?, ?, ?, ?)
+`
+
+type CreateOAuthClientParams struct {
+	ClientID         string
+	ClientSecretHash string
+	Name             string
+	Scopes           string
+	RedirectUris     sql.NullString
+	CreatedBy        sql.NullInt32
+}
+
+// OAuth2 client credentials queries
+func (q *Queries) CreateOAuthClient(ctx context.Context, arg CreateOAuthClientParams) error {
+	_, err := q.db.ExecContext(ctx, createOAuthClient,
+		arg.ClientID,
+		arg.ClientSecretHash,
+		arg.Name,
+		arg.Scopes,
+		arg.RedirectUris,
+		arg.CreatedBy,
+	)
+	return err
+}
+
+const createOutboxEmail = `-- name: CreateOutboxEmail :execrows
+
+INSERT IGNORE INTO email_outbox (member_id, recipient, type, subject, text_body, html_body, dedupe_key, next_attempt_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateOutboxEmailParams struct {
+	MemberID      sql.NullInt32
+	Recipient     string
+	Type          string
+	Subject       string
+	TextBody      string
+	HtmlBody      string
+	DedupeKey     sql.NullString
+	NextAttemptAt time.Time
+}
+
+// Email notification queries
+// returns 0 if an email with the same dedupe key was already queued
+func (q *Queries) CreateOutboxEmail(ctx context.Context, arg CreateOutboxEmailParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createOutboxEmail,
+		arg.MemberID,
+		arg.Recipient,
+		arg.Type,
+		arg.Subject,
+		arg.TextBody,
+		arg.HtmlBody,
+		arg.DedupeKey,
+		arg.NextAttemptAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createPosition = `-- name: CreatePosition :exec
+INSERT INTO positions (position_id, position_name, position_rank) VALUES (?, ?, ?)
+`
+
+type CreatePositionParams struct {
+	PositionID   string
+	PositionName string
+	PositionRank int32
+}
+
+func (q *Queries) CreatePosition(ctx context.Context, arg CreatePositionParams) error {
+	_, err := q.db.ExecContext(ctx, createPosition, arg.PositionID, arg.PositionName, arg.PositionRank)
+	return err
+}
+
+const createPubRequest = `-- name: CreatePubRequest :execlastid
+INSERT INTO pub_requests (
+  event_id, pub_type, pub_drive_id, pub_status, posting_date, pub_details, pub_content,
+  caption, opa_numbers, for_posting, created_at, requester_id, dimensions
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreatePubRequestParams struct {
+	EventID     sql.NullInt32
+	PubType     sql.NullString
+	PubDriveID  string
+	PubStatus   sql.NullString
+	PostingDate time.Time
+	PubDetails  string
+	PubContent  string
+	Caption     string
+	OpaNumbers  string
+	ForPosting  bool
+	CreatedAt   time.Time
+	RequesterID sql.NullInt32
+	Dimensions  sql.NullString
+}
+
+func (q *Queries) CreatePubRequest(ctx context.Context, arg CreatePubRequestParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createPubRequest,
+		arg.EventID,
+		arg.PubType,
+		arg.PubDriveID,
+		arg.PubStatus,
+		arg.PostingDate,
+		arg.PubDetails,
+		arg.PubContent,
+		arg.Caption,
+		arg.OpaNumbers,
+		arg.ForPosting,
+		arg.CreatedAt,
+		arg.RequesterID,
+		arg.Dimensions,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const createPubRequestComment = `-- name: CreatePubRequestComment :execlastid
+INSERT INTO pub_request_comments (pub_request_id, member_id, body, from_status, to_status)
+VALUES (?, ?, ?, ?, ?)
+`
+
+type CreatePubRequestCommentParams struct {
+	PubRequestID int32
+	MemberID     sql.NullInt32
+	Body         sql.NullString
+	FromStatus   sql.NullString
+	ToStatus     sql.NullString
+}
+
+func (q *Queries) CreatePubRequestComment(ctx context.Context, arg CreatePubRequestCommentParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createPubRequestComment,
+		arg.PubRequestID,
+		arg.MemberID,
+		arg.Body,
+		arg.FromStatus,
+		arg.ToStatus,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const createScheduledJob = `-- name: CreateScheduledJob :exec
+
+INSERT IGNORE INTO scheduled_jobs (name) VALUES (?)
+`
+
+// Scheduled job queries
+func (q *Queries) CreateScheduledJob(ctx context.Context, name string) error {
+	_, err := q.db.ExecContext(ctx, createScheduledJob, name)
+	return err
+}
+
+const createSession = `-- name: CreateSession :exec
+
+INSERT INTO sessions (id, member_id, expires_at, user_agent, ip_address, auth_time)
+VALUES (?, ?, ?, ?, ?, NOW())
+`
+
+type CreateSessionParams struct {
+	ID        string
+	MemberID  int32
+	ExpiresAt time.Time
+	UserAgent sql.NullString
+	IpAddress sql.NullString
+}
+
+// Session queries for web UI authentication
+func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) error {
+	_, err := q.db.ExecContext(ctx, createSession,
+		arg.ID,
+		arg.MemberID,
+		arg.ExpiresAt,
+		arg.UserAgent,
+		arg.IpAddress,
+	)
+	return err
+}
+
+const createTelegramLinkCode = `-- name: CreateTelegramLinkCode :exec
+
+INSERT INTO telegram_link_codes (code_hash, member_id, expires_at) VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE code_hash = VALUES(code_hash), expires_at = VALUES(expires_at), created_at = CURRENT_TIMESTAMP
+`
+
+type CreateTelegramLinkCodeParams struct {
+	CodeHash  string
+	MemberID  int32
+	ExpiresAt time.Time
+}
+
+// Telegram bot queries
+// replaces the member's previous code, if any
+func (q *Queries) CreateTelegramLinkCode(ctx context.Context, arg CreateTelegramLinkCodeParams) error {
+	_, err := q.db.ExecContext(ctx, createTelegramLinkCode, arg.CodeHash, arg.MemberID, arg.ExpiresAt)
+	return err
+}
+
+const createTemplateFile = `-- name: CreateTemplateFile :execrows
+INSERT IGNORE INTO event_files (event_id, template_id, title, file_order, file_status)
+VALUES (?, ?, ?, ?, ?)
+`
+
+type CreateTemplateFileParams struct {
+	EventID    int32
+	TemplateID sql.NullInt32
+	Title      string
+	FileOrder  int32
+	FileStatus string
+}
+
+// returns 0 if the event already has the template's document
+func (q *Queries) CreateTemplateFile(ctx context.Context, arg CreateTemplateFileParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createTemplateFile,
+		arg.EventID,
+		arg.TemplateID,
+		arg.Title,
+		arg.FileOrder,
+		arg.FileStatus,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createTerm = `-- name: CreateTerm :execlastid
+INSERT INTO terms (term, start_year, end_year, start_date, end_date) VALUES (?, ?, ?, ?, ?)
+`
+
+type CreateTermParams struct {
+	Term      int32
+	StartYear int32
+	EndYear   int32
+	StartDate sql.NullTime
+	EndDate   sql.NullTime
+}
+
+func (q *Queries) CreateTerm(ctx context.Context, arg CreateTermParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createTerm,
+		arg.Term,
+		arg.StartYear,
+		arg.EndYear,
+		arg.StartDate,
+		arg.EndDate,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const createTermRosterEntry = `-- name: CreateTermRosterEntry :execrows
+INSERT IGNORE INTO term_rosters (term_id, member_id, position_id, committee_id) VALUES (?, ?, ?, ?)
+`
+
+type CreateTermRosterEntryParams struct {
+	TermID      int32
+	MemberID    int32
+	PositionID  sql.NullString
+	CommitteeID sql.NullString
+}
+
+// returns 0 if the member is already in the term's archived membership
+func (q *Queries) CreateTermRosterEntry(ctx context.Context, arg CreateTermRosterEntryParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createTermRosterEntry,
+		arg.TermID,
+		arg.MemberID,
+		arg.PositionID,
+		arg.CommitteeID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createTrackerReminder = `-- name: CreateTrackerReminder :execrows
+INSERT IGNORE INTO tracker_reminders (event_id, stage, kind, days_before, deadline)
+VALUES (?, ?, ?, ?, ?)
+`
+
+type CreateTrackerReminderParams struct {
+	EventID    int32
+	Stage      string
+	Kind       string
+	DaysBefore int32
+	Deadline   time.Time
+}
+
+// returns 0 if the reminder was already sent
+func (q *Queries) CreateTrackerReminder(ctx context.Context, arg CreateTrackerReminderParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createTrackerReminder,
+		arg.EventID,
+		arg.Stage,
+		arg.Kind,
+		arg.DaysBefore,
+		arg.Deadline,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createTrackerStatusHistory = `-- name: CreateTrackerStatusHistory :exec
+INSERT INTO tracker_status_history (event_id, stage, from_status, to_status, changed_by, note)
+VALUES (?, ?, ?, ?, ?, ?)
+`
+
+type CreateTrackerStatusHistoryParams struct {
+	EventID    int32
+	Stage      string
+	FromStatus string
+	ToStatus   string
+	ChangedBy  sql.NullInt32
+	Note       sql.NullString
+}
+
+func (q *Queries) CreateTrackerStatusHistory(ctx context.Context, arg CreateTrackerStatusHistoryParams) error {
+	_, err := q.db.ExecContext(ctx, createTrackerStatusHistory,
+		arg.EventID,
+		arg.Stage,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.ChangedBy,
+		arg.Note,
+	)
+	return err
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :execlastid
+INSERT INTO webhook_deliveries (endpoint_id, event_id, event_type, payload, next_attempt_at, replay_of)
+VALUES (?, ?, ?, ?, ?, ?)
+`
+
+type CreateWebhookDeliveryParams struct {
+	EndpointID    int32
+	EventID       string
+	EventType     string
+	Payload       string
+	NextAttemptAt time.Time
+	ReplayOf      sql.NullInt32
+}
+
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createWebhookDelivery,
+		arg.EndpointID,
+		arg.EventID,
+		arg.EventType,
+		arg.Payload,
+		arg.NextAttemptAt,
+		arg.ReplayOf,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const createWebhookDeliveryAttempt = `-- name: CreateWebhookDeliveryAttempt :exec
+INSERT INTO webhook_delivery_attempts (delivery_id, attempt, status_code, error, duration_ms) VALUES (?, ?, ?, ?, ?)
+`
+
+type CreateWebhookDeliveryAttemptParams struct {
+	DeliveryID int32
+	Attempt    int32
+	StatusCode sql.NullInt32
+	Error      sql.NullString
+	DurationMs int32
+}
+
+func (q *Queries) CreateWebhookDeliveryAttempt(ctx context.Context, arg CreateWebhookDeliveryAttemptParams) error {
+	_, err := q.db.ExecContext(ctx, createWebhookDeliveryAttempt,
+		arg.DeliveryID,
+		arg.Attempt,
+		arg.StatusCode,
+		arg.Error,
+		arg.DurationMs,
+	)
+	return err
+}
+
+const createWebhookEndpoint = `-- name: CreateWebhookEndpoint :execlastid
+INSERT INTO webhook_endpoints (url, description, secret, events) VALUES (?, ?, ?, ?)
+`
+
+type CreateWebhookEndpointParams struct {
+	Url         string
+	Description sql.NullString
+	Secret      string
+	Events      string
+}
+
+func (q *Queries) CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createWebhookEndpoint,
+		arg.Url,
+		arg.Description,
+		arg.Secret,
+		arg.Events,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const deleteAPIKey = `-- name: DeleteAPIKey :exec
+DELETE FROM api_keys WHERE member_email = ? LIMIT 1
+`
+
+func (q *Queries) DeleteAPIKey(ctx context.Context, memberEmail string) error {
+	_, err := q.db.ExecContext(ctx, deleteAPIKey, memberEmail)
+	return err
+}
+
+const deleteAPIKeyById = `-- name: DeleteAPIKeyById :execrows
+DELETE FROM api_keys WHERE api_key_id = ? AND member_email = ?
+`
+
+type DeleteAPIKeyByIdParams struct {
+	ApiKeyID    int32
+	MemberEmail string
+}
+
+func (q *Queries) DeleteAPIKeyById(ctx context.Context, arg DeleteAPIKeyByIdParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteAPIKeyById, arg.ApiKeyID, arg.MemberEmail)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteAllSessionsForMember = `-- name: DeleteAllSessionsForMember :exec
+DELETE FROM sessions WHERE member_id = ?
+`
+
+func (q *Queries) DeleteAllSessionsForMember(ctx context.Context, memberID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteAllSessionsForMember, memberID)
+	return err
+}
+
+const deleteAuthorizationCode = `-- name: DeleteAuthorizationCode :execrows
+DELETE FROM oidc_authorization_codes WHERE code_hash = ?
+`
+
+func (q *Queries) DeleteAuthorizationCode(ctx context.Context, codeHash string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteAuthorizationCode, codeHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteCalendarFeedToken = `-- name: DeleteCalendarFeedToken :exec
+DELETE FROM calendar_feed_tokens WHERE member_id = ?
+`
+
+func (q *Queries) DeleteCalendarFeedToken(ctx context.Context, memberID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteCalendarFeedToken, memberID)
+	return err
+}
+
+const deleteCommittee = `-- name: DeleteCommittee :execrows
+DELETE FROM committees WHERE committee_id = ?
+`
+
+func (q *Queries) DeleteCommittee(ctx context.Context, committeeID string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteCommittee, committeeID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteDiscordLink = `-- name: DeleteDiscordLink :execrows
+DELETE FROM discord_links WHERE member_id = ?
+`
+
+func (q *Queries) DeleteDiscordLink(ctx context.Context, memberID int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteDiscordLink, memberID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteDiscordLinkByUser = `-- name: DeleteDiscordLinkByUser :execrows
+DELETE FROM discord_links WHERE discord_user_id = ?
+`
+
+func (q *Queries) DeleteDiscordLinkByUser(ctx context.Context, discordUserID string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteDiscordLinkByUser, discordUserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteDivision = `-- name: DeleteDivision :execrows
+DELETE FROM divisions WHERE division_id = ?
+`
+
+func (q *Queries) DeleteDivision(ctx context.Context, divisionID string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteDivision, divisionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteEvent = `-- name: DeleteEvent :execrows
+DELETE FROM events WHERE id = ?
+`
+
+func (q *Queries) DeleteEvent(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteEvent, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteEventDates = `-- name: DeleteEventDates :exec
+DELETE FROM event_dates WHERE event_id = ?
+`
+
+func (q *Queries) DeleteEventDates(ctx context.Context, eventID int32) error {
+	_, err := q.db.ExecContext(ctx, deleteEventDates, eventID)
+	return err
+}
+
+const deleteEventFile = `-- name: DeleteEventFile :exec
+DELETE FROM event_files WHERE id = ? AND event_id = ?
+`
+
+type DeleteEventFileParams struct {
+	ID      int32
+	EventID int32
+}
+
+func (q *Queries) DeleteEventFile(ctx context.Context, arg DeleteEventFileParams) error {
+	_, err := q.db.ExecContext(ctx, deleteEventFile, arg.ID, arg.EventID)
+	return err
+}
+
+const deleteEventParticipant = `-- name: DeleteEventParticipant :execrows
+DELETE FROM event_participants WHERE event_id = ? AND student_id = ?
+`
+
+type DeleteEventParticipantParams struct {
+	EventID   int32
+	StudentID int32
+}
+
+func (q *Queries) DeleteEventParticipant(ctx context.Context, arg DeleteEventParticipantParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteEventParticipant, arg.EventID, arg.StudentID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteHouse = `-- name: DeleteHouse :execrows
+DELETE FROM houses WHERE id = ?
+`
+
+func (q *Queries) DeleteHouse(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteHouse, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteOAuthClient = `-- name: DeleteOAuthClient :execrows
+DELETE FROM oauth_clients WHERE client_id = ?
+`
+
+func (q *Queries) DeleteOAuthClient(ctx context.Context, clientID string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteOAuthClient, clientID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deletePosition = `-- name: DeletePosition :execrows
+DELETE FROM positions WHERE position_id = ?
+`
+
+func (q *Queries) DeletePosition(ctx context.Context, positionID string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deletePosition, positionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteSession = `-- name: DeleteSession :exec
+DELETE FROM sessions WHERE id = ?
+`
+
+func (q *Queries) DeleteSession(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, deleteSession, id)
+	return err
+}
+
+const deleteTelegramLink = `-- name: DeleteTelegramLink :execrows
+DELETE FROM telegram_links WHERE member_id = ?
+`
+
+func (q *Queries) DeleteTelegramLink(ctx context.Context, memberID int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteTelegramLink, memberID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteTelegramLinkByUser = `-- name: DeleteTelegramLinkByUser :execrows
+DELETE FROM telegram_links WHERE telegram_user_id = ?
+`
+
+func (q *Queries) DeleteTelegramLinkByUser(ctx context.Context, telegramUserID int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteTelegramLinkByUser, telegramUserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteTelegramLinkCode = `-- name: DeleteTelegramLinkCode :execrows
+DELETE FROM telegram_link_codes WHERE code_hash = ?
+`
+
+func (q *Queries) DeleteTelegramLinkCode(ctx context.Context, codeHash string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteTelegramLinkCode, codeHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteTerm = `-- name: DeleteTerm :execrows
+DELETE FROM terms WHERE id = ?
+`
+
+func (q *Queries) DeleteTerm(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteTerm, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteTrackerReminder = `-- name: DeleteTrackerReminder :exec
+DELETE FROM tracker_reminders
+WHERE event_id = ? AND stage = ? AND kind = ? AND days_before = ? AND deadline = ?
+`
+
+type DeleteTrackerReminderParams struct {
+	EventID    int32
+	Stage      string
+	Kind       string
+	DaysBefore int32
+	Deadline   time.Time
+}
+
+func (q *Queries) DeleteTrackerReminder(ctx context.Context, arg DeleteTrackerReminderParams) error {
+	_, err := q.db.ExecContext(ctx, deleteTrackerReminder,
+		arg.EventID,
+		arg.Stage,
+		arg.Kind,
+		arg.DaysBefore,
+		arg.Deadline,
+	)
+	return err
+}
+
+const deleteWebhookEndpoint = `-- name: DeleteWebhookEndpoint :execrows
+DELETE FROM webhook_endpoints WHERE id = ?
+`
+
+func (q *Queries) DeleteWebhookEndpoint(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteWebhookEndpoint, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const extendSession = `-- name: ExtendSession :exec
+UPDATE sessions SET expires_at = ?, last_activity = NOW() WHERE id = ?
+`
+
+type ExtendSessionParams struct {
+	ExpiresAt time.Time
+	ID        string
+}
+
+func (q *Queries) ExtendSession(ctx context.Context, arg ExtendSessionParams) error {
+	_, err := q.db.ExecContext(ctx, extendSession, arg.ExpiresAt, arg.ID)
+	return err
+}
+
+const fillPubRequestDriveIDs = `-- name: FillPubRequestDriveIDs :execrows
+UPDATE pub_requests SET pub_drive_id = ? WHERE event_id = ? AND pub_drive_id = ''
+`
+
+type FillPubRequestDriveIDsParams struct {
+	PubDriveID string
+	EventID    sql.NullInt32
+}
+
+func (q *Queries) FillPubRequestDriveIDs(ctx context.Context, arg FillPubRequestDriveIDsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, fillPubRequestDriveIDs, arg.PubDriveID, arg.EventID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const fillTrackerDocuDriveID = `-- name: FillTrackerDocuDriveID :exec
+UPDATE event_trackers SET docu_drive_id = ? WHERE event_id = ? AND (docu_drive_id IS NULL OR docu_drive_id = '')
+`
+
+type FillTrackerDocuDriveIDParams struct {
+	DocuDriveID sql.NullString
+	EventID     int32
+}
+
+// only fills in the ID if none was set by hand
+func (q *Queries) FillTrackerDocuDriveID(ctx context.Context, arg FillTrackerDocuDriveIDParams) error {
+	_, err := q.db.ExecContext(ctx, fillTrackerDocuDriveID, arg.DocuDriveID, arg.EventID)
+	return err
+}
+
+const fillTrackerFinDriveID = `-- name: FillTrackerFinDriveID :exec
+UPDATE event_trackers SET fin_drive_id = ? WHERE event_id = ? AND (fin_drive_id IS NULL OR fin_drive_id = '')
+`
+
+type FillTrackerFinDriveIDParams struct {
+	FinDriveID sql.NullString
+	EventID    int32
+}
+
+// only fills in the ID if none was set by hand
+func (q *Queries) FillTrackerFinDriveID(ctx context.Context, arg FillTrackerFinDriveIDParams) error {
+	_, err := q.db.ExecContext(ctx, fillTrackerFinDriveID, arg.FinDriveID, arg.EventID)
+	return err
+}
+
+const fillTrackerFinPostactsDeadline = `-- name: FillTrackerFinPostactsDeadline :execrows
+UPDATE event_trackers SET fin_postacts_deadline = ? WHERE event_id = ? AND fin_postacts_deadline IS NULL
+`
+
+type FillTrackerFinPostactsDeadlineParams struct {
+	FinPostactsDeadline sql.NullTime
+	EventID             int32
+}
+
+// only fills in the deadline if none was set by hand
+func (q *Queries) FillTrackerFinPostactsDeadline(ctx context.Context, arg FillTrackerFinPostactsDeadlineParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, fillTrackerFinPostactsDeadline, arg.FinPostactsDeadline, arg.EventID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const fillTrackerFinPreactsDeadline = `-- name: FillTrackerFinPreactsDeadline :execrows
+UPDATE event_trackers SET fin_preacts_deadline = ? WHERE event_id = ? AND fin_preacts_deadline IS NULL
+`
+
+type FillTrackerFinPreactsDeadlineParams struct {
+	FinPreactsDeadline sql.NullTime
+	EventID            int32
+}
+
+// only fills in the deadline if none was set by hand
+func (q *Queries) FillTrackerFinPreactsDeadline(ctx context.Context, arg FillTrackerFinPreactsDeadlineParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, fillTrackerFinPreactsDeadline, arg.FinPreactsDeadline, arg.EventID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const fillTrackerPostactsDeadline = `-- name: FillTrackerPostactsDeadline :execrows
+UPDATE event_trackers SET postacts_deadline = ? WHERE event_id = ? AND postacts_deadline IS NULL
+`
+
+type FillTrackerPostactsDeadlineParams struct {
+	PostactsDeadline sql.NullTime
+	EventID          int32
+}
+
+// only fills in the deadline if none was set by hand
+func (q *Queries) FillTrackerPostactsDeadline(ctx context.Context, arg FillTrackerPostactsDeadlineParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, fillTrackerPostactsDeadline, arg.PostactsDeadline, arg.EventID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const fillTrackerPreactsDeadline = `-- name: FillTrackerPreactsDeadline :execrows
+UPDATE event_trackers SET preacts_deadline = ? WHERE event_id = ? AND preacts_deadline IS NULL
+`
+
+type FillTrackerPreactsDeadlineParams struct {
+	PreactsDeadline sql.NullTime
+	EventID         int32
+}
+
+// only fills in the deadline if none was set by hand
+func (q *Queries) FillTrackerPreactsDeadline(ctx context.Context, arg FillTrackerPreactsDeadlineParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, fillTrackerPreactsDeadline, arg.PreactsDeadline, arg.EventID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const finishEventDriveFolders = `-- name: FinishEventDriveFolders :exec
+UPDATE event_drive_folders
+SET status = ?, attempts = ?, next_attempt_at = ?, last_error = ?, provisioned_at = ?
+WHERE event_id = ?
+`
+
+type FinishEventDriveFoldersParams struct {
+	Status        string
+	Attempts      int32
+	NextAttemptAt sql.NullTime
+	LastError     sql.NullString
+	ProvisionedAt sql.NullTime
+	EventID       int32
+}
+
+func (q *Queries) FinishEventDriveFolders(ctx context.Context, arg FinishEventDriveFoldersParams) error {
+	_, err := q.db.ExecContext(ctx, finishEventDriveFolders,
+		arg.Status,
+		arg.Attempts,
+		arg.NextAttemptAt,
+		arg.LastError,
+		arg.ProvisionedAt,
+		arg.EventID,
+	)
+	return err
+}
+
+const finishOutboxEmailAttempt = `-- name: FinishOutboxEmailAttempt :exec
+UPDATE email_outbox
+SET status = ?, attempts = ?, next_attempt_at = ?, last_error = ?, sent_at = ?, locked_until = NULL
+WHERE id = ?
+`
+
+type FinishOutboxEmailAttemptParams struct {
+	Status        string
+	Attempts      int32
+	NextAttemptAt time.Time
+	LastError     sql.NullString
+	SentAt        sql.NullTime
+	ID            int32
+}
+
+func (q *Queries) FinishOutboxEmailAttempt(ctx context.Context, arg FinishOutboxEmailAttemptParams) error {
+	_, err := q.db.ExecContext(ctx, finishOutboxEmailAttempt,
+		arg.Status,
+		arg.Attempts,
+		arg.NextAttemptAt,
+		arg.LastError,
+		arg.SentAt,
+		arg.ID,
+	)
+	return err
+}
+
+const finishScheduledJob = `-- name: FinishScheduledJob :exec
+UPDATE scheduled_jobs
+SET last_run_at = ?, last_status = ?, last_error = ?, locked_until = NULL
+WHERE name = ?
+`
+
+type FinishScheduledJobParams struct {
+	LastRunAt  sql.NullTime
+	LastStatus sql.NullString
+	LastError  sql.NullString
+	Name       string
+}
+
+func (q *Queries) FinishScheduledJob(ctx context.Context, arg FinishScheduledJobParams) error {
+	_, err := q.db.ExecContext(ctx, finishScheduledJob,
+		arg.LastRunAt,
+		arg.LastStatus,
+		arg.LastError,
+		arg.Name,
+	)
+	return err
+}
+
+const finishWebhookDeliveryAttempt = `-- name: FinishWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET status = ?, attempts = ?, next_attempt_at = ?, last_status_code = ?, last_error = ?, delivered_at = ?, locked_until = NULL
+WHERE id = ?
+`
+
+type FinishWebhookDeliveryAttemptParams struct {
+	Status         string
+	Attempts       int32
+	NextAttemptAt  time.Time
+	LastStatusCode sql.NullInt32
+	LastError      sql.NullString
+	DeliveredAt    sql.NullTime
+	ID             int32
+}
+
+func (q *Queries) FinishWebhookDeliveryAttempt(ctx context.Context, arg FinishWebhookDeliveryAttemptParams) error {
+	_, err := q.db.ExecContext(ctx, finishWebhookDeliveryAttempt,
+		arg.Status,
+		arg.Attempts,
+		arg.NextAttemptAt,
+		arg.LastStatusCode,
+		arg.LastError,
+		arg.DeliveredAt,
+		arg.ID,
+	)
+	return err
+}
+
+const getAPIKeyInfo = `-- name: GetAPIKeyInfo :one
+SELECT api_key_id, member_email, api_key_hash, project, allowed_origin, is_dev, is_admin, created_at, expires_at FROM api_keys WHERE api_key_hash = ?
+`
+
+func (q *Queries) GetAPIKeyInfo(ctx context.Context, apiKeyHash string) (ApiKey, error) {
+	row := q.db.QueryRowContext(ctx, getAPIKeyInfo, apiKeyHash)
+	var i ApiKey
+	err := row.Scan(
+		&i.ApiKeyID,
+		&i.MemberEmail,
+		&i.ApiKeyHash,
+		&i.Project,
+		&i.AllowedOrigin,
+		&i.IsDev,
+		&i.IsAdmin,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getAPIKeyInfoWithEmail = `-- name: GetAPIKeyInfoWithEmail :one
+SELECT api_key_id, member_email, api_key_hash, project, allowed_origin, is_dev, is_admin, created_at, expires_at FROM api_keys WHERE member_email = ?
+`
+
+func (q *Queries) GetAPIKeyInfoWithEmail(ctx context.Context, memberEmail string) (ApiKey, error) {
+	row := q.db.QueryRowContext(ctx, getAPIKeyInfoWithEmail, memberEmail)
+	var i ApiKey
+	err := row.Scan(
+		&i.ApiKeyID,
+		&i.MemberEmail,
+		&i.ApiKeyHash,
+		&i.Project,
+		&i.AllowedOrigin,
+		&i.IsDev,
+		&i.IsAdmin,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getActiveTerm = `-- name: GetActiveTerm :one
+SELECT id, term, start_year, end_year, start_date, end_date, is_active FROM terms WHERE is_active = TRUE LIMIT 1
+`
+
+func (q *Queries) GetActiveTerm(ctx context.Context) (Term, error) {
+	row := q.db.QueryRowContext(ctx, getActiveTerm)
+	var i Term
+	err := row.Scan(
+		&i.ID,
+		&i.Term,
+		&i.StartYear,
+		&i.EndYear,
+		&i.StartDate,
+		&i.EndDate,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const getAllAPIKeyHashes = `-- name: GetAllAPIKeyHashes :many
+SELECT api_key_hash FROM api_keys
+`
+
+func (q *Queries) GetAllAPIKeyHashes(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getAllAPIKeyHashes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var api_key_hash string
+		if err := rows.Scan(&api_key_hash); err != nil {
+			return nil, err
+		}
+		items = append(items, api_key_hash)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCommittees = `-- name: GetAllCommittees :many
+SELECT c.committee_id, c.committee_name, c.committee_head, c.division_id FROM committees c
+`
+
+func (q *Queries) GetAllCommittees(ctx context.Context) ([]Committee, error) {
+	rows, err := q.db.QueryContext(ctx, getAllCommittees)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Committee
+	for rows.Next() {
+		var i Committee
+		if err := rows.Scan(
+			&i.CommitteeID,
+			&i.CommitteeName,
+			&i.CommitteeHead,
+			&i.DivisionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllDivisions = `-- name: GetAllDivisions :many
+SELECT d.division_id, d.division_name, d.division_head FROM divisions d
+`
+
+func (q *Queries) GetAllDivisions(ctx context.Context) ([]Division, error) {
+	rows, err := q.db.QueryContext(ctx, getAllDivisions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Division
+	for rows.Next() {
+		var i Division
+		if err := rows.Scan(&i.DivisionID, &i.DivisionName, &i.DivisionHead); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllRoles = `-- name: GetAllRoles :many
+
+SELECT id, name, description FROM roles ORDER BY id
+`
+
+// RBAC: Role queries
+func (q *Queries) GetAllRoles(ctx context.Context) ([]Role, error) {
+	rows, err := q.db.QueryContext(ctx, getAllRoles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Role
+	for rows.Next() {
+		var i Role
+		if err := rows.Scan(&i.ID, &i.Name, &i.Description); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAuthorizationCode = `-- name: GetAuthorizationCode :one
+SELECT code_hash, client_id, member_id, redirect_uri, scope, nonce, code_challenge, expires_at, created_at
+FROM oidc_authorization_codes WHERE code_hash = ? AND expires_at > NOW()
+`
+
+func (q *Queries) GetAuthorizationCode(ctx context.Context, codeHash string) (OidcAuthorizationCode, error) {
+	row := q.db.QueryRowContext(ctx, getAuthorizationCode, codeHash)
+	var i OidcAuthorizationCode
+	err := row.Scan(
+		&i.CodeHash,
+		&i.ClientID,
+		&i.MemberID,
+		&i.RedirectUri,
+		&i.Scope,
+		&i.Nonce,
+		&i.CodeChallenge,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getCalendarFeedToken = `-- name: GetCalendarFeedToken :one
+
+SELECT member_id, token_hash, created_at, last_used_at FROM calendar_feed_tokens WHERE member_id = ?
+`
+
+// Calendar feed queries
+func (q *Queries) GetCalendarFeedToken(ctx context.Context, memberID int32) (CalendarFeedToken, error) {
+	row := q.db.QueryRowContext(ctx, getCalendarFeedToken, memberID)
+	var i CalendarFeedToken
+	err := row.Scan(
+		&i.MemberID,
+		&i.TokenHash,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+	)
+	return i, err
+}
+
+const getCalendarFeedTokenByHash = `-- name: GetCalendarFeedTokenByHash :one
+SELECT member_id, token_hash, created_at, last_used_at FROM calendar_feed_tokens WHERE token_hash = ?
+`
+
+func (q *Queries) GetCalendarFeedTokenByHash(ctx context.Context, tokenHash string) (CalendarFeedToken, error) {
+	row := q.db.QueryRowContext(ctx, getCalendarFeedTokenByHash, tokenHash)
+	var i CalendarFeedToken
+	err := row.Scan(
+		&i.MemberID,
+		&i.TokenHash,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+	)
+	return i, err
+}
+
+const getCommittee = `-- name: GetCommittee :one
+SELECT c.committee_id, c.committee_name, c.committee_head, c.division_id,
+       d.division_name, h.full_name AS head_name
+FROM committees c
+LEFT JOIN divisions d ON c.division_id = d.division_id
+LEFT JOIN members h ON c.committee_head = h.id
+WHERE c.committee_id = ?
+`
+
+type GetCommitteeRow struct {
+	CommitteeID   string
+	CommitteeName string
+	CommitteeHead sql.NullInt32
+	DivisionID    sql.NullString
+	DivisionName  sql.NullString
+	HeadName      sql.NullString
+}
+
+func (q *Queries) GetCommittee(ctx context.Context, committeeID string) (GetCommitteeRow, error) {
+	row := q.db.QueryRowContext(ctx, getCommittee, committeeID)
+	var i GetCommitteeRow
+	err := row.Scan(
+		&i.CommitteeID,
+		&i.CommitteeName,
+		&i.CommitteeHead,
+		&i.DivisionID,
+		&i.DivisionName,
+		&i.HeadName,
+	)
+	return i, err
+}
+
+const getDiscordLink = `-- name: GetDiscordLink :one
+SELECT member_id, discord_user_id, discord_username, linked_at FROM discord_links WHERE member_id = ?
+`
+
+func (q *Queries) GetDiscordLink(ctx context.Context, memberID int32) (DiscordLink, error) {
+	row := q.db.QueryRowContext(ctx, getDiscordLink, memberID)
+	var i DiscordLink
+	err := row.Scan(
+		&i.MemberID,
+		&i.DiscordUserID,
+		&i.DiscordUsername,
+		&i.LinkedAt,
+	)
+	return i, err
+}
+
+const getDivision = `-- name: GetDivision :one
+SELECT d.division_id, d.division_name, d.division_head, h.full_name AS head_name
+FROM divisions d
+LEFT JOIN members h ON d.division_head = h.id
+WHERE d.division_id = ?
+`
+
+type GetDivisionRow struct {
+	DivisionID   string
+	DivisionName string
+	DivisionHead sql.NullInt32
+	HeadName     sql.NullString
+}
+
+func (q *Queries) GetDivision(ctx context.Context, divisionID string) (GetDivisionRow, error) {
+	row := q.db.QueryRowContext(ctx, getDivision, divisionID)
+	var i GetDivisionRow
+	err := row.Scan(
+		&i.DivisionID,
+		&i.DivisionName,
+		&i.DivisionHead,
+		&i.HeadName,
+	)
+	return i, err
+}
+
+const getEmailsInAPIKey = `-- name: GetEmailsInAPIKey :many
+SELECT member_email FROM api_keys
+`
+
+func (q *Queries) GetEmailsInAPIKey(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getEmailsInAPIKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var member_email string
+		if err := rows.Scan(&member_email); err != nil {
+			return nil, err
+		}
+		items = append(items, member_email)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEventById = `-- name: GetEventById :one
+SELECT id, arn, name, committee_id, type, nature_id, term_id, created_at, duration_id, brief_description, goals, objectives, strategies, measures, budget_allocation, venue, docu_head, fin_head FROM events WHERE id = ?
+`
+
+func (q *Queries) GetEventById(ctx context.Context, id int32) (Event, error) {
+	row := q.db.QueryRowContext(ctx, getEventById, id)
+	var i Event
+	err := row.Scan(
+		&i.ID,
+		&i.Arn,
+		&i.Name,
+		&i.CommitteeID,
+		&i.Type,
+		&i.NatureID,
+		&i.TermID,
+		&i.CreatedAt,
+		&i.DurationID,
+		&i.BriefDescription,
+		&i.Goals,
+		&i.Objectives,
+		&i.Strategies,
+		&i.Measures,
+		&i.BudgetAllocation,
+		&i.Venue,
+		&i.DocuHead,
+		&i.FinHead,
+	)
+	return i, err
+}
+
+const getEventDriveFolders = `-- name: GetEventDriveFolders :one
+SELECT event_id, status, root_folder_id, docu_folder_id, fin_folder_id, pub_folder_id, folders, attempts, next_attempt_at, last_error, provisioned_at, created_at FROM event_drive_folders WHERE event_id = ?
+`
+
+func (q *Queries) GetEventDriveFolders(ctx context.Context, eventID int32) (EventDriveFolder, error) {
+	row := q.db.QueryRowContext(ctx, getEventDriveFolders, eventID)
+	var i EventDriveFolder
+	err := row.Scan(
+		&i.EventID,
+		&i.Status,
+		&i.RootFolderID,
+		&i.DocuFolderID,
+		&i.FinFolderID,
+		&i.PubFolderID,
+		&i.Folders,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastError,
+		&i.ProvisionedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getEventFile = `-- name: GetEventFile :one
+SELECT f.id, f.event_id, f.file_key, f.file_order, f.file_status, f.title, f.template_id, f.content_type, f.uploaded_by, f.uploaded_at, f.reviewer_note, f.reviewed_by, f.reviewed_at, u.full_name AS uploaded_by_name, r.full_name AS reviewed_by_name
+FROM event_files f
+LEFT JOIN members u ON u.id = f.uploaded_by
+LEFT JOIN members r ON r.id = f.reviewed_by
+WHERE f.id = ? AND f.event_id = ?
+`
+
+type GetEventFileRow struct {
+	ID             int32
+	EventID        int32
+	FileKey        sql.NullString
+	FileOrder      int32
+	FileStatus     string
+	Title          string
+	TemplateID     sql.NullInt32
+	ContentType    sql.NullString
+	UploadedBy     sql.NullInt32
+	UploadedAt     sql.NullTime
+	ReviewerNote   sql.NullString
+	ReviewedBy     sql.NullInt32
+	ReviewedAt     sql.NullTime
+	UploadedByName sql.NullString
+	ReviewedByName sql.NullString
+}
+
+type GetEventFileParams struct {
+	ID      int32
+	EventID int32
+}
+
+func (q *Queries) GetEventFile(ctx context.Context, arg GetEventFileParams) (GetEventFileRow, error) {
+	row := q.db.QueryRowContext(ctx, getEventFile, arg.ID, arg.EventID)
+	var i GetEventFileRow
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.FileKey,
+		&i.FileOrder,
+		&i.FileStatus,
+		&i.Title,
+		&i.TemplateID,
+		&i.ContentType,
+		&i.UploadedBy,
+		&i.UploadedAt,
+		&i.ReviewerNote,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.UploadedByName,
+		&i.ReviewedByName,
+	)
+	return i, err
+}
+
+const getEventParticipant = `-- name: GetEventParticipant :one
+SELECT event_id, student_id, student_name, student_email, notes, registered_at, checked_in_at, checked_in_by, verified_at FROM event_participants WHERE event_id = ? AND student_id = ?
+`
+
+type GetEventParticipantParams struct {
+	EventID   int32
+	StudentID int32
+}
+
+func (q *Queries) GetEventParticipant(ctx context.Context, arg GetEventParticipantParams) (EventParticipant, error) {
+	row := q.db.QueryRowContext(ctx, getEventParticipant, arg.EventID, arg.StudentID)
+	var i EventParticipant
+	err := row.Scan(
+		&i.EventID,
+		&i.StudentID,
+		&i.StudentName,
+		&i.StudentEmail,
+		&i.Notes,
+		&i.RegisteredAt,
+		&i.CheckedInAt,
+		&i.CheckedInBy,
+		&i.VerifiedAt,
+	)
+	return i, err
+}
+
+const getEventTracker = `-- name: GetEventTracker :one
+
+SELECT event_id, preacts_deadline, preacts_status, postacts_deadline, postacts_status, docu_drive_id, fin_drive_id, fin_preacts_deadline, fin_preacts_status, fin_postacts_deadline, fin_postacts_status FROM event_trackers WHERE event_id = ?
+`
+
+// Event tracker queries
+func (q *Queries) GetEventTracker(ctx context.Context, eventID int32) (EventTracker, error) {
+	row := q.db.QueryRowContext(ctx, getEventTracker, eventID)
+	var i EventTracker
+	err := row.Scan(
+		&i.EventID,
+		&i.PreactsDeadline,
+		&i.PreactsStatus,
+		&i.PostactsDeadline,
+		&i.PostactsStatus,
+		&i.DocuDriveID,
+		&i.FinDriveID,
+		&i.FinPreactsDeadline,
+		&i.FinPreactsStatus,
+		&i.FinPostactsDeadline,
+		&i.FinPostactsStatus,
+	)
+	return i, err
+}
+
+const getHouse = `-- name: GetHouse :one
+SELECT id, name, description FROM houses WHERE id = ?
+`
+
+func (q *Queries) GetHouse(ctx context.Context, id int32) (House, error) {
+	row := q.db.QueryRowContext(ctx, getHouse, id)
+	var i House
+	err := row.Scan(&i.ID, &i.Name, &i.Description)
+	return i, err
+}
+
+const getHouseByName = `-- name: GetHouseByName :one
+SELECT id, name, description FROM houses WHERE name = ?
+`
+
+func (q *Queries) GetHouseByName(ctx context.Context, name sql.NullString) (House, error) {
+	row := q.db.QueryRowContext(ctx, getHouseByName, name)
+	var i House
+	err := row.Scan(&i.ID, &i.Name, &i.Description)
+	return i, err
+}
+
+const getHouseLeaderboard = `-- name: GetHouseLeaderboard :many
+SELECT h.id, h.name, CAST(COALESCE(SUM(p.points), 0) AS SIGNED) AS points
+FROM houses h
+LEFT JOIN house_points p ON p.house_id = h.id AND p.term_id = ?
+GROUP BY h.id, h.name
+ORDER BY points DESC, h.name
+`
+
+type GetHouseLeaderboardRow struct {
+	ID     int32
+	Name   sql.NullString
+	Points int64
+}
+
+// every house with its points total in a term, houses without points score 0
+func (q *Queries) GetHouseLeaderboard(ctx context.Context, termID int32) ([]GetHouseLeaderboardRow, error) {
+	rows, err := q.db.QueryContext(ctx, getHouseLeaderboard, termID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetHouseLeaderboardRow
+	for rows.Next() {
+		var i GetHouseLeaderboardRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.Points); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getHouseLeaderboardAllTerms = `-- name: GetHouseLeaderboardAllTerms :many
+SELECT h.id, h.name, CAST(COALESCE(SUM(p.points), 0) AS SIGNED) AS points
+FROM houses h
+LEFT JOIN house_points p ON p.house_id = h.id
+GROUP BY h.id, h.name
+ORDER BY points DESC, h.name
+`
+
+type GetHouseLeaderboardAllTermsRow struct {
+	ID     int32
+	Name   sql.NullString
+	Points int64
+}
+
+func (q *Queries) GetHouseLeaderboardAllTerms(ctx context.Context) ([]GetHouseLeaderboardAllTermsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getHouseLeaderboardAllTerms)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetHouseLeaderboardAllTermsRow
+	for rows.Next() {
+		var i GetHouseLeaderboardAllTermsRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.Points); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getHousePoints = `-- name: GetHousePoints :one
+SELECT p.id, p.house_id, p.term_id, p.points, p.reason, p.awarded_by, p.event_id, p.created_at, m.full_name AS awarded_by_name, e.name AS event_name
+FROM house_points p
+LEFT JOIN members m ON m.id = p.awarded_by
+LEFT JOIN events e ON e.id = p.event_id
+WHERE p.id = ?
+`
+
+type GetHousePointsRow struct {
+	ID            int32
+	HouseID       int32
+	TermID        int32
+	Points        int32
+	Reason        string
+	AwardedBy     sql.NullInt32
+	EventID       sql.NullInt32
+	CreatedAt     sql.NullTime
+	AwardedByName sql.NullString
+	EventName     sql.NullString
+}
+
+func (q *Queries) GetHousePoints(ctx context.Context, id int32) (GetHousePointsRow, error) {
+	row := q.db.QueryRowContext(ctx, getHousePoints, id)
+	var i GetHousePointsRow
+	err := row.Scan(
+		&i.ID,
+		&i.HouseID,
+		&i.TermID,
+		&i.Points,
+		&i.Reason,
+		&i.AwardedBy,
+		&i.EventID,
+		&i.CreatedAt,
+		&i.AwardedByName,
+		&i.EventName,
+	)
+	return i, err
+}
+
+const getLatestTerm = `-- name: GetLatestTerm :one
+SELECT id, term, start_year, end_year, start_date, end_date, is_active FROM terms ORDER BY start_year DESC, term DESC LIMIT 1
+`
+
+func (q *Queries) GetLatestTerm(ctx context.Context) (Term, error) {
+	row := q.db.QueryRowContext(ctx, getLatestTerm)
+	var i Term
+	err := row.Scan(
+		&i.ID,
+		&i.Term,
+		&i.StartYear,
+		&i.EndYear,
+		&i.StartDate,
+		&i.EndDate,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const getMemberAuthInfo = `-- name: GetMemberAuthInfo :one
+SELECT id, position_id, committee_id FROM members WHERE email = ?
+`
+
+type GetMemberAuthInfoRow struct {
+	ID          int32
+	PositionID  sql.NullString
+	CommitteeID sql.NullString
+}
+
+// lightweight query for authorization checks (no image_url dependency)
+func (q *Queries) GetMemberAuthInfo(ctx context.Context, email string) (GetMemberAuthInfoRow, error) {
+	row := q.db.QueryRowContext(ctx, getMemberAuthInfo, email)
+	var i GetMemberAuthInfoRow
+	err := row.Scan(&i.ID, &i.PositionID, &i.CommitteeID)
+	return i, err
+}
+
+const getMemberByEmail = `-- name: GetMemberByEmail :one
+SELECT id, email, full_name, nickname, position_id, committee_id, college, program,
+       discord, interests, contact_number, fb_link, telegram, house_id, image_url
+FROM members WHERE email = ?
+`
+
+type GetMemberByEmailRow struct {
+	ID            int32
+	Email         string
+	FullName      string
+	Nickname      sql.NullString
+	PositionID    sql.NullString
+	CommitteeID   sql.NullString
+	College       sql.NullString
+	Program       sql.NullString
+	Discord       sql.NullString
+	Interests     sql.NullString
+	ContactNumber sql.NullString
+	FbLink        sql.NullString
+	Telegram      sql.NullString
+	HouseID       sql.NullInt32
+	ImageUrl      sql.NullString
+}
+
+func (q *Queries) GetMemberByEmail(ctx context.Context, email string) (GetMemberByEmailRow, error) {
+	row := q.db.QueryRowContext(ctx, getMemberByEmail, email)
+	var i GetMemberByEmailRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.FullName,
+		&i.Nickname,
+		&i.PositionID,
+		&i.CommitteeID,
+		&i.College,
+		&i.Program,
+		&i.Discord,
+		&i.Interests,
+		&i.ContactNumber,
+		&i.FbLink,
+		&i.Telegram,
+		&i.HouseID,
+		&i.ImageUrl,
+	)
+	return i, err
+}
+
+const getMemberContact = `-- name: GetMemberContact :one
+SELECT id, full_name, email FROM members WHERE id = ?
+`
+
+type GetMemberContactRow struct {
+	ID       int32
+	FullName string
+	Email    string
+}
+
+func (q *Queries) GetMemberContact(ctx context.Context, id int32) (GetMemberContactRow, error) {
+	row := q.db.QueryRowContext(ctx, getMemberContact, id)
+	var i GetMemberContactRow
+	err := row.Scan(&i.ID, &i.FullName, &i.Email)
+	return i, err
+}
+
+const getMemberInfo = `-- name: GetMemberInfo :one
+SELECT
+  m.id, m.email, m.full_name, m.nickname, m.image_url,
+  c.committee_id, c.committee_name,
+  d.division_id, d.division_name,
+  p.position_id, p.position_name,
+  h.name as house_name,
+  m.contact_number, m.college, m.program,
+  m.interests, m.discord, m.fb_link, m.telegram
+FROM members m
+LEFT JOIN committees c ON m.committee_id = c.committee_id
+LEFT JOIN divisions d ON c.division_id = d.division_id
+LEFT JOIN positions p ON m.position_id = p.position_id
+LEFT JOIN houses h ON m.house_id = h.id
+WHERE m.email = ?
+`
+
+type GetMemberInfoRow struct {
+	ID            int32
+	Email         string
+	FullName      string
+	Nickname      sql.NullString
+	ImageUrl      sql.NullString
+	CommitteeID   sql.NullString
+	CommitteeName sql.NullString
+	DivisionID    sql.NullString
+	DivisionName  sql.NullString
+	PositionID    sql.NullString
+	PositionName  sql.NullString
+	HouseName     sql.NullString
+	ContactNumber sql.NullString
+	College       sql.NullString
+	Program       sql.NullString
+	Interests     sql.NullString
+	Discord       sql.NullString
+	FbLink        sql.NullString
+	Telegram      sql.NullString
+}
+
+func (q *Queries) GetMemberInfo(ctx context.Context, email string) (GetMemberInfoRow, error) {
+	row := q.db.QueryRowContext(ctx, getMemberInfo, email)
+	var i GetMemberInfoRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.FullName,
+		&i.Nickname,
+		&i.ImageUrl,
+		&i.CommitteeID,
+		&i.CommitteeName,
+		&i.DivisionID,
+		&i.DivisionName,
+		&i.PositionID,
+		&i.PositionName,
+		&i.HouseName,
+		&i.ContactNumber,
+		&i.College,
+		&i.Program,
+		&i.Interests,
+		&i.Discord,
+		&i.FbLink,
+		&i.Telegram,
+	)
+	return i, err
+}
+
+const getMemberInfoById = `-- name: GetMemberInfoById :one
+SELECT
+  m.id, m.email, m.full_name, m.nickname, m.image_url,
+  c.committee_id, c.committee_name,
+  d.division_id, d.division_name,
+  p.position_id, p.position_name,
+  h.name as house_name,
+  m.contact_number, m.college, m.program,
+  m.interests, m.discord, m.fb_link, m.telegram
+FROM members m
+LEFT JOIN committees c ON m.committee_id = c.committee_id
+LEFT JOIN divisions d ON c.division_id = d.division_id
+LEFT JOIN positions p ON m.position_id = p.position_id
+LEFT JOIN houses h ON m.house_id = h.id
+WHERE m.id = ?
+`
+
+type GetMemberInfoByIdRow struct {
+	ID            int32
+	Email         string
+	FullName      string
+	Nickname      sql.NullString
+	ImageUrl      sql.NullString
+	CommitteeID   sql.NullString
+	CommitteeName sql.NullString
+	DivisionID    sql.NullString
+	DivisionName  sql.NullString
+	PositionID    sql.NullString
+	PositionName  sql.NullString
+	HouseName     sql.NullString
+	ContactNumber sql.NullString
+	College       sql.NullString
+	Program       sql.NullString
+	Interests     sql.NullString
+	Discord       sql.NullString
+	FbLink        sql.NullString
+	Telegram      sql.NullString
+}
+
+func (q *Queries) GetMemberInfoById(ctx context.Context, id int32) (GetMemberInfoByIdRow, error) {
+	row := q.db.QueryRowContext(ctx, getMemberInfoById, id)
+	var i GetMemberInfoByIdRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.FullName,
+		&i.Nickname,
+		&i.ImageUrl,
+		&i.CommitteeID,
+		&i.CommitteeName,
+		&i.DivisionID,
+		&i.DivisionName,
+		&i.PositionID,
+		&i.PositionName,
+		&i.HouseName,
+		&i.ContactNumber,
+		&i.College,
+		&i.Program,
+		&i.Interests,
+		&i.Discord,
+		&i.FbLink,
+		&i.Telegram,
+	)
+	return i, err
+}
+
+const getMemberRoles = `-- name: GetMemberRoles :many
+SELECT r.id, r.name, r.description, mr.granted_by, mr.granted_at
+FROM member_roles mr
+JOIN roles r ON mr.role_id = r.id
+WHERE mr.member_id = ?
+`
+
+type GetMemberRolesRow struct {
+	ID          string
+	Name        string
+	Description sql.NullString
+	GrantedBy   sql.NullInt32
+	GrantedAt   sql.NullTime
+}
+
+func (q *Queries) GetMemberRoles(ctx context.Context, memberID int32) ([]GetMemberRolesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getMemberRoles, memberID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMemberRolesRow
+	for rows.Next() {
+		var i GetMemberRolesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.GrantedBy,
+			&i.GrantedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMembersWithRole = `-- name: GetMembersWithRole :many
+SELECT m.id, m.email, m.full_name, m.position_id, m.committee_id, mr.granted_at
+FROM member_roles mr
+JOIN members m ON mr.member_id = m.id
+WHERE mr.role_id = ?
+ORDER BY mr.granted_at DESC
+`
+
+type GetMembersWithRoleRow struct {
+	ID          int32
+	Email       string
+	FullName    string
+	PositionID  sql.NullString
+	CommitteeID sql.NullString
+	GrantedAt   sql.NullTime
+}
+
+func (q *Queries) GetMembersWithRole(ctx context.Context, roleID string) ([]GetMembersWithRoleRow, error) {
+	rows, err := q.db.QueryContext(ctx, getMembersWithRole, roleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMembersWithRoleRow
+	for rows.Next() {
+		var i GetMembersWithRoleRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.FullName,
+			&i.PositionID,
+			&i.CommitteeID,
+			&i.GrantedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getNotification = `-- name: GetNotification :one
+SELECT id, member_id, type, title, body, link, dedupe_key, read_at, created_at FROM notifications WHERE id = ? AND member_id = ?
+`
+
+type GetNotificationParams struct {
+	ID       int32
+	MemberID int32
+}
+
+func (q *Queries) GetNotification(ctx context.Context, arg GetNotificationParams) (Notification, error) {
+	row := q.db.QueryRowContext(ctx, getNotification, arg.ID, arg.MemberID)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.MemberID,
+		&i.Type,
+		&i.Title,
+		&i.Body,
+		&i.Link,
+		&i.DedupeKey,
+		&i.ReadAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getNotificationPreference = `-- name: GetNotificationPreference :one
+SELECT email_enabled FROM notification_preferences WHERE member_id = ? AND type = ?
+`
+
+type GetNotificationPreferenceParams struct {
+	MemberID int32
+	Type     string
+}
+
+func (q *Queries) GetNotificationPreference(ctx context.Context, arg GetNotificationPreferenceParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, getNotificationPreference, arg.MemberID, arg.Type)
+	var email_enabled bool
+	err := row.Scan(&email_enabled)
+	return email_enabled, err
+}
+
+const getOAuthClient = `-- name: GetOAuthClient :one
+SELECT client_id, client_secret_hash, name, scopes, created_by, created_at, redirect_uris
+FROM oauth_clients WHERE client_id = ?
+`
+
+func (q *Queries) GetOAuthClient(ctx context.Context, clientID string) (OauthClient, error) {
+	row := q.db.QueryRowContext(ctx, getOAuthClient, clientID)
+	var i OauthClient
+	err := row.Scan(
+		&i.ClientID,
+		&i.ClientSecretHash,
+		&i.Name,
+		&i.Scopes,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.RedirectUris,
+	)
+	return i, err
+}
+
+const getPosition = `-- name: GetPosition :one
+SELECT position_id, position_name, position_rank FROM positions WHERE position_id = ?
+`
+
+func (q *Queries) GetPosition(ctx context.Context, positionID string) (Position, error) {
+	row := q.db.QueryRowContext(ctx, getPosition, positionID)
+	var i Position
+	err := row.Scan(&i.PositionID, &i.PositionName, &i.PositionRank)
+	return i, err
+}
+
+const getPubRequest = `-- name: GetPubRequest :one
+SELECT p.id, p.event_id, p.pub_head, p.pub_type, p.pub_drive_id, p.pub_status, p.posting_date, p.pub_details, p.pub_content, p.caption, p.opa_numbers, p.for_posting, p.created_at, p.requester_id, p.dimensions, p.updated_at, e.name AS event_name, e.committee_id, r.full_name AS requester_name, h.full_name AS pub_head_name
+FROM pub_requests p
+LEFT JOIN events e ON e.id = p.event_id
+LEFT JOIN members r ON r.id = p.requester_id
+LEFT JOIN members h ON h.id = p.pub_head
+WHERE p.id = ?
+`
+
+type GetPubRequestRow struct {
+	ID            int32
+	EventID       sql.NullInt32
+	PubHead       sql.NullInt32
+	PubType       sql.NullString
+	PubDriveID    string
+	PubStatus     sql.NullString
+	PostingDate   time.Time
+	PubDetails    string
+	PubContent    string
+	Caption       string
+	OpaNumbers    string
+	ForPosting    bool
+	CreatedAt     time.Time
+	RequesterID   sql.NullInt32
+	Dimensions    sql.NullString
+	UpdatedAt     sql.NullTime
+	EventName     sql.NullString
+	CommitteeID   sql.NullString
+	RequesterName sql.NullString
+	PubHeadName   sql.NullString
+}
+
+func (q *Queries) GetPubRequest(ctx context.Context, id int32) (GetPubRequestRow, error) {
+	row := q.db.QueryRowContext(ctx, getPubRequest, id)
+	var i GetPubRequestRow
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.PubHead,
+		&i.PubType,
+		&i.PubDriveID,
+		&i.PubStatus,
+		&i.PostingDate,
+		&i.PubDetails,
+		&i.PubContent,
+		&i.Caption,
+		&i.OpaNumbers,
+		&i.ForPosting,
+		&i.CreatedAt,
+		&i.RequesterID,
+		&i.Dimensions,
+		&i.UpdatedAt,
+		&i.EventName,
+		&i.CommitteeID,
+		&i.RequesterName,
+		&i.PubHeadName,
+	)
+	return i, err
+}
+
+const getRoleById = `-- name: GetRoleById :one
+SELECT id, name, description FROM roles WHERE id = ?
+`
+
+func (q *Queries) GetRoleById(ctx context.Context, id string) (Role, error) {
+	row := q.db.QueryRowContext(ctx, getRoleById, id)
+	var i Role
+	err := row.Scan(&i.ID, &i.Name, &i.Description)
+	return i, err
+}
+
+const getScheduledJob = `-- name: GetScheduledJob :one
+SELECT name, last_run_at, last_status, last_error, locked_until FROM scheduled_jobs WHERE name = ?
+`
+
+func (q *Queries) GetScheduledJob(ctx context.Context, name string) (ScheduledJob, error) {
+	row := q.db.QueryRowContext(ctx, getScheduledJob, name)
+	var i ScheduledJob
+	err := row.Scan(
+		&i.Name,
+		&i.LastRunAt,
+		&i.LastStatus,
+		&i.LastError,
+		&i.LockedUntil,
+	)
+	return i, err
+}
+
+const getSession = `-- name: GetSession :one
+SELECT id, member_id, created_at, expires_at, last_activity, user_agent, ip_address, auth_time
+FROM sessions WHERE id = ? AND expires_at > NOW()
+`
+
+func (q *Queries) GetSession(ctx context.Context, id string) (Session, error) {
+	row := q.db.QueryRowContext(ctx, getSession, id)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.MemberID,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.LastActivity,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.AuthTime,
+	)
+	return i, err
+}
+
+const getSessionWithMember = `-- name: GetSessionWithMember :one
+SELECT 
+    s.id, s.member_id, s.created_at, s.expires_at, s.last_activity, s.user_agent, s.ip_address, s.auth_time,
+    m.email, m.full_name
+FROM sessions s
+JOIN members m ON s.member_id = m.id
+WHERE s.id = ? AND s.expires_at > NOW()
+`
+
+type GetSessionWithMemberRow struct {
+	ID           string
+	MemberID     int32
+	CreatedAt    sql.NullTime
+	ExpiresAt    time.Time
+	LastActivity sql.NullTime
+	UserAgent    sql.NullString
+	IpAddress    sql.NullString
+	AuthTime     sql.NullTime
+	Email        string
+	FullName     string
+}
+
+func (q *Queries) GetSessionWithMember(ctx context.Context, id string) (GetSessionWithMemberRow, error) {
+	row := q.db.QueryRowContext(ctx, getSessionWithMember, id)
+	var i GetSessionWithMemberRow
+	err := row.Scan(
+		&i.ID,
+		&i.MemberID,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.LastActivity,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.AuthTime,
+		&i.Email,
+		&i.FullName,
+	)
+	return i, err
+}
+
+const getTelegramLink = `-- name: GetTelegramLink :one
+SELECT member_id, telegram_user_id, telegram_username, linked_at FROM telegram_links WHERE member_id = ?
+`
+
+func (q *Queries) GetTelegramLink(ctx context.Context, memberID int32) (TelegramLink, error) {
+	row := q.db.QueryRowContext(ctx, getTelegramLink, memberID)
+	var i TelegramLink
+	err := row.Scan(
+		&i.MemberID,
+		&i.TelegramUserID,
+		&i.TelegramUsername,
+		&i.LinkedAt,
+	)
+	return i, err
+}
+
+const getTelegramLinkByUser = `-- name: GetTelegramLinkByUser :one
+SELECT member_id, telegram_user_id, telegram_username, linked_at FROM telegram_links WHERE telegram_user_id = ?
+`
+
+func (q *Queries) GetTelegramLinkByUser(ctx context.Context, telegramUserID int64) (TelegramLink, error) {
+	row := q.db.QueryRowContext(ctx, getTelegramLinkByUser, telegramUserID)
+	var i TelegramLink
+	err := row.Scan(
+		&i.MemberID,
+		&i.TelegramUserID,
+		&i.TelegramUsername,
+		&i.LinkedAt,
+	)
+	return i, err
+}
+
+const getTelegramLinkCode = `-- name: GetTelegramLinkCode :one
+SELECT code_hash, member_id, expires_at, created_at
+FROM telegram_link_codes WHERE code_hash = ? AND expires_at > NOW()
+`
+
+func (q *Queries) GetTelegramLinkCode(ctx context.Context, codeHash string) (TelegramLinkCode, error) {
+	row := q.db.QueryRowContext(ctx, getTelegramLinkCode, codeHash)
+	var i TelegramLinkCode
+	err := row.Scan(
+		&i.CodeHash,
+		&i.MemberID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getTerm = `-- name: GetTerm :one
+
+SELECT id, term, start_year, end_year, start_date, end_date, is_active FROM terms WHERE id = ?
+`
+
+// Term queries
+func (q *Queries) GetTerm(ctx context.Context, id int32) (Term, error) {
+	row := q.db.QueryRowContext(ctx, getTerm, id)
+	var i Term
+	err := row.Scan(
+		&i.ID,
+		&i.Term,
+		&i.StartYear,
+		&i.EndYear,
+		&i.StartDate,
+		&i.EndDate,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const getTermByDate = `-- name: GetTermByDate :one
+SELECT id, term, start_year, end_year, start_date, end_date, is_active FROM terms WHERE start_date <= ? AND end_date >= ? ORDER BY start_date DESC LIMIT 1
+`
+
+type GetTermByDateParams struct {
+	StartDate sql.NullTime
+	EndDate   sql.NullTime
+}
+
+func (q *Queries) GetTermByDate(ctx context.Context, arg GetTermByDateParams) (Term, error) {
+	row := q.db.QueryRowContext(ctx, getTermByDate, arg.StartDate, arg.EndDate)
+	var i Term
+	err := row.Scan(
+		&i.ID,
+		&i.Term,
+		&i.StartYear,
+		&i.EndYear,
+		&i.StartDate,
+		&i.EndDate,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const getTermByNumber = `-- name: GetTermByNumber :one
+SELECT id, term, start_year, end_year, start_date, end_date, is_active FROM terms WHERE term = ? AND start_year = ?
+`
+
+type GetTermByNumberParams struct {
+	Term      int32
+	StartYear int32
+}
+
+func (q *Queries) GetTermByNumber(ctx context.Context, arg GetTermByNumberParams) (Term, error) {
+	row := q.db.QueryRowContext(ctx, getTermByNumber, arg.Term, arg.StartYear)
+	var i Term
+	err := row.Scan(
+		&i.ID,
+		&i.Term,
+		&i.StartYear,
+		&i.EndYear,
+		&i.StartDate,
+		&i.EndDate,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const getWebhookDelivery = `-- name: GetWebhookDelivery :one
+SELECT id, endpoint_id, event_id, event_type, payload, status, attempts, next_attempt_at, locked_until, last_status_code, last_error, delivered_at, replay_of, created_at FROM webhook_deliveries WHERE id = ? AND endpoint_id = ?
+`
+
+type GetWebhookDeliveryParams struct {
+	ID         int32
+	EndpointID int32
+}
+
+func (q *Queries) GetWebhookDelivery(ctx context.Context, arg GetWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRowContext(ctx, getWebhookDelivery, arg.ID, arg.EndpointID)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.EndpointID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LockedUntil,
+		&i.LastStatusCode,
+		&i.LastError,
+		&i.DeliveredAt,
+		&i.ReplayOf,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getWebhookEndpoint = `-- name: GetWebhookEndpoint :one
+SELECT id, url, description, secret, events, is_active, created_at FROM webhook_endpoints WHERE id = ?
+`
+
+func (q *Queries) GetWebhookEndpoint(ctx context.Context, id int32) (WebhookEndpoint, error) {
+	row := q.db.QueryRowContext(ctx, getWebhookEndpoint, id)
+	var i WebhookEndpoint
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.Description,
+		&i.Secret,
+		&i.Events,
+		&i.IsActive,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const grantRole = `-- name: GrantRole :exec
+INSERT INTO member_roles (member_id, role_id, granted_by) VALUES (?, ?, ?)
+`
+
+type GrantRoleParams struct {
+	MemberID  int32
+	RoleID    string
+	GrantedBy sql.NullInt32
+}
+
+func (q *Queries) GrantRole(ctx context.Context, arg GrantRoleParams) error {
+	_, err := q.db.ExecContext(ctx, grantRole, arg.MemberID, arg.RoleID, arg.GrantedBy)
+	return err
+}
+
+const hasRole = `-- name: HasRole :one
+SELECT EXISTS(SELECT 1 FROM member_roles WHERE member_id = ? AND role_id = ?)
+`
+
+type HasRoleParams struct {
+	MemberID int32
+	RoleID   string
+}
+
+func (q *Queries) HasRole(ctx context.Context, arg HasRoleParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, hasRole, arg.MemberID, arg.RoleID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const hasTermRoster = `-- name: HasTermRoster :one
+SELECT EXISTS(SELECT 1 FROM term_rosters WHERE term_id = ?)
+`
+
+func (q *Queries) HasTermRoster(ctx context.Context, termID int32) (bool, error) {
+	row := q.db.QueryRowContext(ctx, hasTermRoster, termID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isAdmin = `-- name: IsAdmin :one
+SELECT EXISTS(SELECT 1 FROM member_roles WHERE member_id = ? AND role_id = 'ADMIN')
+`
+
+func (q *Queries) IsAdmin(ctx context.Context, memberID int32) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isAdmin, memberID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isEventDocuHead = `-- name: IsEventDocuHead :one
+SELECT EXISTS(SELECT 1 FROM event_docu_head WHERE event_id = ? AND member_id = ?)
+`
+
+type IsEventDocuHeadParams struct {
+	EventID  int32
+	MemberID int32
+}
+
+func (q *Queries) IsEventDocuHead(ctx context.Context, arg IsEventDocuHeadParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isEventDocuHead, arg.EventID, arg.MemberID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isEventHead = `-- name: IsEventHead :one
+SELECT EXISTS(SELECT 1 FROM event_heads WHERE event_id = ? AND member_id = ?)
+`
+
+type IsEventHeadParams struct {
+	EventID  int32
+	MemberID int32
+}
+
+func (q *Queries) IsEventHead(ctx context.Context, arg IsEventHeadParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isEventHead, arg.EventID, arg.MemberID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isStudentClaimed = `-- name: IsStudentClaimed :one
+SELECT EXISTS(
+    SELECT 1 FROM event_participants
+    WHERE verified_at IS NOT NULL
+      AND ((student_id = ? AND student_email <> ?) OR (student_email = ? AND student_id <> ?))
+)
+`
+
+type IsStudentClaimedParams struct {
+	StudentID      int32
+	StudentEmail   sql.NullString
+	StudentEmail_2 sql.NullString
+	StudentID_2    int32
+}
+
+// whether a verified registration ties the student ID to another email, or the email to another student ID
+func (q *Queries) IsStudentClaimed(ctx context.Context, arg IsStudentClaimedParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isStudentClaimed,
+		arg.StudentID,
+		arg.StudentEmail,
+		arg.StudentEmail_2,
+		arg.StudentID_2,
+	)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const linkDiscordAccount = `-- name: LinkDiscordAccount :exec
+INSERT INTO discord_links (member_id, discord_user_id, discord_username) VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE discord_user_id = VALUES(discord_user_id), discord_username = VALUES(discord_username), linked_at = CURRENT_TIMESTAMP
+`
+
+type LinkDiscordAccountParams struct {
+	MemberID        int32
+	DiscordUserID   string
+	DiscordUsername sql.NullString
+}
+
+func (q *Queries) LinkDiscordAccount(ctx context.Context, arg LinkDiscordAccountParams) error {
+	_, err := q.db.ExecContext(ctx, linkDiscordAccount, arg.MemberID, arg.DiscordUserID, arg.DiscordUsername)
+	return err
+}
+
+const linkTelegramAccount = `-- name: LinkTelegramAccount :exec
+INSERT INTO telegram_links (member_id, telegram_user_id, telegram_username) VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE telegram_user_id = VALUES(telegram_user_id), telegram_username = VALUES(telegram_username), linked_at = CURRENT_TIMESTAMP
+`
+
+type LinkTelegramAccountParams struct {
+	MemberID         int32
+	TelegramUserID   int64
+	TelegramUsername sql.NullString
+}
+
+func (q *Queries) LinkTelegramAccount(ctx context.Context, arg LinkTelegramAccountParams) error {
+	_, err := q.db.ExecContext(ctx, linkTelegramAccount, arg.MemberID, arg.TelegramUserID, arg.TelegramUsername)
+	return err
+}
+
+const listAPIKeysByEmail = `-- name: ListAPIKeysByEmail :many
+SELECT api_key_id, member_email, project, allowed_origin, is_dev, is_admin, created_at, expires_at FROM api_keys WHERE member_email = ? ORDER BY created_at DESC
+`
+
+type ListAPIKeysByEmailRow struct {
+	ApiKeyID      int32
+	MemberEmail   string
+	Project       sql.NullString
+	AllowedOrigin sql.NullString
+	IsDev         bool
+	IsAdmin       bool
+	CreatedAt     sql.NullTime
+	ExpiresAt     sql.NullTime
+}
+
+func (q *Queries) ListAPIKeysByEmail(ctx context.Context, memberEmail string) ([]ListAPIKeysByEmailRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAPIKeysByEmail, memberEmail)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAPIKeysByEmailRow
+	for rows.Next() {
+		var i ListAPIKeysByEmailRow
+		if err := rows.Scan(
+			&i.ApiKeyID,
+			&i.MemberEmail,
+			&i.Project,
+			&i.AllowedOrigin,
+			&i.IsDev,
+			&i.IsAdmin,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAPIKeysExpiringBetween = `-- name: ListAPIKeysExpiringBetween :many
+SELECT k.api_key_id, k.project, k.expires_at, m.id AS member_id
+FROM api_keys k
+JOIN members m ON m.email = k.member_email
+WHERE k.expires_at > ? AND k.expires_at <= ?
+ORDER BY k.expires_at
+`
+
+type ListAPIKeysExpiringBetweenRow struct {
+	ApiKeyID  int32
+	Project   sql.NullString
+	ExpiresAt sql.NullTime
+	MemberID  int32
+}
+
+type ListAPIKeysExpiringBetweenParams struct {
+	ExpiresAt   sql.NullTime
+	ExpiresAt_2 sql.NullTime
+}
+
+func (q *Queries) ListAPIKeysExpiringBetween(ctx context.Context, arg ListAPIKeysExpiringBetweenParams) ([]ListAPIKeysExpiringBetweenRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAPIKeysExpiringBetween, arg.ExpiresAt, arg.ExpiresAt_2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAPIKeysExpiringBetweenRow
+	for rows.Next() {
+		var i ListAPIKeysExpiringBetweenRow
+		if err := rows.Scan(
+			&i.ApiKeyID,
+			&i.Project,
+			&i.ExpiresAt,
+			&i.MemberID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActiveWebhookEndpoints = `-- name: ListActiveWebhookEndpoints :many
+SELECT id, url, description, secret, events, is_active, created_at FROM webhook_endpoints WHERE is_active = TRUE ORDER BY id
+`
+
+func (q *Queries) ListActiveWebhookEndpoints(ctx context.Context) ([]WebhookEndpoint, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveWebhookEndpoints)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookEndpoint
+	for rows.Next() {
+		var i WebhookEndpoint
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.Description,
+			&i.Secret,
+			&i.Events,
+			&i.IsActive,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCalendarEventDates = `-- name: ListCalendarEventDates :many
+SELECT d.id, d.event_id, d.start_time, d.end_time, e.name, e.arn, e.committee_id, e.venue, e.brief_description
+FROM event_dates d
+JOIN events e ON e.id = d.event_id
+WHERE d.end_time >= ?
+ORDER BY d.start_time
+`
+
+type ListCalendarEventDatesRow struct {
+	ID               int32
+	EventID          int32
+	StartTime        time.Time
+	EndTime          time.Time
+	Name             string
+	Arn              string
+	CommitteeID      string
+	Venue            sql.NullString
+	BriefDescription sql.NullString
+}
+
+func (q *Queries) ListCalendarEventDates(ctx context.Context, endTime time.Time) ([]ListCalendarEventDatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCalendarEventDates, endTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCalendarEventDatesRow
+	for rows.Next() {
+		var i ListCalendarEventDatesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.StartTime,
+			&i.EndTime,
+			&i.Name,
+			&i.Arn,
+			&i.CommitteeID,
+			&i.Venue,
+			&i.BriefDescription,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCalendarEventDatesByCommittee = `-- name: ListCalendarEventDatesByCommittee :many
+SELECT d.id, d.event_id, d.start_time, d.end_time, e.name, e.arn, e.committee_id, e.venue, e.brief_description
+FROM event_dates d
+JOIN events e ON e.id = d.event_id
+WHERE d.end_time >= ? AND e.committee_id = ?
+ORDER BY d.start_time
+`
+
+type ListCalendarEventDatesByCommitteeRow struct {
+	ID               int32
+	EventID          int32
+	StartTime        time.Time
+	EndTime          time.Time
+	Name             string
+	Arn              string
+	CommitteeID      string
+	Venue            sql.NullString
+	BriefDescription sql.NullString
+}
+
+type ListCalendarEventDatesByCommitteeParams struct {
+	EndTime     time.Time
+	CommitteeID string
+}
+
+func (q *Queries) ListCalendarEventDatesByCommittee(ctx context.Context, arg ListCalendarEventDatesByCommitteeParams) ([]ListCalendarEventDatesByCommitteeRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCalendarEventDatesByCommittee, arg.EndTime, arg.CommitteeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCalendarEventDatesByCommitteeRow
+	for rows.Next() {
+		var i ListCalendarEventDatesByCommitteeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.StartTime,
+			&i.EndTime,
+			&i.Name,
+			&i.Arn,
+			&i.CommitteeID,
+			&i.Venue,
+			&i.BriefDescription,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCalendarEventDatesByHead = `-- name: ListCalendarEventDatesByHead :many
+SELECT d.id, d.event_id, d.start_time, d.end_time, e.name, e.arn, e.committee_id, e.venue, e.brief_description
+FROM event_dates d
+JOIN events e ON e.id = d.event_id
+JOIN event_heads h ON h.event_id = e.id
+WHERE d.end_time >= ? AND h.member_id = ?
+ORDER BY d.start_time
+`
+
+type ListCalendarEventDatesByHeadRow struct {
+	ID               int32
+	EventID          int32
+	StartTime        time.Time
+	EndTime          time.Time
+	Name             string
+	Arn              string
+	CommitteeID      string
+	Venue            sql.NullString
+	BriefDescription sql.NullString
+}
+
+type ListCalendarEventDatesByHeadParams struct {
+	EndTime  time.Time
+	MemberID int32
+}
+
+func (q *Queries) ListCalendarEventDatesByHead(ctx context.Context, arg ListCalendarEventDatesByHeadParams) ([]ListCalendarEventDatesByHeadRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCalendarEventDatesByHead, arg.EndTime, arg.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCalendarEventDatesByHeadRow
+	for rows.Next() {
+		var i ListCalendarEventDatesByHeadRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.StartTime,
+			&i.EndTime,
+			&i.Name,
+			&i.Arn,
+			&i.CommitteeID,
+			&i.Venue,
+			&i.BriefDescription,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCommitteeMembers = `-- name: ListCommitteeMembers :many
+SELECT m.id, m.full_name, m.nickname, m.email, m.image_url, p.position_id, p.position_name
+FROM members m
+LEFT JOIN positions p ON m.position_id = p.position_id
+WHERE m.committee_id = ?
+ORDER BY m.full_name
+`
+
+type ListCommitteeMembersRow struct {
+	ID           int32
+	FullName     string
+	Nickname     sql.NullString
+	Email        string
+	ImageUrl     sql.NullString
+	PositionID   sql.NullString
+	PositionName sql.NullString
+}
+
+func (q *Queries) ListCommitteeMembers(ctx context.Context, committeeID sql.NullString) ([]ListCommitteeMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCommitteeMembers, committeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCommitteeMembersRow
+	for rows.Next() {
+		var i ListCommitteeMembersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Nickname,
+			&i.Email,
+			&i.ImageUrl,
+			&i.PositionID,
+			&i.PositionName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCommittees = `-- name: ListCommittees :many
+SELECT committee_id, committee_name, committee_head, division_id FROM committees ORDER BY committee_name
+`
+
+func (q *Queries) ListCommittees(ctx context.Context) ([]Committee, error) {
+	rows, err := q.db.QueryContext(ctx, listCommittees)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Committee
+	for rows.Next() {
+		var i Committee
+		if err := rows.Scan(
+			&i.CommitteeID,
+			&i.CommitteeName,
+			&i.CommitteeHead,
+			&i.DivisionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCommitteesByDivisionIDs = `-- name: ListCommitteesByDivisionIDs :many
+SELECT committee_id, committee_name, committee_head, division_id FROM committees WHERE division_id IN (/*SLICE:division_ids*/?) ORDER BY committee_name
+`
+
+func (q *Queries) ListCommitteesByDivisionIDs(ctx context.Context, divisionIds []sql.NullString) ([]Committee, error) {
+	query := listCommitteesByDivisionIDs
+	var queryParams []interface{}
+	if len(divisionIds) > 0 {
+		for _, v := range divisionIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:division_ids*/?", strings.Repeat(",?", len(divisionIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:division_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Committee
+	for rows.Next() {
+		var i Committee
+		if err := rows.Scan(
+			&i.CommitteeID,
+			&i.CommitteeName,
+			&i.CommitteeHead,
+			&i.DivisionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCommitteesByIDs = `-- name: ListCommitteesByIDs :many
+SELECT committee_id, committee_name, committee_head, division_id FROM committees WHERE committee_id IN (/*SLICE:committee_ids*/?)
+`
+
+func (q *Queries) ListCommitteesByIDs(ctx context.Context, committeeIds []string) ([]Committee, error) {
+	query := listCommitteesByIDs
+	var queryParams []interface{}
+	if len(committeeIds) > 0 {
+		for _, v := range committeeIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:committee_ids*/?", strings.Repeat(",?", len(committeeIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:committee_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 	var items []Committee
 	for rows.Next() {
-		var i Committee
+		var i Committee
+		if err := rows.Scan(
+			&i.CommitteeID,
+			&i.CommitteeName,
+			&i.CommitteeHead,
+			&i.DivisionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCommitteesWithHeads = `-- name: ListCommitteesWithHeads :many
+SELECT c.committee_id, c.committee_name, c.committee_head, c.division_id, h.full_name AS head_name
+FROM committees c
+LEFT JOIN members h ON c.committee_head = h.id
+ORDER BY c.committee_name
+`
+
+type ListCommitteesWithHeadsRow struct {
+	CommitteeID   string
+	CommitteeName string
+	CommitteeHead sql.NullInt32
+	DivisionID    sql.NullString
+	HeadName      sql.NullString
+}
+
+func (q *Queries) ListCommitteesWithHeads(ctx context.Context) ([]ListCommitteesWithHeadsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCommitteesWithHeads)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListCommitteesWithHeadsRow
+	for rows.Next() {
+		var i ListCommitteesWithHeadsRow
+		if err := rows.Scan(
+			&i.CommitteeID,
+			&i.CommitteeName,
+			&i.CommitteeHead,
+			&i.DivisionID,
+			&i.HeadName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDiscordLinkedMembers = `-- name: ListDiscordLinkedMembers :many
+
+SELECT m.id, m.full_name, l.discord_user_id, l.discord_username, m.committee_id, m.position_id, m.house_id
+FROM discord_links l
+JOIN members m ON m.id = l.member_id
+ORDER BY m.id
+`
+
+type ListDiscordLinkedMembersRow struct {
+	ID              int32
+	FullName        string
+	DiscordUserID   string
+	DiscordUsername sql.NullString
+	CommitteeID     sql.NullString
+	PositionID      sql.NullString
+	HouseID         sql.NullInt32
+}
+
+// Discord queries
+// members with a verified Discord account, for role sync
+func (q *Queries) ListDiscordLinkedMembers(ctx context.Context) ([]ListDiscordLinkedMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDiscordLinkedMembers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDiscordLinkedMembersRow
+	for rows.Next() {
+		var i ListDiscordLinkedMembersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.DiscordUserID,
+			&i.DiscordUsername,
+			&i.CommitteeID,
+			&i.PositionID,
+			&i.HouseID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDivisions = `-- name: ListDivisions :many
+SELECT division_id, division_name, division_head FROM divisions ORDER BY division_name
+`
+
+func (q *Queries) ListDivisions(ctx context.Context) ([]Division, error) {
+	rows, err := q.db.QueryContext(ctx, listDivisions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Division
+	for rows.Next() {
+		var i Division
+		if err := rows.Scan(&i.DivisionID, &i.DivisionName, &i.DivisionHead); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDivisionsByIDs = `-- name: ListDivisionsByIDs :many
+SELECT division_id, division_name, division_head FROM divisions WHERE division_id IN (/*SLICE:division_ids*/?)
+`
+
+func (q *Queries) ListDivisionsByIDs(ctx context.Context, divisionIds []string) ([]Division, error) {
+	query := listDivisionsByIDs
+	var queryParams []interface{}
+	if len(divisionIds) > 0 {
+		for _, v := range divisionIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:division_ids*/?", strings.Repeat(",?", len(divisionIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:division_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Division
+	for rows.Next() {
+		var i Division
+		if err := rows.Scan(&i.DivisionID, &i.DivisionName, &i.DivisionHead); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDivisionsWithHeads = `-- name: ListDivisionsWithHeads :many
+SELECT d.division_id, d.division_name, d.division_head, h.full_name AS head_name
+FROM divisions d
+LEFT JOIN members h ON d.division_head = h.id
+ORDER BY d.division_name
+`
+
+type ListDivisionsWithHeadsRow struct {
+	DivisionID   string
+	DivisionName string
+	DivisionHead sql.NullInt32
+	HeadName     sql.NullString
+}
+
+func (q *Queries) ListDivisionsWithHeads(ctx context.Context) ([]ListDivisionsWithHeadsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDivisionsWithHeads)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDivisionsWithHeadsRow
+	for rows.Next() {
+		var i ListDivisionsWithHeadsRow
+		if err := rows.Scan(
+			&i.DivisionID,
+			&i.DivisionName,
+			&i.DivisionHead,
+			&i.HeadName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDocuStatuses = `-- name: ListDocuStatuses :many
+SELECT id, title FROM docu_status
+`
+
+func (q *Queries) ListDocuStatuses(ctx context.Context) ([]DocuStatus, error) {
+	rows, err := q.db.QueryContext(ctx, listDocuStatuses)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DocuStatus
+	for rows.Next() {
+		var i DocuStatus
+		if err := rows.Scan(&i.ID, &i.Title); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDocumentTemplates = `-- name: ListDocumentTemplates :many
+SELECT id, doc_title, doc_key, doc_order, required FROM document_templates ORDER BY doc_order, id
+`
+
+func (q *Queries) ListDocumentTemplates(ctx context.Context) ([]DocumentTemplate, error) {
+	rows, err := q.db.QueryContext(ctx, listDocumentTemplates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DocumentTemplate
+	for rows.Next() {
+		var i DocumentTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.DocTitle,
+			&i.DocKey,
+			&i.DocOrder,
+			&i.Required,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDueOutboxEmails = `-- name: ListDueOutboxEmails :many
+SELECT id, recipient, type, subject, text_body, html_body, attempts
+FROM email_outbox
+WHERE status = 'PENDING' AND next_attempt_at <= ?
+  AND (locked_until IS NULL OR locked_until < ?)
+ORDER BY next_attempt_at
+LIMIT ?
+`
+
+type ListDueOutboxEmailsRow struct {
+	ID        int32
+	Recipient string
+	Type      string
+	Subject   string
+	TextBody  string
+	HtmlBody  string
+	Attempts  int32
+}
+
+type ListDueOutboxEmailsParams struct {
+	NextAttemptAt time.Time
+	LockedUntil   sql.NullTime
+	Limit         int32
+}
+
+// pending emails that are due and not being sent by another instance
+func (q *Queries) ListDueOutboxEmails(ctx context.Context, arg ListDueOutboxEmailsParams) ([]ListDueOutboxEmailsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDueOutboxEmails, arg.NextAttemptAt, arg.LockedUntil, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDueOutboxEmailsRow
+	for rows.Next() {
+		var i ListDueOutboxEmailsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Recipient,
+			&i.Type,
+			&i.Subject,
+			&i.TextBody,
+			&i.HtmlBody,
+			&i.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDueWebhookDeliveries = `-- name: ListDueWebhookDeliveries :many
+SELECT d.id, d.endpoint_id, d.event_id, d.event_type, d.payload, d.attempts, e.url, e.secret
+FROM webhook_deliveries d
+JOIN webhook_endpoints e ON e.id = d.endpoint_id
+WHERE d.status = 'PENDING' AND e.is_active = TRUE AND d.next_attempt_at <= ?
+  AND (d.locked_until IS NULL OR d.locked_until < ?)
+ORDER BY d.next_attempt_at
+LIMIT ?
+`
+
+type ListDueWebhookDeliveriesRow struct {
+	ID         int32
+	EndpointID int32
+	EventID    string
+	EventType  string
+	Payload    string
+	Attempts   int32
+	Url        string
+	Secret     string
+}
+
+type ListDueWebhookDeliveriesParams struct {
+	NextAttemptAt time.Time
+	LockedUntil   sql.NullTime
+	Limit         int32
+}
+
+// pending deliveries to active endpoints that are due and not being sent by another instance
+func (q *Queries) ListDueWebhookDeliveries(ctx context.Context, arg ListDueWebhookDeliveriesParams) ([]ListDueWebhookDeliveriesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDueWebhookDeliveries, arg.NextAttemptAt, arg.LockedUntil, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDueWebhookDeliveriesRow
+	for rows.Next() {
+		var i ListDueWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EndpointID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Attempts,
+			&i.Url,
+			&i.Secret,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventDates = `-- name: ListEventDates :many
+SELECT id, event_id, start_time, end_time FROM event_dates WHERE event_id = ? ORDER BY start_time
+`
+
+func (q *Queries) ListEventDates(ctx context.Context, eventID int32) ([]EventDate, error) {
+	rows, err := q.db.QueryContext(ctx, listEventDates, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EventDate
+	for rows.Next() {
+		var i EventDate
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.StartTime,
+			&i.EndTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventDatesEndingAfter = `-- name: ListEventDatesEndingAfter :many
+
+SELECT id, event_id, start_time, end_time FROM event_dates WHERE end_time >= ? ORDER BY event_id, start_time
+`
+
+// Tracker deadline queries
+func (q *Queries) ListEventDatesEndingAfter(ctx context.Context, endTime time.Time) ([]EventDate, error) {
+	rows, err := q.db.QueryContext(ctx, listEventDatesEndingAfter, endTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EventDate
+	for rows.Next() {
+		var i EventDate
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.StartTime,
+			&i.EndTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventDocuHeads = `-- name: ListEventDocuHeads :many
+SELECT m.id, m.full_name, m.email
+FROM event_docu_head d
+JOIN members m ON m.id = d.member_id
+WHERE d.event_id = ?
+ORDER BY m.full_name
+`
+
+type ListEventDocuHeadsRow struct {
+	ID       int32
+	FullName string
+	Email    string
+}
+
+func (q *Queries) ListEventDocuHeads(ctx context.Context, eventID int32) ([]ListEventDocuHeadsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEventDocuHeads, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListEventDocuHeadsRow
+	for rows.Next() {
+		var i ListEventDocuHeadsRow
+		if err := rows.Scan(&i.ID, &i.FullName, &i.Email); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventDurations = `-- name: ListEventDurations :many
+SELECT id, name FROM event_durations ORDER BY id
+`
+
+func (q *Queries) ListEventDurations(ctx context.Context) ([]EventDuration, error) {
+	rows, err := q.db.QueryContext(ctx, listEventDurations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EventDuration
+	for rows.Next() {
+		var i EventDuration
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventFiles = `-- name: ListEventFiles :many
+SELECT f.id, f.event_id, f.file_key, f.file_order, f.file_status, f.title, f.template_id, f.content_type, f.uploaded_by, f.uploaded_at, f.reviewer_note, f.reviewed_by, f.reviewed_at, u.full_name AS uploaded_by_name, r.full_name AS reviewed_by_name
+FROM event_files f
+LEFT JOIN members u ON u.id = f.uploaded_by
+LEFT JOIN members r ON r.id = f.reviewed_by
+WHERE f.event_id = ?
+ORDER BY f.file_order, f.id
+`
+
+type ListEventFilesRow struct {
+	ID             int32
+	EventID        int32
+	FileKey        sql.NullString
+	FileOrder      int32
+	FileStatus     string
+	Title          string
+	TemplateID     sql.NullInt32
+	ContentType    sql.NullString
+	UploadedBy     sql.NullInt32
+	UploadedAt     sql.NullTime
+	ReviewerNote   sql.NullString
+	ReviewedBy     sql.NullInt32
+	ReviewedAt     sql.NullTime
+	UploadedByName sql.NullString
+	ReviewedByName sql.NullString
+}
+
+func (q *Queries) ListEventFiles(ctx context.Context, eventID int32) ([]ListEventFilesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEventFiles, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListEventFilesRow
+	for rows.Next() {
+		var i ListEventFilesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.FileKey,
+			&i.FileOrder,
+			&i.FileStatus,
+			&i.Title,
+			&i.TemplateID,
+			&i.ContentType,
+			&i.UploadedBy,
+			&i.UploadedAt,
+			&i.ReviewerNote,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
+			&i.UploadedByName,
+			&i.ReviewedByName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventHeads = `-- name: ListEventHeads :many
+SELECT m.id, m.full_name, m.email, m.position_id, m.committee_id
+FROM event_heads eh
+JOIN members m ON eh.member_id = m.id
+WHERE eh.event_id = ?
+ORDER BY m.full_name
+`
+
+type ListEventHeadsRow struct {
+	ID          int32
+	FullName    string
+	Email       string
+	PositionID  sql.NullString
+	CommitteeID sql.NullString
+}
+
+func (q *Queries) ListEventHeads(ctx context.Context, eventID int32) ([]ListEventHeadsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEventHeads, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListEventHeadsRow
+	for rows.Next() {
+		var i ListEventHeadsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Email,
+			&i.PositionID,
+			&i.CommitteeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventNatures = `-- name: ListEventNatures :many
+SELECT id, name FROM event_natures ORDER BY name
+`
+
+func (q *Queries) ListEventNatures(ctx context.Context) ([]EventNature, error) {
+	rows, err := q.db.QueryContext(ctx, listEventNatures)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EventNature
+	for rows.Next() {
+		var i EventNature
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventParticipants = `-- name: ListEventParticipants :many
+
+SELECT event_id, student_id, student_name, student_email, notes, registered_at, checked_in_at, checked_in_by, verified_at FROM event_participants WHERE event_id = ? ORDER BY student_name, student_id
+`
+
+// Event participant queries
+func (q *Queries) ListEventParticipants(ctx context.Context, eventID int32) ([]EventParticipant, error) {
+	rows, err := q.db.QueryContext(ctx, listEventParticipants, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EventParticipant
+	for rows.Next() {
+		var i EventParticipant
+		if err := rows.Scan(
+			&i.EventID,
+			&i.StudentID,
+			&i.StudentName,
+			&i.StudentEmail,
+			&i.Notes,
+			&i.RegisteredAt,
+			&i.CheckedInAt,
+			&i.CheckedInBy,
+			&i.VerifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventTrackers = `-- name: ListEventTrackers :many
+SELECT t.event_id, t.preacts_deadline, t.preacts_status, t.postacts_deadline, t.postacts_status, t.docu_drive_id, t.fin_drive_id, t.fin_preacts_deadline, t.fin_preacts_status, t.fin_postacts_deadline, t.fin_postacts_status, e.name, e.committee_id
+FROM event_trackers t
+JOIN events e ON e.id = t.event_id
+ORDER BY t.event_id DESC
+`
+
+type ListEventTrackersRow struct {
+	EventID             int32
+	PreactsDeadline     sql.NullTime
+	PreactsStatus       string
+	PostactsDeadline    sql.NullTime
+	PostactsStatus      string
+	DocuDriveID         sql.NullString
+	FinDriveID          sql.NullString
+	FinPreactsDeadline  sql.NullTime
+	FinPreactsStatus    sql.NullString
+	FinPostactsDeadline sql.NullTime
+	FinPostactsStatus   sql.NullString
+	Name                string
+	CommitteeID         string
+}
+
+func (q *Queries) ListEventTrackers(ctx context.Context) ([]ListEventTrackersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEventTrackers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListEventTrackersRow
+	for rows.Next() {
+		var i ListEventTrackersRow
+		if err := rows.Scan(
+			&i.EventID,
+			&i.PreactsDeadline,
+			&i.PreactsStatus,
+			&i.PostactsDeadline,
+			&i.PostactsStatus,
+			&i.DocuDriveID,
+			&i.FinDriveID,
+			&i.FinPreactsDeadline,
+			&i.FinPreactsStatus,
+			&i.FinPostactsDeadline,
+			&i.FinPostactsStatus,
+			&i.Name,
+			&i.CommitteeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventTrackersByCommittee = `-- name: ListEventTrackersByCommittee :many
+SELECT t.event_id, t.preacts_deadline, t.preacts_status, t.postacts_deadline, t.postacts_status, t.docu_drive_id, t.fin_drive_id, t.fin_preacts_deadline, t.fin_preacts_status, t.fin_postacts_deadline, t.fin_postacts_status, e.name, e.committee_id
+FROM event_trackers t
+JOIN events e ON e.id = t.event_id
+WHERE e.committee_id = ?
+ORDER BY t.event_id DESC
+`
+
+type ListEventTrackersByCommitteeRow struct {
+	EventID             int32
+	PreactsDeadline     sql.NullTime
+	PreactsStatus       string
+	PostactsDeadline    sql.NullTime
+	PostactsStatus      string
+	DocuDriveID         sql.NullString
+	FinDriveID          sql.NullString
+	FinPreactsDeadline  sql.NullTime
+	FinPreactsStatus    sql.NullString
+	FinPostactsDeadline sql.NullTime
+	FinPostactsStatus   sql.NullString
+	Name                string
+	CommitteeID         string
+}
+
+func (q *Queries) ListEventTrackersByCommittee(ctx context.Context, committeeID string) ([]ListEventTrackersByCommitteeRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEventTrackersByCommittee, committeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListEventTrackersByCommitteeRow
+	for rows.Next() {
+		var i ListEventTrackersByCommitteeRow
+		if err := rows.Scan(
+			&i.EventID,
+			&i.PreactsDeadline,
+			&i.PreactsStatus,
+			&i.PostactsDeadline,
+			&i.PostactsStatus,
+			&i.DocuDriveID,
+			&i.FinDriveID,
+			&i.FinPreactsDeadline,
+			&i.FinPreactsStatus,
+			&i.FinPostactsDeadline,
+			&i.FinPostactsStatus,
+			&i.Name,
+			&i.CommitteeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventTrackersByHead = `-- name: ListEventTrackersByHead :many
+SELECT t.event_id, t.preacts_deadline, t.preacts_status, t.postacts_deadline, t.postacts_status, t.docu_drive_id, t.fin_drive_id, t.fin_preacts_deadline, t.fin_preacts_status, t.fin_postacts_deadline, t.fin_postacts_status, e.name, e.committee_id
+FROM event_trackers t
+JOIN events e ON e.id = t.event_id
+JOIN event_heads h ON h.event_id = t.event_id
+WHERE h.member_id = ?
+ORDER BY t.event_id DESC
+`
+
+type ListEventTrackersByHeadRow struct {
+	EventID             int32
+	PreactsDeadline     sql.NullTime
+	PreactsStatus       string
+	PostactsDeadline    sql.NullTime
+	PostactsStatus      string
+	DocuDriveID         sql.NullString
+	FinDriveID          sql.NullString
+	FinPreactsDeadline  sql.NullTime
+	FinPreactsStatus    sql.NullString
+	FinPostactsDeadline sql.NullTime
+	FinPostactsStatus   sql.NullString
+	Name                string
+	CommitteeID         string
+}
+
+func (q *Queries) ListEventTrackersByHead(ctx context.Context, memberID int32) ([]ListEventTrackersByHeadRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEventTrackersByHead, memberID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListEventTrackersByHeadRow
+	for rows.Next() {
+		var i ListEventTrackersByHeadRow
+		if err := rows.Scan(
+			&i.EventID,
+			&i.PreactsDeadline,
+			&i.PreactsStatus,
+			&i.PostactsDeadline,
+			&i.PostactsStatus,
+			&i.DocuDriveID,
+			&i.FinDriveID,
+			&i.FinPreactsDeadline,
+			&i.FinPreactsStatus,
+			&i.FinPostactsDeadline,
+			&i.FinPostactsStatus,
+			&i.Name,
+			&i.CommitteeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventTypes = `-- name: ListEventTypes :many
+SELECT id, name FROM event_types ORDER BY name
+`
+
+func (q *Queries) ListEventTypes(ctx context.Context) ([]EventType, error) {
+	rows, err := q.db.QueryContext(ctx, listEventTypes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EventType
+	for rows.Next() {
+		var i EventType
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEvents = `-- name: ListEvents :many
+
+SELECT id, arn, name, committee_id, type, nature_id, term_id, created_at, duration_id, brief_description, goals, objectives, strategies, measures, budget_allocation, venue, docu_head, fin_head FROM events ORDER BY created_at DESC, id DESC
+`
+
+// Event queries
+func (q *Queries) ListEvents(ctx context.Context) ([]Event, error) {
+	rows, err := q.db.QueryContext(ctx, listEvents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.Arn,
+			&i.Name,
+			&i.CommitteeID,
+			&i.Type,
+			&i.NatureID,
+			&i.TermID,
+			&i.CreatedAt,
+			&i.DurationID,
+			&i.BriefDescription,
+			&i.Goals,
+			&i.Objectives,
+			&i.Strategies,
+			&i.Measures,
+			&i.BudgetAllocation,
+			&i.Venue,
+			&i.DocuHead,
+			&i.FinHead,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventsByCommittee = `-- name: ListEventsByCommittee :many
+SELECT id, arn, name, committee_id, type, nature_id, term_id, created_at, duration_id, brief_description, goals, objectives, strategies, measures, budget_allocation, venue, docu_head, fin_head FROM events WHERE committee_id = ? ORDER BY created_at DESC, id DESC
+`
+
+func (q *Queries) ListEventsByCommittee(ctx context.Context, committeeID string) ([]Event, error) {
+	rows, err := q.db.QueryContext(ctx, listEventsByCommittee, committeeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.Arn,
+			&i.Name,
+			&i.CommitteeID,
+			&i.Type,
+			&i.NatureID,
+			&i.TermID,
+			&i.CreatedAt,
+			&i.DurationID,
+			&i.BriefDescription,
+			&i.Goals,
+			&i.Objectives,
+			&i.Strategies,
+			&i.Measures,
+			&i.BudgetAllocation,
+			&i.Venue,
+			&i.DocuHead,
+			&i.FinHead,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventsByTerm = `-- name: ListEventsByTerm :many
+SELECT id, arn, name, committee_id, type, nature_id, term_id, created_at, duration_id, brief_description, goals, objectives, strategies, measures, budget_allocation, venue, docu_head, fin_head FROM events WHERE term_id = ? ORDER BY created_at DESC, id DESC
+`
+
+func (q *Queries) ListEventsByTerm(ctx context.Context, termID int32) ([]Event, error) {
+	rows, err := q.db.QueryContext(ctx, listEventsByTerm, termID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.Arn,
+			&i.Name,
+			&i.CommitteeID,
+			&i.Type,
+			&i.NatureID,
+			&i.TermID,
+			&i.CreatedAt,
+			&i.DurationID,
+			&i.BriefDescription,
+			&i.Goals,
+			&i.Objectives,
+			&i.Strategies,
+			&i.Measures,
+			&i.BudgetAllocation,
+			&i.Venue,
+			&i.DocuHead,
+			&i.FinHead,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventsDueForDriveFolders = `-- name: ListEventsDueForDriveFolders :many
+
+SELECT e.id, e.arn, e.name, e.committee_id
+FROM events e
+JOIN event_trackers t ON t.event_id = e.id
+LEFT JOIN event_drive_folders f ON f.event_id = e.id
+WHERE t.preacts_status = 'APPROVED'
+  AND (f.event_id IS NULL OR (f.status = 'PENDING' AND f.next_attempt_at <= ?))
+ORDER BY e.id
+LIMIT ?
+`
+
+type ListEventsDueForDriveFoldersRow struct {
+	ID          int32
+	Arn         string
+	Name        string
+	CommitteeID string
+}
+
+type ListEventsDueForDriveFoldersParams struct {
+	NextAttemptAt sql.NullTime
+	Limit         int32
+}
+
+// Event Drive folder queries
+// events with approved pre-acts whose Drive folders have not been created yet
+func (q *Queries) ListEventsDueForDriveFolders(ctx context.Context, arg ListEventsDueForDriveFoldersParams) ([]ListEventsDueForDriveFoldersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEventsDueForDriveFolders, arg.NextAttemptAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListEventsDueForDriveFoldersRow
+	for rows.Next() {
+		var i ListEventsDueForDriveFoldersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Arn,
+			&i.Name,
+			&i.CommitteeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFileStatuses = `-- name: ListFileStatuses :many
+
+SELECT id, title FROM file_statuses
+`
+
+// Event file queries
+func (q *Queries) ListFileStatuses(ctx context.Context) ([]FileStatus, error) {
+	rows, err := q.db.QueryContext(ctx, listFileStatuses)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FileStatus
+	for rows.Next() {
+		var i FileStatus
+		if err := rows.Scan(&i.ID, &i.Title); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFinProcessRefs = `-- name: ListFinProcessRefs :many
+SELECT id, name FROM fin_process_ref ORDER BY name
+`
+
+func (q *Queries) ListFinProcessRefs(ctx context.Context) ([]FinProcessRef, error) {
+	rows, err := q.db.QueryContext(ctx, listFinProcessRefs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FinProcessRef
+	for rows.Next() {
+		var i FinProcessRef
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFinProcesses = `-- name: ListFinProcesses :many
+SELECT fp.id, fp.process, r.name
+FROM fin_processes fp
+JOIN fin_process_ref r ON r.id = fp.process
+WHERE fp.tracker_id = ?
+ORDER BY fp.id
+`
+
+type ListFinProcessesRow struct {
+	ID      int32
+	Process string
+	Name    string
+}
+
+func (q *Queries) ListFinProcesses(ctx context.Context, trackerID int32) ([]ListFinProcessesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listFinProcesses, trackerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListFinProcessesRow
+	for rows.Next() {
+		var i ListFinProcessesRow
+		if err := rows.Scan(&i.ID, &i.Process, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFinStatuses = `-- name: ListFinStatuses :many
+SELECT id, title FROM fin_status
+`
+
+func (q *Queries) ListFinStatuses(ctx context.Context) ([]FinStatus, error) {
+	rows, err := q.db.QueryContext(ctx, listFinStatuses)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FinStatus
+	for rows.Next() {
+		var i FinStatus
+		if err := rows.Scan(&i.ID, &i.Title); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHouseMembers = `-- name: ListHouseMembers :many
+SELECT m.id, m.full_name, m.nickname, m.email, m.position_id, m.committee_id, m.image_url
+FROM members m
+WHERE m.house_id = ?
+ORDER BY m.full_name
+`
+
+type ListHouseMembersRow struct {
+	ID          int32
+	FullName    string
+	Nickname    sql.NullString
+	Email       string
+	PositionID  sql.NullString
+	CommitteeID sql.NullString
+	ImageUrl    sql.NullString
+}
+
+func (q *Queries) ListHouseMembers(ctx context.Context, houseID sql.NullInt32) ([]ListHouseMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listHouseMembers, houseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListHouseMembersRow
+	for rows.Next() {
+		var i ListHouseMembersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Nickname,
+			&i.Email,
+			&i.PositionID,
+			&i.CommitteeID,
+			&i.ImageUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHousePoints = `-- name: ListHousePoints :many
+SELECT p.id, p.house_id, p.term_id, p.points, p.reason, p.awarded_by, p.event_id, p.created_at, m.full_name AS awarded_by_name, e.name AS event_name
+FROM house_points p
+LEFT JOIN members m ON m.id = p.awarded_by
+LEFT JOIN events e ON e.id = p.event_id
+WHERE p.house_id = ? AND p.term_id = ?
+ORDER BY p.created_at DESC, p.id DESC
+`
+
+type ListHousePointsRow struct {
+	ID            int32
+	HouseID       int32
+	TermID        int32
+	Points        int32
+	Reason        string
+	AwardedBy     sql.NullInt32
+	EventID       sql.NullInt32
+	CreatedAt     sql.NullTime
+	AwardedByName sql.NullString
+	EventName     sql.NullString
+}
+
+type ListHousePointsParams struct {
+	HouseID int32
+	TermID  int32
+}
+
+func (q *Queries) ListHousePoints(ctx context.Context, arg ListHousePointsParams) ([]ListHousePointsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listHousePoints, arg.HouseID, arg.TermID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListHousePointsRow
+	for rows.Next() {
+		var i ListHousePointsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.HouseID,
+			&i.TermID,
+			&i.Points,
+			&i.Reason,
+			&i.AwardedBy,
+			&i.EventID,
+			&i.CreatedAt,
+			&i.AwardedByName,
+			&i.EventName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHousePointsAllTerms = `-- name: ListHousePointsAllTerms :many
+SELECT p.id, p.house_id, p.term_id, p.points, p.reason, p.awarded_by, p.event_id, p.created_at, m.full_name AS awarded_by_name, e.name AS event_name
+FROM house_points p
+LEFT JOIN members m ON m.id = p.awarded_by
+LEFT JOIN events e ON e.id = p.event_id
+WHERE p.house_id = ?
+ORDER BY p.created_at DESC, p.id DESC
+`
+
+type ListHousePointsAllTermsRow struct {
+	ID            int32
+	HouseID       int32
+	TermID        int32
+	Points        int32
+	Reason        string
+	AwardedBy     sql.NullInt32
+	EventID       sql.NullInt32
+	CreatedAt     sql.NullTime
+	AwardedByName sql.NullString
+	EventName     sql.NullString
+}
+
+func (q *Queries) ListHousePointsAllTerms(ctx context.Context, houseID int32) ([]ListHousePointsAllTermsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listHousePointsAllTerms, houseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListHousePointsAllTermsRow
+	for rows.Next() {
+		var i ListHousePointsAllTermsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.HouseID,
+			&i.TermID,
+			&i.Points,
+			&i.Reason,
+			&i.AwardedBy,
+			&i.EventID,
+			&i.CreatedAt,
+			&i.AwardedByName,
+			&i.EventName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHouses = `-- name: ListHouses :many
+
+SELECT h.id, h.name, h.description, COUNT(m.id) AS member_count
+FROM houses h
+LEFT JOIN members m ON m.house_id = h.id
+GROUP BY h.id, h.name, h.description
+ORDER BY h.name
+`
+
+type ListHousesRow struct {
+	ID          int32
+	Name        sql.NullString
+	Description sql.NullString
+	MemberCount int64
+}
+
+// House queries
+func (q *Queries) ListHouses(ctx context.Context) ([]ListHousesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listHouses)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListHousesRow
+	for rows.Next() {
+		var i ListHousesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.MemberCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHousesByIDs = `-- name: ListHousesByIDs :many
+SELECT id, name, description FROM houses WHERE id IN (/*SLICE:ids*/?)
+`
+
+func (q *Queries) ListHousesByIDs(ctx context.Context, ids []int32) ([]House, error) {
+	query := listHousesByIDs
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []House
+	for rows.Next() {
+		var i House
+		if err := rows.Scan(&i.ID, &i.Name, &i.Description); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMemberAssignments = `-- name: ListMemberAssignments :many
+SELECT id, position_id, committee_id FROM members ORDER BY id
+`
+
+type ListMemberAssignmentsRow struct {
+	ID          int32
+	PositionID  sql.NullString
+	CommitteeID sql.NullString
+}
+
+// every member with their position and committee, archived as the membership of a term on rollover
+func (q *Queries) ListMemberAssignments(ctx context.Context) ([]ListMemberAssignmentsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listMemberAssignments)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMemberAssignmentsRow
+	for rows.Next() {
+		var i ListMemberAssignmentsRow
+		if err := rows.Scan(&i.ID, &i.PositionID, &i.CommitteeID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMemberEmails = `-- name: ListMemberEmails :many
+
+SELECT email FROM members WHERE email IN (/*SLICE:emails*/?)
+`
+
+// gRPC membership checks
+func (q *Queries) ListMemberEmails(ctx context.Context, emails []string) ([]string, error) {
+	query := listMemberEmails
+	var queryParams []interface{}
+	if len(emails) > 0 {
+		for _, v := range emails {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:emails*/?", strings.Repeat(",?", len(emails))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:emails*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		items = append(items, email)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMemberIDs = `-- name: ListMemberIDs :many
+SELECT id FROM members WHERE id IN (/*SLICE:ids*/?)
+`
+
+func (q *Queries) ListMemberIDs(ctx context.Context, ids []int32) ([]int32, error) {
+	query := listMemberIDs
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMembers = `-- name: ListMembers :many
+SELECT
+    m.id,
+    m.full_name,
+    m.nickname,
+    m.email,
+    m.telegram,
+    m.position_id,
+    m.committee_id,
+    m.college,
+    m.program,
+    m.discord,
+    m.interests,
+    m.contact_number,
+    m.fb_link,
+    m.image_url,
+    h.name as house_name
+FROM members m
+LEFT JOIN houses h ON m.house_id = h.id
+ORDER BY m.email
+`
+
+type ListMembersRow struct {
+	ID            int32
+	FullName      string
+	Nickname      sql.NullString
+	Email         string
+	Telegram      sql.NullString
+	PositionID    sql.NullString
+	CommitteeID   sql.NullString
+	College       sql.NullString
+	Program       sql.NullString
+	Discord       sql.NullString
+	Interests     sql.NullString
+	ContactNumber sql.NullString
+	FbLink        sql.NullString
+	ImageUrl      sql.NullString
+	HouseName     sql.NullString
+}
+
+func (q *Queries) ListMembers(ctx context.Context) ([]ListMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listMembers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMembersRow
+	for rows.Next() {
+		var i ListMembersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Nickname,
+			&i.Email,
+			&i.Telegram,
+			&i.PositionID,
+			&i.CommitteeID,
+			&i.College,
+			&i.Program,
+			&i.Discord,
+			&i.Interests,
+			&i.ContactNumber,
+			&i.FbLink,
+			&i.ImageUrl,
+			&i.HouseName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMembersByCommitteeIDs = `-- name: ListMembersByCommitteeIDs :many
+SELECT id, full_name, nickname, email, telegram, position_id, committee_id, college, program, discord, interests, contact_number, fb_link, house_id, image_url FROM members WHERE committee_id IN (/*SLICE:committee_ids*/?) ORDER BY full_name
+`
+
+func (q *Queries) ListMembersByCommitteeIDs(ctx context.Context, committeeIds []sql.NullString) ([]Member, error) {
+	query := listMembersByCommitteeIDs
+	var queryParams []interface{}
+	if len(committeeIds) > 0 {
+		for _, v := range committeeIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:committee_ids*/?", strings.Repeat(",?", len(committeeIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:committee_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Member
+	for rows.Next() {
+		var i Member
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Nickname,
+			&i.Email,
+			&i.Telegram,
+			&i.PositionID,
+			&i.CommitteeID,
+			&i.College,
+			&i.Program,
+			&i.Discord,
+			&i.Interests,
+			&i.ContactNumber,
+			&i.FbLink,
+			&i.HouseID,
+			&i.ImageUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMembersByHouseIDs = `-- name: ListMembersByHouseIDs :many
+SELECT id, full_name, nickname, email, telegram, position_id, committee_id, college, program, discord, interests, contact_number, fb_link, house_id, image_url FROM members WHERE house_id IN (/*SLICE:house_ids*/?) ORDER BY full_name
+`
+
+func (q *Queries) ListMembersByHouseIDs(ctx context.Context, houseIds []sql.NullInt32) ([]Member, error) {
+	query := listMembersByHouseIDs
+	var queryParams []interface{}
+	if len(houseIds) > 0 {
+		for _, v := range houseIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:house_ids*/?", strings.Repeat(",?", len(houseIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:house_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Member
+	for rows.Next() {
+		var i Member
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Nickname,
+			&i.Email,
+			&i.Telegram,
+			&i.PositionID,
+			&i.CommitteeID,
+			&i.College,
+			&i.Program,
+			&i.Discord,
+			&i.Interests,
+			&i.ContactNumber,
+			&i.FbLink,
+			&i.HouseID,
+			&i.ImageUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMembersByIDs = `-- name: ListMembersByIDs :many
+SELECT id, full_name, nickname, email, telegram, position_id, committee_id, college, program, discord, interests, contact_number, fb_link, house_id, image_url FROM members WHERE id IN (/*SLICE:ids*/?)
+`
+
+func (q *Queries) ListMembersByIDs(ctx context.Context, ids []int32) ([]Member, error) {
+	query := listMembersByIDs
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Member
+	for rows.Next() {
+		var i Member
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Nickname,
+			&i.Email,
+			&i.Telegram,
+			&i.PositionID,
+			&i.CommitteeID,
+			&i.College,
+			&i.Program,
+			&i.Discord,
+			&i.Interests,
+			&i.ContactNumber,
+			&i.FbLink,
+			&i.HouseID,
+			&i.ImageUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMembersPage = `-- name: ListMembersPage :many
+
+SELECT id, full_name, nickname, email, telegram, position_id, committee_id, college, program, discord, interests, contact_number, fb_link, house_id, image_url FROM members WHERE id > ? ORDER BY id LIMIT ?
+`
+
+type ListMembersPageParams struct {
+	ID    int32
+	Limit int32
+}
+
+// GraphQL queries (batched by the GraphQL loaders)
+func (q *Queries) ListMembersPage(ctx context.Context, arg ListMembersPageParams) ([]Member, error) {
+	rows, err := q.db.QueryContext(ctx, listMembersPage, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Member
+	for rows.Next() {
+		var i Member
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Nickname,
+			&i.Email,
+			&i.Telegram,
+			&i.PositionID,
+			&i.CommitteeID,
+			&i.College,
+			&i.Program,
+			&i.Discord,
+			&i.Interests,
+			&i.ContactNumber,
+			&i.FbLink,
+			&i.HouseID,
+			&i.ImageUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotificationPreferences = `-- name: ListNotificationPreferences :many
+SELECT member_id, type, email_enabled, updated_at FROM notification_preferences WHERE member_id = ?
+`
+
+func (q *Queries) ListNotificationPreferences(ctx context.Context, memberID int32) ([]NotificationPreference, error) {
+	rows, err := q.db.QueryContext(ctx, listNotificationPreferences, memberID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationPreference
+	for rows.Next() {
+		var i NotificationPreference
+		if err := rows.Scan(
+			&i.MemberID,
+			&i.Type,
+			&i.EmailEnabled,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotifications = `-- name: ListNotifications :many
+SELECT id, member_id, type, title, body, link, dedupe_key, read_at, created_at FROM notifications WHERE member_id = ? AND id < ? ORDER BY id DESC LIMIT ?
+`
+
+type ListNotificationsParams struct {
+	MemberID int32
+	ID       int32
+	Limit    int32
+}
+
+// newest first, before the given ID
+func (q *Queries) ListNotifications(ctx context.Context, arg ListNotificationsParams) ([]Notification, error) {
+	rows, err := q.db.QueryContext(ctx, listNotifications, arg.MemberID, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Notification
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.MemberID,
+			&i.Type,
+			&i.Title,
+			&i.Body,
+			&i.Link,
+			&i.DedupeKey,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNotificationsAfter = `-- name: ListNotificationsAfter :many
+SELECT id, member_id, type, title, body, link, dedupe_key, read_at, created_at FROM notifications WHERE member_id = ? AND id > ? ORDER BY id LIMIT ?
+`
+
+type ListNotificationsAfterParams struct {
+	MemberID int32
+	ID       int32
+	Limit    int32
+}
+
+// oldest first, for the live stream
+func (q *Queries) ListNotificationsAfter(ctx context.Context, arg ListNotificationsAfterParams) ([]Notification, error) {
+	rows, err := q.db.QueryContext(ctx, listNotificationsAfter, arg.MemberID, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Notification
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.MemberID,
+			&i.Type,
+			&i.Title,
+			&i.Body,
+			&i.Link,
+			&i.DedupeKey,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOAuthClients = `-- name: ListOAuthClients :many
+SELECT client_id, client_secret_hash, name, scopes, created_by, created_at, redirect_uris
+FROM oauth_clients ORDER BY created_at DESC
+`
+
+func (q *Queries) ListOAuthClients(ctx context.Context) ([]OauthClient, error) {
+	rows, err := q.db.QueryContext(ctx, listOAuthClients)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OauthClient
+	for rows.Next() {
+		var i OauthClient
+		if err := rows.Scan(
+			&i.ClientID,
+			&i.ClientSecretHash,
+			&i.Name,
+			&i.Scopes,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.RedirectUris,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOfficers = `-- name: ListOfficers :many
+SELECT m.id, m.full_name, m.email, m.position_id, m.committee_id
+FROM members m
+WHERE m.position_id IS NOT NULL AND m.position_id <> 'MEM'
+ORDER BY m.committee_id, m.position_id, m.full_name
+`
+
+type ListOfficersRow struct {
+	ID          int32
+	FullName    string
+	Email       string
+	PositionID  sql.NullString
+	CommitteeID sql.NullString
+}
+
+// members with a position above plain member, i.e. the current officer roster
+func (q *Queries) ListOfficers(ctx context.Context) ([]ListOfficersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOfficers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOfficersRow
+	for rows.Next() {
+		var i ListOfficersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Email,
+			&i.PositionID,
+			&i.CommitteeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrgChartMembers = `-- name: ListOrgChartMembers :many
+
+SELECT m.id, m.full_name, m.nickname, m.image_url, m.position_id, m.committee_id
+FROM members m
+WHERE m.committee_id IS NOT NULL OR m.position_id IS NOT NULL
+ORDER BY m.full_name
+`
+
+type ListOrgChartMembersRow struct {
+	ID          int32
+	FullName    string
+	Nickname    sql.NullString
+	ImageUrl    sql.NullString
+	PositionID  sql.NullString
+	CommitteeID sql.NullString
+}
+
+// Org chart queries
+// members placed on the org chart of the current term: committee members and officers
+func (q *Queries) ListOrgChartMembers(ctx context.Context) ([]ListOrgChartMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOrgChartMembers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOrgChartMembersRow
+	for rows.Next() {
+		var i ListOrgChartMembersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Nickname,
+			&i.ImageUrl,
+			&i.PositionID,
+			&i.CommitteeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrgChartRoster = `-- name: ListOrgChartRoster :many
+SELECT m.id, m.full_name, m.nickname, m.image_url, r.position_id, r.committee_id
+FROM term_rosters r
+JOIN members m ON m.id = r.member_id
+WHERE r.term_id = ?
+ORDER BY m.full_name
+`
+
+type ListOrgChartRosterRow struct {
+	ID          int32
+	FullName    string
+	Nickname    sql.NullString
+	ImageUrl    sql.NullString
+	PositionID  sql.NullString
+	CommitteeID sql.NullString
+}
+
+// members placed on the org chart of a past term, from the roster archived at rollover
+func (q *Queries) ListOrgChartRoster(ctx context.Context, termID int32) ([]ListOrgChartRosterRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOrgChartRoster, termID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOrgChartRosterRow
+	for rows.Next() {
+		var i ListOrgChartRosterRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Nickname,
+			&i.ImageUrl,
+			&i.PositionID,
+			&i.CommitteeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPositions = `-- name: ListPositions :many
+
+SELECT position_id, position_name, position_rank FROM positions ORDER BY position_rank DESC, position_name
+`
+
+// Position queries
+func (q *Queries) ListPositions(ctx context.Context) ([]Position, error) {
+	rows, err := q.db.QueryContext(ctx, listPositions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Position
+	for rows.Next() {
+		var i Position
+		if err := rows.Scan(&i.PositionID, &i.PositionName, &i.PositionRank); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPositionsByIDs = `-- name: ListPositionsByIDs :many
+SELECT position_id, position_name, position_rank FROM positions WHERE position_id IN (/*SLICE:position_ids*/?)
+`
+
+func (q *Queries) ListPositionsByIDs(ctx context.Context, positionIds []string) ([]Position, error) {
+	query := listPositionsByIDs
+	var queryParams []interface{}
+	if len(positionIds) > 0 {
+		for _, v := range positionIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:position_ids*/?", strings.Repeat(",?", len(positionIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:position_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Position
+	for rows.Next() {
+		var i Position
+		if err := rows.Scan(&i.PositionID, &i.PositionName, &i.PositionRank); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPubReqStatuses = `-- name: ListPubReqStatuses :many
+
+SELECT id, name FROM pub_req_status
+`
+
+// Publicity request queries
+func (q *Queries) ListPubReqStatuses(ctx context.Context) ([]PubReqStatus, error) {
+	rows, err := q.db.QueryContext(ctx, listPubReqStatuses)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PubReqStatus
+	for rows.Next() {
+		var i PubReqStatus
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPubRequestComments = `-- name: ListPubRequestComments :many
+SELECT c.id, c.pub_request_id, c.member_id, c.body, c.from_status, c.to_status, c.created_at, m.full_name AS member_name
+FROM pub_request_comments c
+LEFT JOIN members m ON m.id = c.member_id
+WHERE c.pub_request_id = ?
+ORDER BY c.created_at, c.id
+`
+
+type ListPubRequestCommentsRow struct {
+	ID           int32
+	PubRequestID int32
+	MemberID     sql.NullInt32
+	Body         sql.NullString
+	FromStatus   sql.NullString
+	ToStatus     sql.NullString
+	CreatedAt    sql.NullTime
+	MemberName   sql.NullString
+}
+
+func (q *Queries) ListPubRequestComments(ctx context.Context, pubRequestID int32) ([]ListPubRequestCommentsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPubRequestComments, pubRequestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPubRequestCommentsRow
+	for rows.Next() {
+		var i ListPubRequestCommentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PubRequestID,
+			&i.MemberID,
+			&i.Body,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.CreatedAt,
+			&i.MemberName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPubRequests = `-- name: ListPubRequests :many
+SELECT p.id, p.event_id, p.pub_head, p.pub_type, p.pub_drive_id, p.pub_status, p.posting_date, p.pub_details, p.pub_content, p.caption, p.opa_numbers, p.for_posting, p.created_at, p.requester_id, p.dimensions, p.updated_at, e.name AS event_name, e.committee_id, r.full_name AS requester_name, h.full_name AS pub_head_name
+FROM pub_requests p
+LEFT JOIN events e ON e.id = p.event_id
+LEFT JOIN members r ON r.id = p.requester_id
+LEFT JOIN members h ON h.id = p.pub_head
+ORDER BY p.posting_date DESC, p.id DESC
+`
+
+type ListPubRequestsRow struct {
+	ID            int32
+	EventID       sql.NullInt32
+	PubHead       sql.NullInt32
+	PubType       sql.NullString
+	PubDriveID    string
+	PubStatus     sql.NullString
+	PostingDate   time.Time
+	PubDetails    string
+	PubContent    string
+	Caption       string
+	OpaNumbers    string
+	ForPosting    bool
+	CreatedAt     time.Time
+	RequesterID   sql.NullInt32
+	Dimensions    sql.NullString
+	UpdatedAt     sql.NullTime
+	EventName     sql.NullString
+	CommitteeID   sql.NullString
+	RequesterName sql.NullString
+	PubHeadName   sql.NullString
+}
+
+func (q *Queries) ListPubRequests(ctx context.Context) ([]ListPubRequestsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPubRequests)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPubRequestsRow
+	for rows.Next() {
+		var i ListPubRequestsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.PubHead,
+			&i.PubType,
+			&i.PubDriveID,
+			&i.PubStatus,
+			&i.PostingDate,
+			&i.PubDetails,
+			&i.PubContent,
+			&i.Caption,
+			&i.OpaNumbers,
+			&i.ForPosting,
+			&i.CreatedAt,
+			&i.RequesterID,
+			&i.Dimensions,
+			&i.UpdatedAt,
+			&i.EventName,
+			&i.CommitteeID,
+			&i.RequesterName,
+			&i.PubHeadName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPubRequestsByEvent = `-- name: ListPubRequestsByEvent :many
+SELECT p.id, p.event_id, p.pub_head, p.pub_type, p.pub_drive_id, p.pub_status, p.posting_date, p.pub_details, p.pub_content, p.caption, p.opa_numbers, p.for_posting, p.created_at, p.requester_id, p.dimensions, p.updated_at, e.name AS event_name, e.committee_id, r.full_name AS requester_name, h.full_name AS pub_head_name
+FROM pub_requests p
+LEFT JOIN events e ON e.id = p.event_id
+LEFT JOIN members r ON r.id = p.requester_id
+LEFT JOIN members h ON h.id = p.pub_head
+WHERE p.event_id = ?
+ORDER BY p.posting_date DESC, p.id DESC
+`
+
+type ListPubRequestsByEventRow struct {
+	ID            int32
+	EventID       sql.NullInt32
+	PubHead       sql.NullInt32
+	PubType       sql.NullString
+	PubDriveID    string
+	PubStatus     sql.NullString
+	PostingDate   time.Time
+	PubDetails    string
+	PubContent    string
+	Caption       string
+	OpaNumbers    string
+	ForPosting    bool
+	CreatedAt     time.Time
+	RequesterID   sql.NullInt32
+	Dimensions    sql.NullString
+	UpdatedAt     sql.NullTime
+	EventName     sql.NullString
+	CommitteeID   sql.NullString
+	RequesterName sql.NullString
+	PubHeadName   sql.NullString
+}
+
+func (q *Queries) ListPubRequestsByEvent(ctx context.Context, eventID sql.NullInt32) ([]ListPubRequestsByEventRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPubRequestsByEvent, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPubRequestsByEventRow
+	for rows.Next() {
+		var i ListPubRequestsByEventRow
 		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.PubHead,
+			&i.PubType,
+			&i.PubDriveID,
+			&i.PubStatus,
+			&i.PostingDate,
+			&i.PubDetails,
+			&i.PubContent,
+			&i.Caption,
+			&i.OpaNumbers,
+			&i.ForPosting,
+			&i.CreatedAt,
+			&i.RequesterID,
+			&i.Dimensions,
+			&i.UpdatedAt,
+			&i.EventName,
 			&i.CommitteeID,
-			&i.CommitteeName,
-			&i.CommitteeHead,
-			&i.DivisionID,
+			&i.RequesterName,
+			&i.PubHeadName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPubRequestsPostingBetween = `-- name: ListPubRequestsPostingBetween :many
+SELECT p.id, p.event_id, p.pub_head, p.pub_type, p.pub_drive_id, p.pub_status, p.posting_date, p.pub_details, p.pub_content, p.caption, p.opa_numbers, p.for_posting, p.created_at, p.requester_id, p.dimensions, p.updated_at, e.name AS event_name, e.committee_id, r.full_name AS requester_name, h.full_name AS pub_head_name
+FROM pub_requests p
+LEFT JOIN events e ON e.id = p.event_id
+LEFT JOIN members r ON r.id = p.requester_id
+LEFT JOIN members h ON h.id = p.pub_head
+WHERE p.posting_date >= ? AND p.posting_date < ?
+  AND p.for_posting = TRUE
+  AND p.pub_status <> 'CANCELLED'
+ORDER BY p.posting_date, p.id
+`
+
+type ListPubRequestsPostingBetweenRow struct {
+	ID            int32
+	EventID       sql.NullInt32
+	PubHead       sql.NullInt32
+	PubType       sql.NullString
+	PubDriveID    string
+	PubStatus     sql.NullString
+	PostingDate   time.Time
+	PubDetails    string
+	PubContent    string
+	Caption       string
+	OpaNumbers    string
+	ForPosting    bool
+	CreatedAt     time.Time
+	RequesterID   sql.NullInt32
+	Dimensions    sql.NullString
+	UpdatedAt     sql.NullTime
+	EventName     sql.NullString
+	CommitteeID   sql.NullString
+	RequesterName sql.NullString
+	PubHeadName   sql.NullString
+}
+
+type ListPubRequestsPostingBetweenParams struct {
+	PostingDate   time.Time
+	PostingDate_2 time.Time
+}
+
+// requests to be posted in [from, to), excluding cancelled ones
+func (q *Queries) ListPubRequestsPostingBetween(ctx context.Context, arg ListPubRequestsPostingBetweenParams) ([]ListPubRequestsPostingBetweenRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPubRequestsPostingBetween, arg.PostingDate, arg.PostingDate_2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPubRequestsPostingBetweenRow
+	for rows.Next() {
+		var i ListPubRequestsPostingBetweenRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.PubHead,
+			&i.PubType,
+			&i.PubDriveID,
+			&i.PubStatus,
+			&i.PostingDate,
+			&i.PubDetails,
+			&i.PubContent,
+			&i.Caption,
+			&i.OpaNumbers,
+			&i.ForPosting,
+			&i.CreatedAt,
+			&i.RequesterID,
+			&i.Dimensions,
+			&i.UpdatedAt,
+			&i.EventName,
+			&i.CommitteeID,
+			&i.RequesterName,
+			&i.PubHeadName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRequiredDocumentTemplates = `-- name: ListRequiredDocumentTemplates :many
+SELECT id, doc_title, doc_key, doc_order, required FROM document_templates WHERE required = TRUE ORDER BY doc_order, id
+`
+
+func (q *Queries) ListRequiredDocumentTemplates(ctx context.Context) ([]DocumentTemplate, error) {
+	rows, err := q.db.QueryContext(ctx, listRequiredDocumentTemplates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DocumentTemplate
+	for rows.Next() {
+		var i DocumentTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.DocTitle,
+			&i.DocKey,
+			&i.DocOrder,
+			&i.Required,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRolesByMemberIDs = `-- name: ListRolesByMemberIDs :many
+SELECT mr.member_id, r.id, r.name, r.description
+FROM member_roles mr
+JOIN roles r ON mr.role_id = r.id
+WHERE mr.member_id IN (/*SLICE:member_ids*/?)
+ORDER BY r.id
+`
+
+type ListRolesByMemberIDsRow struct {
+	MemberID    int32
+	ID          string
+	Name        string
+	Description sql.NullString
+}
+
+func (q *Queries) ListRolesByMemberIDs(ctx context.Context, memberIds []int32) ([]ListRolesByMemberIDsRow, error) {
+	query := listRolesByMemberIDs
+	var queryParams []interface{}
+	if len(memberIds) > 0 {
+		for _, v := range memberIds {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:member_ids*/?", strings.Repeat(",?", len(memberIds))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:member_ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRolesByMemberIDsRow
+	for rows.Next() {
+		var i ListRolesByMemberIDsRow
+		if err := rows.Scan(
+			&i.MemberID,
+			&i.ID,
+			&i.Name,
+			&i.Description,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTermMembers = `-- name: ListTermMembers :many
+SELECT
+    m.id,
+    m.full_name,
+    m.nickname,
+    m.email,
+    m.telegram,
+    r.position_id,
+    r.committee_id,
+    m.college,
+    m.program,
+    m.discord,
+    m.interests,
+    m.contact_number,
+    m.fb_link,
+    m.image_url,
+    h.name as house_name
+FROM term_rosters r
+JOIN members m ON m.id = r.member_id
+LEFT JOIN houses h ON m.house_id = h.id
+WHERE r.term_id = ?
+ORDER BY m.email
+`
+
+type ListTermMembersRow struct {
+	ID            int32
+	FullName      string
+	Nickname      sql.NullString
+	Email         string
+	Telegram      sql.NullString
+	PositionID    sql.NullString
+	CommitteeID   sql.NullString
+	College       sql.NullString
+	Program       sql.NullString
+	Discord       sql.NullString
+	Interests     sql.NullString
+	ContactNumber sql.NullString
+	FbLink        sql.NullString
+	ImageUrl      sql.NullString
+	HouseName     sql.NullString
+}
+
+// the archived members of a term, with the position and committee they had then
+func (q *Queries) ListTermMembers(ctx context.Context, termID int32) ([]ListTermMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTermMembers, termID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTermMembersRow
+	for rows.Next() {
+		var i ListTermMembersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Nickname,
+			&i.Email,
+			&i.Telegram,
+			&i.PositionID,
+			&i.CommitteeID,
+			&i.College,
+			&i.Program,
+			&i.Discord,
+			&i.Interests,
+			&i.ContactNumber,
+			&i.FbLink,
+			&i.ImageUrl,
+			&i.HouseName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTermPositionRanks = `-- name: ListTermPositionRanks :many
+SELECT position_id, position_rank FROM term_position_ranks WHERE term_id = ? ORDER BY position_rank DESC, position_id
+`
+
+type ListTermPositionRanksRow struct {
+	PositionID   string
+	PositionRank int32
+}
+
+func (q *Queries) ListTermPositionRanks(ctx context.Context, termID int32) ([]ListTermPositionRanksRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTermPositionRanks, termID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTermPositionRanksRow
+	for rows.Next() {
+		var i ListTermPositionRanksRow
+		if err := rows.Scan(&i.PositionID, &i.PositionRank); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTermRoster = `-- name: ListTermRoster :many
+SELECT r.term_id, r.member_id, r.position_id, r.committee_id, r.archived_at, m.full_name, m.email
+FROM term_rosters r
+JOIN members m ON m.id = r.member_id
+WHERE r.term_id = ? AND r.position_id IS NOT NULL AND r.position_id <> 'MEM'
+ORDER BY r.committee_id, r.position_id, m.full_name
+`
+
+type ListTermRosterRow struct {
+	TermID      int32
+	MemberID    int32
+	PositionID  sql.NullString
+	CommitteeID sql.NullString
+	ArchivedAt  sql.NullTime
+	FullName    string
+	Email       string
+}
+
+// the officers among the archived members of a term
+func (q *Queries) ListTermRoster(ctx context.Context, termID int32) ([]ListTermRosterRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTermRoster, termID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTermRosterRow
+	for rows.Next() {
+		var i ListTermRosterRow
+		if err := rows.Scan(
+			&i.TermID,
+			&i.MemberID,
+			&i.PositionID,
+			&i.CommitteeID,
+			&i.ArchivedAt,
+			&i.FullName,
+			&i.Email,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTerms = `-- name: ListTerms :many
+SELECT id, term, start_year, end_year, start_date, end_date, is_active FROM terms ORDER BY start_year DESC, term DESC
+`
+
+func (q *Queries) ListTerms(ctx context.Context) ([]Term, error) {
+	rows, err := q.db.QueryContext(ctx, listTerms)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Term
+	for rows.Next() {
+		var i Term
+		if err := rows.Scan(
+			&i.ID,
+			&i.Term,
+			&i.StartYear,
+			&i.EndYear,
+			&i.StartDate,
+			&i.EndDate,
+			&i.IsActive,
 		); err != nil {
 			return nil, err
 		}
@@ -233,20 +6313,57 @@ func (q *Queries) GetAllCommittees(ctx context.Context) ([]Committee, error) {
 	return items, nil
 }
 
-const getAllDivisions = `-- name: GetAllDivisions :many
-SELECT d.division_id, d.division_name, d.division_head FROM divisions d
+const listTrackerDeadlines = `-- name: ListTrackerDeadlines :many
+SELECT t.event_id, t.preacts_deadline, t.preacts_status, t.postacts_deadline, t.postacts_status, t.docu_drive_id, t.fin_drive_id, t.fin_preacts_deadline, t.fin_preacts_status, t.fin_postacts_deadline, t.fin_postacts_status, e.name, e.committee_id, e.docu_head, e.fin_head
+FROM event_trackers t
+JOIN events e ON e.id = t.event_id
+ORDER BY t.event_id
 `
 
-func (q *Queries) GetAllDivisions(ctx context.Context) ([]Division, error) {
-	rows, err := q.db.QueryContext(ctx, getAllDivisions)
+type ListTrackerDeadlinesRow struct {
+	EventID             int32
+	PreactsDeadline     sql.NullTime
+	PreactsStatus       string
+	PostactsDeadline    sql.NullTime
+	PostactsStatus      string
+	DocuDriveID         sql.NullString
+	FinDriveID          sql.NullString
+	FinPreactsDeadline  sql.NullTime
+	FinPreactsStatus    sql.NullString
+	FinPostactsDeadline sql.NullTime
+	FinPostactsStatus   sql.NullString
+	Name                string
+	CommitteeID         string
+	DocuHead            sql.NullInt32
+	FinHead             sql.NullInt32
+}
+
+func (q *Queries) ListTrackerDeadlines(ctx context.Context) ([]ListTrackerDeadlinesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTrackerDeadlines)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Division
+	var items []ListTrackerDeadlinesRow
 	for rows.Next() {
-		var i Division
-		if err := rows.Scan(&i.DivisionID, &i.DivisionName, &i.DivisionHead); err != nil {
+		var i ListTrackerDeadlinesRow
+		if err := rows.Scan(
+			&i.EventID,
+			&i.PreactsDeadline,
+			&i.PreactsStatus,
+			&i.PostactsDeadline,
+			&i.PostactsStatus,
+			&i.DocuDriveID,
+			&i.FinDriveID,
+			&i.FinPreactsDeadline,
+			&i.FinPreactsStatus,
+			&i.FinPostactsDeadline,
+			&i.FinPostactsStatus,
+			&i.Name,
+			&i.CommitteeID,
+			&i.DocuHead,
+			&i.FinHead,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -260,22 +6377,29 @@ func (q *Queries) GetAllDivisions(ctx context.Context) ([]Division, error) {
 	return items, nil
 }
 
-const getAllRoles = `-- name: GetAllRoles :many
-
-SELECT id, name, description FROM roles ORDER BY id
+const listTrackerStatusHistory = `-- name: ListTrackerStatusHistory :many
+SELECT id, event_id, stage, from_status, to_status, changed_by, note, changed_at FROM tracker_status_history WHERE event_id = ? ORDER BY changed_at DESC, id DESC
 `
 
-// RBAC: Role queries
-func (q *Queries) GetAllRoles(ctx context.Context) ([]Role, error) {
-	rows, err := q.db.QueryContext(ctx, getAllRoles)
+func (q *Queries) ListTrackerStatusHistory(ctx context.Context, eventID int32) ([]TrackerStatusHistory, error) {
+	rows, err := q.db.QueryContext(ctx, listTrackerStatusHistory, eventID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Role
+	var items []TrackerStatusHistory
 	for rows.Next() {
-		var i Role
-		if err := rows.Scan(&i.ID, &i.Name, &i.Description); err != nil {
+		var i TrackerStatusHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.Stage,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.ChangedBy,
+			&i.Note,
+			&i.ChangedAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -289,23 +6413,39 @@ func (q *Queries) GetAllRoles(ctx context.Context) ([]Role, error) {
 	return items, nil
 }
 
-const getEmailsInAPIKey = `-- name: GetEmailsInAPIKey :many
-SELECT member_email FROM api_keys
+const listUnreadNotifications = `-- name: ListUnreadNotifications :many
+SELECT id, member_id, type, title, body, link, dedupe_key, read_at, created_at FROM notifications WHERE member_id = ? AND id < ? AND read_at IS NULL ORDER BY id DESC LIMIT ?
 `
 
-func (q *Queries) GetEmailsInAPIKey(ctx context.Context) ([]string, error) {
-	rows, err := q.db.QueryContext(ctx, getEmailsInAPIKey)
+type ListUnreadNotificationsParams struct {
+	MemberID int32
+	ID       int32
+	Limit    int32
+}
+
+func (q *Queries) ListUnreadNotifications(ctx context.Context, arg ListUnreadNotificationsParams) ([]Notification, error) {
+	rows, err := q.db.QueryContext(ctx, listUnreadNotifications, arg.MemberID, arg.ID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []Notification
 	for rows.Next() {
-		var member_email string
-		if err := rows.Scan(&member_email); err != nil {
+		var i Notification
+		if err := rows.Scan(
+			&i.ID,
+			&i.MemberID,
+			&i.Type,
+			&i.Title,
+			&i.Body,
+			&i.Link,
+			&i.DedupeKey,
+			&i.ReadAt,
+			&i.CreatedAt,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, member_email)
+		items = append(items, i)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -313,236 +6453,42 @@ func (q *Queries) GetEmailsInAPIKey(ctx context.Context) ([]string, error) {
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
-	return items, nil
-}
-
-const getMemberAuthInfo = `-- name: GetMemberAuthInfo :one
-SELECT id, position_id, committee_id FROM members WHERE email = ?
-`
-
-type GetMemberAuthInfoRow struct {
-	ID          int32
-	PositionID  sql.NullString
-	CommitteeID sql.NullString
-}
-
-// lightweight query for authorization checks (no image_url dependency)
-func (q *Queries) GetMemberAuthInfo(ctx context.Context, email string) (GetMemberAuthInfoRow, error) {
-	row := q.db.QueryRowContext(ctx, getMemberAuthInfo, email)
-	var i GetMemberAuthInfoRow
-	err := row.Scan(&i.ID, &i.PositionID, &i.CommitteeID)
-	return i, err
-}
-
-const getMemberByEmail = `-- name: GetMemberByEmail :one
-SELECT id, email, full_name, nickname, position_id, committee_id, college, program,
-       discord, interests, contact_number, fb_link, telegram, house_id, image_url
-FROM members WHERE email = ?
-`
-
-type GetMemberByEmailRow struct {
-	ID            int32
-	Email         string
-	FullName      string
-	Nickname      sql.NullString
-	PositionID    sql.NullString
-	CommitteeID   sql.NullString
-	College       sql.NullString
-	Program       sql.NullString
-	Discord       sql.NullString
-	Interests     sql.NullString
-	ContactNumber sql.NullString
-	FbLink        sql.NullString
-	Telegram      sql.NullString
-	HouseID       sql.NullInt32
-	ImageUrl      sql.NullString
-}
-
-func (q *Queries) GetMemberByEmail(ctx context.Context, email string) (GetMemberByEmailRow, error) {
-	row := q.db.QueryRowContext(ctx, getMemberByEmail, email)
-	var i GetMemberByEmailRow
-	err := row.Scan(
-		&i.ID,
-		&i.Email,
-		&i.FullName,
-		&i.Nickname,
-		&i.PositionID,
-		&i.CommitteeID,
-		&i.College,
-		&i.Program,
-		&i.Discord,
-		&i.Interests,
-		&i.ContactNumber,
-		&i.FbLink,
-		&i.Telegram,
-		&i.HouseID,
-		&i.ImageUrl,
-	)
-	return i, err
-}
-
-const getMemberInfo = `-- name: GetMemberInfo :one
-SELECT
-  m.id, m.email, m.full_name, m.nickname, m.image_url,
-  c.committee_id, c.committee_name,
-  d.division_id, d.division_name,
-  p.position_id, p.position_name,
-  h.name as house_name,
-  m.contact_number, m.college, m.program,
-  m.interests, m.discord, m.fb_link, m.telegram
-FROM members m
-LEFT JOIN committees c ON m.committee_id = c.committee_id
-LEFT JOIN divisions d ON c.division_id = d.division_id
-LEFT JOIN positions p ON m.position_id = p.position_id
-LEFT JOIN houses h ON m.house_id = h.id
-WHERE m.email = ?
-`
-
-type GetMemberInfoRow struct {
-	ID            int32
-	Email         string
-	FullName      string
-	Nickname      sql.NullString
-	ImageUrl      sql.NullString
-	CommitteeID   sql.NullString
-	CommitteeName sql.NullString
-	DivisionID    sql.NullString
-	DivisionName  sql.NullString
-	PositionID    sql.NullString
-	PositionName  sql.NullString
-	HouseName     sql.NullString
-	ContactNumber sql.NullString
-	College       sql.NullString
-	Program       sql.NullString
-	Interests     sql.NullString
-	Discord       sql.NullString
-	FbLink        sql.NullString
-	Telegram      sql.NullString
-}
-
-func (q *Queries) GetMemberInfo(ctx context.Context, email string) (GetMemberInfoRow, error) {
-	row := q.db.QueryRowContext(ctx, getMemberInfo, email)
-	var i GetMemberInfoRow
-	err := row.Scan(
-		&i.ID,
-		&i.Email,
-		&i.FullName,
-		&i.Nickname,
-		&i.ImageUrl,
-		&i.CommitteeID,
-		&i.CommitteeName,
-		&i.DivisionID,
-		&i.DivisionName,
-		&i.PositionID,
-		&i.PositionName,
-		&i.HouseName,
-		&i.ContactNumber,
-		&i.College,
-		&i.Program,
-		&i.Interests,
-		&i.Discord,
-		&i.FbLink,
-		&i.Telegram,
-	)
-	return i, err
-}
-
-const getMemberInfoById = `-- name: GetMemberInfoById :one
-SELECT
-  m.id, m.email, m.full_name, m.nickname, m.image_url,
-  c.committee_id, c.committee_name,
-  d.division_id, d.division_name,
-  p.position_id, p.position_name,
-  h.name as house_name,
-  m.contact_number, m.college, m.program,
-  m.interests, m.discord, m.fb_link, m.telegram
-FROM members m
-LEFT JOIN committees c ON m.committee_id = c.committee_id
-LEFT JOIN divisions d ON c.division_id = d.division_id
-LEFT JOIN positions p ON m.position_id = p.position_id
-LEFT JOIN houses h ON m.house_id = h.id
-WHERE m.id = ?
-`
-
-type GetMemberInfoByIdRow struct {
-	ID            int32
-	Email         string
-	FullName      string
-	Nickname      sql.NullString
-	ImageUrl      sql.NullString
-	CommitteeID   sql.NullString
-	CommitteeName sql.NullString
-	DivisionID    sql.NullString
-	DivisionName  sql.NullString
-	PositionID    sql.NullString
-	PositionName  sql.NullString
-	HouseName     sql.NullString
-	ContactNumber sql.NullString
-	College       sql.NullString
-	Program       sql.NullString
-	Interests     sql.NullString
-	Discord       sql.NullString
-	FbLink        sql.NullString
-	Telegram      sql.NullString
-}
-
-func (q *Queries) GetMemberInfoById(ctx context.Context, id int32) (GetMemberInfoByIdRow, error) {
-	row := q.db.QueryRowContext(ctx, getMemberInfoById, id)
-	var i GetMemberInfoByIdRow
-	err := row.Scan(
-		&i.ID,
-		&i.Email,
-		&i.FullName,
-		&i.Nickname,
-		&i.ImageUrl,
-		&i.CommitteeID,
-		&i.CommitteeName,
-		&i.DivisionID,
-		&i.DivisionName,
-		&i.PositionID,
-		&i.PositionName,
-		&i.HouseName,
-		&i.ContactNumber,
-		&i.College,
-		&i.Program,
-		&i.Interests,
-		&i.Discord,
-		&i.FbLink,
-		&i.Telegram,
-	)
-	return i, err
-}
-
-const getMemberRoles = `-- name: GetMemberRoles :many
-SELECT r.id, r.name, r.description, mr.granted_by, mr.granted_at
-FROM member_roles mr
-JOIN roles r ON mr.role_id = r.id
-WHERE mr.member_id = ?
-`
-
-type GetMemberRolesRow struct {
-	ID          string
-	Name        string
-	Description sql.NullString
-	GrantedBy   sql.NullInt32
-	GrantedAt   sql.NullTime
+	return items, nil
 }
 
-func (q *Queries) GetMemberRoles(ctx context.Context, memberID int32) ([]GetMemberRolesRow, error) {
-	rows, err := q.db.QueryContext(ctx, getMemberRoles, memberID)
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT id, endpoint_id, event_id, event_type, payload, status, attempts, next_attempt_at, locked_until, last_status_code, last_error, delivered_at, replay_of, created_at FROM webhook_deliveries WHERE endpoint_id = ? ORDER BY id DESC LIMIT ?
+`
+
+type ListWebhookDeliveriesParams struct {
+	EndpointID int32
+	Limit      int32
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookDeliveries, arg.EndpointID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetMemberRolesRow
+	var items []WebhookDelivery
 	for rows.Next() {
-		var i GetMemberRolesRow
+		var i WebhookDelivery
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
-			&i.Description,
-			&i.GrantedBy,
-			&i.GrantedAt,
+			&i.EndpointID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LockedUntil,
+			&i.LastStatusCode,
+			&i.LastError,
+			&i.DeliveredAt,
+			&i.ReplayOf,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -557,39 +6503,64 @@ func (q *Queries) GetMemberRoles(ctx context.Context, memberID int32) ([]GetMemb
 	return items, nil
 }
 
-const getMembersWithRole = `-- name: GetMembersWithRole :many
-SELECT m.id, m.email, m.full_name, m.position_id, m.committee_id, mr.granted_at
-FROM member_roles mr
-JOIN members m ON mr.member_id = m.id
-WHERE mr.role_id = ?
-ORDER BY mr.granted_at DESC
+const listWebhookDeliveryAttempts = `-- name: ListWebhookDeliveryAttempts :many
+SELECT id, delivery_id, attempt, status_code, error, duration_ms, attempted_at FROM webhook_delivery_attempts WHERE delivery_id = ? ORDER BY attempt
 `
 
-type GetMembersWithRoleRow struct {
-	ID          int32
-	Email       string
-	FullName    string
-	PositionID  sql.NullString
-	CommitteeID sql.NullString
-	GrantedAt   sql.NullTime
+func (q *Queries) ListWebhookDeliveryAttempts(ctx context.Context, deliveryID int32) ([]WebhookDeliveryAttempt, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookDeliveryAttempts, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookDeliveryAttempt
+	for rows.Next() {
+		var i WebhookDeliveryAttempt
+		if err := rows.Scan(
+			&i.ID,
+			&i.DeliveryID,
+			&i.Attempt,
+			&i.StatusCode,
+			&i.Error,
+			&i.DurationMs,
+			&i.AttemptedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) GetMembersWithRole(ctx context.Context, roleID string) ([]GetMembersWithRoleRow, error) {
-	rows, err := q.db.QueryContext(ctx, getMembersWithRole, roleID)
+const listWebhookEndpoints = `-- name: ListWebhookEndpoints :many
+
+SELECT id, url, description, secret, events, is_active, created_at FROM webhook_endpoints ORDER BY id
+`
+
+// Webhook queries
+func (q *Queries) ListWebhookEndpoints(ctx context.Context) ([]WebhookEndpoint, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookEndpoints)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetMembersWithRoleRow
+	var items []WebhookEndpoint
 	for rows.Next() {
-		var i GetMembersWithRoleRow
+		var i WebhookEndpoint
 		if err := rows.Scan(
 			&i.ID,
-			&i.Email,
-			&i.FullName,
-			&i.PositionID,
-			&i.CommitteeID,
-			&i.GrantedAt,
+			&i.Url,
+			&i.Description,
+			&i.Secret,
+			&i.Events,
+			&i.IsActive,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -604,229 +6575,218 @@ func (q *Queries) GetMembersWithRole(ctx context.Context, roleID string) ([]GetM
 	return items, nil
 }
 
-const getRoleById = `-- name: GetRoleById :one
-SELECT id, name, description FROM roles WHERE id = ?
+const markAllNotificationsRead = `-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications SET read_at = ? WHERE member_id = ? AND read_at IS NULL
 `
 
-func (q *Queries) GetRoleById(ctx context.Context, id string) (Role, error) {
-	row := q.db.QueryRowContext(ctx, getRoleById, id)
-	var i Role
-	err := row.Scan(&i.ID, &i.Name, &i.Description)
-	return i, err
+type MarkAllNotificationsReadParams struct {
+	ReadAt   sql.NullTime
+	MemberID int32
 }
 
-const getSession = `-- name: GetSession :one
-SELECT id, member_id, created_at, expires_at, last_activity, user_agent, ip_address
-FROM sessions WHERE id = ? AND expires_at > NOW()
+func (q *Queries) MarkAllNotificationsRead(ctx context.Context, arg MarkAllNotificationsReadParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markAllNotificationsRead, arg.ReadAt, arg.MemberID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const markNotificationRead = `-- name: MarkNotificationRead :execrows
+UPDATE notifications SET read_at = ? WHERE id = ? AND member_id = ? AND read_at IS NULL
 `
 
-func (q *Queries) GetSession(ctx context.Context, id string) (Session, error) {
-	row := q.db.QueryRowContext(ctx, getSession, id)
-	var i Session
-	err := row.Scan(
-		&i.ID,
-		&i.MemberID,
-		&i.CreatedAt,
-		&i.ExpiresAt,
-		&i.LastActivity,
-		&i.UserAgent,
-		&i.IpAddress,
-	)
-	return i, err
+type MarkNotificationReadParams struct {
+	ReadAt   sql.NullTime
+	ID       int32
+	MemberID int32
 }
 
-const getSessionWithMember = `-- name: GetSessionWithMember :one
-SELECT 
-    s.id, s.member_id, s.created_at, s.expires_at, s.last_activity, s.user_agent, s.ip_address,
-    m.email, m.full_name
-FROM sessions s
-JOIN members m ON s.member_id = m.id
-WHERE s.id = ? AND s.expires_at > NOW()
+func (q *Queries) MarkNotificationRead(ctx context.Context, arg MarkNotificationReadParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markNotificationRead, arg.ReadAt, arg.ID, arg.MemberID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const removeEventDocuHead = `-- name: RemoveEventDocuHead :execrows
+DELETE FROM event_docu_head WHERE event_id = ? AND member_id = ?
 `
 
-type GetSessionWithMemberRow struct {
-	ID           string
-	MemberID     int32
-	CreatedAt    sql.NullTime
-	ExpiresAt    time.Time
-	LastActivity sql.NullTime
-	UserAgent    sql.NullString
-	IpAddress    sql.NullString
-	Email        string
-	FullName     string
+type RemoveEventDocuHeadParams struct {
+	EventID  int32
+	MemberID int32
 }
 
-func (q *Queries) GetSessionWithMember(ctx context.Context, id string) (GetSessionWithMemberRow, error) {
-	row := q.db.QueryRowContext(ctx, getSessionWithMember, id)
-	var i GetSessionWithMemberRow
-	err := row.Scan(
-		&i.ID,
-		&i.MemberID,
-		&i.CreatedAt,
-		&i.ExpiresAt,
-		&i.LastActivity,
-		&i.UserAgent,
-		&i.IpAddress,
-		&i.Email,
-		&i.FullName,
-	)
-	return i, err
+func (q *Queries) RemoveEventDocuHead(ctx context.Context, arg RemoveEventDocuHeadParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, removeEventDocuHead, arg.EventID, arg.MemberID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
-const grantRole = `-- name: GrantRole :exec
-INSERT INTO member_roles (member_id, role_id, granted_by) VALUES (?, ?, ?)
+const removeEventHead = `-- name: RemoveEventHead :execrows
+DELETE FROM event_heads WHERE event_id = ? AND member_id = ?
 `
 
-type GrantRoleParams struct {
-	MemberID  int32
-	RoleID    string
-	GrantedBy sql.NullInt32
+type RemoveEventHeadParams struct {
+	EventID  int32
+	MemberID int32
 }
 
-func (q *Queries) GrantRole(ctx context.Context, arg GrantRoleParams) error {
-	_, err := q.db.ExecContext(ctx, grantRole, arg.MemberID, arg.RoleID, arg.GrantedBy)
-	return err
+func (q *Queries) RemoveEventHead(ctx context.Context, arg RemoveEventHeadParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, removeEventHead, arg.EventID, arg.MemberID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
-const hasRole = `-- name: HasRole :one
-SELECT EXISTS(SELECT 1 FROM member_roles WHERE member_id = ? AND role_id = ?)
+const removeFinProcess = `-- name: RemoveFinProcess :execrows
+DELETE FROM fin_processes WHERE id = ? AND tracker_id = ?
 `
 
-type HasRoleParams struct {
-	MemberID int32
-	RoleID   string
+type RemoveFinProcessParams struct {
+	ID        int32
+	TrackerID int32
 }
 
-func (q *Queries) HasRole(ctx context.Context, arg HasRoleParams) (bool, error) {
-	row := q.db.QueryRowContext(ctx, hasRole, arg.MemberID, arg.RoleID)
-	var exists bool
-	err := row.Scan(&exists)
-	return exists, err
+func (q *Queries) RemoveFinProcess(ctx context.Context, arg RemoveFinProcessParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, removeFinProcess, arg.ID, arg.TrackerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
-const isAdmin = `-- name: IsAdmin :one
-SELECT EXISTS(SELECT 1 FROM member_roles WHERE member_id = ? AND role_id = 'ADMIN')
+const requeueEventDriveFolders = `-- name: RequeueEventDriveFolders :exec
+UPDATE event_drive_folders
+SET status = 'PENDING', attempts = 0, next_attempt_at = ?, last_error = NULL
+WHERE event_id = ?
 `
 
-func (q *Queries) IsAdmin(ctx context.Context, memberID int32) (bool, error) {
-	row := q.db.QueryRowContext(ctx, isAdmin, memberID)
-	var exists bool
-	err := row.Scan(&exists)
-	return exists, err
+type RequeueEventDriveFoldersParams struct {
+	NextAttemptAt sql.NullTime
+	EventID       int32
 }
 
-const listAPIKeysByEmail = `-- name: ListAPIKeysByEmail :many
-SELECT api_key_id, member_email, project, allowed_origin, is_dev, is_admin, created_at, expires_at FROM api_keys WHERE member_email = ? ORDER BY created_at DESC
+func (q *Queries) RequeueEventDriveFolders(ctx context.Context, arg RequeueEventDriveFoldersParams) error {
+	_, err := q.db.ExecContext(ctx, requeueEventDriveFolders, arg.NextAttemptAt, arg.EventID)
+	return err
+}
+
+const reviewEventFile = `-- name: ReviewEventFile :execrows
+UPDATE event_files
+SET file_status = ?, reviewer_note = ?, reviewed_by = ?, reviewed_at = ?
+WHERE id = ? AND file_status = ?
 `
 
-type ListAPIKeysByEmailRow struct {
-	ApiKeyID      int32
-	MemberEmail   string
-	Project       sql.NullString
-	AllowedOrigin sql.NullString
-	IsDev         bool
-	IsAdmin       bool
-	CreatedAt     sql.NullTime
-	ExpiresAt     sql.NullTime
+type ReviewEventFileParams struct {
+	FileStatus   string
+	ReviewerNote sql.NullString
+	ReviewedBy   sql.NullInt32
+	ReviewedAt   sql.NullTime
+	ID           int32
+	FileStatus_2 string
 }
 
-func (q *Queries) ListAPIKeysByEmail(ctx context.Context, memberEmail string) ([]ListAPIKeysByEmailRow, error) {
-	rows, err := q.db.QueryContext(ctx, listAPIKeysByEmail, memberEmail)
+// compare-and-set, like UpdateEventFileStatus, also recording the reviewer's note
+func (q *Queries) ReviewEventFile(ctx context.Context, arg ReviewEventFileParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, reviewEventFile,
+		arg.FileStatus,
+		arg.ReviewerNote,
+		arg.ReviewedBy,
+		arg.ReviewedAt,
+		arg.ID,
+		arg.FileStatus_2,
+	)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []ListAPIKeysByEmailRow
-	for rows.Next() {
-		var i ListAPIKeysByEmailRow
-		if err := rows.Scan(
-			&i.ApiKeyID,
-			&i.MemberEmail,
-			&i.Project,
-			&i.AllowedOrigin,
-			&i.IsDev,
-			&i.IsAdmin,
-			&i.CreatedAt,
-			&i.ExpiresAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected()
 }
 
-const listMembers = `-- name: ListMembers :many
-SELECT
-    m.id,
-    m.full_name,
-    m.nickname,
-    m.email,
-    m.telegram,
-    m.position_id,
-    m.committee_id,
-    m.college,
-    m.program,
-    m.discord,
-    m.interests,
-    m.contact_number,
-    m.fb_link,
-    m.image_url,
-    h.name as house_name
-FROM members m
-LEFT JOIN houses h ON m.house_id = h.id
-ORDER BY m.email
+const revokeRole = `-- name: RevokeRole :exec
+DELETE FROM member_roles WHERE member_id = ? AND role_id = ?
 `
 
-type ListMembersRow struct {
-	ID            int32
-	FullName      string
-	Nickname      sql.NullString
-	Email         string
-	Telegram      sql.NullString
-	PositionID    sql.NullString
-	CommitteeID   sql.NullString
-	College       sql.NullString
-	Program       sql.NullString
-	Discord       sql.NullString
-	Interests     sql.NullString
-	ContactNumber sql.NullString
-	FbLink        sql.NullString
-	ImageUrl      sql.NullString
-	HouseName     sql.NullString
+type RevokeRoleParams struct {
+	MemberID int32
+	RoleID   string
+}
+
+func (q *Queries) RevokeRole(ctx context.Context, arg RevokeRoleParams) error {
+	_, err := q.db.ExecContext(ctx, revokeRole, arg.MemberID, arg.RoleID)
+	return err
+}
+
+const saveEventDriveFolderIDs = `-- name: SaveEventDriveFolderIDs :exec
+UPDATE event_drive_folders
+SET root_folder_id = ?, docu_folder_id = ?, fin_folder_id = ?, pub_folder_id = ?, folders = ?
+WHERE event_id = ?
+`
+
+type SaveEventDriveFolderIDsParams struct {
+	RootFolderID sql.NullString
+	DocuFolderID sql.NullString
+	FinFolderID  sql.NullString
+	PubFolderID  sql.NullString
+	Folders      sql.NullString
+	EventID      int32
+}
+
+func (q *Queries) SaveEventDriveFolderIDs(ctx context.Context, arg SaveEventDriveFolderIDsParams) error {
+	_, err := q.db.ExecContext(ctx, saveEventDriveFolderIDs,
+		arg.RootFolderID,
+		arg.DocuFolderID,
+		arg.FinFolderID,
+		arg.PubFolderID,
+		arg.Folders,
+		arg.EventID,
+	)
+	return err
+}
+
+const searchMembers = `-- name: SearchMembers :many
+SELECT m.id, m.full_name, m.nickname, m.position_id, m.committee_id
+FROM members m
+WHERE m.full_name LIKE ? OR m.nickname LIKE ?
+ORDER BY m.full_name
+LIMIT ?
+`
+
+type SearchMembersRow struct {
+	ID          int32
+	FullName    string
+	Nickname    sql.NullString
+	PositionID  sql.NullString
+	CommitteeID sql.NullString
 }
 
-func (q *Queries) ListMembers(ctx context.Context) ([]ListMembersRow, error) {
-	rows, err := q.db.QueryContext(ctx, listMembers)
+type SearchMembersParams struct {
+	FullName string
+	Nickname sql.NullString
+	Limit    int32
+}
+
+// members whose name or nickname contains the search term
+func (q *Queries) SearchMembers(ctx context.Context, arg SearchMembersParams) ([]SearchMembersRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchMembers, arg.FullName, arg.Nickname, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListMembersRow
+	var items []SearchMembersRow
 	for rows.Next() {
-		var i ListMembersRow
+		var i SearchMembersRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.FullName,
 			&i.Nickname,
-			&i.Email,
-			&i.Telegram,
 			&i.PositionID,
 			&i.CommitteeID,
-			&i.College,
-			&i.Program,
-			&i.Discord,
-			&i.Interests,
-			&i.ContactNumber,
-			&i.FbLink,
-			&i.ImageUrl,
-			&i.HouseName,
 		); err != nil {
 			return nil, err
 		}
@@ -841,17 +6801,75 @@ func (q *Queries) ListMembers(ctx context.Context) ([]ListMembersRow, error) {
 	return items, nil
 }
 
-const revokeRole = `-- name: RevokeRole :exec
-DELETE FROM member_roles WHERE member_id = ? AND role_id = ?
+const setActiveTerm = `-- name: SetActiveTerm :execrows
+UPDATE terms SET is_active = TRUE WHERE id = ?
 `
 
-type RevokeRoleParams struct {
-	MemberID int32
-	RoleID   string
+func (q *Queries) SetActiveTerm(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setActiveTerm, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
-func (q *Queries) RevokeRole(ctx context.Context, arg RevokeRoleParams) error {
-	_, err := q.db.ExecContext(ctx, revokeRole, arg.MemberID, arg.RoleID)
+const setCommitteeHead = `-- name: SetCommitteeHead :exec
+UPDATE committees SET committee_head = ? WHERE committee_id = ?
+`
+
+type SetCommitteeHeadParams struct {
+	CommitteeHead sql.NullInt32
+	CommitteeID   string
+}
+
+func (q *Queries) SetCommitteeHead(ctx context.Context, arg SetCommitteeHeadParams) error {
+	_, err := q.db.ExecContext(ctx, setCommitteeHead, arg.CommitteeHead, arg.CommitteeID)
+	return err
+}
+
+const setDivisionHead = `-- name: SetDivisionHead :exec
+UPDATE divisions SET division_head = ? WHERE division_id = ?
+`
+
+type SetDivisionHeadParams struct {
+	DivisionHead sql.NullInt32
+	DivisionID   string
+}
+
+func (q *Queries) SetDivisionHead(ctx context.Context, arg SetDivisionHeadParams) error {
+	_, err := q.db.ExecContext(ctx, setDivisionHead, arg.DivisionHead, arg.DivisionID)
+	return err
+}
+
+const setNotificationPreference = `-- name: SetNotificationPreference :exec
+INSERT INTO notification_preferences (member_id, type, email_enabled) VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE email_enabled = VALUES(email_enabled)
+`
+
+type SetNotificationPreferenceParams struct {
+	MemberID     int32
+	Type         string
+	EmailEnabled bool
+}
+
+func (q *Queries) SetNotificationPreference(ctx context.Context, arg SetNotificationPreferenceParams) error {
+	_, err := q.db.ExecContext(ctx, setNotificationPreference, arg.MemberID, arg.Type, arg.EmailEnabled)
+	return err
+}
+
+const setTermPositionRank = `-- name: SetTermPositionRank :exec
+INSERT INTO term_position_ranks (term_id, position_id, position_rank) VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE position_rank = VALUES(position_rank)
+`
+
+type SetTermPositionRankParams struct {
+	TermID       int32
+	PositionID   string
+	PositionRank int32
+}
+
+func (q *Queries) SetTermPositionRank(ctx context.Context, arg SetTermPositionRankParams) error {
+	_, err := q.db.ExecContext(ctx, setTermPositionRank, arg.TermID, arg.PositionID, arg.PositionRank)
 	return err
 }
 
@@ -892,6 +6910,280 @@ func (q *Queries) StoreAPIKey(ctx context.Context, arg StoreAPIKeyParams) error
 	return err
 }
 
+const touchCalendarFeedToken = `-- name: TouchCalendarFeedToken :exec
+UPDATE calendar_feed_tokens SET last_used_at = NOW() WHERE member_id = ?
+`
+
+func (q *Queries) TouchCalendarFeedToken(ctx context.Context, memberID int32) error {
+	_, err := q.db.ExecContext(ctx, touchCalendarFeedToken, memberID)
+	return err
+}
+
+const updateCommittee = `-- name: UpdateCommittee :exec
+UPDATE committees SET committee_name = ?, division_id = ? WHERE committee_id = ?
+`
+
+type UpdateCommitteeParams struct {
+	CommitteeName string
+	DivisionID    sql.NullString
+	CommitteeID   string
+}
+
+func (q *Queries) UpdateCommittee(ctx context.Context, arg UpdateCommitteeParams) error {
+	_, err := q.db.ExecContext(ctx, updateCommittee, arg.CommitteeName, arg.DivisionID, arg.CommitteeID)
+	return err
+}
+
+const updateDivision = `-- name: UpdateDivision :exec
+UPDATE divisions SET division_name = ? WHERE division_id = ?
+`
+
+type UpdateDivisionParams struct {
+	DivisionName string
+	DivisionID   string
+}
+
+func (q *Queries) UpdateDivision(ctx context.Context, arg UpdateDivisionParams) error {
+	_, err := q.db.ExecContext(ctx, updateDivision, arg.DivisionName, arg.DivisionID)
+	return err
+}
+
+const updateEvent = `-- name: UpdateEvent :exec
+UPDATE events SET
+    arn = ?,
+    name = ?,
+    committee_id = ?,
+    type = ?,
+    nature_id = ?,
+    term_id = ?,
+    duration_id = ?,
+    brief_description = ?,
+    goals = ?,
+    objectives = ?,
+    strategies = ?,
+    measures = ?,
+    budget_allocation = ?,
+    venue = ?,
+    docu_head = ?,
+    fin_head = ?
+WHERE id = ?
+`
+
+type UpdateEventParams struct {
+	Arn              string
+	Name             string
+	CommitteeID      string
+	Type             sql.NullString
+	NatureID         int32
+	TermID           int32
+	DurationID       sql.NullInt32
+	BriefDescription sql.NullString
+	Goals            sql.NullString
+	Objectives       sql.NullString
+	Strategies       sql.NullString
+	Measures         sql.NullString
+	BudgetAllocation sql.NullString
+	Venue            sql.NullString
+	DocuHead         sql.NullInt32
+	FinHead          sql.NullInt32
+	ID               int32
+}
+
+func (q *Queries) UpdateEvent(ctx context.Context, arg UpdateEventParams) error {
+	_, err := q.db.ExecContext(ctx, updateEvent,
+		arg.Arn,
+		arg.Name,
+		arg.CommitteeID,
+		arg.Type,
+		arg.NatureID,
+		arg.TermID,
+		arg.DurationID,
+		arg.BriefDescription,
+		arg.Goals,
+		arg.Objectives,
+		arg.Strategies,
+		arg.Measures,
+		arg.BudgetAllocation,
+		arg.Venue,
+		arg.DocuHead,
+		arg.FinHead,
+		arg.ID,
+	)
+	return err
+}
+
+const updateEventDocuHead = `-- name: UpdateEventDocuHead :exec
+UPDATE events SET docu_head = ? WHERE id = ?
+`
+
+type UpdateEventDocuHeadParams struct {
+	DocuHead sql.NullInt32
+	ID       int32
+}
+
+func (q *Queries) UpdateEventDocuHead(ctx context.Context, arg UpdateEventDocuHeadParams) error {
+	_, err := q.db.ExecContext(ctx, updateEventDocuHead, arg.DocuHead, arg.ID)
+	return err
+}
+
+const updateEventFileOrder = `-- name: UpdateEventFileOrder :exec
+UPDATE event_files SET file_order = ? WHERE id = ? AND event_id = ?
+`
+
+type UpdateEventFileOrderParams struct {
+	FileOrder int32
+	ID        int32
+	EventID   int32
+}
+
+func (q *Queries) UpdateEventFileOrder(ctx context.Context, arg UpdateEventFileOrderParams) error {
+	_, err := q.db.ExecContext(ctx, updateEventFileOrder, arg.FileOrder, arg.ID, arg.EventID)
+	return err
+}
+
+const updateEventFileStatus = `-- name: UpdateEventFileStatus :execrows
+UPDATE event_files SET file_status = ? WHERE id = ? AND file_status = ?
+`
+
+type UpdateEventFileStatusParams struct {
+	FileStatus   string
+	ID           int32
+	FileStatus_2 string
+}
+
+// compare-and-set: returns 0 if the status is no longer the expected one
+func (q *Queries) UpdateEventFileStatus(ctx context.Context, arg UpdateEventFileStatusParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateEventFileStatus, arg.FileStatus, arg.ID, arg.FileStatus_2)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateEventFileUpload = `-- name: UpdateEventFileUpload :exec
+UPDATE event_files
+SET file_key = ?, content_type = ?, uploaded_by = ?, uploaded_at = ?, file_status = ?
+WHERE id = ?
+`
+
+type UpdateEventFileUploadParams struct {
+	FileKey     sql.NullString
+	ContentType sql.NullString
+	UploadedBy  sql.NullInt32
+	UploadedAt  sql.NullTime
+	FileStatus  string
+	ID          int32
+}
+
+func (q *Queries) UpdateEventFileUpload(ctx context.Context, arg UpdateEventFileUploadParams) error {
+	_, err := q.db.ExecContext(ctx, updateEventFileUpload,
+		arg.FileKey,
+		arg.ContentType,
+		arg.UploadedBy,
+		arg.UploadedAt,
+		arg.FileStatus,
+		arg.ID,
+	)
+	return err
+}
+
+const updateEventFinHead = `-- name: UpdateEventFinHead :exec
+UPDATE events SET fin_head = ? WHERE id = ?
+`
+
+type UpdateEventFinHeadParams struct {
+	FinHead sql.NullInt32
+	ID      int32
+}
+
+func (q *Queries) UpdateEventFinHead(ctx context.Context, arg UpdateEventFinHeadParams) error {
+	_, err := q.db.ExecContext(ctx, updateEventFinHead, arg.FinHead, arg.ID)
+	return err
+}
+
+const updateEventTracker = `-- name: UpdateEventTracker :exec
+UPDATE event_trackers
+SET preacts_deadline = ?, postacts_deadline = ?, fin_preacts_deadline = ?, fin_postacts_deadline = ?,
+    docu_drive_id = ?, fin_drive_id = ?
+WHERE event_id = ?
+`
+
+type UpdateEventTrackerParams struct {
+	PreactsDeadline     sql.NullTime
+	PostactsDeadline    sql.NullTime
+	FinPreactsDeadline  sql.NullTime
+	FinPostactsDeadline sql.NullTime
+	DocuDriveID         sql.NullString
+	FinDriveID          sql.NullString
+	EventID             int32
+}
+
+func (q *Queries) UpdateEventTracker(ctx context.Context, arg UpdateEventTrackerParams) error {
+	_, err := q.db.ExecContext(ctx, updateEventTracker,
+		arg.PreactsDeadline,
+		arg.PostactsDeadline,
+		arg.FinPreactsDeadline,
+		arg.FinPostactsDeadline,
+		arg.DocuDriveID,
+		arg.FinDriveID,
+		arg.EventID,
+	)
+	return err
+}
+
+const updateFinPostactsStatus = `-- name: UpdateFinPostactsStatus :execrows
+UPDATE event_trackers SET fin_postacts_status = ? WHERE event_id = ? AND fin_postacts_status = ?
+`
+
+type UpdateFinPostactsStatusParams struct {
+	FinPostactsStatus   sql.NullString
+	EventID             int32
+	FinPostactsStatus_2 sql.NullString
+}
+
+// only applies if the status has not changed since it was read
+func (q *Queries) UpdateFinPostactsStatus(ctx context.Context, arg UpdateFinPostactsStatusParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateFinPostactsStatus, arg.FinPostactsStatus, arg.EventID, arg.FinPostactsStatus_2)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateFinPreactsStatus = `-- name: UpdateFinPreactsStatus :execrows
+UPDATE event_trackers SET fin_preacts_status = ? WHERE event_id = ? AND fin_preacts_status = ?
+`
+
+type UpdateFinPreactsStatusParams struct {
+	FinPreactsStatus   sql.NullString
+	EventID            int32
+	FinPreactsStatus_2 sql.NullString
+}
+
+// only applies if the status has not changed since it was read
+func (q *Queries) UpdateFinPreactsStatus(ctx context.Context, arg UpdateFinPreactsStatusParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateFinPreactsStatus, arg.FinPreactsStatus, arg.EventID, arg.FinPreactsStatus_2)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateHouse = `-- name: UpdateHouse :exec
+UPDATE houses SET name = ?, description = ? WHERE id = ?
+`
+
+type UpdateHouseParams struct {
+	Name        sql.NullString
+	Description sql.NullString
+	ID          int32
+}
+
+func (q *Queries) UpdateHouse(ctx context.Context, arg UpdateHouseParams) error {
+	_, err := q.db.ExecContext(ctx, updateHouse, arg.Name, arg.Description, arg.ID)
+	return err
+}
+
 const updateMemberById = `-- name: UpdateMemberById :exec
 UPDATE members SET
     full_name = ?,
@@ -989,6 +7281,157 @@ func (q *Queries) UpdateMemberSelf(ctx context.Context, arg UpdateMemberSelfPara
 	return err
 }
 
+const updatePendingEventParticipant = `-- name: UpdatePendingEventParticipant :execrows
+UPDATE event_participants
+SET student_name = ?, student_email = ?, notes = ?, registered_at = CURRENT_TIMESTAMP
+WHERE event_id = ? AND student_id = ? AND verified_at IS NULL
+`
+
+type UpdatePendingEventParticipantParams struct {
+	StudentName  sql.NullString
+	StudentEmail sql.NullString
+	Notes        sql.NullString
+	EventID      int32
+	StudentID    int32
+}
+
+// a public registration replaces an earlier one whose email was never verified
+func (q *Queries) UpdatePendingEventParticipant(ctx context.Context, arg UpdatePendingEventParticipantParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updatePendingEventParticipant,
+		arg.StudentName,
+		arg.StudentEmail,
+		arg.Notes,
+		arg.EventID,
+		arg.StudentID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updatePosition = `-- name: UpdatePosition :exec
+UPDATE positions SET position_name = ?, position_rank = ? WHERE position_id = ?
+`
+
+type UpdatePositionParams struct {
+	PositionName string
+	PositionRank int32
+	PositionID   string
+}
+
+func (q *Queries) UpdatePosition(ctx context.Context, arg UpdatePositionParams) error {
+	_, err := q.db.ExecContext(ctx, updatePosition, arg.PositionName, arg.PositionRank, arg.PositionID)
+	return err
+}
+
+const updatePostactsStatus = `-- name: UpdatePostactsStatus :execrows
+UPDATE event_trackers SET postacts_status = ? WHERE event_id = ? AND postacts_status = ?
+`
+
+type UpdatePostactsStatusParams struct {
+	PostactsStatus   string
+	EventID          int32
+	PostactsStatus_2 string
+}
+
+// only applies if the status has not changed since it was read
+func (q *Queries) UpdatePostactsStatus(ctx context.Context, arg UpdatePostactsStatusParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updatePostactsStatus, arg.PostactsStatus, arg.EventID, arg.PostactsStatus_2)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updatePreactsStatus = `-- name: UpdatePreactsStatus :execrows
+UPDATE event_trackers SET preacts_status = ? WHERE event_id = ? AND preacts_status = ?
+`
+
+type UpdatePreactsStatusParams struct {
+	PreactsStatus   string
+	EventID         int32
+	PreactsStatus_2 string
+}
+
+// only applies if the status has not changed since it was read
+func (q *Queries) UpdatePreactsStatus(ctx context.Context, arg UpdatePreactsStatusParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updatePreactsStatus, arg.PreactsStatus, arg.EventID, arg.PreactsStatus_2)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updatePubRequest = `-- name: UpdatePubRequest :exec
+UPDATE pub_requests
+SET pub_type = ?, pub_drive_id = ?, posting_date = ?, pub_details = ?, pub_content = ?,
+    caption = ?, opa_numbers = ?, for_posting = ?, dimensions = ?
+WHERE id = ?
+`
+
+type UpdatePubRequestParams struct {
+	PubType     sql.NullString
+	PubDriveID  string
+	PostingDate time.Time
+	PubDetails  string
+	PubContent  string
+	Caption     string
+	OpaNumbers  string
+	ForPosting  bool
+	Dimensions  sql.NullString
+	ID          int32
+}
+
+func (q *Queries) UpdatePubRequest(ctx context.Context, arg UpdatePubRequestParams) error {
+	_, err := q.db.ExecContext(ctx, updatePubRequest,
+		arg.PubType,
+		arg.PubDriveID,
+		arg.PostingDate,
+		arg.PubDetails,
+		arg.PubContent,
+		arg.Caption,
+		arg.OpaNumbers,
+		arg.ForPosting,
+		arg.Dimensions,
+		arg.ID,
+	)
+	return err
+}
+
+const updatePubRequestHead = `-- name: UpdatePubRequestHead :exec
+UPDATE pub_requests SET pub_head = ? WHERE id = ?
+`
+
+type UpdatePubRequestHeadParams struct {
+	PubHead sql.NullInt32
+	ID      int32
+}
+
+func (q *Queries) UpdatePubRequestHead(ctx context.Context, arg UpdatePubRequestHeadParams) error {
+	_, err := q.db.ExecContext(ctx, updatePubRequestHead, arg.PubHead, arg.ID)
+	return err
+}
+
+const updatePubRequestStatus = `-- name: UpdatePubRequestStatus :execrows
+UPDATE pub_requests SET pub_status = ? WHERE id = ? AND pub_status = ?
+`
+
+type UpdatePubRequestStatusParams struct {
+	PubStatus   sql.NullString
+	ID          int32
+	PubStatus_2 sql.NullString
+}
+
+// compare-and-set: returns 0 if the status is no longer the expected one
+func (q *Queries) UpdatePubRequestStatus(ctx context.Context, arg UpdatePubRequestStatusParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updatePubRequestStatus, arg.PubStatus, arg.ID, arg.PubStatus_2)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateSessionActivity = `-- name: UpdateSessionActivity :exec
 UPDATE sessions SET last_activity = NOW() WHERE id = ?
 `
@@ -997,3 +7440,79 @@ func (q *Queries) UpdateSessionActivity(ctx context.Context, id string) error {
 	_, err := q.db.ExecContext(ctx, updateSessionActivity, id)
 	return err
 }
+
+const updateSessionAuthTime = `-- name: UpdateSessionAuthTime :exec
+UPDATE sessions SET auth_time = NOW() WHERE id = ?
+`
+
+func (q *Queries) UpdateSessionAuthTime(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, updateSessionAuthTime, id)
+	return err
+}
+
+const updateTerm = `-- name: UpdateTerm :exec
+UPDATE terms SET term = ?, start_year = ?, end_year = ?, start_date = ?, end_date = ? WHERE id = ?
+`
+
+type UpdateTermParams struct {
+	Term      int32
+	StartYear int32
+	EndYear   int32
+	StartDate sql.NullTime
+	EndDate   sql.NullTime
+	ID        int32
+}
+
+func (q *Queries) UpdateTerm(ctx context.Context, arg UpdateTermParams) error {
+	_, err := q.db.ExecContext(ctx, updateTerm,
+		arg.Term,
+		arg.StartYear,
+		arg.EndYear,
+		arg.StartDate,
+		arg.EndDate,
+		arg.ID,
+	)
+	return err
+}
+
+const updateWebhookEndpoint = `-- name: UpdateWebhookEndpoint :exec
+UPDATE webhook_endpoints SET url = ?, description = ?, events = ?, is_active = ? WHERE id = ?
+`
+
+type UpdateWebhookEndpointParams struct {
+	Url         string
+	Description sql.NullString
+	Events      string
+	IsActive    bool
+	ID          int32
+}
+
+func (q *Queries) UpdateWebhookEndpoint(ctx context.Context, arg UpdateWebhookEndpointParams) error {
+	_, err := q.db.ExecContext(ctx, updateWebhookEndpoint,
+		arg.Url,
+		arg.Description,
+		arg.Events,
+		arg.IsActive,
+		arg.ID,
+	)
+	return err
+}
+
+const verifyEventParticipant = `-- name: VerifyEventParticipant :execrows
+UPDATE event_participants SET verified_at = NOW()
+WHERE event_id = ? AND student_id = ? AND student_email = ? AND verified_at IS NULL
+`
+
+type VerifyEventParticipantParams struct {
+	EventID      int32
+	StudentID    int32
+	StudentEmail sql.NullString
+}
+
+func (q *Queries) VerifyEventParticipant(ctx context.Context, arg VerifyEventParticipantParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, verifyEventParticipant, arg.EventID, arg.StudentID, arg.StudentEmail)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,90 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/pkg/corev1"
+)
+
+// Authenticator validates API keys (middlewares.APIKeyAuthenticator)
+type Authenticator interface {
+	AuthenticateToken(ctx context.Context, token string) (*auth.Principal, error)
+}
+
+// serviceScopes are the scopes each service needs, the same as the REST endpoints it mirrors.
+// Services not listed here are denied.
+var serviceScopes = map[string]string{
+	corev1.MemberService_ServiceDesc.ServiceName:    auth.ScopeMembersRead,
+	corev1.CommitteeService_ServiceDesc.ServiceName: auth.ScopeCommitteesRead,
+}
+
+// authInterceptor authenticates calls with the API key in their "authorization" metadata
+// and applies the checks of the REST API routes (RequireAPIAccess and RequireScope)
+type authInterceptor struct {
+	authenticator Authenticator
+	rbacService   *auth.RBACService
+}
+
+func (a *authInterceptor) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := a.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authInterceptor) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (a *authInterceptor) authorize(ctx context.Context, fullMethod string) error {
+	token, ok := bearerToken(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing API key")
+	}
+	principal, err := a.authenticator.AuthenticateToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, auth.ErrNoCredentials) {
+			return status.Error(codes.Unauthenticated, "invalid API key")
+		}
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if !a.rbacService.CanAccessAPIByEmail(ctx, principal.Email) {
+		return status.Error(codes.PermissionDenied, "insufficient privileges")
+	}
+
+	// fullMethod is "/package.Service/Method"
+	service, _, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	scope, ok := serviceScopes[service]
+	if !ok || !principal.HasScope(scope) {
+		log.Warn().Int32("key_id", principal.KeyID).Str("method", fullMethod).Msg("gRPC call denied: missing scope")
+		return status.Errorf(codes.PermissionDenied, "requires the %s scope", scope)
+	}
+	return nil
+}
+
+// bearerToken returns the token of the "authorization: Bearer <token>" metadata
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", false
+	}
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	return token, ok && token != ""
+}
@@ -0,0 +1,39 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+	"github.com/dlsu-lscs/lscs-core-api/pkg/corev1"
+)
+
+// committeeServer implements corev1.CommitteeServiceServer
+type committeeServer struct {
+	corev1.UnimplementedCommitteeServiceServer
+	dbService database.Service
+}
+
+// ListCommittees returns all committees with their heads
+func (s *committeeServer) ListCommittees(ctx context.Context, _ *corev1.ListCommitteesRequest) (*corev1.ListCommitteesResponse, error) {
+	q := repository.New(s.dbService.GetConnection())
+	rows, err := q.ListCommitteesWithHeads(ctx)
+	if err != nil {
+		return nil, internalError(err, "failed to list committees")
+	}
+
+	committees := make([]*corev1.Committee, len(rows))
+	for i, row := range rows {
+		committee := &corev1.Committee{
+			CommitteeId:   row.CommitteeID,
+			CommitteeName: row.CommitteeName,
+			HeadName:      nullString(row.HeadName),
+			DivisionId:    nullString(row.DivisionID),
+		}
+		if row.CommitteeHead.Valid {
+			committee.CommitteeHead = &row.CommitteeHead.Int32
+		}
+		committees[i] = committee
+	}
+	return &corev1.ListCommitteesResponse{Committees: committees}, nil
+}
@@ -0,0 +1,161 @@
+package rpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+	"github.com/dlsu-lscs/lscs-core-api/pkg/corev1"
+)
+
+// maxMembershipQueries is how many emails and IDs CheckMembership takes at once
+const maxMembershipQueries = 1000
+
+// memberServer implements corev1.MemberServiceServer
+type memberServer struct {
+	corev1.UnimplementedMemberServiceServer
+	dbService database.Service
+}
+
+// GetMember returns a member's profile by email or ID
+func (s *memberServer) GetMember(ctx context.Context, req *corev1.GetMemberRequest) (*corev1.Member, error) {
+	q := repository.New(s.dbService.GetConnection())
+
+	var row repository.GetMemberInfoRow
+	var err error
+	switch lookup := req.GetLookup().(type) {
+	case *corev1.GetMemberRequest_Email:
+		row, err = q.GetMemberInfo(ctx, lookup.Email)
+	case *corev1.GetMemberRequest_Id:
+		var byID repository.GetMemberInfoByIdRow
+		byID, err = q.GetMemberInfoById(ctx, lookup.Id)
+		row = repository.GetMemberInfoRow(byID)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "email or id is required")
+	}
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "not an LSCS member")
+		}
+		return nil, internalError(err, "failed to get member info")
+	}
+	return toMember(row), nil
+}
+
+// CheckMembership checks a batch of emails and IDs with at most one query for each
+func (s *memberServer) CheckMembership(ctx context.Context, req *corev1.CheckMembershipRequest) (*corev1.CheckMembershipResponse, error) {
+	queries := req.GetQueries()
+	if len(queries) > maxMembershipQueries {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d queries are allowed", maxMembershipQueries)
+	}
+
+	var emails []string
+	var ids []int32
+	for i, query := range queries {
+		switch lookup := query.GetLookup().(type) {
+		case *corev1.MembershipQuery_Email:
+			emails = append(emails, lookup.Email)
+		case *corev1.MembershipQuery_Id:
+			ids = append(ids, lookup.Id)
+		default:
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("query %d has no email or id", i))
+		}
+	}
+
+	q := repository.New(s.dbService.GetConnection())
+	// emails are compared case-insensitively, like the database does
+	memberEmails := map[string]bool{}
+	if len(emails) > 0 {
+		found, err := q.ListMemberEmails(ctx, emails)
+		if err != nil {
+			return nil, internalError(err, "failed to check member emails")
+		}
+		for _, email := range found {
+			memberEmails[strings.ToLower(email)] = true
+		}
+	}
+	memberIDs := map[int32]bool{}
+	if len(ids) > 0 {
+		found, err := q.ListMemberIDs(ctx, ids)
+		if err != nil {
+			return nil, internalError(err, "failed to check member IDs")
+		}
+		for _, id := range found {
+			memberIDs[id] = true
+		}
+	}
+
+	results := make([]*corev1.MembershipResult, len(queries))
+	for i, query := range queries {
+		isMember := memberIDs[query.GetId()]
+		if _, ok := query.GetLookup().(*corev1.MembershipQuery_Email); ok {
+			isMember = memberEmails[strings.ToLower(query.GetEmail())]
+		}
+		results[i] = &corev1.MembershipResult{Query: query, IsMember: isMember}
+	}
+	return &corev1.CheckMembershipResponse{Results: results}, nil
+}
+
+// StreamMembership checks each query as it arrives
+func (s *memberServer) StreamMembership(stream grpc.BidiStreamingServer[corev1.MembershipQuery, corev1.MembershipResult]) error {
+	ctx := stream.Context()
+	q := repository.New(s.dbService.GetConnection())
+	for {
+		query, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch lookup := query.GetLookup().(type) {
+		case *corev1.MembershipQuery_Email:
+			_, err = q.CheckEmailIfMember(ctx, lookup.Email)
+		case *corev1.MembershipQuery_Id:
+			_, err = q.CheckIdIfMember(ctx, lookup.Id)
+		default:
+			return status.Error(codes.InvalidArgument, "email or id is required")
+		}
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return internalError(err, "failed to check membership")
+		}
+
+		if err := stream.Send(&corev1.MembershipResult{Query: query, IsMember: err == nil}); err != nil {
+			return err
+		}
+	}
+}
+
+func toMember(m repository.GetMemberInfoRow) *corev1.Member {
+	return &corev1.Member{
+		Id:            m.ID,
+		Email:         m.Email,
+		FullName:      m.FullName,
+		Nickname:      nullString(m.Nickname),
+		ImageUrl:      nullString(m.ImageUrl),
+		CommitteeId:   nullString(m.CommitteeID),
+		CommitteeName: nullString(m.CommitteeName),
+		DivisionId:    nullString(m.DivisionID),
+		DivisionName:  nullString(m.DivisionName),
+		PositionId:    nullString(m.PositionID),
+		PositionName:  nullString(m.PositionName),
+		HouseName:     nullString(m.HouseName),
+		ContactNumber: nullString(m.ContactNumber),
+		College:       nullString(m.College),
+		Program:       nullString(m.Program),
+		Interests:     nullString(m.Interests),
+		Discord:       nullString(m.Discord),
+		FbLink:        nullString(m.FbLink),
+		Telegram:      nullString(m.Telegram),
+	}
+}
@@ -0,0 +1,40 @@
+package rpc
+
+import (
+	"database/sql"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/pkg/corev1"
+)
+
+// NewServer creates the gRPC server for internal services,
+// with the member and committee services registered
+func NewServer(authenticator Authenticator, dbService database.Service, rbacService *auth.RBACService) *grpc.Server {
+	interceptor := &authInterceptor{authenticator: authenticator, rbacService: rbacService}
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptor.unary),
+		grpc.ChainStreamInterceptor(interceptor.stream),
+	)
+	corev1.RegisterMemberServiceServer(s, &memberServer{dbService: dbService})
+	corev1.RegisterCommitteeServiceServer(s, &committeeServer{dbService: dbService})
+	return s
+}
+
+// internalError logs an error and returns a status that hides it from the client
+func internalError(err error, msg string) error {
+	log.Error().Err(err).Msg(msg)
+	return status.Error(codes.Internal, "internal server error")
+}
+
+func nullString(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
@@ -0,0 +1,302 @@
+package rpc
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/pkg/corev1"
+)
+
+// mockDBService is a mock implementation of the database.Service interface.
+type mockDBService struct {
+	db *sql.DB
+}
+
+func (m *mockDBService) Health() map[string]string {
+	return nil
+}
+
+func (m *mockDBService) Close() error {
+	return nil
+}
+
+func (m *mockDBService) GetConnection() *sql.DB {
+	return m.db
+}
+
+// mockAuthenticator accepts a single API key
+type mockAuthenticator struct {
+	token     string
+	principal *auth.Principal
+}
+
+func (m *mockAuthenticator) AuthenticateToken(_ context.Context, token string) (*auth.Principal, error) {
+	if token != m.token {
+		return nil, auth.ErrNoCredentials
+	}
+	return m.principal, nil
+}
+
+const testEmail = "juan_delacruz@dlsu.edu.ph"
+
+// newTestClient starts the gRPC server on an in-memory listener
+func newTestClient(t *testing.T, db *sql.DB, scopes []string) *grpc.ClientConn {
+	t.Helper()
+	authenticator := &mockAuthenticator{
+		token:     "test-key",
+		principal: &auth.Principal{MemberID: 12312345, Email: testEmail, Method: auth.AuthMethodAPIKey, KeyID: 1, Scopes: scopes},
+	}
+	dbService := &mockDBService{db: db}
+	s := NewServer(authenticator, dbService, auth.NewRBACService(dbService))
+
+	lis := bufconn.Listen(1024 * 1024)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func withKey(ctx context.Context, key string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+key)
+}
+
+// expectAPIAccess mocks the RBAC check of the key's owner (an RND member)
+func expectAPIAccess(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("SELECT id, position_id, committee_id FROM members").
+		WithArgs(testEmail).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "position_id", "committee_id"}).AddRow(12312345, "MEM", "RND"))
+}
+
+func TestAuth(t *testing.T) {
+	t.Run("missing API key", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		client := corev1.NewCommitteeServiceClient(newTestClient(t, db, nil))
+		_, err = client.ListCommittees(t.Context(), &corev1.ListCommitteesRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Equal(t, "missing API key", status.Convert(err).Message())
+	})
+
+	t.Run("invalid API key", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		client := corev1.NewCommitteeServiceClient(newTestClient(t, db, nil))
+		_, err = client.ListCommittees(withKey(t.Context(), "wrong-key"), &corev1.ListCommitteesRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Equal(t, "invalid API key", status.Convert(err).Message())
+	})
+
+	t.Run("owner without API access", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT id, position_id, committee_id FROM members").
+			WithArgs(testEmail).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "position_id", "committee_id"}).AddRow(12312345, "MEM", "EXT"))
+
+		client := corev1.NewCommitteeServiceClient(newTestClient(t, db, nil))
+		_, err = client.ListCommittees(withKey(t.Context(), "test-key"), &corev1.ListCommitteesRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("missing scope", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectAPIAccess(mock)
+
+		client := corev1.NewMemberServiceClient(newTestClient(t, db, []string{auth.ScopeCommitteesRead}))
+		_, err = client.GetMember(withKey(t.Context(), "test-key"), &corev1.GetMemberRequest{Lookup: &corev1.GetMemberRequest_Id{Id: 12312345}})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, "requires the members:read scope", status.Convert(err).Message())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGetMember(t *testing.T) {
+	columns := []string{
+		"id", "email", "full_name", "nickname", "image_url",
+		"committee_id", "committee_name",
+		"division_id", "division_name",
+		"position_id", "position_name",
+		"house_name",
+		"contact_number", "college", "program",
+		"interests", "discord", "fb_link", "telegram",
+	}
+
+	t.Run("found by email", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectAPIAccess(mock)
+		mock.ExpectQuery("SELECT").
+			WithArgs(testEmail).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(
+				12312345, testEmail, "Juan Dela Cruz", "Juan", nil,
+				"RND", "Research and Development",
+				"INT", "Internals",
+				"MEM", "Member",
+				nil,
+				nil, "CCS", "BSCS",
+				nil, nil, nil, nil,
+			))
+
+		client := corev1.NewMemberServiceClient(newTestClient(t, db, []string{auth.ScopeMembersRead}))
+		member, err := client.GetMember(withKey(t.Context(), "test-key"), &corev1.GetMemberRequest{Lookup: &corev1.GetMemberRequest_Email{Email: testEmail}})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(12312345), member.GetId())
+		assert.Equal(t, "Juan Dela Cruz", member.GetFullName())
+		assert.Equal(t, "Juan", member.GetNickname())
+		assert.Equal(t, "RND", member.GetCommitteeId())
+		assert.Nil(t, member.ImageUrl)
+		assert.Nil(t, member.HouseName)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectAPIAccess(mock)
+		mock.ExpectQuery("SELECT").
+			WithArgs(99999999).
+			WillReturnError(sql.ErrNoRows)
+
+		client := corev1.NewMemberServiceClient(newTestClient(t, db, []string{auth.ScopeMembersRead}))
+		_, err = client.GetMember(withKey(t.Context(), "test-key"), &corev1.GetMemberRequest{Lookup: &corev1.GetMemberRequest_Id{Id: 99999999}})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no lookup", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectAPIAccess(mock)
+
+		client := corev1.NewMemberServiceClient(newTestClient(t, db, []string{auth.ScopeMembersRead}))
+		_, err = client.GetMember(withKey(t.Context(), "test-key"), &corev1.GetMemberRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestCheckMembership(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	expectAPIAccess(mock)
+	mock.ExpectQuery("SELECT email FROM members WHERE email IN").
+		WithArgs("Juan_DelaCruz@dlsu.edu.ph", "nobody@dlsu.edu.ph").
+		WillReturnRows(sqlmock.NewRows([]string{"email"}).AddRow(testEmail))
+	mock.ExpectQuery("SELECT id FROM members WHERE id IN").
+		WithArgs(12312346, 99999999).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12312346))
+
+	client := corev1.NewMemberServiceClient(newTestClient(t, db, []string{auth.ScopeMembersRead}))
+	res, err := client.CheckMembership(withKey(t.Context(), "test-key"), &corev1.CheckMembershipRequest{
+		Queries: []*corev1.MembershipQuery{
+			{Lookup: &corev1.MembershipQuery_Id{Id: 12312346}},
+			{Lookup: &corev1.MembershipQuery_Email{Email: "Juan_DelaCruz@dlsu.edu.ph"}},
+			{Lookup: &corev1.MembershipQuery_Id{Id: 99999999}},
+			{Lookup: &corev1.MembershipQuery_Email{Email: "nobody@dlsu.edu.ph"}},
+		},
+	})
+	assert.NoError(t, err)
+
+	results := res.GetResults()
+	assert.Len(t, results, 4)
+	assert.Equal(t, int32(12312346), results[0].GetQuery().GetId())
+	assert.True(t, results[0].GetIsMember())
+	assert.Equal(t, "Juan_DelaCruz@dlsu.edu.ph", results[1].GetQuery().GetEmail())
+	assert.True(t, results[1].GetIsMember())
+	assert.False(t, results[2].GetIsMember())
+	assert.False(t, results[3].GetIsMember())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStreamMembership(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	expectAPIAccess(mock)
+	mock.ExpectQuery("SELECT email FROM members WHERE email = ?").
+		WithArgs(testEmail).
+		WillReturnRows(sqlmock.NewRows([]string{"email"}).AddRow(testEmail))
+	mock.ExpectQuery("SELECT id FROM members WHERE id = ?").
+		WithArgs(99999999).
+		WillReturnError(sql.ErrNoRows)
+
+	client := corev1.NewMemberServiceClient(newTestClient(t, db, []string{auth.ScopeMembersRead}))
+	stream, err := client.StreamMembership(withKey(t.Context(), "test-key"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, stream.Send(&corev1.MembershipQuery{Lookup: &corev1.MembershipQuery_Email{Email: testEmail}}))
+	result, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.True(t, result.GetIsMember())
+
+	assert.NoError(t, stream.Send(&corev1.MembershipQuery{Lookup: &corev1.MembershipQuery_Id{Id: 99999999}}))
+	result, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(99999999), result.GetQuery().GetId())
+	assert.False(t, result.GetIsMember())
+
+	assert.NoError(t, stream.CloseSend())
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListCommittees(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	expectAPIAccess(mock)
+	mock.ExpectQuery("SELECT c.committee_id, c.committee_name").
+		WillReturnRows(sqlmock.NewRows([]string{"committee_id", "committee_name", "committee_head", "division_id", "head_name"}).
+			AddRow("EXT", "External Affairs", nil, "EXT", nil).
+			AddRow("RND", "Research and Development", 12312345, "INT", "Juan Dela Cruz"))
+
+	client := corev1.NewCommitteeServiceClient(newTestClient(t, db, []string{auth.ScopeCommitteesRead}))
+	res, err := client.ListCommittees(withKey(t.Context(), "test-key"), &corev1.ListCommitteesRequest{})
+	assert.NoError(t, err)
+
+	committees := res.GetCommittees()
+	assert.Len(t, committees, 2)
+	assert.Nil(t, committees[0].CommitteeHead)
+	assert.Equal(t, int32(12312345), committees[1].GetCommitteeHead())
+	assert.Equal(t, "Juan Dela Cruz", committees[1].GetHeadName())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,103 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+const (
+	// pollInterval is how often a job checks whether it is due
+	pollInterval = time.Minute
+	// defaultLockTTL is how long a run may take before another instance may take over
+	defaultLockTTL = 10 * time.Minute
+)
+
+// Job is a periodic background task.
+// Unlike a plain ticker, its last run is persisted in scheduled_jobs: a restart does not run it
+// again before its interval has passed, and only one instance runs it at a time.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	LockTTL  time.Duration // defaults to 10 minutes
+	Run      func(ctx context.Context) error
+}
+
+// Start starts a background goroutine that runs the job whenever it is due
+func Start(ctx context.Context, dbService database.Service, job Job) {
+	go func() {
+		q := repository.New(dbService.GetConnection())
+		if err := q.CreateScheduledJob(ctx, job.Name); err != nil {
+			log.Error().Err(err).Str("job", job.Name).Msg("failed to register scheduled job")
+		}
+
+		poll := pollInterval
+		if job.Interval < poll {
+			poll = job.Interval
+		}
+		ticker := time.NewTicker(poll)
+		defer ticker.Stop()
+
+		for {
+			if _, err := RunIfDue(ctx, dbService, job, time.Now()); err != nil {
+				log.Error().Err(err).Str("job", job.Name).Msg("scheduled job failed")
+			}
+
+			select {
+			case <-ctx.Done():
+				log.Info().Str("job", job.Name).Msg("scheduled job stopped")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// RunIfDue runs the job if its last run is at least one interval old and no other instance is running it.
+// It returns whether the job ran.
+func RunIfDue(ctx context.Context, dbService database.Service, job Job, now time.Time) (bool, error) {
+	q := repository.New(dbService.GetConnection())
+
+	lockTTL := job.LockTTL
+	if lockTTL == 0 {
+		lockTTL = defaultLockTTL
+	}
+
+	acquired, err := q.AcquireScheduledJob(ctx, repository.AcquireScheduledJobParams{
+		LockedUntil:   sql.NullTime{Time: now.Add(lockTTL), Valid: true},
+		Name:          job.Name,
+		LockedUntil_2: sql.NullTime{Time: now, Valid: true},
+		LastRunAt:     sql.NullTime{Time: now.Add(-job.Interval), Valid: true},
+	})
+	if err != nil {
+		return false, err
+	}
+	if acquired == 0 {
+		return false, nil
+	}
+
+	runErr := job.Run(ctx)
+
+	finish := repository.FinishScheduledJobParams{
+		LastRunAt:  sql.NullTime{Time: now, Valid: true},
+		LastStatus: sql.NullString{String: "ok", Valid: true},
+		Name:       job.Name,
+	}
+	if runErr != nil {
+		finish.LastStatus.String = "error"
+		finish.LastError = sql.NullString{String: runErr.Error(), Valid: true}
+	}
+	if err := q.FinishScheduledJob(ctx, finish); err != nil {
+		log.Error().Err(err).Str("job", job.Name).Msg("failed to record scheduled job run")
+	}
+
+	if runErr == nil {
+		log.Debug().Str("job", job.Name).Msg("scheduled job completed")
+	}
+	return true, runErr
+}
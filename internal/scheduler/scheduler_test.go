@@ -0,0 +1,98 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockDBService is a mock implementation of the database.Service interface.
+type mockDBService struct {
+	db *sql.DB
+}
+
+func (m *mockDBService) Health() map[string]string {
+	return nil
+}
+
+func (m *mockDBService) Close() error {
+	return nil
+}
+
+func (m *mockDBService) GetConnection() *sql.DB {
+	return m.db
+}
+
+func TestRunIfDue(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	t.Run("runs the job when the lock is acquired", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		runs := 0
+		job := Job{Name: "test", Interval: time.Hour, Run: func(ctx context.Context) error {
+			runs++
+			return nil
+		}}
+
+		mock.ExpectExec("UPDATE scheduled_jobs SET locked_until").
+			WithArgs(now.Add(defaultLockTTL), "test", now, now.Add(-time.Hour)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE scheduled_jobs").
+			WithArgs(now, "ok", nil, "test").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		ran, err := RunIfDue(context.Background(), &mockDBService{db: db}, job, now)
+		assert.NoError(t, err)
+		assert.True(t, ran)
+		assert.Equal(t, 1, runs)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("skips the job when it is not due or locked", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		job := Job{Name: "test", Interval: time.Hour, Run: func(ctx context.Context) error {
+			t.Fatal("job should not run")
+			return nil
+		}}
+
+		mock.ExpectExec("UPDATE scheduled_jobs SET locked_until").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		ran, err := RunIfDue(context.Background(), &mockDBService{db: db}, job, now)
+		assert.NoError(t, err)
+		assert.False(t, ran)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("records a failed run", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		job := Job{Name: "test", Interval: time.Hour, Run: func(ctx context.Context) error {
+			return errors.New("boom")
+		}}
+
+		mock.ExpectExec("UPDATE scheduled_jobs SET locked_until").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE scheduled_jobs").
+			WithArgs(now, "error", "boom", "test").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		ran, err := RunIfDue(context.Background(), &mockDBService{db: db}, job, now)
+		assert.EqualError(t, err, "boom")
+		assert.True(t, ran)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
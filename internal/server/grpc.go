@@ -0,0 +1,22 @@
+package server
+
+import (
+	"google.golang.org/grpc"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/middlewares"
+	"github.com/dlsu-lscs/lscs-core-api/internal/rpc"
+)
+
+// NewGRPCServer creates the gRPC server for internal services.
+// It uses the same database and API keys as the HTTP server.
+func NewGRPCServer(cfg *config.Config) *grpc.Server {
+	dbService := database.New(cfg)
+	return rpc.NewServer(
+		middlewares.NewAPIKeyAuthenticator(cfg, dbService),
+		dbService,
+		auth.NewRBACService(dbService),
+	)
+}
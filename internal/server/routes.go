@@ -5,8 +5,6 @@ import (
 
 	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
 	"github.com/dlsu-lscs/lscs-core-api/internal/middlewares"
-	"github.com/golang-jwt/jwt/v5"
-	echojwt "github.com/labstack/echo-jwt/v4"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	echoSwagger "github.com/swaggo/echo-swagger"
@@ -20,7 +18,7 @@ func (s *Server) RegisterRoutes(e *echo.Echo) {
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     s.cfg.AllowedOrigins,
 		AllowMethods:     []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
-		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentLength, echo.HeaderAcceptEncoding, echo.HeaderContentType, echo.HeaderAuthorization},
+		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentLength, echo.HeaderAcceptEncoding, echo.HeaderContentType, echo.HeaderAuthorization, auth.CSRFHeaderName},
 		AllowCredentials: true, // required for cookies
 	}))
 
@@ -38,17 +36,62 @@ func (s *Server) RegisterRoutes(e *echo.Echo) {
 	authRoutes.GET("/google/callback", s.oauthHandler.GoogleCallbackHandler)
 	authRoutes.POST("/logout", s.oauthHandler.LogoutHandler)
 
-	// --- Session-protected routes (Web UI) ---
+	// --- OAuth2 / OIDC provider routes (public) ---
+	e.GET("/.well-known/openid-configuration", s.clientHandler.DiscoveryHandler)
+	e.GET("/oauth/jwks", s.clientHandler.JWKSHandler)
+	e.POST("/oauth/token", s.clientHandler.TokenHandler)
+	e.GET("/oauth/userinfo", s.clientHandler.UserInfoHandler)
+
+	// --- Authenticators ---
+	// Each protected group accepts any of its allowed methods and stores an
+	// auth.Principal in the request context for handlers and RBAC middlewares.
+	sessionAuth := middlewares.NewSessionAuthenticator(s.sessionService, s.cfg)
+	apiKeyAuth := middlewares.NewAPIKeyAuthenticator(s.cfg, s.db)
+	googleAuth := middlewares.NewGoogleAuthenticator(s.cfg, s.db)
+	clientAuth := middlewares.NewClientCredentialsAuthenticator(s.cfg, s.db)
+
+	// members acting directly (web UI session or Google ID token)
+	memberAuth := middlewares.Authenticate(sessionAuth, googleAuth)
+	// any principal, including API keys and OAuth2 clients
+	// (client tokens are checked before API keys since both are signed with the JWT secret)
+	anyAuth := middlewares.Authenticate(clientAuth, apiKeyAuth, sessionAuth, googleAuth)
+	csrf := middlewares.CSRFMiddleware(s.cfg)
+
+	// --- Event registration (public, for non-members) ---
+	e.POST("/events/:id/register", s.participantHandler.RegisterHandler)
+	e.POST("/events/:id/register/verify", s.participantHandler.VerifyRegistrationHandler)
+
+	// --- Calendar feeds (public, authenticated by the feed token in the URL) ---
+	e.GET("/calendar/feeds/:token/org.ics", s.calendarHandler.OrgFeedHandler)
+	e.GET("/calendar/feeds/:token/mine.ics", s.calendarHandler.MyFeedHandler)
+	e.GET("/calendar/feeds/:token/committees/:committee_id", s.calendarHandler.CommitteeFeedHandler)
+
+	// --- Telegram bot webhook (public, authenticated by the webhook secret token) ---
+	e.POST("/telegram/webhook", s.telegramHandler.WebhookHandler)
+
+	// "Sign in with LSCS": members without a session are sent through Google login first
+	e.GET("/oauth/authorize", s.clientHandler.AuthorizeHandler, middlewares.OptionalAuthenticate(sessionAuth))
+
+	// --- Member routes (Web UI) ---
 	sessionProtected := e.Group("/auth")
-	sessionProtected.Use(middlewares.SessionMiddleware(s.sessionService, s.cfg))
+	sessionProtected.Use(memberAuth, csrf)
+	sessionProtected.GET("/csrf", s.oauthHandler.CSRFTokenHandler)
 	sessionProtected.GET("/me", s.memberHandler.GetMeHandler)
 	sessionProtected.PUT("/me", s.memberHandler.UpdateMeHandler)
 	sessionProtected.GET("/members/:id", s.memberHandler.GetMemberByIDHandler)
 	sessionProtected.PUT("/members/:id", s.memberHandler.UpdateMemberByIDHandler, middlewares.RequireCanEditMember(s.rbacService))
 
+	// --- Role management (Web UI, admin only) ---
+	// granting a role is a sensitive action and needs a recent Google login (step-up)
+	requireAdmin := middlewares.RequireAdmin(s.rbacService)
+	sessionProtected.GET("/roles", s.authHandler.ListRolesHandler, requireAdmin)
+	sessionProtected.GET("/members/:id/roles", s.authHandler.ListMemberRolesHandler, requireAdmin)
+	sessionProtected.POST("/members/:id/roles", s.authHandler.GrantRoleHandler, requireAdmin, middlewares.RequireStepUp("grant_role"))
+	sessionProtected.DELETE("/members/:id/roles/:role_id", s.authHandler.RevokeRoleHandler, requireAdmin)
+
 	// --- Upload routes (Web UI) ---
 	uploadProtected := e.Group("/upload")
-	uploadProtected.Use(middlewares.SessionMiddleware(s.sessionService, s.cfg))
+	uploadProtected.Use(memberAuth, csrf)
 	uploadProtected.POST("/profile-image", s.uploadHandler.GenerateUploadURLHandler)
 	uploadProtected.POST("/profile-image/complete", s.uploadHandler.CompleteUploadHandler)
 	uploadProtected.DELETE("/profile-image", s.uploadHandler.DeleteImageHandler)
@@ -56,31 +99,217 @@ func (s *Server) RegisterRoutes(e *echo.Echo) {
 	// --- API Key routes (Web UI) ---
 	// Note: Authorization checks (RND AVP+) are done inline in handlers
 	apiKeyProtected := e.Group("/api-keys")
-	apiKeyProtected.Use(middlewares.SessionMiddleware(s.sessionService, s.cfg))
+	apiKeyProtected.Use(memberAuth, csrf)
 	apiKeyProtected.GET("", s.authHandler.ListAPIKeys)
 	apiKeyProtected.DELETE("/:id", s.authHandler.RevokeAPIKey)
 
 	// --- API Key Request routes (Web UI) ---
-	// Uses session-based auth instead of Bearer tokens for web UI compatibility
+	// Accepts a session cookie or a Google ID token, never another API key
 	apiRequestKeyProtected := e.Group("/request-key")
-	apiRequestKeyProtected.Use(middlewares.SessionMiddleware(s.sessionService, s.cfg))
+	apiRequestKeyProtected.Use(memberAuth, csrf)
 	apiRequestKeyProtected.POST("", s.authHandler.RequestKeyHandler)
 
-	// --- JWT Protected routes (API Keys) ---
+	// --- Event routes (Web UI) ---
+	// committee VPs/AVPs can only manage their own committee's events (checked in handlers)
+	eventProtected := e.Group("/events")
+	eventProtected.Use(memberAuth, csrf)
+	eventProtected.GET("", s.eventHandler.ListEventsHandler)
+	eventProtected.GET("/options", s.eventHandler.GetEventOptionsHandler)
+	eventProtected.GET("/:id", s.eventHandler.GetEventHandler)
+	eventProtected.POST("", s.eventHandler.CreateEventHandler)
+	eventProtected.PUT("/:id", s.eventHandler.UpdateEventHandler)
+	eventProtected.DELETE("/:id", s.eventHandler.DeleteEventHandler)
+	eventProtected.POST("/:id/heads", s.eventHandler.AddEventHeadHandler)
+	eventProtected.DELETE("/:id/heads/:member_id", s.eventHandler.RemoveEventHeadHandler)
+
+	// participants and attendance (officers: committee managers and event heads, checked in handlers)
+	eventProtected.POST("/:id/participants/me", s.participantHandler.RegisterMeHandler)
+	eventProtected.GET("/:id/participants/me/ticket", s.participantHandler.GetMyTicketHandler)
+	eventProtected.GET("/:id/participants", s.participantHandler.ListParticipantsHandler)
+	eventProtected.POST("/:id/participants/import", s.participantHandler.ImportParticipantsHandler)
+	eventProtected.DELETE("/:id/participants/:student_id", s.participantHandler.RemoveParticipantHandler)
+	eventProtected.POST("/:id/check-in", s.participantHandler.CheckInHandler)
+	eventProtected.GET("/:id/attendance.csv", s.participantHandler.ExportAttendanceHandler)
+
+	// documents (organizers upload and submit, documentation reviewers approve, checked in handlers)
+	eventProtected.GET("/file-workflow", s.fileHandler.GetFileWorkflowHandler)
+	eventProtected.GET("/document-templates", s.fileHandler.ListDocumentTemplatesHandler)
+	eventProtected.GET("/:id/files", s.fileHandler.ListFilesHandler)
+	eventProtected.POST("/:id/files", s.fileHandler.CompleteFileUploadHandler)
+	eventProtected.POST("/:id/files/upload-url", s.fileHandler.CreateFileUploadURLHandler)
+	eventProtected.POST("/:id/files/from-templates", s.fileHandler.CreateTemplateFilesHandler)
+	eventProtected.PUT("/:id/files/order", s.fileHandler.ReorderFilesHandler)
+	eventProtected.POST("/:id/files/:file_id/transitions", s.fileHandler.TransitionFileHandler)
+	eventProtected.DELETE("/:id/files/:file_id", s.fileHandler.DeleteFileHandler)
+
+	// documentation and finance tracker (organizer/reviewer checks in handlers)
+	eventProtected.GET("/:id/tracker", s.trackerHandler.GetTrackerHandler)
+	eventProtected.PUT("/:id/tracker", s.trackerHandler.UpdateTrackerHandler)
+	eventProtected.POST("/:id/tracker/transitions", s.trackerHandler.TransitionHandler)
+	eventProtected.GET("/:id/tracker/history", s.trackerHandler.ListHistoryHandler)
+	eventProtected.PUT("/:id/tracker/heads", s.trackerHandler.UpdateHeadsHandler)
+	eventProtected.POST("/:id/tracker/docu-heads", s.trackerHandler.AddDocuHeadHandler)
+	eventProtected.DELETE("/:id/tracker/docu-heads/:member_id", s.trackerHandler.RemoveDocuHeadHandler)
+	eventProtected.POST("/:id/tracker/fin-processes", s.trackerHandler.AddFinProcessHandler)
+	eventProtected.DELETE("/:id/tracker/fin-processes/:process_id", s.trackerHandler.RemoveFinProcessHandler)
+
+	// Google Drive folders (created automatically once pre-acts are approved; admin only)
+	eventProtected.GET("/:id/drive-folders", s.driveHandler.GetFoldersHandler, requireAdmin)
+	eventProtected.POST("/:id/drive-folders", s.driveHandler.ProvisionFoldersHandler, requireAdmin)
+
+	// publicity requests (requester/Publicity checks in handlers)
+	eventProtected.GET("/:id/pub-requests", s.publicityHandler.ListEventPubRequestsHandler)
+	eventProtected.POST("/:id/pub-requests", s.publicityHandler.CreatePubRequestHandler)
+
+	// --- Event trackers ---
+	trackerProtected := e.Group("/trackers")
+	trackerProtected.Use(memberAuth, csrf)
+	trackerProtected.GET("", s.trackerHandler.ListTrackersHandler)
+	trackerProtected.GET("/workflows", s.trackerHandler.GetWorkflowsHandler)
+	trackerProtected.GET("/overdue", s.trackerHandler.ListOverdueHandler)
+
+	// --- Publicity requests ---
+	pubProtected := e.Group("/pub-requests")
+	pubProtected.Use(memberAuth, csrf)
+	pubProtected.GET("", s.publicityHandler.ListPubRequestsHandler)
+	pubProtected.GET("/workflow", s.publicityHandler.GetWorkflowHandler)
+	pubProtected.GET("/calendar", s.publicityHandler.GetCalendarHandler)
+	pubProtected.GET("/:id", s.publicityHandler.GetPubRequestHandler)
+	pubProtected.PUT("/:id", s.publicityHandler.UpdatePubRequestHandler)
+	pubProtected.POST("/:id/claim", s.publicityHandler.ClaimPubRequestHandler)
+	pubProtected.PUT("/:id/pub-head", s.publicityHandler.AssignPubHeadHandler)
+	pubProtected.POST("/:id/transitions", s.publicityHandler.TransitionHandler)
+	pubProtected.GET("/:id/comments", s.publicityHandler.ListCommentsHandler)
+	pubProtected.POST("/:id/comments", s.publicityHandler.AddCommentHandler)
+
+	// --- Calendar feed tokens ---
+	calendarProtected := e.Group("/calendar")
+	calendarProtected.Use(memberAuth, csrf)
+	calendarProtected.GET("/token", s.calendarHandler.GetFeedTokenHandler)
+	calendarProtected.POST("/token", s.calendarHandler.RotateFeedTokenHandler)
+	calendarProtected.DELETE("/token", s.calendarHandler.RevokeFeedTokenHandler)
+
+	// --- Terms (changes are admin only) ---
+	termProtected := e.Group("/terms")
+	termProtected.Use(memberAuth, csrf)
+	termProtected.GET("", s.termHandler.ListTermsHandler)
+	termProtected.GET("/current", s.termHandler.GetCurrentTermHandler)
+	termProtected.GET("/:id", s.termHandler.GetTermHandler)
+	termProtected.GET("/:id/roster", s.termHandler.GetRosterHandler)
+	termProtected.GET("/:id/ranks", s.termHandler.GetRanksHandler)
+	termProtected.POST("", s.termHandler.CreateTermHandler, requireAdmin)
+	termProtected.POST("/rollover", s.termHandler.RolloverHandler, requireAdmin, middlewares.RequireStepUp("term_rollover"))
+	termProtected.PUT("/:id", s.termHandler.UpdateTermHandler, requireAdmin)
+	termProtected.DELETE("/:id", s.termHandler.DeleteTermHandler, requireAdmin)
+	termProtected.POST("/:id/activate", s.termHandler.ActivateTermHandler, requireAdmin)
+	termProtected.PUT("/:id/ranks/:position_id", s.termHandler.SetRankHandler, requireAdmin)
+
+	// --- Houses (changes are admin only, points are awarded by officers) ---
+	houseProtected := e.Group("/houses")
+	houseProtected.Use(memberAuth, csrf)
+	houseProtected.GET("", s.houseHandler.ListHousesHandler)
+	houseProtected.GET("/leaderboard", s.houseHandler.GetLeaderboardHandler)
+	houseProtected.GET("/:id", s.houseHandler.GetHouseHandler)
+	houseProtected.GET("/:id/members", s.houseHandler.ListHouseMembersHandler)
+	houseProtected.GET("/:id/points", s.houseHandler.ListPointsHandler)
+	houseProtected.POST("/:id/points", s.houseHandler.AwardPointsHandler, middlewares.RequirePosition(s.db, "AVP"))
+	houseProtected.POST("", s.houseHandler.CreateHouseHandler, requireAdmin)
+	houseProtected.PUT("/:id", s.houseHandler.UpdateHouseHandler, requireAdmin)
+	houseProtected.DELETE("/:id", s.houseHandler.DeleteHouseHandler, requireAdmin)
+
+	// --- Committee and division management (Web UI, admin only) ---
+	committeeAdmin := e.Group("/committees")
+	committeeAdmin.Use(memberAuth, csrf, requireAdmin)
+	committeeAdmin.POST("", s.committeeHandler.CreateCommitteeHandler)
+	committeeAdmin.PUT("/:id", s.committeeHandler.UpdateCommitteeHandler)
+	committeeAdmin.PUT("/:id/head", s.committeeHandler.SetCommitteeHeadHandler)
+	committeeAdmin.DELETE("/:id", s.committeeHandler.DeleteCommitteeHandler)
+
+	divisionAdmin := e.Group("/divisions")
+	divisionAdmin.Use(memberAuth, csrf, requireAdmin)
+	divisionAdmin.POST("", s.committeeHandler.CreateDivisionHandler)
+	divisionAdmin.PUT("/:id", s.committeeHandler.UpdateDivisionHandler)
+	divisionAdmin.PUT("/:id/head", s.committeeHandler.SetDivisionHeadHandler)
+	divisionAdmin.DELETE("/:id", s.committeeHandler.DeleteDivisionHandler)
+
+	// --- Positions (the rank is the authority hierarchy, changes are admin only) ---
+	positionProtected := e.Group("/positions")
+	positionProtected.Use(memberAuth, csrf)
+	positionProtected.GET("", s.positionHandler.ListPositionsHandler)
+	positionProtected.GET("/:id", s.positionHandler.GetPositionHandler)
+	positionProtected.POST("", s.positionHandler.CreatePositionHandler, requireAdmin)
+	positionProtected.PUT("/:id", s.positionHandler.UpdatePositionHandler, requireAdmin)
+	positionProtected.DELETE("/:id", s.positionHandler.DeletePositionHandler, requireAdmin)
+
+	// --- Outbound webhooks (Web UI, admin only) ---
+	webhookAdmin := e.Group("/webhooks")
+	webhookAdmin.Use(memberAuth, csrf, requireAdmin)
+	webhookAdmin.GET("", s.webhookHandler.ListEndpointsHandler)
+	webhookAdmin.POST("", s.webhookHandler.CreateEndpointHandler)
+	webhookAdmin.GET("/:id", s.webhookHandler.GetEndpointHandler)
+	webhookAdmin.PUT("/:id", s.webhookHandler.UpdateEndpointHandler)
+	webhookAdmin.DELETE("/:id", s.webhookHandler.DeleteEndpointHandler)
+	webhookAdmin.POST("/:id/ping", s.webhookHandler.PingHandler)
+	webhookAdmin.GET("/:id/deliveries", s.webhookHandler.ListDeliveriesHandler)
+	webhookAdmin.GET("/:id/deliveries/:delivery_id", s.webhookHandler.GetDeliveryHandler)
+	webhookAdmin.POST("/:id/deliveries/:delivery_id/replay", s.webhookHandler.ReplayDeliveryHandler)
+
+	// --- Discord role sync (Web UI, admin only) ---
+	discordAdmin := e.Group("/discord")
+	discordAdmin.Use(memberAuth, csrf, requireAdmin)
+	discordAdmin.GET("/sync", s.discordHandler.PlanSyncHandler)
+	discordAdmin.POST("/sync", s.discordHandler.SyncHandler)
+
+	// --- Discord account linking (Web UI) ---
+	discordProtected := e.Group("/discord")
+	discordProtected.Use(memberAuth, csrf)
+	discordProtected.GET("/link", s.discordHandler.GetLinkHandler)
+	discordProtected.DELETE("/link", s.discordHandler.UnlinkHandler)
+	discordProtected.GET("/link/start", s.discordHandler.StartLinkHandler)
+	discordProtected.GET("/link/callback", s.discordHandler.LinkCallbackHandler)
+
+	// --- Telegram account linking (Web UI) ---
+	telegramProtected := e.Group("/telegram")
+	telegramProtected.Use(memberAuth, csrf)
+	telegramProtected.POST("/link-code", s.telegramHandler.CreateLinkCodeHandler)
+	telegramProtected.GET("/link", s.telegramHandler.GetLinkHandler)
+	telegramProtected.DELETE("/link", s.telegramHandler.UnlinkHandler)
+
+	// --- Notifications: in-app inbox, live stream and email preferences (Web UI) ---
+	notificationProtected := e.Group("/notifications")
+	notificationProtected.Use(memberAuth, csrf)
+	notificationProtected.GET("", s.notificationHandler.ListNotificationsHandler)
+	notificationProtected.GET("/stream", s.notificationHandler.StreamHandler)
+	notificationProtected.POST("/read-all", s.notificationHandler.MarkAllReadHandler)
+	notificationProtected.POST("/:id/read", s.notificationHandler.MarkReadHandler)
+	notificationProtected.GET("/preferences", s.notificationHandler.GetPreferencesHandler)
+	notificationProtected.PUT("/preferences", s.notificationHandler.UpdatePreferencesHandler)
+
+	// --- OAuth2 client management (Web UI, admin only) ---
+	clientProtected := e.Group("/oauth/clients")
+	clientProtected.Use(memberAuth, csrf, middlewares.RequireAdmin(s.rbacService))
+	clientProtected.GET("", s.clientHandler.ListClientsHandler)
+	clientProtected.POST("", s.clientHandler.CreateClientHandler)
+	clientProtected.DELETE("/:client_id", s.clientHandler.DeleteClientHandler)
+
+	// --- API routes (API keys, OAuth2 clients, sessions, Google ID tokens) ---
 	protected := e.Group("")
-	protected.Use(echojwt.WithConfig(echojwt.Config{
-		NewClaimsFunc: func(c echo.Context) jwt.Claims { return new(auth.JwtCustomClaims) },
-		SigningKey:    []byte(s.cfg.JWTSecret),
-		TokenLookup:   "header:Authorization:Bearer ",
-		SigningMethod: "HS256",
-	}))
-	protected.Use(middlewares.JWTEmailMiddleware)
+	protected.Use(anyAuth, csrf)
 	protected.Use(middlewares.RequireAPIAccess(s.rbacService))
 
-	protected.GET("/members", s.memberHandler.GetAllMembersHandler)
-	protected.GET("/committees", s.committeeHandler.GetAllCommitteesHandler)
-	protected.POST("/member", s.memberHandler.GetMemberInfo)
-	protected.POST("/member-id", s.memberHandler.GetMemberInfoByID)
-	protected.POST("/check-email", s.memberHandler.CheckEmailHandler)
-	protected.POST("/check-id", s.memberHandler.CheckIDIfMember)
+	protected.GET("/members", s.memberHandler.GetAllMembersHandler, middlewares.RequireScope(auth.ScopeMembersRead))
+	protected.GET("/committees", s.committeeHandler.GetAllCommitteesHandler, middlewares.RequireScope(auth.ScopeCommitteesRead))
+	protected.GET("/committees/:id", s.committeeHandler.GetCommitteeHandler, middlewares.RequireScope(auth.ScopeCommitteesRead))
+	protected.GET("/divisions", s.committeeHandler.GetAllDivisionsHandler, middlewares.RequireScope(auth.ScopeCommitteesRead))
+	protected.GET("/divisions/:id", s.committeeHandler.GetDivisionHandler, middlewares.RequireScope(auth.ScopeCommitteesRead))
+	protected.GET("/org-chart", s.orgChartHandler.OrgChartHandler, middlewares.RequireScope(auth.ScopeCommitteesRead))
+	protected.GET("/members/:id/chain", s.orgChartHandler.ChainHandler, middlewares.RequireScope(auth.ScopeMembersRead))
+	protected.POST("/member", s.memberHandler.GetMemberInfo, middlewares.RequireScope(auth.ScopeMembersRead))
+	protected.POST("/member-id", s.memberHandler.GetMemberInfoByID, middlewares.RequireScope(auth.ScopeMembersRead))
+	protected.POST("/check-email", s.memberHandler.CheckEmailHandler, middlewares.RequireScope(auth.ScopeMembersRead))
+	protected.POST("/check-id", s.memberHandler.CheckIDIfMember, middlewares.RequireScope(auth.ScopeMembersRead))
+
+	// GraphQL: scopes and roles are checked per field, the same as the REST routes above
+	protected.GET("/graphql", s.graphHandler.GraphQLHandler)
+	protected.POST("/graphql", s.graphHandler.GraphQLHandler)
 }
@@ -7,11 +7,25 @@ import (
 	"time"
 
 	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/calendar"
 	"github.com/dlsu-lscs/lscs-core-api/internal/committee"
 	"github.com/dlsu-lscs/lscs-core-api/internal/config"
 	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/discord"
+	"github.com/dlsu-lscs/lscs-core-api/internal/drive"
+	"github.com/dlsu-lscs/lscs-core-api/internal/event"
+	"github.com/dlsu-lscs/lscs-core-api/internal/graph"
+	"github.com/dlsu-lscs/lscs-core-api/internal/house"
 	"github.com/dlsu-lscs/lscs-core-api/internal/member"
+	"github.com/dlsu-lscs/lscs-core-api/internal/notification"
+	"github.com/dlsu-lscs/lscs-core-api/internal/orgchart"
+	"github.com/dlsu-lscs/lscs-core-api/internal/position"
+	"github.com/dlsu-lscs/lscs-core-api/internal/publicity"
 	"github.com/dlsu-lscs/lscs-core-api/internal/storage"
+	"github.com/dlsu-lscs/lscs-core-api/internal/telegram"
+	"github.com/dlsu-lscs/lscs-core-api/internal/term"
+	"github.com/dlsu-lscs/lscs-core-api/internal/tracker"
+	"github.com/dlsu-lscs/lscs-core-api/internal/webhook"
 	"github.com/labstack/echo/v4"
 )
 
@@ -22,11 +36,28 @@ type Server struct {
 	db database.Service
 
 	// handlers
-	authHandler      *auth.Handler
-	oauthHandler     *auth.OAuthHandler
-	memberHandler    *member.Handler
-	committeeHandler *committee.Handler
-	uploadHandler    *storage.UploadHandler
+	authHandler         *auth.Handler
+	oauthHandler        *auth.OAuthHandler
+	clientHandler       *auth.ClientHandler
+	memberHandler       *member.Handler
+	committeeHandler    *committee.Handler
+	eventHandler        *event.Handler
+	participantHandler  *event.ParticipantHandler
+	fileHandler         *event.FileHandler
+	trackerHandler      *tracker.Handler
+	publicityHandler    *publicity.Handler
+	calendarHandler     *calendar.Handler
+	termHandler         *term.Handler
+	houseHandler        *house.Handler
+	positionHandler     *position.Handler
+	orgChartHandler     *orgchart.Handler
+	graphHandler        *graph.Handler
+	webhookHandler      *webhook.Handler
+	discordHandler      *discord.Handler
+	telegramHandler     *telegram.Handler
+	driveHandler        *drive.Handler
+	notificationHandler *notification.Handler
+	uploadHandler       *storage.UploadHandler
 
 	// services
 	sessionService auth.SessionService
@@ -56,19 +87,57 @@ func NewServer(cfg *config.Config) *http.Server {
 	// start session cleanup job (runs every hour)
 	ctx := context.Background()
 	auth.StartCleanupJob(ctx, sessionService, 1*time.Hour)
+	auth.StartAuthorizationCodeCleanupJob(ctx, dbService, 1*time.Hour)
+
+	// load the position hierarchy and pick up changes made by other instances
+	auth.StartPositionRefreshJob(ctx, dbService, 5*time.Minute)
+
+	// queue notification emails in the outbox (the dispatcher sends them and retries failures)
+	// and add in-app notifications, waking the member's open notification streams
+	notificationBroker := notification.NewBroker()
+	notifier := notification.NewNotifier(dbService, cfg, notificationBroker)
+	notification.StartDispatcher(ctx, dbService, cfg)
+	notification.StartKeyExpiryJob(ctx, dbService, cfg, notifier)
+
+	// start tracker deadline job (default deadlines and reminders, overdue notices are emailed)
+	tracker.StartDeadlineJob(ctx, dbService, cfg, notifier)
+
+	// send queued webhook deliveries and retry failed ones
+	webhook.StartDispatcher(ctx, dbService, cfg)
+
+	// create the Drive folders of events once their pre-acts are approved (nil if not configured)
+	driveProvisioner := drive.NewProvisionerFromConfig(ctx, cfg, dbService)
+	drive.StartProvisionJob(ctx, dbService, cfg, driveProvisioner)
 
 	NewServer := &Server{
-		port:             cfg.Port,
-		cfg:              cfg,
-		db:               dbService,
-		sessionService:   sessionService,
-		rbacService:      rbacService,
-		s3Service:        s3Service,
-		uploadHandler:    uploadHandler,
-		authHandler:      auth.NewHandler(auth.NewService(cfg.JWTSecret, cfg), dbService, rbacService),
-		oauthHandler:     auth.NewOAuthHandler(cfg, sessionService, dbService),
-		memberHandler:    member.NewHandler(dbService),
-		committeeHandler: committee.NewHandler(dbService),
+		port:                cfg.Port,
+		cfg:                 cfg,
+		db:                  dbService,
+		sessionService:      sessionService,
+		rbacService:         rbacService,
+		s3Service:           s3Service,
+		uploadHandler:       uploadHandler,
+		authHandler:         auth.NewHandler(auth.NewService(cfg.JWTSecret, cfg), dbService, rbacService, notifier),
+		oauthHandler:        auth.NewOAuthHandler(cfg, sessionService, dbService),
+		clientHandler:       auth.NewClientHandler(cfg, dbService),
+		memberHandler:       member.NewHandler(dbService, notifier),
+		committeeHandler:    committee.NewHandler(dbService),
+		eventHandler:        event.NewHandler(dbService, rbacService),
+		participantHandler:  event.NewParticipantHandler(cfg, dbService, rbacService, notifier),
+		fileHandler:         event.NewFileHandler(cfg, dbService, rbacService, s3Service),
+		trackerHandler:      tracker.NewHandler(cfg, dbService, rbacService),
+		publicityHandler:    publicity.NewHandler(cfg, dbService, rbacService),
+		calendarHandler:     calendar.NewHandler(cfg, dbService),
+		termHandler:         term.NewHandler(dbService),
+		houseHandler:        house.NewHandler(dbService),
+		positionHandler:     position.NewHandler(dbService),
+		orgChartHandler:     orgchart.NewHandler(dbService),
+		graphHandler:        graph.NewHandler(cfg, dbService, rbacService),
+		webhookHandler:      webhook.NewHandler(dbService),
+		discordHandler:      discord.NewHandler(cfg, dbService),
+		telegramHandler:     telegram.NewHandler(cfg, dbService, rbacService),
+		driveHandler:        drive.NewHandler(dbService, driveProvisioner),
+		notificationHandler: notification.NewHandler(cfg, dbService, notificationBroker),
 	}
 
 	// Declare Server config
@@ -8,6 +8,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
 
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
 	"github.com/dlsu-lscs/lscs-core-api/internal/config"
 	"github.com/dlsu-lscs/lscs-core-api/internal/database"
 	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
@@ -79,10 +80,11 @@ func (h *UploadHandler) GenerateUploadURLHandler(c echo.Context) error {
 		return err
 	}
 
-	memberID, ok := c.Get("user_id").(int32)
+	principal, ok := auth.GetPrincipal(c)
 	if !ok {
 		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
 	}
+	memberID := principal.MemberID
 
 	uploadURL, objectKey, err := h.s3Service.GenerateUploadURL(c.Request().Context(), memberID, req.ContentType)
 	if err != nil {
@@ -117,10 +119,11 @@ func (h *UploadHandler) CompleteUploadHandler(c echo.Context) error {
 		})
 	}
 
-	memberID, ok := c.Get("user_id").(int32)
+	principal, ok := auth.GetPrincipal(c)
 	if !ok {
 		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
 	}
+	memberID := principal.MemberID
 
 	req := new(CompleteUploadRequest)
 	if err := helpers.BindAndValidate(c, req); err != nil {
@@ -182,10 +185,11 @@ func (h *UploadHandler) DeleteImageHandler(c echo.Context) error {
 		})
 	}
 
-	memberID, ok := c.Get("user_id").(int32)
+	principal, ok := auth.GetPrincipal(c)
 	if !ok {
 		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
 	}
+	memberID := principal.MemberID
 
 	// get current image_url
 	ctx := c.Request().Context()
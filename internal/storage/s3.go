@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -120,7 +121,37 @@ func (s *S3Service) GenerateUploadURL(ctx context.Context, memberID int32, conte
 	return presignOutput.URL, objectKey, nil
 }
 
-// GenerateDownloadURL generates a pre-signed URL for downloading a profile image
+// GenerateDocumentUploadURL generates a pre-signed URL for uploading a document of an event
+func (s *S3Service) GenerateDocumentUploadURL(ctx context.Context, eventID int32, fileName, contentType string) (string, string, error) {
+	if !s.IsEnabled() {
+		return "", "", fmt.Errorf("S3 service not enabled")
+	}
+
+	if !IsValidDocumentType(contentType) {
+		return "", "", fmt.Errorf("invalid content type: %s", contentType)
+	}
+
+	// generate object key: event-files/{event_id}/{timestamp}-{file_name}
+	timestamp := time.Now().Format("20060102-150405")
+	objectKey := fmt.Sprintf("%s%s-%s", EventFilePrefix(eventID), timestamp, sanitizeFileName(fileName))
+
+	presignClient := s3.NewPresignClient(s.client)
+	presignParams := &s3.PutObjectInput{
+		Bucket:      aws.String(s.config.Bucket),
+		Key:         aws.String(objectKey),
+		ContentType: aws.String(contentType),
+	}
+
+	presignOutput, err := presignClient.PresignPutObject(ctx, presignParams, s3.WithPresignExpires(15*time.Minute))
+	if err != nil {
+		log.Error().Err(err).Str("object_key", objectKey).Msg("failed to generate presigned URL")
+		return "", "", fmt.Errorf("failed to generate upload URL: %w", err)
+	}
+
+	return presignOutput.URL, objectKey, nil
+}
+
+// GenerateDownloadURL generates a pre-signed URL for downloading an object
 func (s *S3Service) GenerateDownloadURL(ctx context.Context, objectKey string) (string, error) {
 	if !s.IsEnabled() {
 		return "", fmt.Errorf("S3 service not enabled")
@@ -204,6 +235,51 @@ func getExtension(contentType string) string {
 	}
 }
 
+// EventFilePrefix returns the prefix of the object keys of an event's documents
+func EventFilePrefix(eventID int32) string {
+	return fmt.Sprintf("event-files/%d/", eventID)
+}
+
+// IsValidDocumentType returns true if the content type is accepted for event documents:
+// PDF, Word, Excel, PowerPoint, JPEG/PNG images and ZIP archives
+func IsValidDocumentType(contentType string) bool {
+	switch contentType {
+	case "application/pdf",
+		"application/msword",
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		"application/vnd.ms-excel",
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		"application/vnd.ms-powerpoint",
+		"application/vnd.openxmlformats-officedocument.presentationml.presentation",
+		"image/jpeg",
+		"image/png",
+		"application/zip":
+		return true
+	}
+	return false
+}
+
+// sanitizeFileName keeps letters, digits, dots, dashes and underscores of a file name, so it can be used in an object key
+func sanitizeFileName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('-')
+		}
+	}
+	sanitized := strings.Trim(b.String(), ".-")
+	if len(sanitized) > 100 {
+		sanitized = sanitized[len(sanitized)-100:]
+	}
+	if sanitized == "" {
+		return "file"
+	}
+	return sanitized
+}
+
 func isValidImageType(contentType string) bool {
 	validTypes := map[string]bool{
 		"image/jpeg": true,
@@ -0,0 +1,315 @@
+package telegram
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/orgchart"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// maxSearchResults is how many members a name search lists before asking for a more specific query
+const maxSearchResults = 5
+
+const helpText = `LSCS Core bot
+
+/link <code> - link your Telegram account (get a code from your profile on the web UI)
+/unlink - unlink your Telegram account
+/whois <id or name> - look up a member
+/contact <id or name> - a member's contact details (private chat only)
+/head <committee or division ID> - who heads a committee or division`
+
+// Bot answers commands sent to the Telegram bot.
+// Lookups are only answered for linked accounts and follow the same RBAC rules as the HTTP API.
+type Bot struct {
+	dbService database.Service
+	rbac      *auth.RBACService
+	orgChart  *orgchart.Service
+	client    Client
+}
+
+func NewBot(dbService database.Service, rbac *auth.RBACService, client Client) *Bot {
+	return &Bot{
+		dbService: dbService,
+		rbac:      rbac,
+		orgChart:  orgchart.NewService(dbService),
+		client:    client,
+	}
+}
+
+// HandleUpdate answers a command. Updates that aren't text messages from users are ignored.
+func (b *Bot) HandleUpdate(ctx context.Context, u Update) error {
+	msg := u.Message
+	if msg == nil || msg.From == nil || msg.From.IsBot || !strings.HasPrefix(msg.Text, "/") {
+		return nil
+	}
+
+	command, arg := parseCommand(msg.Text)
+	reply, err := b.answer(ctx, msg, command, arg)
+	if err != nil {
+		log.Error().Err(err).Str("command", command).Int64("telegram_user_id", msg.From.ID).Msg("failed to answer telegram command")
+		reply = "Something went wrong, please try again later."
+	}
+	if reply == "" {
+		return nil
+	}
+	return b.client.SendMessage(ctx, msg.Chat.ID, reply)
+}
+
+func (b *Bot) answer(ctx context.Context, msg *Message, command, arg string) (string, error) {
+	switch command {
+	case "start", "help":
+		return helpText, nil
+	case "link":
+		return b.link(ctx, msg, arg)
+	case "unlink", "whois", "contact", "head":
+	default:
+		// commands meant for other bots in the group are ignored
+		if msg.Chat.Type != ChatPrivate {
+			return "", nil
+		}
+		return "Unknown command.\n\n" + helpText, nil
+	}
+
+	actor, linked, err := b.linkedMember(ctx, msg.From.ID)
+	if err != nil {
+		return "", err
+	}
+	if !linked {
+		return "Link your Telegram account first: request a code from your profile on the web UI, then send /link <code> to me in a private chat.", nil
+	}
+
+	switch command {
+	case "unlink":
+		return b.unlink(ctx, msg.From.ID)
+	case "whois":
+		return b.whois(ctx, actor, arg)
+	case "contact":
+		if msg.Chat.Type != ChatPrivate {
+			return "For privacy, contact details are only sent in a private chat with me.", nil
+		}
+		return b.contact(ctx, actor, arg)
+	default:
+		return b.head(ctx, arg)
+	}
+}
+
+// link links the sender's account to the member who requested the code.
+// The sender's username must match the Telegram username on the member's profile.
+func (b *Bot) link(ctx context.Context, msg *Message, code string) (string, error) {
+	if msg.Chat.Type != ChatPrivate {
+		return "Link codes are one-time secrets; send /link <code> to me in a private chat.", nil
+	}
+	if code == "" {
+		return "Usage: /link <code>. Request a code from your profile on the web UI.", nil
+	}
+
+	q := repository.New(b.dbService.GetConnection())
+
+	linkCode, err := q.GetTelegramLinkCode(ctx, hashCode(code))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "That code is invalid or has expired. Request a new one from your profile.", nil
+		}
+		return "", fmt.Errorf("get telegram link code: %w", err)
+	}
+
+	member, err := q.GetMemberInfoById(ctx, linkCode.MemberID)
+	if err != nil {
+		return "", fmt.Errorf("get member: %w", err)
+	}
+	if NormalizeUsername(member.Telegram.String) == "" || NormalizeUsername(member.Telegram.String) != NormalizeUsername(msg.From.Username) {
+		return "Your Telegram username doesn't match the one on your LSCS profile. Update your profile, then try again.", nil
+	}
+
+	// codes are single use
+	deleted, err := q.DeleteTelegramLinkCode(ctx, linkCode.CodeHash)
+	if err != nil {
+		return "", fmt.Errorf("delete telegram link code: %w", err)
+	}
+	if deleted == 0 {
+		return "That code is invalid or has expired. Request a new one from your profile.", nil
+	}
+
+	// an account can only be linked to one member
+	if _, err := q.DeleteTelegramLinkByUser(ctx, msg.From.ID); err != nil {
+		return "", fmt.Errorf("unlink previous member: %w", err)
+	}
+	if err := q.LinkTelegramAccount(ctx, repository.LinkTelegramAccountParams{
+		MemberID:         member.ID,
+		TelegramUserID:   msg.From.ID,
+		TelegramUsername: sql.NullString{String: msg.From.Username, Valid: msg.From.Username != ""},
+	}); err != nil {
+		return "", fmt.Errorf("link telegram account: %w", err)
+	}
+
+	log.Info().Int32("member_id", member.ID).Int64("telegram_user_id", msg.From.ID).Msg("telegram account linked")
+	return fmt.Sprintf("Linked to %s. Send /help to see what I can do.", member.FullName), nil
+}
+
+func (b *Bot) unlink(ctx context.Context, telegramUserID int64) (string, error) {
+	q := repository.New(b.dbService.GetConnection())
+	if _, err := q.DeleteTelegramLinkByUser(ctx, telegramUserID); err != nil {
+		return "", fmt.Errorf("unlink telegram account: %w", err)
+	}
+	return "Your Telegram account is no longer linked.", nil
+}
+
+func (b *Bot) whois(ctx context.Context, actor int32, query string) (string, error) {
+	member, reply, err := b.findMember(ctx, actor, query, "whois")
+	if member == nil {
+		return reply, err
+	}
+
+	name := member.FullName
+	if member.Nickname.Valid && member.Nickname.String != "" {
+		name += " (" + member.Nickname.String + ")"
+	}
+	lines := []string{name, fmt.Sprintf("ID: %d", member.ID)}
+	if member.PositionName.Valid {
+		lines = append(lines, "Position: "+member.PositionName.String)
+	}
+	if member.CommitteeName.Valid {
+		lines = append(lines, fmt.Sprintf("Committee: %s (%s)", member.CommitteeName.String, member.CommitteeID.String))
+	}
+	if member.DivisionName.Valid {
+		lines = append(lines, "Division: "+member.DivisionName.String)
+	}
+	if member.HouseName.Valid {
+		lines = append(lines, "House: "+member.HouseName.String)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func (b *Bot) contact(ctx context.Context, actor int32, query string) (string, error) {
+	member, reply, err := b.findMember(ctx, actor, query, "contact")
+	if member == nil {
+		return reply, err
+	}
+
+	lines := []string{member.FullName, "Email: " + member.Email}
+	if member.Telegram.Valid && member.Telegram.String != "" {
+		lines = append(lines, "Telegram: @"+NormalizeUsername(member.Telegram.String))
+	}
+	if member.Discord.Valid && member.Discord.String != "" {
+		lines = append(lines, "Discord: "+member.Discord.String)
+	}
+	if member.ContactNumber.Valid && member.ContactNumber.String != "" {
+		lines = append(lines, "Contact number: "+member.ContactNumber.String)
+	}
+	if member.FbLink.Valid && member.FbLink.String != "" {
+		lines = append(lines, "Facebook: "+member.FbLink.String)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func (b *Bot) head(ctx context.Context, unitID string) (string, error) {
+	if unitID == "" {
+		return "Usage: /head <committee or division ID>, e.g. /head RND", nil
+	}
+	unitID = strings.ToUpper(unitID)
+
+	chart, err := b.orgChart.Build(ctx, "")
+	if err != nil {
+		return "", fmt.Errorf("build org chart: %w", err)
+	}
+
+	node := chart.Find(orgchart.NodeCommittee, unitID)
+	if node == nil {
+		node = chart.Find(orgchart.NodeDivision, unitID)
+	}
+	if node == nil {
+		return fmt.Sprintf("There is no committee or division %s.", unitID), nil
+	}
+	if node.Head == nil {
+		return fmt.Sprintf("%s (%s) has no head.", node.Name, node.ID), nil
+	}
+
+	head := node.Head.FullName
+	if node.Head.PositionName != "" {
+		head += ", " + node.Head.PositionName
+	}
+	return fmt.Sprintf("%s (%s) is headed by %s (ID %d).", node.Name, node.ID, head, node.Head.MemberID), nil
+}
+
+// findMember resolves a member ID or a name search to one member the actor can view.
+// If it doesn't resolve to exactly one, member is nil and reply explains why.
+func (b *Bot) findMember(ctx context.Context, actor int32, query, command string) (member *repository.GetMemberInfoByIdRow, reply string, err error) {
+	if query == "" {
+		return nil, fmt.Sprintf("Usage: /%s <id or name>", command), nil
+	}
+
+	q := repository.New(b.dbService.GetConnection())
+
+	id, err := strconv.ParseInt(query, 10, 32)
+	if err != nil {
+		pattern := "%" + likeEscaper.Replace(query) + "%"
+		matches, err := q.SearchMembers(ctx, repository.SearchMembersParams{
+			FullName: pattern,
+			Nickname: sql.NullString{String: pattern, Valid: true},
+			Limit:    maxSearchResults + 1,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("search members: %w", err)
+		}
+		switch {
+		case len(matches) == 0:
+			return nil, "No member found.", nil
+		case len(matches) > 1:
+			lines := []string{"Several members match; use their ID:"}
+			for i, m := range matches {
+				if i == maxSearchResults {
+					lines = append(lines, "...")
+					break
+				}
+				lines = append(lines, fmt.Sprintf("%d - %s", m.ID, m.FullName))
+			}
+			return nil, strings.Join(lines, "\n"), nil
+		}
+		id = int64(matches[0].ID)
+	}
+
+	if !b.rbac.CanViewMember(ctx, actor, int32(id)) {
+		return nil, "You don't have permission to view this member.", nil
+	}
+
+	info, err := q.GetMemberInfoById(ctx, int32(id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, "No member found.", nil
+		}
+		return nil, "", fmt.Errorf("get member: %w", err)
+	}
+	return &info, "", nil
+}
+
+// linkedMember returns the member a Telegram account is linked to
+func (b *Bot) linkedMember(ctx context.Context, telegramUserID int64) (int32, bool, error) {
+	q := repository.New(b.dbService.GetConnection())
+	link, err := q.GetTelegramLinkByUser(ctx, telegramUserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("get telegram link: %w", err)
+	}
+	return link.MemberID, true, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// parseCommand splits "/whois@lscs_bot Juan" into "whois" and "Juan"
+func parseCommand(text string) (command, arg string) {
+	command, arg, _ = strings.Cut(strings.TrimSpace(text), " ")
+	command = strings.TrimPrefix(command, "/")
+	command, _, _ = strings.Cut(command, "@")
+	return strings.ToLower(command), strings.TrimSpace(arg)
+}
@@ -0,0 +1,322 @@
+package telegram
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+)
+
+// mockDBService is a mock implementation of the database.Service interface.
+type mockDBService struct {
+	db *sql.DB
+}
+
+func (m *mockDBService) Health() map[string]string {
+	return nil
+}
+
+func (m *mockDBService) Close() error {
+	return nil
+}
+
+func (m *mockDBService) GetConnection() *sql.DB {
+	return m.db
+}
+
+type sentMessage struct {
+	chatID int64
+	text   string
+}
+
+// fakeClient records the messages the bot sends
+type fakeClient struct {
+	sent []sentMessage
+}
+
+func (f *fakeClient) SendMessage(ctx context.Context, chatID int64, text string) error {
+	f.sent = append(f.sent, sentMessage{chatID: chatID, text: text})
+	return nil
+}
+
+const (
+	telegramUserID = int64(5550001)
+	privateChatID  = int64(5550001)
+	groupChatID    = int64(-100200300)
+)
+
+var (
+	memberInfoColumns = []string{
+		"id", "email", "full_name", "nickname", "image_url", "committee_id", "committee_name",
+		"division_id", "division_name", "position_id", "position_name", "house_name",
+		"contact_number", "college", "program", "interests", "discord", "fb_link", "telegram",
+	}
+	linkColumns = []string{"member_id", "telegram_user_id", "telegram_username", "linked_at"}
+)
+
+func newTestBot(db *sql.DB) (*Bot, *fakeClient) {
+	dbService := &mockDBService{db: db}
+	client := &fakeClient{}
+	return NewBot(dbService, auth.NewRBACService(dbService), client), client
+}
+
+func message(chatID int64, chatType, text string) Update {
+	return Update{UpdateID: 1, Message: &Message{
+		MessageID: 1,
+		From:      &User{ID: telegramUserID, Username: "JuanDC"},
+		Chat:      Chat{ID: chatID, Type: chatType},
+		Text:      text,
+	}}
+}
+
+func memberInfoRow(telegram any) *sqlmock.Rows {
+	return sqlmock.NewRows(memberInfoColumns).AddRow(
+		12312345, "juan_delacruz@dlsu.edu.ph", "Juan Dela Cruz", "Juan", nil, "RND", "Research and Development",
+		"INT", "Internals", "VP", "Vice President", "Gryffindor",
+		"+639123456789", "CCS", "BSCS", nil, "juandc", nil, telegram)
+}
+
+func expectLinked(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery("SELECT (.+) FROM telegram_links WHERE telegram_user_id").
+		WithArgs(telegramUserID).
+		WillReturnRows(sqlmock.NewRows(linkColumns).AddRow(12312345, telegramUserID, "JuanDC", time.Now()))
+}
+
+func TestBotLink(t *testing.T) {
+	codeColumns := []string{"code_hash", "member_id", "expires_at", "created_at"}
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM telegram_link_codes WHERE code_hash").
+			WithArgs(hashCode("K7WQ2MZP")).
+			WillReturnRows(sqlmock.NewRows(codeColumns).AddRow(hashCode("K7WQ2MZP"), 12312345, time.Now().Add(time.Minute), time.Now()))
+		mock.ExpectQuery("SELECT (.+) FROM members m").
+			WithArgs(int32(12312345)).
+			WillReturnRows(memberInfoRow("@juandc"))
+		mock.ExpectExec("DELETE FROM telegram_link_codes").
+			WithArgs(hashCode("K7WQ2MZP")).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("DELETE FROM telegram_links WHERE telegram_user_id").
+			WithArgs(telegramUserID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("INSERT INTO telegram_links").
+			WithArgs(int32(12312345), telegramUserID, sql.NullString{String: "JuanDC", Valid: true}).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		bot, client := newTestBot(db)
+		assert.NoError(t, bot.HandleUpdate(t.Context(), message(privateChatID, ChatPrivate, "/link k7wq-2mzp")))
+
+		if assert.Len(t, client.sent, 1) {
+			assert.Equal(t, privateChatID, client.sent[0].chatID)
+			assert.Contains(t, client.sent[0].text, "Linked to Juan Dela Cruz")
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - username does not match the profile", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM telegram_link_codes WHERE code_hash").
+			WillReturnRows(sqlmock.NewRows(codeColumns).AddRow(hashCode("K7WQ2MZP"), 12312345, time.Now().Add(time.Minute), time.Now()))
+		mock.ExpectQuery("SELECT (.+) FROM members m").
+			WillReturnRows(memberInfoRow("someone_else"))
+
+		bot, client := newTestBot(db)
+		assert.NoError(t, bot.HandleUpdate(t.Context(), message(privateChatID, ChatPrivate, "/link K7WQ2MZP")))
+
+		if assert.Len(t, client.sent, 1) {
+			assert.Contains(t, client.sent[0].text, "doesn't match")
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - sent in a group", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		bot, client := newTestBot(db)
+		assert.NoError(t, bot.HandleUpdate(t.Context(), message(groupChatID, "supergroup", "/link@lscs_bot K7WQ2MZP")))
+
+		if assert.Len(t, client.sent, 1) {
+			assert.Contains(t, client.sent[0].text, "private chat")
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestBotLookups(t *testing.T) {
+	t.Run("fail - account not linked", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM telegram_links WHERE telegram_user_id").
+			WillReturnError(sql.ErrNoRows)
+
+		bot, client := newTestBot(db)
+		assert.NoError(t, bot.HandleUpdate(t.Context(), message(groupChatID, "group", "/whois Juan")))
+
+		if assert.Len(t, client.sent, 1) {
+			assert.Contains(t, client.sent[0].text, "Link your Telegram account first")
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - whois by name", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectLinked(mock)
+		mock.ExpectQuery("SELECT (.+) FROM members m WHERE m.full_name LIKE").
+			WithArgs("%dela\\_cruz%", sql.NullString{String: "%dela\\_cruz%", Valid: true}, int32(maxSearchResults+1)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "nickname", "position_id", "committee_id"}).
+				AddRow(12312345, "Juan Dela Cruz", "Juan", "VP", "RND"))
+		mock.ExpectQuery("SELECT (.+) FROM members m").
+			WithArgs(int32(12312345)).
+			WillReturnRows(memberInfoRow("juandc"))
+
+		bot, client := newTestBot(db)
+		assert.NoError(t, bot.HandleUpdate(t.Context(), message(groupChatID, "group", "/whois@lscs_bot dela_cruz")))
+
+		if assert.Len(t, client.sent, 1) {
+			text := client.sent[0].text
+			assert.Contains(t, text, "Juan Dela Cruz (Juan)")
+			assert.Contains(t, text, "Committee: Research and Development (RND)")
+			// contact details are left out of /whois
+			assert.NotContains(t, text, "+639123456789")
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - several matches", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectLinked(mock)
+		mock.ExpectQuery("SELECT (.+) FROM members m WHERE m.full_name LIKE").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "nickname", "position_id", "committee_id"}).
+				AddRow(12312345, "Juan Dela Cruz", nil, nil, nil).
+				AddRow(12299999, "Juan Luna", nil, nil, nil))
+
+		bot, client := newTestBot(db)
+		assert.NoError(t, bot.HandleUpdate(t.Context(), message(privateChatID, ChatPrivate, "/whois juan")))
+
+		if assert.Len(t, client.sent, 1) {
+			assert.Contains(t, client.sent[0].text, "12299999 - Juan Luna")
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - contact in a private chat", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectLinked(mock)
+		mock.ExpectQuery("SELECT (.+) FROM members m").
+			WithArgs(int32(12312345)).
+			WillReturnRows(memberInfoRow("https://t.me/JuanDC"))
+
+		bot, client := newTestBot(db)
+		assert.NoError(t, bot.HandleUpdate(t.Context(), message(privateChatID, ChatPrivate, "/contact 12312345")))
+
+		if assert.Len(t, client.sent, 1) {
+			text := client.sent[0].text
+			assert.Contains(t, text, "Contact number: +639123456789")
+			assert.Contains(t, text, "Telegram: @juandc")
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - contact in a group", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectLinked(mock)
+
+		bot, client := newTestBot(db)
+		assert.NoError(t, bot.HandleUpdate(t.Context(), message(groupChatID, "group", "/contact 12312345")))
+
+		if assert.Len(t, client.sent, 1) {
+			assert.Contains(t, client.sent[0].text, "only sent in a private chat")
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - head of a committee", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectLinked(mock)
+		termColumns := []string{"id", "term", "start_year", "end_year", "start_date", "end_date", "is_active"}
+		for i := 0; i < 2; i++ {
+			mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").
+				WillReturnRows(sqlmock.NewRows(termColumns).AddRow(3, 1, 2025, 2026, nil, nil, true))
+		}
+		mock.ExpectQuery("SELECT (.+) FROM divisions d").
+			WillReturnRows(sqlmock.NewRows([]string{"division_id", "division_name", "division_head", "head_name"}))
+		mock.ExpectQuery("SELECT (.+) FROM committees c").
+			WillReturnRows(sqlmock.NewRows([]string{"committee_id", "committee_name", "committee_head", "division_id", "head_name"}).
+				AddRow("RND", "Research and Development", 6, nil, "Maria Clara"))
+		mock.ExpectQuery("SELECT (.+) FROM positions ORDER BY").
+			WillReturnRows(sqlmock.NewRows([]string{"position_id", "position_name", "position_rank"}).
+				AddRow("PRES", "President", 7).
+				AddRow("AVP", "Associate Vice President", 4))
+		mock.ExpectQuery("SELECT (.+) FROM members m WHERE").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "nickname", "image_url", "position_id", "committee_id"}).
+				AddRow(1, "Member", nil, nil, "MEM", "RND").
+				AddRow(2, "President", nil, nil, "PRES", nil).
+				AddRow(6, "Maria Clara", nil, nil, "AVP", "RND"))
+
+		bot, client := newTestBot(db)
+		assert.NoError(t, bot.HandleUpdate(t.Context(), message(groupChatID, "group", "/head rnd")))
+
+		if assert.Len(t, client.sent, 1) {
+			assert.Equal(t, "Research and Development (RND) is headed by Maria Clara, Associate Vice President (ID 6).", client.sent[0].text)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestBotIgnores(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	bot, client := newTestBot(db)
+	// plain messages and other bots' commands in groups
+	assert.NoError(t, bot.HandleUpdate(t.Context(), message(groupChatID, "group", "who heads RND?")))
+	assert.NoError(t, bot.HandleUpdate(t.Context(), message(groupChatID, "group", "/roll@dice_bot")))
+	assert.NoError(t, bot.HandleUpdate(t.Context(), Update{UpdateID: 2}))
+	assert.Empty(t, client.sent)
+
+	assert.NoError(t, bot.HandleUpdate(t.Context(), message(privateChatID, ChatPrivate, "/start")))
+	if assert.Len(t, client.sent, 1) {
+		assert.True(t, strings.HasPrefix(client.sent[0].text, "LSCS Core bot"))
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGenerateCode(t *testing.T) {
+	code, hash, err := generateCode()
+	assert.NoError(t, err)
+	assert.Len(t, code, codeLength)
+	assert.Equal(t, hash, hashCode(strings.ToLower(code[:4])+"-"+code[4:]))
+	assert.Equal(t, "juandc", NormalizeUsername(" https://t.me/JuanDC"))
+}
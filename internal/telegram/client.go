@@ -0,0 +1,99 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const apiBaseURL = "https://api.telegram.org"
+
+// Update is an incoming update from the Bot API. Only text messages are handled.
+type Update struct {
+	UpdateID int64    `json:"update_id"`
+	Message  *Message `json:"message,omitempty"`
+}
+
+type Message struct {
+	MessageID int64  `json:"message_id"`
+	From      *User  `json:"from,omitempty"`
+	Chat      Chat   `json:"chat"`
+	Text      string `json:"text,omitempty"`
+}
+
+type User struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username,omitempty"`
+	IsBot    bool   `json:"is_bot"`
+}
+
+// Chat types
+const (
+	ChatPrivate = "private"
+)
+
+type Chat struct {
+	ID   int64  `json:"id"`
+	Type string `json:"type"`
+}
+
+// Client is the subset of the Telegram Bot API used by the bot
+type Client interface {
+	SendMessage(ctx context.Context, chatID int64, text string) error
+}
+
+// HTTPClient calls the Telegram Bot API
+type HTTPClient struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+func NewHTTPClient(token string) *HTTPClient {
+	return &HTTPClient{
+		baseURL: apiBaseURL,
+		token:   token,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// SendMessage sends a plain text message to a chat
+func (c *HTTPClient) SendMessage(ctx context.Context, chatID int64, text string) error {
+	body, err := json.Marshal(map[string]any{
+		"chat_id":                  chatID,
+		"text":                     text,
+		"disable_web_page_preview": true,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/bot"+c.token+"/sendMessage", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		OK          bool   `json:"ok"`
+		Description string `json:"description"`
+	}
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return fmt.Errorf("telegram sendMessage: %d: %s", resp.StatusCode, respBody)
+	}
+	if !result.OK {
+		return fmt.Errorf("telegram sendMessage: %d: %s", resp.StatusCode, result.Description)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package telegram
+
+import (
+	"time"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+)
+
+// LinkCodeResponse is a one-time code for linking a Telegram account.
+// The member sends "/link <code>" to the bot from the Telegram username on their profile.
+type LinkCodeResponse struct {
+	Code      string    `json:"code" example:"K7WQ2MZP"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// LinkResponse is the Telegram account linked to the authenticated member
+type LinkResponse struct {
+	Linked           bool                   `json:"linked" example:"true"`
+	TelegramUsername helpers.NullableString `json:"telegram_username"`
+	LinkedAt         *time.Time             `json:"linked_at,omitempty"`
+}
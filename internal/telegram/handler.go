@@ -0,0 +1,186 @@
+package telegram
+
+import (
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// secretTokenHeader carries the secret_token set when the webhook was registered
+const secretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// Handler serves the Telegram webhook and lets members link their account
+type Handler struct {
+	dbService database.Service
+	bot       *Bot // nil when the bot isn't configured
+	secret    string
+	codeTTL   time.Duration
+	now       func() time.Time
+}
+
+func NewHandler(cfg *config.Config, dbService database.Service, rbac *auth.RBACService) *Handler {
+	h := &Handler{dbService: dbService, secret: cfg.TelegramWebhookSecret, codeTTL: cfg.TelegramLinkCodeTTL, now: time.Now}
+	if cfg.TelegramBotToken == "" || cfg.TelegramWebhookSecret == "" {
+		log.Info().Msg("Telegram configuration not complete, bot disabled")
+		return h
+	}
+	h.bot = NewBot(dbService, rbac, NewHTTPClient(cfg.TelegramBotToken))
+	return h
+}
+
+// WebhookHandler godoc
+// @Summary Telegram webhook
+// @Description Receives updates from the Telegram Bot API and answers bot commands. Telegram authenticates with the webhook's secret token.
+// @Tags telegram
+// @Accept json
+// @Produce json
+// @Param X-Telegram-Bot-Api-Secret-Token header string true "Webhook secret token"
+// @Success 200 "Update handled"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid update"
+// @Failure 401 {object} helpers.ErrorResponse "Invalid secret token"
+// @Failure 503 {object} helpers.ErrorResponse "Telegram bot not configured"
+// @Router /telegram/webhook [post]
+func (h *Handler) WebhookHandler(c echo.Context) error {
+	if h.bot == nil {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": "Telegram bot not configured"})
+	}
+	token := c.Request().Header.Get(secretTokenHeader)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(h.secret)) != 1 {
+		return helpers.ErrUnauthorized(c, "Invalid secret token")
+	}
+
+	var update Update
+	if err := c.Bind(&update); err != nil {
+		return helpers.ErrBadRequest(c, "Invalid update")
+	}
+
+	// Telegram redelivers updates that aren't acknowledged, so failures are only logged
+	if err := h.bot.HandleUpdate(c.Request().Context(), update); err != nil {
+		log.Error().Err(err).Int64("update_id", update.UpdateID).Msg("failed to handle telegram update")
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// CreateLinkCodeHandler godoc
+// @Summary Get a Telegram link code
+// @Description Issues a one-time code for linking the authenticated member's Telegram account, replacing any previous code. Send "/link <code>" to the bot in a private chat, from the Telegram username on your profile.
+// @Tags telegram
+// @Produce json
+// @Success 201 {object} LinkCodeResponse "Link code"
+// @Failure 400 {object} helpers.ErrorResponse "No Telegram username on the profile"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Failure 503 {object} helpers.ErrorResponse "Telegram bot not configured"
+// @Security SessionAuth
+// @Router /telegram/link-code [post]
+func (h *Handler) CreateLinkCodeHandler(c echo.Context) error {
+	if h.bot == nil {
+		return c.JSON(http.StatusServiceUnavailable, map[string]string{"error": "Telegram bot not configured"})
+	}
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return helpers.ErrUnauthorized(c, "")
+	}
+
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	member, err := q.GetMemberInfoById(ctx, principal.MemberID)
+	if err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to get member for telegram link code")
+		return helpers.ErrInternal(c, "")
+	}
+	if NormalizeUsername(member.Telegram.String) == "" {
+		return helpers.ErrBadRequest(c, "Add your Telegram username to your profile first")
+	}
+
+	code, hash, err := generateCode()
+	if err != nil {
+		log.Error().Err(err).Msg("failed to generate telegram link code")
+		return helpers.ErrInternal(c, "")
+	}
+	expiresAt := h.now().Add(h.codeTTL).UTC()
+	if err := q.CreateTelegramLinkCode(ctx, repository.CreateTelegramLinkCodeParams{
+		CodeHash:  hash,
+		MemberID:  principal.MemberID,
+		ExpiresAt: expiresAt,
+	}); err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to store telegram link code")
+		return helpers.ErrInternal(c, "")
+	}
+
+	return c.JSON(http.StatusCreated, LinkCodeResponse{Code: code, ExpiresAt: expiresAt})
+}
+
+// GetLinkHandler godoc
+// @Summary Get my Telegram link
+// @Description Returns the Telegram account linked to the authenticated member, if any
+// @Tags telegram
+// @Produce json
+// @Success 200 {object} LinkResponse "Telegram link"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /telegram/link [get]
+func (h *Handler) GetLinkHandler(c echo.Context) error {
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return helpers.ErrUnauthorized(c, "")
+	}
+
+	q := repository.New(h.dbService.GetConnection())
+	link, err := q.GetTelegramLink(c.Request().Context(), principal.MemberID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return c.JSON(http.StatusOK, LinkResponse{})
+		}
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to get telegram link")
+		return helpers.ErrInternal(c, "")
+	}
+
+	resp := LinkResponse{Linked: true, TelegramUsername: helpers.NullableString{NullString: link.TelegramUsername}}
+	if link.LinkedAt.Valid {
+		resp.LinkedAt = &link.LinkedAt.Time
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// UnlinkHandler godoc
+// @Summary Unlink my Telegram account
+// @Description Unlinks the Telegram account of the authenticated member
+// @Tags telegram
+// @Success 204 "Unlinked"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "No linked Telegram account"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /telegram/link [delete]
+func (h *Handler) UnlinkHandler(c echo.Context) error {
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return helpers.ErrUnauthorized(c, "")
+	}
+
+	q := repository.New(h.dbService.GetConnection())
+	rows, err := q.DeleteTelegramLink(c.Request().Context(), principal.MemberID)
+	if err != nil {
+		log.Error().Err(err).Int32("member_id", principal.MemberID).Msg("failed to unlink telegram account")
+		return helpers.ErrInternal(c, "")
+	}
+	if rows == 0 {
+		return helpers.ErrNotFound(c, "No linked Telegram account")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
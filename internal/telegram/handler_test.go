@@ -0,0 +1,116 @@
+package telegram
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+)
+
+func TestWebhookHandler(t *testing.T) {
+	newRequest := func(secret string) (echo.Context, *httptest.ResponseRecorder) {
+		body := `{"update_id":1,"message":{"message_id":1,"from":{"id":5550001,"username":"JuanDC"},"chat":{"id":5550001,"type":"private"},"text":"/help"}}`
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/telegram/webhook", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(secretTokenHeader, secret)
+		rec := httptest.NewRecorder()
+		return e.NewContext(req, rec), rec
+	}
+
+	t.Run("success", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		bot, client := newTestBot(db)
+		h := &Handler{bot: bot, secret: "s3cret"}
+
+		c, rec := newRequest("s3cret")
+		if assert.NoError(t, h.WebhookHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+		if assert.Len(t, client.sent, 1) {
+			assert.Equal(t, int64(5550001), client.sent[0].chatID)
+		}
+	})
+
+	t.Run("fail - wrong secret token", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		bot, client := newTestBot(db)
+		h := &Handler{bot: bot, secret: "s3cret"}
+
+		c, rec := newRequest("guess")
+		if assert.NoError(t, h.WebhookHandler(c)) {
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		}
+		assert.Empty(t, client.sent)
+	})
+}
+
+func TestCreateLinkCodeHandler(t *testing.T) {
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/telegram/link-code", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		auth.SetPrincipal(c, &auth.Principal{MemberID: 12312345, Method: auth.AuthMethodSession})
+		return c, rec
+	}
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+		mock.ExpectQuery("SELECT (.+) FROM members m").
+			WithArgs(int32(12312345)).
+			WillReturnRows(memberInfoRow("@juandc"))
+		mock.ExpectExec("INSERT INTO telegram_link_codes").
+			WithArgs(sqlmock.AnyArg(), int32(12312345), now.Add(10*time.Minute)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		bot, _ := newTestBot(db)
+		h := &Handler{dbService: &mockDBService{db: db}, bot: bot, codeTTL: 10 * time.Minute, now: func() time.Time { return now }}
+
+		c, rec := newContext()
+		if assert.NoError(t, h.CreateLinkCodeHandler(c)) {
+			assert.Equal(t, http.StatusCreated, rec.Code)
+			var resp LinkCodeResponse
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Len(t, resp.Code, codeLength)
+			assert.Equal(t, now.Add(10*time.Minute), resp.ExpiresAt)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - no telegram username on the profile", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM members m").
+			WillReturnRows(memberInfoRow(nil))
+
+		bot, _ := newTestBot(db)
+		h := &Handler{dbService: &mockDBService{db: db}, bot: bot, codeTTL: 10 * time.Minute, now: time.Now}
+
+		c, rec := newContext()
+		if assert.NoError(t, h.CreateLinkCodeHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,52 @@
+package telegram
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// codeAlphabet leaves out characters that are easy to mix up (0/O, 1/I/L)
+const (
+	codeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+	codeLength   = 8
+)
+
+// generateCode creates a one-time link code and the hash stored for it
+func generateCode() (code, hash string, err error) {
+	// bytes at or above limit are skipped so every character is equally likely
+	limit := 256 - 256%len(codeAlphabet)
+	var b strings.Builder
+	buf := make([]byte, codeLength)
+	for b.Len() < codeLength {
+		if _, err := rand.Read(buf); err != nil {
+			return "", "", err
+		}
+		for _, v := range buf {
+			if int(v) < limit && b.Len() < codeLength {
+				b.WriteByte(codeAlphabet[int(v)%len(codeAlphabet)])
+			}
+		}
+	}
+	code = b.String()
+	return code, hashCode(code), nil
+}
+
+// hashCode returns the SHA-256 hash of a link code, as stored in telegram_link_codes.
+// Codes are case-insensitive and may be typed with spaces or dashes.
+func hashCode(code string) string {
+	normalized := strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(code))
+	hash := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(hash[:])
+}
+
+// NormalizeUsername reduces a Telegram username as stored on a profile
+// (@handle, handle or a t.me link) to the lowercase handle
+func NormalizeUsername(username string) string {
+	username = strings.TrimSpace(username)
+	for _, prefix := range []string{"https://t.me/", "http://t.me/", "t.me/"} {
+		username = strings.TrimPrefix(username, prefix)
+	}
+	return strings.ToLower(strings.TrimPrefix(username, "@"))
+}
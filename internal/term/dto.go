@@ -0,0 +1,146 @@
+package term
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// dateFormat is the format of term start and end dates
+const dateFormat = "2006-01-02"
+
+// TermRequest represents the request body for creating or updating a term
+type TermRequest struct {
+	Term      int32   `json:"term" validate:"required,min=1,max=3" example:"1"`
+	StartYear int32   `json:"start_year" validate:"required,min=2000,max=2100" example:"2025"`
+	EndYear   int32   `json:"end_year" validate:"required,min=2000,max=2100" example:"2026"`
+	StartDate *string `json:"start_date,omitempty" validate:"omitempty,datetime=2006-01-02" example:"2025-09-01"`
+	EndDate   *string `json:"end_date,omitempty" validate:"omitempty,datetime=2006-01-02" example:"2025-12-13"`
+}
+
+// RolloverRequest represents the request body for a term rollover.
+// Give either the ID of an existing term or the new term to create.
+type RolloverRequest struct {
+	TermID *int32       `json:"term_id,omitempty" validate:"omitempty,gt=0" example:"2"`
+	Term   *TermRequest `json:"term,omitempty"`
+}
+
+// TermResponse represents an academic term
+type TermResponse struct {
+	ID        int32   `json:"id" example:"1"`
+	Term      int32   `json:"term" example:"1"`
+	StartYear int32   `json:"start_year" example:"2025"`
+	EndYear   int32   `json:"end_year" example:"2026"`
+	Name      string  `json:"name" example:"AY 2025-2026 Term 1"`
+	StartDate *string `json:"start_date,omitempty" example:"2025-09-01"`
+	EndDate   *string `json:"end_date,omitempty" example:"2025-12-13"`
+	Active    bool    `json:"active" example:"true"`
+}
+
+// ListTermsResponse lists the terms, newest first
+type ListTermsResponse struct {
+	Terms     []TermResponse `json:"terms"`
+	CurrentID *int32         `json:"current_id,omitempty" example:"1"`
+}
+
+// RosterEntryResponse represents an officer in a term's roster
+type RosterEntryResponse struct {
+	MemberID    int32                  `json:"member_id" example:"12312345"`
+	FullName    string                 `json:"full_name" example:"Juan Dela Cruz"`
+	Email       string                 `json:"email" example:"juan_delacruz@dlsu.edu.ph"`
+	PositionID  helpers.NullableString `json:"position_id"`
+	CommitteeID helpers.NullableString `json:"committee_id"`
+}
+
+// RosterResponse is the officer roster of a term. The roster of the current term is the
+// live one from member positions; past terms have the roster archived on rollover.
+type RosterResponse struct {
+	Term     TermResponse          `json:"term"`
+	Archived bool                  `json:"archived" example:"false"`
+	Officers []RosterEntryResponse `json:"officers"`
+}
+
+// SetRankRequest represents the request body for setting a position's rank in a past term
+type SetRankRequest struct {
+	Rank int32 `json:"rank" validate:"gte=0,lte=100" example:"5"`
+}
+
+// RankResponse represents the rank of a position in a term
+type RankResponse struct {
+	PositionID string `json:"position_id" example:"VP"`
+	Rank       int32  `json:"rank" example:"5"`
+}
+
+// RanksResponse is the position hierarchy of a term, highest rank first. The current term uses
+// the ranks of the positions; past terms have the ranks archived on rollover, which can be edited.
+type RanksResponse struct {
+	Term     TermResponse   `json:"term"`
+	Archived bool           `json:"archived" example:"true"`
+	Ranks    []RankResponse `json:"ranks"`
+}
+
+// RolloverResponse describes a completed term rollover. Archived is the number of members
+// archived as the previous term's membership.
+type RolloverResponse struct {
+	Previous *TermResponse `json:"previous,omitempty"`
+	Current  TermResponse  `json:"current"`
+	Archived int64         `json:"archived" example:"120"`
+}
+
+func toTermResponse(t repository.Term) TermResponse {
+	return TermResponse{
+		ID:        t.ID,
+		Term:      t.Term,
+		StartYear: t.StartYear,
+		EndYear:   t.EndYear,
+		Name:      Name(t),
+		StartDate: formatDate(t.StartDate),
+		EndDate:   formatDate(t.EndDate),
+		Active:    t.IsActive,
+	}
+}
+
+func formatDate(d sql.NullTime) *string {
+	if !d.Valid {
+		return nil
+	}
+	s := d.Time.Format(dateFormat)
+	return &s
+}
+
+// termParams validates a term request and converts it to the columns of the terms table.
+// msg describes the problem when the request is invalid.
+func termParams(req TermRequest) (params repository.CreateTermParams, msg string) {
+	if req.EndYear != req.StartYear+1 {
+		return params, "end_year must be the year after start_year"
+	}
+
+	params = repository.CreateTermParams{
+		Term:      req.Term,
+		StartYear: req.StartYear,
+		EndYear:   req.EndYear,
+		StartDate: parseDate(req.StartDate),
+		EndDate:   parseDate(req.EndDate),
+	}
+	if params.StartDate.Valid != params.EndDate.Valid {
+		return params, "start_date and end_date must be given together"
+	}
+	if params.StartDate.Valid && !params.StartDate.Time.Before(params.EndDate.Time) {
+		return params, "start_date must be before end_date"
+	}
+	return params, ""
+}
+
+// parseDate parses an optional date already validated as YYYY-MM-DD
+func parseDate(s *string) sql.NullTime {
+	if s == nil {
+		return sql.NullTime{}
+	}
+	t, err := time.Parse(dateFormat, *s)
+	if err != nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t, Valid: true}
+}
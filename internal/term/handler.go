@@ -0,0 +1,635 @@
+package term
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// Handler manages academic terms, the active term and term rollover.
+// Changes are restricted to admins by the routes.
+type Handler struct {
+	dbService database.Service
+	resolver  *Resolver
+	service   *Service
+}
+
+func NewHandler(dbService database.Service) *Handler {
+	return &Handler{
+		dbService: dbService,
+		resolver:  NewResolver(dbService),
+		service:   NewService(dbService),
+	}
+}
+
+// ListTermsHandler lists the terms
+// @Summary List terms
+// @Description List the academic terms, newest first, with the ID of the current term
+// @Tags terms
+// @Produce json
+// @Success 200 {object} ListTermsResponse "List of terms"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /terms [get]
+func (h *Handler) ListTermsHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	terms, err := q.ListTerms(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list terms")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	resp := ListTermsResponse{Terms: make([]TermResponse, 0, len(terms))}
+	for _, t := range terms {
+		resp.Terms = append(resp.Terms, toTermResponse(t))
+	}
+
+	if len(terms) > 0 {
+		current, err := h.resolver.Current(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to resolve current term")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+		}
+		resp.CurrentID = &current.ID
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetCurrentTermHandler returns the current term
+// @Summary Get current term
+// @Description Get the current term: the term marked active, else the term whose dates include today, else the latest term
+// @Tags terms
+// @Produce json
+// @Success 200 {object} TermResponse "Current term"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "No terms"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /terms/current [get]
+func (h *Handler) GetCurrentTermHandler(c echo.Context) error {
+	t, err := h.resolver.Current(c.Request().Context())
+	if err != nil {
+		if errors.Is(err, ErrNoTerm) {
+			return c.JSON(http.StatusNotFound, map[string]string{"error": "No terms"})
+		}
+		log.Error().Err(err).Msg("failed to resolve current term")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return c.JSON(http.StatusOK, toTermResponse(t))
+}
+
+// GetTermHandler returns a term
+// @Summary Get term
+// @Description Get a term by ID
+// @Tags terms
+// @Produce json
+// @Param id path int true "Term ID"
+// @Success 200 {object} TermResponse "Term"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid term ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "Term not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /terms/{id} [get]
+func (h *Handler) GetTermHandler(c echo.Context) error {
+	id, err := parseTermID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid term ID"})
+	}
+	return h.respondWithTerm(c, http.StatusOK, id)
+}
+
+// GetRosterHandler returns the officer roster of a term
+// @Summary Get term roster
+// @Description Get the officers of a term. The current term's roster comes from member positions; past terms have the roster archived when the term was rolled over. Terms that have not started have no roster.
+// @Tags terms
+// @Produce json
+// @Param id path string true "Term ID or \"current\""
+// @Success 200 {object} RosterResponse "Officer roster"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid term ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "Term not found, not started or its roster not archived"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /terms/{id}/roster [get]
+func (h *Handler) GetRosterHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	t, archived, ok, err := h.archivedTerm(c, c.Param("id"))
+	if !ok {
+		return err
+	}
+
+	resp := RosterResponse{
+		Term:     toTermResponse(t),
+		Archived: archived,
+		Officers: []RosterEntryResponse{},
+	}
+
+	if !resp.Archived {
+		officers, err := q.ListOfficers(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to list officers")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+		}
+		for _, o := range officers {
+			resp.Officers = append(resp.Officers, RosterEntryResponse{
+				MemberID:    o.ID,
+				FullName:    o.FullName,
+				Email:       o.Email,
+				PositionID:  helpers.NullableString{NullString: o.PositionID},
+				CommitteeID: helpers.NullableString{NullString: o.CommitteeID},
+			})
+		}
+		return c.JSON(http.StatusOK, resp)
+	}
+
+	roster, err := q.ListTermRoster(ctx, t.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("term_id", t.ID).Msg("failed to list term roster")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	for _, r := range roster {
+		resp.Officers = append(resp.Officers, RosterEntryResponse{
+			MemberID:    r.MemberID,
+			FullName:    r.FullName,
+			Email:       r.Email,
+			PositionID:  helpers.NullableString{NullString: r.PositionID},
+			CommitteeID: helpers.NullableString{NullString: r.CommitteeID},
+		})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetRanksHandler returns the position hierarchy of a term
+// @Summary Get term ranks
+// @Description Get the rank of each position in a term, highest first. The current term uses the ranks of the positions; past terms have the ranks archived when the term was rolled over. Org charts of past terms are drawn with these ranks.
+// @Tags terms
+// @Produce json
+// @Param id path string true "Term ID or \"current\""
+// @Success 200 {object} RanksResponse "Position ranks"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid term ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "Term not found, not started or its roster not archived"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /terms/{id}/ranks [get]
+func (h *Handler) GetRanksHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	t, archived, ok, err := h.archivedTerm(c, c.Param("id"))
+	if !ok {
+		return err
+	}
+
+	resp := RanksResponse{
+		Term:     toTermResponse(t),
+		Archived: archived,
+		Ranks:    []RankResponse{},
+	}
+
+	if !resp.Archived {
+		positions, err := q.ListPositions(ctx)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to list positions")
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+		}
+		for _, p := range positions {
+			resp.Ranks = append(resp.Ranks, RankResponse{PositionID: p.PositionID, Rank: p.PositionRank})
+		}
+		return c.JSON(http.StatusOK, resp)
+	}
+
+	ranks, err := q.ListTermPositionRanks(ctx, t.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("term_id", t.ID).Msg("failed to list term ranks")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	for _, r := range ranks {
+		resp.Ranks = append(resp.Ranks, RankResponse{PositionID: r.PositionID, Rank: r.PositionRank})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// SetRankHandler sets the rank of a position in a past term
+// @Summary Set term rank
+// @Description Set the rank of a position in a past term, e.g. to draw its org chart with the hierarchy it had. Ranks of the current term are changed on the position. Admin only.
+// @Tags terms
+// @Accept json
+// @Produce json
+// @Param id path int true "Term ID"
+// @Param position_id path string true "Position ID"
+// @Param request body SetRankRequest true "Rank"
+// @Success 200 {object} RankResponse "Position rank"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Term not found, not started or its roster not archived"
+// @Failure 409 {object} helpers.ErrorResponse "Term is the current term"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /terms/{id}/ranks/{position_id} [put]
+func (h *Handler) SetRankHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	if _, err := parseTermID(c); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid term ID"})
+	}
+	positionID := strings.ToUpper(strings.TrimSpace(c.Param("position_id")))
+	if positionID == "" || len(positionID) > 10 {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid position ID"})
+	}
+
+	var req SetRankRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	t, archived, ok, err := h.archivedTerm(c, c.Param("id"))
+	if !ok {
+		return err
+	}
+	if !archived {
+		return c.JSON(http.StatusConflict, map[string]string{"error": "The current term uses the position ranks, change the rank on the position"})
+	}
+
+	if err := q.SetTermPositionRank(c.Request().Context(), repository.SetTermPositionRankParams{
+		TermID:       t.ID,
+		PositionID:   positionID,
+		PositionRank: req.Rank,
+	}); err != nil {
+		log.Error().Err(err).Int32("term_id", t.ID).Str("position_id", positionID).Msg("failed to set term rank")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().
+		Int32("term_id", t.ID).
+		Str("position_id", positionID).
+		Int32("rank", req.Rank).
+		Int32("updated_by", principalID(c)).
+		Msg("term rank set")
+
+	return c.JSON(http.StatusOK, RankResponse{PositionID: positionID, Rank: req.Rank})
+}
+
+// CreateTermHandler creates a term
+// @Summary Create term
+// @Description Create an academic term. The new term is not active until it is activated or rolled over to. Admin only.
+// @Tags terms
+// @Accept json
+// @Produce json
+// @Param request body TermRequest true "Term"
+// @Success 201 {object} TermResponse "Created term"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 409 {object} helpers.ErrorResponse "Term already exists"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /terms [post]
+func (h *Handler) CreateTermHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	var req TermRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+	params, msg := termParams(req)
+	if msg != "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": msg})
+	}
+
+	if exists, err := h.termExists(c, params, 0); err != nil {
+		return err
+	} else if exists {
+		return c.JSON(http.StatusConflict, map[string]string{"error": "Term already exists"})
+	}
+
+	id, err := q.CreateTerm(ctx, params)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create term")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().Int64("term_id", id).Int32("created_by", principalID(c)).Msg("term created")
+
+	return h.respondWithTerm(c, http.StatusCreated, int32(id))
+}
+
+// UpdateTermHandler replaces a term
+// @Summary Update term
+// @Description Replace a term's number, years and dates. Admin only.
+// @Tags terms
+// @Accept json
+// @Produce json
+// @Param id path int true "Term ID"
+// @Param request body TermRequest true "Term"
+// @Success 200 {object} TermResponse "Updated term"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Term not found"
+// @Failure 409 {object} helpers.ErrorResponse "Term already exists"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /terms/{id} [put]
+func (h *Handler) UpdateTermHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	id, err := parseTermID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid term ID"})
+	}
+
+	var req TermRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+	params, msg := termParams(req)
+	if msg != "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": msg})
+	}
+
+	if _, err := q.GetTerm(ctx, id); err != nil {
+		return h.termError(c, err, id)
+	}
+	if exists, err := h.termExists(c, params, id); err != nil {
+		return err
+	} else if exists {
+		return c.JSON(http.StatusConflict, map[string]string{"error": "Term already exists"})
+	}
+
+	if err := q.UpdateTerm(ctx, repository.UpdateTermParams{
+		Term:      params.Term,
+		StartYear: params.StartYear,
+		EndYear:   params.EndYear,
+		StartDate: params.StartDate,
+		EndDate:   params.EndDate,
+		ID:        id,
+	}); err != nil {
+		log.Error().Err(err).Int32("term_id", id).Msg("failed to update term")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().Int32("term_id", id).Int32("updated_by", principalID(c)).Msg("term updated")
+
+	return h.respondWithTerm(c, http.StatusOK, id)
+}
+
+// DeleteTermHandler deletes a term
+// @Summary Delete term
+// @Description Delete a term. The active term and terms with events or house points cannot be deleted. Admin only.
+// @Tags terms
+// @Produce json
+// @Param id path int true "Term ID"
+// @Success 200 {object} map[string]string "Term deleted"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid term ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Term not found"
+// @Failure 409 {object} helpers.ErrorResponse "Term is active or in use"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /terms/{id} [delete]
+func (h *Handler) DeleteTermHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	id, err := parseTermID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid term ID"})
+	}
+
+	t, err := q.GetTerm(ctx, id)
+	if err != nil {
+		return h.termError(c, err, id)
+	}
+	if t.IsActive {
+		return c.JSON(http.StatusConflict, map[string]string{"error": "The active term cannot be deleted"})
+	}
+
+	if _, err := q.DeleteTerm(ctx, id); err != nil {
+		if helpers.IsForeignKeyViolation(err) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": "Term is in use and cannot be deleted"})
+		}
+		log.Error().Err(err).Int32("term_id", id).Msg("failed to delete term")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().Int32("term_id", id).Int32("deleted_by", principalID(c)).Msg("term deleted")
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Term deleted successfully"})
+}
+
+// ActivateTermHandler marks a term as the active term
+// @Summary Activate term
+// @Description Make a term the active (current) term without archiving the roster, e.g. to correct a rollover. Refused while the current term's roster is not archived; use rollover when a new term starts. Admin only.
+// @Tags terms
+// @Produce json
+// @Param id path int true "Term ID"
+// @Success 200 {object} TermResponse "Active term"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid term ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Term not found"
+// @Failure 409 {object} helpers.ErrorResponse "Roster of the current term is not archived"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /terms/{id}/activate [post]
+func (h *Handler) ActivateTermHandler(c echo.Context) error {
+	id, err := parseTermID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid term ID"})
+	}
+
+	if err := h.service.Activate(c.Request().Context(), id); err != nil {
+		if errors.Is(err, ErrRosterNotArchived) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": "The current term's roster is not archived, roll over to start a new term"})
+		}
+		return h.termError(c, err, id)
+	}
+
+	log.Info().Int32("term_id", id).Int32("activated_by", principalID(c)).Msg("term activated")
+
+	return h.respondWithTerm(c, http.StatusOK, id)
+}
+
+// RolloverHandler starts a new term
+// @Summary Roll over to a new term
+// @Description Archive the membership and officer roster of the current term and make the new term active. The new term is an existing term (term_id) or created from "term". Member positions are not changed. Admin only, requires a recent login.
+// @Tags terms
+// @Accept json
+// @Produce json
+// @Param request body RolloverRequest true "New term"
+// @Success 200 {object} RolloverResponse "Rollover result"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Term not found"
+// @Failure 409 {object} helpers.ErrorResponse "Term already exists or is already current"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /terms/rollover [post]
+func (h *Handler) RolloverHandler(c echo.Context) error {
+	var req RolloverRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+	if (req.TermID == nil) == (req.Term == nil) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Give either term_id or term"})
+	}
+
+	var termID int32
+	var params repository.CreateTermParams
+	if req.TermID != nil {
+		termID = *req.TermID
+	} else {
+		if validationErr := helpers.ValidateStruct(req.Term); validationErr != nil {
+			return helpers.ErrValidation(c, validationErr)
+		}
+		var msg string
+		if params, msg = termParams(*req.Term); msg != "" {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": msg})
+		}
+		if exists, err := h.termExists(c, params, 0); err != nil {
+			return err
+		} else if exists {
+			return c.JSON(http.StatusConflict, map[string]string{"error": "Term already exists, roll over with its term_id"})
+		}
+	}
+
+	result, err := h.service.Rollover(c.Request().Context(), termID, params)
+	if err != nil {
+		if errors.Is(err, ErrSameTerm) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": "Term is already the current term"})
+		}
+		return h.termError(c, err, termID)
+	}
+
+	log.Info().
+		Int32("previous_term_id", result.Previous.ID).
+		Int32("term_id", result.Current.ID).
+		Int64("archived", result.Archived).
+		Int32("rolled_over_by", principalID(c)).
+		Msg("term rolled over")
+
+	resp := RolloverResponse{
+		Current:  toTermResponse(result.Current),
+		Archived: result.Archived,
+	}
+	if result.Previous.ID != 0 {
+		previous := toTermResponse(result.Previous)
+		resp.Previous = &previous
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// archivedTerm resolves a term ID or "current" and returns the term and whether its roster is archived.
+// If ok is false, the error response was already written.
+func (h *Handler) archivedTerm(c echo.Context, param string) (t repository.Term, archived, ok bool, err error) {
+	ctx := c.Request().Context()
+
+	id, all, err := h.resolver.Resolve(ctx, param)
+	switch {
+	case errors.Is(err, ErrInvalidTerm) || all:
+		return t, false, false, c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid term ID"})
+	case errors.Is(err, ErrNoTerm):
+		return t, false, false, c.JSON(http.StatusNotFound, map[string]string{"error": "Term not found"})
+	case err != nil:
+		log.Error().Err(err).Msg("failed to resolve term")
+		return t, false, false, c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	t, archived, err = h.resolver.Archived(ctx, id)
+	switch {
+	case errors.Is(err, ErrTermNotStarted):
+		return t, false, false, c.JSON(http.StatusNotFound, map[string]string{"error": "Term has not started"})
+	case errors.Is(err, ErrRosterNotArchived):
+		return t, false, false, c.JSON(http.StatusNotFound, map[string]string{"error": "Term roster not archived"})
+	case err != nil:
+		return t, false, false, h.termError(c, err, id)
+	}
+	return t, archived, true, nil
+}
+
+// termExists returns true if another term than exceptID has the same number and start year.
+// If err is not nil, the error response was already written.
+func (h *Handler) termExists(c echo.Context, params repository.CreateTermParams, exceptID int32) (bool, error) {
+	q := repository.New(h.dbService.GetConnection())
+
+	existing, err := q.GetTermByNumber(c.Request().Context(), repository.GetTermByNumberParams{
+		Term:      params.Term,
+		StartYear: params.StartYear,
+	})
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("failed to check term")
+		return false, c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	return existing.ID != exceptID, nil
+}
+
+func (h *Handler) respondWithTerm(c echo.Context, status int, id int32) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	t, err := q.GetTerm(c.Request().Context(), id)
+	if err != nil {
+		return h.termError(c, err, id)
+	}
+	return c.JSON(status, toTermResponse(t))
+}
+
+func (h *Handler) termError(c echo.Context, err error, id int32) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Term not found"})
+	}
+	log.Error().Err(err).Int32("term_id", id).Msg("term operation failed")
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+}
+
+func principalID(c echo.Context) int32 {
+	if principal, ok := auth.GetPrincipal(c); ok {
+		return principal.MemberID
+	}
+	return 0
+}
+
+func parseTermID(c echo.Context) (int32, error) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	return int32(id), err
+}
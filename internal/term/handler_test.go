@@ -0,0 +1,311 @@
+package term
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+)
+
+func newHandler(db *sql.DB) *Handler {
+	h := NewHandler(&mockDBService{db: db})
+	now := func() time.Time { return time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC) }
+	h.resolver.now = now
+	h.service.now = now
+	return h
+}
+
+func newRolloverContext(body string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/terms/rollover", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+	return c, rec
+}
+
+func TestRolloverHandler(t *testing.T) {
+	t.Run("success - archives the membership and ranks of the outgoing term", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").WillReturnRows(termRow(1, 1, true))
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE id = ?").
+			WithArgs(int32(2)).
+			WillReturnRows(termRow(2, 2, false))
+		mock.ExpectQuery("SELECT id, position_id, committee_id FROM members").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "position_id", "committee_id"}).
+				AddRow(10, "PRES", nil).
+				AddRow(11, "VP", "RND").
+				AddRow(12, "MEM", "RND"))
+		mock.ExpectExec("INSERT IGNORE INTO term_rosters").
+			WithArgs(int32(1), int32(10), sql.NullString{String: "PRES", Valid: true}, sql.NullString{}).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT IGNORE INTO term_rosters").
+			WithArgs(int32(1), int32(11), sql.NullString{String: "VP", Valid: true}, sql.NullString{String: "RND", Valid: true}).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT IGNORE INTO term_rosters").
+			WithArgs(int32(1), int32(12), sql.NullString{String: "MEM", Valid: true}, sql.NullString{String: "RND", Valid: true}).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT IGNORE INTO term_position_ranks").
+			WithArgs(int32(1)).
+			WillReturnResult(sqlmock.NewResult(0, 7))
+		mock.ExpectExec("UPDATE terms SET is_active = FALSE").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE terms SET is_active = TRUE").
+			WithArgs(int32(2)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		c, rec := newRolloverContext(`{"term_id":2}`)
+
+		if assert.NoError(t, newHandler(db).RolloverHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var resp RolloverResponse
+			json.Unmarshal(rec.Body.Bytes(), &resp)
+			assert.Equal(t, int64(3), resp.Archived)
+			assert.Equal(t, int32(2), resp.Current.ID)
+			assert.True(t, resp.Current.Active)
+			if assert.NotNil(t, resp.Previous) {
+				assert.Equal(t, int32(1), resp.Previous.ID)
+				assert.False(t, resp.Previous.Active)
+			}
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - already the current term", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").WillReturnRows(termRow(1, 1, true))
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(termRow(1, 1, true))
+		mock.ExpectRollback()
+
+		c, rec := newRolloverContext(`{"term_id":1}`)
+
+		if assert.NoError(t, newHandler(db).RolloverHandler(c)) {
+			assert.Equal(t, http.StatusConflict, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - both term_id and term", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		c, rec := newRolloverContext(`{"term_id":2,"term":{"term":2,"start_year":2025,"end_year":2026}}`)
+
+		if assert.NoError(t, newHandler(db).RolloverHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
+
+func TestGetRosterHandler(t *testing.T) {
+	getRoster := func(t *testing.T, db *sql.DB, id string) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/terms/"+id+"/roster", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+
+		assert.NoError(t, newHandler(db).GetRosterHandler(c))
+		return rec
+	}
+
+	t.Run("success - past term", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(termRow(1, 1, false))
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").WillReturnRows(termRow(2, 2, true))
+		mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM term_rosters").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectQuery("SELECT (.+) FROM term_rosters r").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows([]string{
+				"term_id", "member_id", "position_id", "committee_id", "archived_at", "full_name", "email",
+			}).AddRow(1, 10, "PRES", nil, time.Now(), "Ana Reyes", "ana@dlsu.edu.ph"))
+
+		rec := getRoster(t, db, "1")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp RosterResponse
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.True(t, resp.Archived)
+		if assert.Len(t, resp.Officers, 1) {
+			assert.Equal(t, int32(10), resp.Officers[0].MemberID)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - past term was never rolled over from", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(termRow(1, 1, false))
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").WillReturnRows(termRow(2, 2, true))
+		mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM term_rosters").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+		rec := getRoster(t, db, "1")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Term roster not archived")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - term has not started", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE id = ?").
+			WithArgs(int32(3)).
+			WillReturnRows(termRow(3, 3, false))
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").WillReturnRows(termRow(2, 2, true))
+		mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM term_rosters").
+			WithArgs(int32(3)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+		rec := getRoster(t, db, "3")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Contains(t, rec.Body.String(), "Term has not started")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestActivateTermHandler(t *testing.T) {
+	activate := func(t *testing.T, db *sql.DB, id string) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/terms/"+id+"/activate", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+		auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+
+		assert.NoError(t, newHandler(db).ActivateTermHandler(c))
+		return rec
+	}
+
+	t.Run("success - roster of the current term is archived", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").WillReturnRows(termRow(2, 2, true))
+		mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM term_rosters").
+			WithArgs(int32(2)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectExec("UPDATE terms SET is_active = FALSE").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE terms SET is_active = TRUE").
+			WithArgs(int32(1)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(termRow(1, 1, true))
+
+		rec := activate(t, db, "1")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - roster of the current term is not archived", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").WillReturnRows(termRow(1, 1, true))
+		mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM term_rosters").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+		mock.ExpectRollback()
+
+		rec := activate(t, db, "2")
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSetRankHandler(t *testing.T) {
+	setRank := func(t *testing.T, db *sql.DB, id, positionID, body string) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPut, "/terms/"+id+"/ranks/"+positionID, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id", "position_id")
+		c.SetParamValues(id, positionID)
+		auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+
+		assert.NoError(t, newHandler(db).SetRankHandler(c))
+		return rec
+	}
+
+	t.Run("success - past term", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(termRow(1, 1, false))
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").WillReturnRows(termRow(2, 2, true))
+		mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM term_rosters").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectExec("INSERT INTO term_position_ranks").
+			WithArgs(int32(1), "CT", int32(4)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		rec := setRank(t, db, "1", "ct", `{"rank":4}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp RankResponse
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.Equal(t, RankResponse{PositionID: "CT", Rank: 4}, resp)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - current term", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE id = ?").
+			WithArgs(int32(2)).
+			WillReturnRows(termRow(2, 2, true))
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").WillReturnRows(termRow(2, 2, true))
+
+		rec := setRank(t, db, "2", "CT", `{"rank":4}`)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,150 @@
+package term
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+var (
+	// ErrNoTerm is returned when there are no terms at all
+	ErrNoTerm = errors.New("no terms")
+	// ErrInvalidTerm is returned when a term parameter is neither "current", "all" nor a term ID
+	ErrInvalidTerm = errors.New("invalid term")
+	// ErrTermNotStarted is returned for a term after the current one that has no archived roster
+	ErrTermNotStarted = errors.New("term has not started")
+)
+
+const (
+	// ParamCurrent selects the current term (also the default when no term is given)
+	ParamCurrent = "current"
+	// ParamAll disables term filtering of list endpoints. Membership queries don't accept it:
+	// membership is per term.
+	ParamAll = "all"
+)
+
+// Resolver finds the current term and resolves term parameters of list endpoints,
+// so queries default to the current term
+type Resolver struct {
+	dbService database.Service
+	now       func() time.Time
+}
+
+func NewResolver(dbService database.Service) *Resolver {
+	return &Resolver{dbService: dbService, now: time.Now}
+}
+
+// Current returns the current term: the term marked active, else the term whose dates include today,
+// else the latest term
+func (r *Resolver) Current(ctx context.Context) (repository.Term, error) {
+	return currentTerm(ctx, repository.New(r.dbService.GetConnection()), r.now())
+}
+
+// Resolve parses a term parameter. "" and "current" are the current term, "all" means
+// no filtering (all is true), anything else must be a term ID.
+func (r *Resolver) Resolve(ctx context.Context, param string) (id int32, all bool, err error) {
+	switch param {
+	case ParamAll:
+		return 0, true, nil
+	case "", ParamCurrent:
+		t, err := r.Current(ctx)
+		if err != nil {
+			return 0, false, err
+		}
+		return t.ID, false, nil
+	}
+
+	parsed, err := strconv.ParseInt(param, 10, 32)
+	if err != nil || parsed <= 0 {
+		return 0, false, ErrInvalidTerm
+	}
+	return int32(parsed), false, nil
+}
+
+// Membership resolves the term parameter of membership queries. live is true when the current
+// membership in members applies: for the current term, or when there are no terms yet.
+// Otherwise the membership of term t was archived when it was rolled over from.
+// "all" is rejected with ErrInvalidTerm.
+func (r *Resolver) Membership(ctx context.Context, param string) (t repository.Term, live bool, err error) {
+	if param == "" || param == ParamCurrent {
+		return t, true, nil
+	}
+
+	id, err := strconv.ParseInt(param, 10, 32)
+	if err != nil || id <= 0 {
+		return t, false, ErrInvalidTerm
+	}
+	t, archived, err := r.Archived(ctx, int32(id))
+	return t, !archived, err
+}
+
+// Archived returns a term and whether its roster is archived. The current term's roster is the live one
+// from member positions; terms that were rolled over from have theirs in term_rosters.
+// Earlier terms that were never rolled over from (e.g. from before rollovers) return ErrRosterNotArchived,
+// and later terms that were never current return ErrTermNotStarted.
+func (r *Resolver) Archived(ctx context.Context, id int32) (t repository.Term, archived bool, err error) {
+	q := repository.New(r.dbService.GetConnection())
+
+	t, err = q.GetTerm(ctx, id)
+	if err != nil {
+		return t, false, err
+	}
+	current, err := r.Current(ctx)
+	if err != nil {
+		return t, false, err
+	}
+	if t.ID == current.ID {
+		return t, false, nil
+	}
+
+	hasRoster, err := q.HasTermRoster(ctx, t.ID)
+	if err != nil {
+		return t, false, err
+	}
+	switch {
+	case hasRoster:
+		return t, true, nil
+	case before(t, current):
+		return t, false, ErrRosterNotArchived
+	default:
+		return t, false, ErrTermNotStarted
+	}
+}
+
+// before returns true if term a comes before term b in the academic calendar
+func before(a, b repository.Term) bool {
+	if a.StartYear != b.StartYear {
+		return a.StartYear < b.StartYear
+	}
+	return a.Term < b.Term
+}
+
+func currentTerm(ctx context.Context, q *repository.Queries, now time.Time) (repository.Term, error) {
+	t, err := q.GetActiveTerm(ctx)
+	if err != sql.ErrNoRows {
+		return t, err
+	}
+
+	today := sql.NullTime{Time: now, Valid: true}
+	t, err = q.GetTermByDate(ctx, repository.GetTermByDateParams{StartDate: today, EndDate: today})
+	if err != sql.ErrNoRows {
+		return t, err
+	}
+
+	t, err = q.GetLatestTerm(ctx)
+	if err == sql.ErrNoRows {
+		return t, ErrNoTerm
+	}
+	return t, err
+}
+
+// Name returns the display name of a term, e.g. "AY 2025-2026 Term 1"
+func Name(t repository.Term) string {
+	return fmt.Sprintf("AY %d-%d Term %d", t.StartYear, t.EndYear, t.Term)
+}
@@ -0,0 +1,129 @@
+package term
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockDBService is a mock implementation of the database.Service interface.
+type mockDBService struct {
+	db *sql.DB
+}
+
+func (m *mockDBService) Health() map[string]string {
+	return nil
+}
+
+func (m *mockDBService) Close() error {
+	return nil
+}
+
+func (m *mockDBService) GetConnection() *sql.DB {
+	return m.db
+}
+
+var termColumns = []string{"id", "term", "start_year", "end_year", "start_date", "end_date", "is_active"}
+
+func termRow(id, number int32, active bool) *sqlmock.Rows {
+	return sqlmock.NewRows(termColumns).AddRow(id, number, 2025, 2026, nil, nil, active)
+}
+
+func newResolver(db *sql.DB) *Resolver {
+	r := NewResolver(&mockDBService{db: db})
+	r.now = func() time.Time { return time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC) }
+	return r
+}
+
+func TestResolverCurrent(t *testing.T) {
+	t.Run("active term", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").WillReturnRows(termRow(2, 2, true))
+
+		current, err := newResolver(db).Current(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), current.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("falls back to dates, then latest term", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE start_date").WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery("SELECT (.+) FROM terms ORDER BY").WillReturnRows(termRow(3, 3, false))
+
+		current, err := newResolver(db).Current(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), current.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no terms", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery("SELECT (.+) FROM terms WHERE start_date").WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery("SELECT (.+) FROM terms ORDER BY").WillReturnError(sql.ErrNoRows)
+
+		_, err = newResolver(db).Current(context.Background())
+		assert.ErrorIs(t, err, ErrNoTerm)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestResolverResolve(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	r := newResolver(db)
+
+	_, all, err := r.Resolve(context.Background(), ParamAll)
+	assert.NoError(t, err)
+	assert.True(t, all)
+
+	id, all, err := r.Resolve(context.Background(), "4")
+	assert.NoError(t, err)
+	assert.False(t, all)
+	assert.Equal(t, int32(4), id)
+
+	_, _, err = r.Resolve(context.Background(), "-1")
+	assert.ErrorIs(t, err, ErrInvalidTerm)
+	_, _, err = r.Resolve(context.Background(), "latest")
+	assert.ErrorIs(t, err, ErrInvalidTerm)
+
+	mock.ExpectQuery("SELECT (.+) FROM terms WHERE is_active").WillReturnRows(termRow(2, 2, true))
+	id, _, err = r.Resolve(context.Background(), "")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), id)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTermParams(t *testing.T) {
+	date := func(s string) *string { return &s }
+
+	_, msg := termParams(TermRequest{Term: 1, StartYear: 2025, EndYear: 2027})
+	assert.NotEmpty(t, msg)
+
+	_, msg = termParams(TermRequest{Term: 1, StartYear: 2025, EndYear: 2026, StartDate: date("2025-09-01")})
+	assert.NotEmpty(t, msg)
+
+	_, msg = termParams(TermRequest{Term: 1, StartYear: 2025, EndYear: 2026, StartDate: date("2025-12-13"), EndDate: date("2025-09-01")})
+	assert.NotEmpty(t, msg)
+
+	params, msg := termParams(TermRequest{Term: 1, StartYear: 2025, EndYear: 2026, StartDate: date("2025-09-01"), EndDate: date("2025-12-13")})
+	assert.Empty(t, msg)
+	assert.True(t, params.StartDate.Valid)
+	assert.Equal(t, time.September, params.StartDate.Time.Month())
+}
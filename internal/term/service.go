@@ -0,0 +1,156 @@
+package term
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+var (
+	// ErrSameTerm is returned when rolling over to the term that is already current
+	ErrSameTerm = errors.New("term is already the current term")
+	// ErrRosterNotArchived is returned for a term whose roster was never archived: when switching away
+	// from it as the current term, or when asking for the membership of a past term that wasn't rolled over from
+	ErrRosterNotArchived = errors.New("term roster not archived")
+)
+
+// Service applies changes that involve several terms
+type Service struct {
+	dbService database.Service
+	now       func() time.Time
+}
+
+func NewService(dbService database.Service) *Service {
+	return &Service{dbService: dbService, now: time.Now}
+}
+
+// RolloverResult describes a completed term rollover
+type RolloverResult struct {
+	Previous repository.Term
+	Current  repository.Term
+	Archived int64 // members added to the previous term's archived membership
+}
+
+// Rollover archives the membership of the current term, with each member's position and committee
+// (and so its officer roster) and the position ranks, and makes another term active.
+// The new term is an existing term (termID) or, when termID is 0, a new term created from params.
+// Member positions are not changed; they are updated as the new officers take office.
+func (s *Service) Rollover(ctx context.Context, termID int32, params repository.CreateTermParams) (RolloverResult, error) {
+	var result RolloverResult
+	db := s.dbService.GetConnection()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return result, err
+	}
+	defer tx.Rollback()
+	qtx := repository.New(db).WithTx(tx)
+
+	previous, err := currentTerm(ctx, qtx, s.now())
+	if err != nil && !errors.Is(err, ErrNoTerm) {
+		return result, err
+	}
+	hasPrevious := err == nil
+
+	if termID == 0 {
+		id, err := qtx.CreateTerm(ctx, params)
+		if err != nil {
+			return result, err
+		}
+		termID = int32(id)
+	}
+	next, err := qtx.GetTerm(ctx, termID)
+	if err != nil {
+		return result, err
+	}
+	if hasPrevious && previous.ID == next.ID {
+		return result, ErrSameTerm
+	}
+
+	if hasPrevious {
+		members, err := qtx.ListMemberAssignments(ctx)
+		if err != nil {
+			return result, err
+		}
+		for _, m := range members {
+			rows, err := qtx.CreateTermRosterEntry(ctx, repository.CreateTermRosterEntryParams{
+				TermID:      previous.ID,
+				MemberID:    m.ID,
+				PositionID:  m.PositionID,
+				CommitteeID: m.CommitteeID,
+			})
+			if err != nil {
+				return result, err
+			}
+			result.Archived += rows
+		}
+		if _, err := qtx.ArchiveTermPositionRanks(ctx, previous.ID); err != nil {
+			return result, err
+		}
+	}
+
+	if err := activate(ctx, qtx, next.ID); err != nil {
+		return result, err
+	}
+	next.IsActive = true
+	previous.IsActive = false
+
+	if err := tx.Commit(); err != nil {
+		return result, err
+	}
+
+	result.Previous = previous
+	result.Current = next
+	return result, nil
+}
+
+// Activate makes a term the active term. Switching away from the current term is only allowed
+// once its roster was archived (by an earlier rollover), otherwise it returns ErrRosterNotArchived.
+func (s *Service) Activate(ctx context.Context, termID int32) error {
+	db := s.dbService.GetConnection()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	qtx := repository.New(db).WithTx(tx)
+
+	current, err := currentTerm(ctx, qtx, s.now())
+	if err != nil && !errors.Is(err, ErrNoTerm) {
+		return err
+	}
+	if err == nil && current.ID != termID {
+		archived, err := qtx.HasTermRoster(ctx, current.ID)
+		if err != nil {
+			return err
+		}
+		if !archived {
+			return ErrRosterNotArchived
+		}
+	}
+
+	if err := activate(ctx, qtx, termID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// activate makes a term the only active term. Returns sql.ErrNoRows if the term does not exist.
+func activate(ctx context.Context, q *repository.Queries, termID int32) error {
+	if err := q.ClearActiveTerm(ctx); err != nil {
+		return err
+	}
+	rows, err := q.SetActiveTerm(ctx, termID)
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,332 @@
+package tracker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+	"github.com/dlsu-lscs/lscs-core-api/internal/scheduler"
+)
+
+// DeadlineJobName is the name of the deadline job in scheduled_jobs
+const DeadlineJobName = "tracker_deadlines"
+
+// Reminder kinds (tracker_reminders.kind)
+const (
+	ReminderKindUpcoming = "REMINDER"
+	ReminderKindOverdue  = "OVERDUE"
+)
+
+// DeadlineRules computes the default deadlines of a tracker from its event's dates
+type DeadlineRules struct {
+	PreactsDaysBefore    int
+	PostactsDaysAfter    int
+	FinPreactsDaysBefore int
+	FinPostactsDaysAfter int
+	ReminderDays         []int // reminders are sent this many days before a deadline
+	OverdueNoticeDays    int   // overdue notices are only sent this many days after a deadline
+}
+
+func RulesFromConfig(cfg *config.Config) DeadlineRules {
+	return DeadlineRules{
+		PreactsDaysBefore:    cfg.TrackerPreactsDaysBefore,
+		PostactsDaysAfter:    cfg.TrackerPostactsDaysAfter,
+		FinPreactsDaysBefore: cfg.TrackerFinPreactsDaysBefore,
+		FinPostactsDaysAfter: cfg.TrackerFinPostactsDaysAfter,
+		ReminderDays:         cfg.TrackerReminderDays,
+		OverdueNoticeDays:    cfg.TrackerOverdueNoticeDays,
+	}
+}
+
+// Default returns the default deadline of a stage for an event running from start to end:
+// pre-acts are due before the event starts, post-acts after it ends
+func (r DeadlineRules) Default(stage Stage, start, end time.Time) time.Time {
+	switch stage {
+	case StagePreacts:
+		return start.AddDate(0, 0, -r.PreactsDaysBefore)
+	case StagePostacts:
+		return end.AddDate(0, 0, r.PostactsDaysAfter)
+	case StageFinPreacts:
+		return start.AddDate(0, 0, -r.FinPreactsDaysBefore)
+	case StageFinPostacts:
+		return end.AddDate(0, 0, r.FinPostactsDaysAfter)
+	}
+	return time.Time{}
+}
+
+// reminderDays returns the reminder due for a deadline that is daysLeft days away:
+// the smallest configured number of days that is at least daysLeft.
+// Each one is sent once, so a deadline gets at most one reminder per configured day.
+func (r DeadlineRules) reminderDays(daysLeft int) (int, bool) {
+	found := false
+	best := 0
+	for _, days := range r.ReminderDays {
+		if days >= daysLeft && (!found || days < best) {
+			best, found = days, true
+		}
+	}
+	return best, found
+}
+
+// Reminder is a deadline notice for a stage of a tracker
+type Reminder struct {
+	EventID     int32
+	EventName   string
+	CommitteeID string
+	Stage       Stage
+	Status      string
+	Deadline    time.Time
+	DaysBefore  int // 0 for overdue notices
+	Overdue     bool
+	Recipients  []HeadResponse
+}
+
+// Notifier delivers deadline reminders
+type Notifier interface {
+	NotifyDeadline(ctx context.Context, r Reminder) error
+}
+
+// LogNotifier writes reminders to the log
+type LogNotifier struct{}
+
+func (LogNotifier) NotifyDeadline(ctx context.Context, r Reminder) error {
+	recipients := make([]int32, 0, len(r.Recipients))
+	for _, head := range r.Recipients {
+		recipients = append(recipients, head.MemberID)
+	}
+	log.Info().
+		Int32("event_id", r.EventID).
+		Str("stage", string(r.Stage)).
+		Str("status", r.Status).
+		Time("deadline", r.Deadline).
+		Int("days_before", r.DaysBefore).
+		Bool("overdue", r.Overdue).
+		Interface("recipients", recipients).
+		Msg("tracker deadline reminder")
+	return nil
+}
+
+// DeadlineEngine fills in default deadlines and sends reminders before and after they pass
+type DeadlineEngine struct {
+	dbService database.Service
+	service   *Service
+	rules     DeadlineRules
+	notifier  Notifier
+}
+
+func NewDeadlineEngine(dbService database.Service, rules DeadlineRules, notifier Notifier) *DeadlineEngine {
+	return &DeadlineEngine{
+		dbService: dbService,
+		service:   NewService(dbService),
+		rules:     rules,
+		notifier:  notifier,
+	}
+}
+
+// StartDeadlineJob starts a background goroutine that periodically runs the deadline engine.
+// Its run state is persisted, so restarts don't run it (and send reminders) more often than the interval.
+func StartDeadlineJob(ctx context.Context, dbService database.Service, cfg *config.Config, notifier Notifier) {
+	engine := NewDeadlineEngine(dbService, RulesFromConfig(cfg), notifier)
+	scheduler.Start(ctx, dbService, scheduler.Job{
+		Name:     DeadlineJobName,
+		Interval: cfg.TrackerDeadlineJobInterval,
+		Run:      engine.Run,
+	})
+}
+
+// Run fills in default deadlines, then sends the reminders that are due
+func (e *DeadlineEngine) Run(ctx context.Context) error {
+	now := time.Now()
+	if err := e.ApplyDefaults(ctx, now); err != nil {
+		return err
+	}
+	return e.SendReminders(ctx, now)
+}
+
+// ApplyDefaults creates the trackers of current events and fills in their missing deadlines from the event dates.
+// Events that ended longer ago than the post-acts windows are left alone.
+func (e *DeadlineEngine) ApplyDefaults(ctx context.Context, now time.Time) error {
+	q := repository.New(e.dbService.GetConnection())
+
+	cutoff := now.AddDate(0, 0, -max(e.rules.PostactsDaysAfter, e.rules.FinPostactsDaysAfter))
+	recent, err := q.ListEventDatesEndingAfter(ctx, cutoff)
+	if err != nil {
+		return err
+	}
+
+	var eventIDs []int32
+	for _, d := range recent {
+		if !slices.Contains(eventIDs, d.EventID) {
+			eventIDs = append(eventIDs, d.EventID)
+		}
+	}
+
+	for _, eventID := range eventIDs {
+		// earlier date ranges may have ended before the cutoff, so use all of them
+		dates, err := q.ListEventDates(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		if len(dates) == 0 {
+			continue
+		}
+		start, end := dates[0].StartTime, dates[0].EndTime
+		for _, d := range dates[1:] {
+			if d.StartTime.Before(start) {
+				start = d.StartTime
+			}
+			if d.EndTime.After(end) {
+				end = d.EndTime
+			}
+		}
+
+		if err := q.CreateEventTracker(ctx, eventID); err != nil {
+			return err
+		}
+
+		// each deadline is filled in on its own, so deadlines set by hand in the meantime are kept
+		changed := false
+		for _, fill := range []struct {
+			stage Stage
+			fill  func(deadline sql.NullTime) (int64, error)
+		}{
+			{StagePreacts, func(deadline sql.NullTime) (int64, error) {
+				return q.FillTrackerPreactsDeadline(ctx, repository.FillTrackerPreactsDeadlineParams{PreactsDeadline: deadline, EventID: eventID})
+			}},
+			{StagePostacts, func(deadline sql.NullTime) (int64, error) {
+				return q.FillTrackerPostactsDeadline(ctx, repository.FillTrackerPostactsDeadlineParams{PostactsDeadline: deadline, EventID: eventID})
+			}},
+			{StageFinPreacts, func(deadline sql.NullTime) (int64, error) {
+				return q.FillTrackerFinPreactsDeadline(ctx, repository.FillTrackerFinPreactsDeadlineParams{FinPreactsDeadline: deadline, EventID: eventID})
+			}},
+			{StageFinPostacts, func(deadline sql.NullTime) (int64, error) {
+				return q.FillTrackerFinPostactsDeadline(ctx, repository.FillTrackerFinPostactsDeadlineParams{FinPostactsDeadline: deadline, EventID: eventID})
+			}},
+		} {
+			rows, err := fill.fill(sql.NullTime{Time: e.rules.Default(fill.stage, start, end), Valid: true})
+			if err != nil {
+				return err
+			}
+			changed = changed || rows > 0
+		}
+		if changed {
+			log.Info().Int32("event_id", eventID).Msg("default tracker deadlines set")
+		}
+	}
+
+	return nil
+}
+
+// SendReminders notifies the heads responsible for incomplete stages whose deadline is near or has recently passed.
+// Each reminder is recorded in tracker_reminders first, so it is only sent once per deadline.
+// Deadlines that passed more than OverdueNoticeDays ago are left to the overdue report,
+// so old trackers that were never closed don't all get a notice at once.
+func (e *DeadlineEngine) SendReminders(ctx context.Context, now time.Time) error {
+	q := repository.New(e.dbService.GetConnection())
+
+	trackers, err := q.ListTrackerDeadlines(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, row := range trackers {
+		t := trackerFromDeadlineRow(row)
+		var heads *eventHeads
+
+		for _, stage := range Stages {
+			status := StageStatus(t, stage)
+			deadline := StageDeadline(t, stage)
+			if IsComplete(status) || !deadline.Valid {
+				continue
+			}
+
+			reminder := repository.CreateTrackerReminderParams{
+				EventID:  row.EventID,
+				Stage:    string(stage),
+				Deadline: deadline.Time,
+			}
+			if now.After(deadline.Time) {
+				if now.After(deadline.Time.AddDate(0, 0, e.rules.OverdueNoticeDays)) {
+					continue
+				}
+				reminder.Kind = ReminderKindOverdue
+			} else {
+				daysLeft := int(math.Ceil(deadline.Time.Sub(now).Hours() / 24))
+				days, ok := e.rules.reminderDays(daysLeft)
+				if !ok {
+					continue
+				}
+				reminder.Kind = ReminderKindUpcoming
+				reminder.DaysBefore = int32(days)
+			}
+
+			claimed, err := q.CreateTrackerReminder(ctx, reminder)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if claimed == 0 {
+				continue // already sent
+			}
+
+			if heads == nil {
+				h, err := e.service.eventHeads(ctx, row)
+				if err != nil {
+					errs = append(errs, err)
+					e.release(ctx, q, reminder)
+					continue
+				}
+				heads = &h
+			}
+
+			if err := e.notifier.NotifyDeadline(ctx, Reminder{
+				EventID:     row.EventID,
+				EventName:   row.Name,
+				CommitteeID: row.CommitteeID,
+				Stage:       stage,
+				Status:      status,
+				Deadline:    deadline.Time,
+				DaysBefore:  int(reminder.DaysBefore),
+				Overdue:     reminder.Kind == ReminderKindOverdue,
+				Recipients:  heads.forStage(stage),
+			}); err != nil {
+				errs = append(errs, err)
+				e.release(ctx, q, reminder)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// release forgets a reminder that could not be sent, so the next run retries it
+func (e *DeadlineEngine) release(ctx context.Context, q *repository.Queries, r repository.CreateTrackerReminderParams) {
+	if err := q.DeleteTrackerReminder(ctx, repository.DeleteTrackerReminderParams(r)); err != nil {
+		log.Error().Err(err).Int32("event_id", r.EventID).Msg("failed to release tracker reminder")
+	}
+}
+
+func trackerFromDeadlineRow(r repository.ListTrackerDeadlinesRow) repository.EventTracker {
+	return repository.EventTracker{
+		EventID:             r.EventID,
+		PreactsDeadline:     r.PreactsDeadline,
+		PreactsStatus:       r.PreactsStatus,
+		PostactsDeadline:    r.PostactsDeadline,
+		PostactsStatus:      r.PostactsStatus,
+		DocuDriveID:         r.DocuDriveID,
+		FinDriveID:          r.FinDriveID,
+		FinPreactsDeadline:  r.FinPreactsDeadline,
+		FinPreactsStatus:    r.FinPreactsStatus,
+		FinPostactsDeadline: r.FinPostactsDeadline,
+		FinPostactsStatus:   r.FinPostactsStatus,
+	}
+}
@@ -0,0 +1,233 @@
+package tracker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeNotifier struct {
+	sent []Reminder
+	err  error
+}
+
+func (n *fakeNotifier) NotifyDeadline(ctx context.Context, r Reminder) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.sent = append(n.sent, r)
+	return nil
+}
+
+var testRules = DeadlineRules{
+	PreactsDaysBefore:    14,
+	PostactsDaysAfter:    14,
+	FinPreactsDaysBefore: 21,
+	FinPostactsDaysAfter: 30,
+	ReminderDays:         []int{7, 3, 1},
+	OverdueNoticeDays:    7,
+}
+
+func TestDeadlineRulesDefault(t *testing.T) {
+	start := time.Date(2026, 11, 20, 9, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 11, 21, 17, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, start.AddDate(0, 0, -14), testRules.Default(StagePreacts, start, end))
+	assert.Equal(t, end.AddDate(0, 0, 14), testRules.Default(StagePostacts, start, end))
+	assert.Equal(t, start.AddDate(0, 0, -21), testRules.Default(StageFinPreacts, start, end))
+	assert.Equal(t, end.AddDate(0, 0, 30), testRules.Default(StageFinPostacts, start, end))
+}
+
+func TestDeadlineRulesReminderDays(t *testing.T) {
+	tests := []struct {
+		daysLeft int
+		want     int
+		ok       bool
+	}{
+		{10, 0, false},
+		{7, 7, true},
+		{5, 7, true},
+		{3, 3, true},
+		{2, 3, true},
+		{1, 1, true},
+	}
+	for _, tt := range tests {
+		got, ok := testRules.reminderDays(tt.daysLeft)
+		assert.Equal(t, tt.ok, ok, "days left: %d", tt.daysLeft)
+		assert.Equal(t, tt.want, got, "days left: %d", tt.daysLeft)
+	}
+}
+
+func TestApplyDefaults(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	start := time.Date(2026, 11, 20, 9, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 11, 21, 17, 0, 0, 0, time.UTC)
+	dateColumns := []string{"id", "event_id", "start_time", "end_time"}
+
+	mock.ExpectQuery("SELECT (.+) FROM event_dates WHERE end_time >= ?").
+		WithArgs(now.AddDate(0, 0, -30)).
+		WillReturnRows(sqlmock.NewRows(dateColumns).AddRow(1, 1, start, end))
+	mock.ExpectQuery("SELECT (.+) FROM event_dates WHERE event_id = ?").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(dateColumns).AddRow(1, 1, start, end))
+	mock.ExpectExec("INSERT IGNORE INTO event_trackers").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// only the deadlines that are still empty are filled in
+	mock.ExpectExec("UPDATE event_trackers SET preacts_deadline = \\? WHERE event_id = \\? AND preacts_deadline IS NULL").
+		WithArgs(start.AddDate(0, 0, -14), 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("UPDATE event_trackers SET postacts_deadline = \\? WHERE event_id = \\? AND postacts_deadline IS NULL").
+		WithArgs(end.AddDate(0, 0, 14), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE event_trackers SET fin_preacts_deadline = \\? WHERE event_id = \\? AND fin_preacts_deadline IS NULL").
+		WithArgs(start.AddDate(0, 0, -21), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE event_trackers SET fin_postacts_deadline = \\? WHERE event_id = \\? AND fin_postacts_deadline IS NULL").
+		WithArgs(end.AddDate(0, 0, 30), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	engine := NewDeadlineEngine(&mockDBService{db: db}, testRules, &fakeNotifier{})
+	assert.NoError(t, engine.ApplyDefaults(context.Background(), now))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+var deadlineColumns = append(append([]string{}, trackerColumns...), "name", "committee_id", "docu_head", "fin_head")
+
+func TestSendReminders(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	preacts := now.Add(2 * 24 * time.Hour)   // 2 days left: 3-day reminder
+	postacts := now.Add(-1 * 24 * time.Hour) // overdue
+
+	expectDeadlines := func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery("SELECT (.+) FROM event_trackers t").
+			WillReturnRows(sqlmock.NewRows(deadlineColumns).AddRow(
+				1, preacts, StatusInProgress, postacts, StatusInProgress,
+				nil, nil, nil, StatusNotApplicable,
+				nil, StatusInit,
+				"Intro to Go Workshop", "RND", nil, nil,
+			))
+	}
+	expectHeads := func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery("SELECT (.+) FROM event_heads eh").
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "email", "position_id", "committee_id"}).
+				AddRow(12312345, "Juan Dela Cruz", "juan_delacruz@dlsu.edu.ph", "MEM", "RND"))
+		mock.ExpectQuery("SELECT (.+) FROM event_docu_head d").
+			WithArgs(1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "email"}))
+	}
+
+	t.Run("sends due reminders once", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectDeadlines(mock)
+		mock.ExpectExec("INSERT IGNORE INTO tracker_reminders").
+			WithArgs(1, "preacts", ReminderKindUpcoming, 3, preacts).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		expectHeads(mock)
+		mock.ExpectExec("INSERT IGNORE INTO tracker_reminders").
+			WithArgs(1, "postacts", ReminderKindOverdue, 0, postacts).
+			WillReturnResult(sqlmock.NewResult(0, 0)) // already sent
+
+		notifier := &fakeNotifier{}
+		engine := NewDeadlineEngine(&mockDBService{db: db}, testRules, notifier)
+
+		assert.NoError(t, engine.SendReminders(context.Background(), now))
+		if assert.Len(t, notifier.sent, 1) {
+			r := notifier.sent[0]
+			assert.Equal(t, StagePreacts, r.Stage)
+			assert.Equal(t, 3, r.DaysBefore)
+			assert.False(t, r.Overdue)
+			if assert.Len(t, r.Recipients, 1) {
+				assert.Equal(t, int32(12312345), r.Recipients[0].MemberID)
+			}
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("releases reminders that could not be sent", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectDeadlines(mock)
+		mock.ExpectExec("INSERT IGNORE INTO tracker_reminders").
+			WithArgs(1, "preacts", ReminderKindUpcoming, 3, preacts).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		expectHeads(mock)
+		mock.ExpectExec("DELETE FROM tracker_reminders").
+			WithArgs(1, "preacts", ReminderKindUpcoming, 3, preacts).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT IGNORE INTO tracker_reminders").
+			WithArgs(1, "postacts", ReminderKindOverdue, 0, postacts).
+			WillReturnResult(sqlmock.NewResult(2, 1))
+		mock.ExpectExec("DELETE FROM tracker_reminders").
+			WithArgs(1, "postacts", ReminderKindOverdue, 0, postacts).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		notifier := &fakeNotifier{err: errors.New("smtp down")}
+		engine := NewDeadlineEngine(&mockDBService{db: db}, testRules, notifier)
+
+		assert.Error(t, engine.SendReminders(context.Background(), now))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("skips deadlines that passed long ago", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		// a tracker from last year that was never closed
+		mock.ExpectQuery("SELECT (.+) FROM event_trackers t").
+			WillReturnRows(sqlmock.NewRows(deadlineColumns).AddRow(
+				1, now.AddDate(-1, 0, 0), StatusApproved, now.AddDate(0, 0, -8), StatusInit,
+				nil, nil, nil, nil,
+				nil, nil,
+				"Intro to Go Workshop", "RND", nil, nil,
+			))
+
+		notifier := &fakeNotifier{}
+		engine := NewDeadlineEngine(&mockDBService{db: db}, testRules, notifier)
+
+		assert.NoError(t, engine.SendReminders(context.Background(), now))
+		assert.Empty(t, notifier.sent)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGroupOverdue(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	juan := HeadResponse{MemberID: 1, FullName: "Juan Dela Cruz"}
+	ana := HeadResponse{MemberID: 2, FullName: "Ana Santos"}
+
+	resp := groupOverdue([]OverdueItem{
+		{EventID: 1, CommitteeID: "RND", Stage: StagePreacts, Heads: []HeadResponse{juan, ana}},
+		{EventID: 2, CommitteeID: "FIN", Stage: StageFinPreacts, Heads: []HeadResponse{juan}},
+		{EventID: 3, CommitteeID: "RND", Stage: StagePostacts, Heads: []HeadResponse{}},
+	}, now)
+
+	assert.Equal(t, 3, resp.Total)
+	if assert.Len(t, resp.ByCommittee, 2) {
+		assert.Equal(t, "FIN", resp.ByCommittee[0].CommitteeID)
+		assert.Equal(t, "RND", resp.ByCommittee[1].CommitteeID)
+		assert.Len(t, resp.ByCommittee[1].Items, 2)
+	}
+	if assert.Len(t, resp.ByHead, 2) {
+		assert.Equal(t, "Ana Santos", resp.ByHead[0].Head.FullName)
+		assert.Len(t, resp.ByHead[1].Items, 2)
+	}
+	if assert.Len(t, resp.Unassigned, 1) {
+		assert.Equal(t, int32(3), resp.Unassigned[0].EventID)
+	}
+}
@@ -0,0 +1,251 @@
+package tracker
+
+import (
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// UpdateTrackerRequest represents the request body for setting the deadlines and drive folders of a tracker.
+// Omitted fields are cleared; cleared deadlines of current events are reset to their defaults by the deadline job.
+type UpdateTrackerRequest struct {
+	PreactsDeadline     *time.Time `json:"preacts_deadline,omitempty" example:"2026-10-29T23:59:00+08:00"`
+	PostactsDeadline    *time.Time `json:"postacts_deadline,omitempty" example:"2026-11-19T23:59:00+08:00"`
+	FinPreactsDeadline  *time.Time `json:"fin_preacts_deadline,omitempty" example:"2026-10-29T23:59:00+08:00"`
+	FinPostactsDeadline *time.Time `json:"fin_postacts_deadline,omitempty" example:"2026-11-19T23:59:00+08:00"`
+	DocuDriveID         *string    `json:"docu_drive_id,omitempty" validate:"omitempty,max=255" example:"1AbCdEfGh"`
+	FinDriveID          *string    `json:"fin_drive_id,omitempty" validate:"omitempty,max=255" example:"1IjKlMnOp"`
+}
+
+// TransitionRequest represents the request body for advancing a stage
+type TransitionRequest struct {
+	Stage  string  `json:"stage" validate:"required,oneof=preacts postacts fin_preacts fin_postacts" example:"preacts"`
+	Status string  `json:"status" validate:"required,max=100" example:"FOR_REVIEW"`
+	Note   *string `json:"note,omitempty" validate:"omitempty,max=1000" example:"Uploaded the activity proposal"`
+}
+
+// UpdateHeadsRequest represents the request body for assigning the documentation and finance heads of an event.
+// Omitted fields are unchanged; 0 removes the head.
+type UpdateHeadsRequest struct {
+	DocuHead *int32 `json:"docu_head,omitempty" validate:"omitempty,gte=0" example:"12312345"`
+	FinHead  *int32 `json:"fin_head,omitempty" validate:"omitempty,gte=0" example:"12312346"`
+}
+
+// AddDocuHeadRequest represents the request body for adding a documentation head to an event
+type AddDocuHeadRequest struct {
+	MemberID int32 `json:"member_id" validate:"required,gt=0" example:"12312345"`
+}
+
+// AddFinProcessRequest represents the request body for adding a finance process to a tracker
+type AddFinProcessRequest struct {
+	Process string `json:"process" validate:"required,max=50" example:"CASH_ADVANCE"`
+}
+
+// StageResponse represents the state of one stage of a tracker
+type StageResponse struct {
+	Status   string       `json:"status" example:"FOR_REVIEW"`
+	Deadline *time.Time   `json:"deadline,omitempty" example:"2026-10-29T23:59:00+08:00"`
+	Overdue  bool         `json:"overdue" example:"false"`
+	Next     []Transition `json:"next"`
+}
+
+// HeadResponse represents a member assigned as documentation head
+type HeadResponse struct {
+	MemberID int32  `json:"member_id" example:"12312345"`
+	FullName string `json:"full_name" example:"Juan Dela Cruz"`
+	Email    string `json:"email" example:"juan_delacruz@dlsu.edu.ph"`
+}
+
+// FinProcessResponse represents a finance process of an event
+type FinProcessResponse struct {
+	ID      int32  `json:"id" example:"1"`
+	Process string `json:"process" example:"CASH_ADVANCE"`
+	Name    string `json:"name" example:"Cash Advance"`
+}
+
+// TrackerResponse represents the documentation and finance tracker of an event.
+// Heads and finance processes are only included when fetching a single tracker.
+type TrackerResponse struct {
+	EventID      int32                  `json:"event_id" example:"1"`
+	EventName    string                 `json:"event_name,omitempty" example:"Intro to Go Workshop"`
+	CommitteeID  string                 `json:"committee_id,omitempty" example:"RND"`
+	Preacts      StageResponse          `json:"preacts"`
+	Postacts     StageResponse          `json:"postacts"`
+	FinPreacts   StageResponse          `json:"fin_preacts"`
+	FinPostacts  StageResponse          `json:"fin_postacts"`
+	DocuDriveID  helpers.NullableString `json:"docu_drive_id"`
+	FinDriveID   helpers.NullableString `json:"fin_drive_id"`
+	DocuHead     *int32                 `json:"docu_head,omitempty" example:"12312345"`
+	FinHead      *int32                 `json:"fin_head,omitempty" example:"12312346"`
+	DocuHeads    []HeadResponse         `json:"docu_heads,omitempty"`
+	FinProcesses []FinProcessResponse   `json:"fin_processes,omitempty"`
+}
+
+// ListTrackersResponse is the response for the GET /trackers endpoint
+type ListTrackersResponse struct {
+	Trackers []TrackerResponse `json:"trackers"`
+}
+
+// StatusResponse represents a status of a workflow
+type StatusResponse struct {
+	ID    string `json:"id" example:"FOR_REVIEW"`
+	Title string `json:"title" example:"For review"`
+}
+
+// WorkflowResponse describes the state machine of a kind of stage
+type WorkflowResponse struct {
+	Stages      []Stage          `json:"stages"`
+	Initial     string           `json:"initial" example:"INIT"`
+	Statuses    []StatusResponse `json:"statuses"`
+	Transitions []Transition     `json:"transitions"`
+}
+
+// WorkflowsResponse is the response for the GET /trackers/workflows endpoint
+type WorkflowsResponse struct {
+	Documentation WorkflowResponse     `json:"documentation"`
+	Finance       WorkflowResponse     `json:"finance"`
+	FinProcesses  []FinProcessResponse `json:"fin_processes"`
+}
+
+// HistoryResponse represents a status change of a tracker
+type HistoryResponse struct {
+	ID         int32                  `json:"id" example:"1"`
+	Stage      string                 `json:"stage" example:"preacts"`
+	FromStatus string                 `json:"from_status" example:"FOR_REVIEW"`
+	ToStatus   string                 `json:"to_status" example:"SUBMITTED"`
+	ChangedBy  *int32                 `json:"changed_by,omitempty" example:"12312345"`
+	Note       helpers.NullableString `json:"note"`
+	ChangedAt  *time.Time             `json:"changed_at,omitempty"`
+}
+
+// ListHistoryResponse is the response for the GET /events/:id/tracker/history endpoint
+type ListHistoryResponse struct {
+	History []HistoryResponse `json:"history"`
+}
+
+// OverdueCommitteeGroup lists the overdue stages of a committee's events
+type OverdueCommitteeGroup struct {
+	CommitteeID string        `json:"committee_id" example:"RND"`
+	Items       []OverdueItem `json:"items"`
+}
+
+// OverdueHeadGroup lists the overdue stages a head is responsible for
+type OverdueHeadGroup struct {
+	Head  HeadResponse  `json:"head"`
+	Items []OverdueItem `json:"items"`
+}
+
+// OverdueResponse is the response for the GET /trackers/overdue endpoint
+type OverdueResponse struct {
+	AsOf        time.Time               `json:"as_of"`
+	Total       int                     `json:"total" example:"4"`
+	ByCommittee []OverdueCommitteeGroup `json:"by_committee"`
+	ByHead      []OverdueHeadGroup      `json:"by_head"`
+	// Unassigned lists the overdue stages without any responsible head
+	Unassigned []OverdueItem `json:"unassigned"`
+}
+
+func toTrackerResponse(t repository.EventTracker, now time.Time) TrackerResponse {
+	return TrackerResponse{
+		EventID:     t.EventID,
+		Preacts:     toStageResponse(t, StagePreacts, now),
+		Postacts:    toStageResponse(t, StagePostacts, now),
+		FinPreacts:  toStageResponse(t, StageFinPreacts, now),
+		FinPostacts: toStageResponse(t, StageFinPostacts, now),
+		DocuDriveID: helpers.NullableString{NullString: t.DocuDriveID},
+		FinDriveID:  helpers.NullableString{NullString: t.FinDriveID},
+	}
+}
+
+func toStageResponse(t repository.EventTracker, stage Stage, now time.Time) StageResponse {
+	status := StageStatus(t, stage)
+	resp := StageResponse{
+		Status: status,
+		Next:   NextTransitions(t, stage),
+	}
+	if deadline := StageDeadline(t, stage); deadline.Valid {
+		resp.Deadline = &deadline.Time
+		resp.Overdue = !IsComplete(status) && now.After(deadline.Time)
+	}
+	return resp
+}
+
+func toHistoryResponse(h repository.TrackerStatusHistory) HistoryResponse {
+	resp := HistoryResponse{
+		ID:         h.ID,
+		Stage:      h.Stage,
+		FromStatus: h.FromStatus,
+		ToStatus:   h.ToStatus,
+		Note:       helpers.NullableString{NullString: h.Note},
+	}
+	if h.ChangedBy.Valid {
+		resp.ChangedBy = &h.ChangedBy.Int32
+	}
+	if h.ChangedAt.Valid {
+		resp.ChangedAt = &h.ChangedAt.Time
+	}
+	return resp
+}
+
+// toNullTime converts an optional time to sql.NullTime
+func toNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+// toNullString converts an optional string to sql.NullString
+func toNullString(s *string) sql.NullString {
+	if s == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *s, Valid: true}
+}
+
+// groupOverdue groups overdue items by committee and by responsible head
+func groupOverdue(items []OverdueItem, now time.Time) OverdueResponse {
+	resp := OverdueResponse{
+		AsOf:        now,
+		Total:       len(items),
+		ByCommittee: []OverdueCommitteeGroup{},
+		ByHead:      []OverdueHeadGroup{},
+		Unassigned:  []OverdueItem{},
+	}
+
+	committees := map[string]int{}
+	heads := map[int32]int{}
+	for _, item := range items {
+		i, ok := committees[item.CommitteeID]
+		if !ok {
+			i = len(resp.ByCommittee)
+			committees[item.CommitteeID] = i
+			resp.ByCommittee = append(resp.ByCommittee, OverdueCommitteeGroup{CommitteeID: item.CommitteeID})
+		}
+		resp.ByCommittee[i].Items = append(resp.ByCommittee[i].Items, item)
+
+		if len(item.Heads) == 0 {
+			resp.Unassigned = append(resp.Unassigned, item)
+		}
+		for _, head := range item.Heads {
+			i, ok := heads[head.MemberID]
+			if !ok {
+				i = len(resp.ByHead)
+				heads[head.MemberID] = i
+				resp.ByHead = append(resp.ByHead, OverdueHeadGroup{Head: head})
+			}
+			resp.ByHead[i].Items = append(resp.ByHead[i].Items, item)
+		}
+	}
+
+	sort.Slice(resp.ByCommittee, func(i, j int) bool {
+		return resp.ByCommittee[i].CommitteeID < resp.ByCommittee[j].CommitteeID
+	})
+	sort.Slice(resp.ByHead, func(i, j int) bool {
+		return resp.ByHead[i].Head.FullName < resp.ByHead[j].Head.FullName
+	})
+	return resp
+}
@@ -0,0 +1,880 @@
+package tracker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// Handler exposes the documentation and finance trackers of events.
+//
+// Organizers (managers of the event's committee and event heads) prepare the requirements;
+// the Documentation committee (its managers and the event's documentation heads) and the
+// Finance committee (its managers and the event's finance head) review them.
+type Handler struct {
+	cfg         *config.Config
+	dbService   database.Service
+	rbacService *auth.RBACService
+	service     *Service
+}
+
+func NewHandler(cfg *config.Config, dbService database.Service, rbacService *auth.RBACService) *Handler {
+	return &Handler{
+		cfg:         cfg,
+		dbService:   dbService,
+		rbacService: rbacService,
+		service:     NewService(dbService),
+	}
+}
+
+// ListTrackersHandler lists event trackers
+// @Summary List trackers
+// @Description List the documentation and finance trackers of events, optionally filtered by committee
+// @Tags trackers
+// @Produce json
+// @Param committee_id query string false "Filter by committee ID"
+// @Success 200 {object} ListTrackersResponse "Trackers"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /trackers [get]
+func (h *Handler) ListTrackersHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	var rows []repository.ListEventTrackersRow
+	var err error
+	if committeeID := c.QueryParam("committee_id"); committeeID != "" {
+		var byCommittee []repository.ListEventTrackersByCommitteeRow
+		byCommittee, err = q.ListEventTrackersByCommittee(ctx, committeeID)
+		for _, r := range byCommittee {
+			rows = append(rows, repository.ListEventTrackersRow(r))
+		}
+	} else {
+		rows, err = q.ListEventTrackers(ctx)
+	}
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list trackers")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	now := time.Now()
+	response := ListTrackersResponse{Trackers: make([]TrackerResponse, 0, len(rows))}
+	for _, r := range rows {
+		t := toTrackerResponse(trackerFromRow(r), now)
+		t.EventName = r.Name
+		t.CommitteeID = r.CommitteeID
+		response.Trackers = append(response.Trackers, t)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetWorkflowsHandler describes the tracker state machines
+// @Summary Get tracker workflows
+// @Description Get the statuses and allowed transitions of the documentation and finance workflows, and the available finance processes
+// @Tags trackers
+// @Produce json
+// @Success 200 {object} WorkflowsResponse "Workflows"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /trackers/workflows [get]
+func (h *Handler) GetWorkflowsHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	docuStatuses, err := q.ListDocuStatuses(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list documentation statuses")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	finStatuses, err := q.ListFinStatuses(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list finance statuses")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	processes, err := q.ListFinProcessRefs(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list finance processes")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	docuTitles := make(map[string]string, len(docuStatuses))
+	for _, s := range docuStatuses {
+		docuTitles[s.ID] = s.Title
+	}
+	finTitles := make(map[string]string, len(finStatuses))
+	for _, s := range finStatuses {
+		finTitles[s.ID] = s.Title
+	}
+
+	response := WorkflowsResponse{
+		Documentation: toWorkflowResponse([]Stage{StagePreacts, StagePostacts}, DocuMachine, docuTitles),
+		Finance:       toWorkflowResponse([]Stage{StageFinPreacts, StageFinPostacts}, FinMachine, finTitles),
+		FinProcesses:  make([]FinProcessResponse, 0, len(processes)),
+	}
+	for _, p := range processes {
+		response.FinProcesses = append(response.FinProcesses, FinProcessResponse{Process: p.ID, Name: p.Name})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// ListOverdueHandler reports overdue tracker stages
+// @Summary List overdue trackers
+// @Description List the incomplete tracker stages whose deadline has passed, grouped by committee and by responsible head (event heads, and documentation or finance heads). Optionally filtered by committee.
+// @Tags trackers
+// @Produce json
+// @Param committee_id query string false "Filter by committee ID"
+// @Success 200 {object} OverdueResponse "Overdue stages"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /trackers/overdue [get]
+func (h *Handler) ListOverdueHandler(c echo.Context) error {
+	now := time.Now()
+
+	items, err := h.service.Overdue(c.Request().Context(), now)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list overdue trackers")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	if committeeID := c.QueryParam("committee_id"); committeeID != "" {
+		filtered := []OverdueItem{}
+		for _, item := range items {
+			if item.CommitteeID == committeeID {
+				filtered = append(filtered, item)
+			}
+		}
+		items = filtered
+	}
+
+	return c.JSON(http.StatusOK, groupOverdue(items, now))
+}
+
+// GetTrackerHandler returns the tracker of an event
+// @Summary Get event tracker
+// @Description Get the documentation and finance tracker of an event, with its heads and finance processes. Events that were never tracked have every stage not started.
+// @Tags trackers
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} TrackerResponse "Tracker"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid event ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/tracker [get]
+func (h *Handler) GetTrackerHandler(c echo.Context) error {
+	event, ok, err := h.getEvent(c)
+	if !ok {
+		return err
+	}
+	return h.respondWithTracker(c, event)
+}
+
+// UpdateTrackerHandler sets the deadlines and drive folders of a tracker
+// @Summary Update event tracker
+// @Description Set the deadlines and drive folders of an event's tracker. Documentation reviewers set the documentation fields and Finance reviewers the finance fields; the other fields are kept.
+// @Tags trackers
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body UpdateTrackerRequest true "Deadlines and drive folders"
+// @Success 200 {object} TrackerResponse "Updated tracker"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not a reviewer of this event"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/tracker [put]
+func (h *Handler) UpdateTrackerHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	var req UpdateTrackerRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	event, ok, err := h.getEvent(c)
+	if !ok {
+		return err
+	}
+
+	acc := h.getAccess(ctx, principal, event)
+	if !acc.docu && !acc.fin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Only Documentation and Finance reviewers can update the tracker"})
+	}
+
+	if err := q.CreateEventTracker(ctx, event.ID); err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to create tracker")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	current, err := q.GetEventTracker(ctx, event.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to get tracker")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	params := repository.UpdateEventTrackerParams{
+		PreactsDeadline:     current.PreactsDeadline,
+		PostactsDeadline:    current.PostactsDeadline,
+		FinPreactsDeadline:  current.FinPreactsDeadline,
+		FinPostactsDeadline: current.FinPostactsDeadline,
+		DocuDriveID:         current.DocuDriveID,
+		FinDriveID:          current.FinDriveID,
+		EventID:             event.ID,
+	}
+	if acc.docu {
+		params.PreactsDeadline = toNullTime(req.PreactsDeadline)
+		params.PostactsDeadline = toNullTime(req.PostactsDeadline)
+		params.DocuDriveID = toNullString(req.DocuDriveID)
+	}
+	if acc.fin {
+		params.FinPreactsDeadline = toNullTime(req.FinPreactsDeadline)
+		params.FinPostactsDeadline = toNullTime(req.FinPostactsDeadline)
+		params.FinDriveID = toNullString(req.FinDriveID)
+	}
+
+	if err := q.UpdateEventTracker(ctx, params); err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to update tracker")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Error updating tracker"})
+	}
+
+	log.Info().
+		Int32("event_id", event.ID).
+		Int32("updated_by", principal.MemberID).
+		Msg("tracker updated")
+
+	return h.respondWithTracker(c, event)
+}
+
+// TransitionHandler advances a stage of a tracker
+// @Summary Advance tracker status
+// @Description Move a stage (preacts, postacts, fin_preacts, fin_postacts) to a new status. Only the transitions of the stage's workflow are allowed (see GET /trackers/workflows); review transitions are reserved to the reviewing committee, and post-acts can only start once pre-acts are complete.
+// @Tags trackers
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body TransitionRequest true "Stage and new status"
+// @Success 200 {object} TrackerResponse "Updated tracker"
+// @Failure 400 {object} helpers.ErrorResponse "Transition not allowed"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not allowed to make this transition"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 409 {object} helpers.ErrorResponse "Previous stage incomplete or status changed concurrently"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/tracker/transitions [post]
+func (h *Handler) TransitionHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	var req TransitionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+	stage, _ := ParseStage(req.Stage)
+
+	event, ok, err := h.getEvent(c)
+	if !ok {
+		return err
+	}
+
+	acc := h.getAccess(ctx, principal, event)
+	reviewer := acc.reviews(stage)
+	if !acc.organizer && !reviewer {
+		log.Warn().
+			Int32("member_id", principal.MemberID).
+			Int32("event_id", event.ID).
+			Str("stage", string(stage)).
+			Msg("tracker transition denied")
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Not an organizer or reviewer of this event"})
+	}
+
+	tracker, err := h.service.Advance(ctx, TransitionInput{
+		EventID:  event.ID,
+		Stage:    stage,
+		To:       req.Status,
+		Reviewer: reviewer,
+		ActorID:  principal.MemberID,
+		Note:     toNullString(req.Note),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidTransition):
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": fmt.Sprintf("Cannot move %s from %s to %s", stage, StageStatus(tracker, stage), req.Status),
+			})
+		case errors.Is(err, ErrReviewRequired):
+			return c.JSON(http.StatusForbidden, map[string]string{
+				"error": fmt.Sprintf("Only the reviewing committee can move %s to %s", stage, req.Status),
+			})
+		case errors.Is(err, ErrPrerequisiteIncomplete):
+			prereq, _ := stage.Prerequisite()
+			return c.JSON(http.StatusConflict, map[string]string{
+				"error": fmt.Sprintf("%s must be complete before %s can start", prereq, stage),
+			})
+		case errors.Is(err, ErrStatusChanged):
+			return c.JSON(http.StatusConflict, map[string]string{"error": "Status was changed by someone else, reload and try again"})
+		}
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to advance tracker")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	log.Info().
+		Int32("event_id", event.ID).
+		Str("stage", string(stage)).
+		Str("status", req.Status).
+		Int32("changed_by", principal.MemberID).
+		Msg("tracker status changed")
+
+	return h.respondWithTracker(c, event)
+}
+
+// ListHistoryHandler lists the status changes of a tracker
+// @Summary Get tracker history
+// @Description List the status changes of an event's tracker, newest first
+// @Tags trackers
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} ListHistoryResponse "Status changes"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid event ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/tracker/history [get]
+func (h *Handler) ListHistoryHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	eventID, err := parseEventID(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid event ID"})
+	}
+
+	history, err := q.ListTrackerStatusHistory(c.Request().Context(), eventID)
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", eventID).Msg("failed to list tracker history")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	response := ListHistoryResponse{History: make([]HistoryResponse, 0, len(history))}
+	for _, entry := range history {
+		response.History = append(response.History, toHistoryResponse(entry))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// UpdateHeadsHandler assigns the documentation and finance heads of an event
+// @Summary Assign tracker heads
+// @Description Assign the documentation head (Documentation managers only) and finance head (Finance managers only) of an event
+// @Tags trackers
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body UpdateHeadsRequest true "Heads"
+// @Success 200 {object} TrackerResponse "Updated tracker"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request or member not found"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not a manager of the reviewing committee"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/tracker/heads [put]
+func (h *Handler) UpdateHeadsHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	db := h.dbService.GetConnection()
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	var req UpdateHeadsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	event, ok, err := h.getEvent(c)
+	if !ok {
+		return err
+	}
+
+	acc := h.getAccess(ctx, principal, event)
+	if req.DocuHead != nil && !acc.docuManager {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Only Documentation managers can assign the documentation head"})
+	}
+	if req.FinHead != nil && !acc.finManager {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Only Finance managers can assign the finance head"})
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to begin transaction")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	defer tx.Rollback()
+	qtx := repository.New(db).WithTx(tx)
+
+	if req.DocuHead != nil {
+		if err := qtx.UpdateEventDocuHead(ctx, repository.UpdateEventDocuHeadParams{
+			DocuHead: optionalMember(*req.DocuHead),
+			ID:       event.ID,
+		}); err != nil {
+			return writeError(c, err, "failed to update documentation head")
+		}
+	}
+	if req.FinHead != nil {
+		if err := qtx.UpdateEventFinHead(ctx, repository.UpdateEventFinHeadParams{
+			FinHead: optionalMember(*req.FinHead),
+			ID:      event.ID,
+		}); err != nil {
+			return writeError(c, err, "failed to update finance head")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error().Err(err).Msg("failed to commit tracker heads")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	eventID := event.ID
+	event, err = repository.New(db).GetEventById(ctx, eventID)
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", eventID).Msg("failed to get event")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return h.respondWithTracker(c, event)
+}
+
+// AddDocuHeadHandler adds a documentation head to an event
+// @Summary Add documentation head
+// @Description Add a member to the documentation heads of an event. Documentation managers only.
+// @Tags trackers
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body AddDocuHeadRequest true "Member to add"
+// @Success 201 {object} map[string]string "Documentation head added"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request or member not found"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not a Documentation manager"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 409 {object} helpers.ErrorResponse "Already a documentation head"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/tracker/docu-heads [post]
+func (h *Handler) AddDocuHeadHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	var req AddDocuHeadRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	event, ok, err := h.getEvent(c)
+	if !ok {
+		return err
+	}
+
+	if !h.getAccess(ctx, principal, event).docuManager {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Only Documentation managers can assign documentation heads"})
+	}
+
+	if err := q.AddEventDocuHead(ctx, repository.AddEventDocuHeadParams{
+		EventID:  event.ID,
+		MemberID: req.MemberID,
+	}); err != nil {
+		if helpers.IsDuplicateEntry(err) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": "Member is already a documentation head of this event"})
+		}
+		return writeError(c, err, "failed to add documentation head")
+	}
+
+	log.Info().
+		Int32("event_id", event.ID).
+		Int32("member_id", req.MemberID).
+		Int32("added_by", principal.MemberID).
+		Msg("documentation head added")
+
+	return c.JSON(http.StatusCreated, map[string]string{"message": "Documentation head added successfully"})
+}
+
+// RemoveDocuHeadHandler removes a documentation head from an event
+// @Summary Remove documentation head
+// @Description Remove a member from the documentation heads of an event. Documentation managers only.
+// @Tags trackers
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param member_id path int true "Member ID"
+// @Success 200 {object} map[string]string "Documentation head removed"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not a Documentation manager"
+// @Failure 404 {object} helpers.ErrorResponse "Event or documentation head not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/tracker/docu-heads/{member_id} [delete]
+func (h *Handler) RemoveDocuHeadHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	memberID, err := strconv.ParseInt(c.Param("member_id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid member ID"})
+	}
+
+	event, ok, err := h.getEvent(c)
+	if !ok {
+		return err
+	}
+
+	if !h.getAccess(ctx, principal, event).docuManager {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Only Documentation managers can remove documentation heads"})
+	}
+
+	rows, err := q.RemoveEventDocuHead(ctx, repository.RemoveEventDocuHeadParams{
+		EventID:  event.ID,
+		MemberID: int32(memberID),
+	})
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to remove documentation head")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Error removing documentation head"})
+	}
+	if rows == 0 {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Documentation head not found"})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Documentation head removed successfully"})
+}
+
+// AddFinProcessHandler adds a finance process to a tracker
+// @Summary Add finance process
+// @Description Add a finance process (see GET /trackers/workflows) to an event's tracker. Organizers and Finance reviewers only.
+// @Tags trackers
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param request body AddFinProcessRequest true "Process to add"
+// @Success 201 {object} FinProcessResponse "Finance process added"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request or unknown process"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not an organizer or Finance reviewer"
+// @Failure 404 {object} helpers.ErrorResponse "Event not found"
+// @Failure 409 {object} helpers.ErrorResponse "Process already added"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/tracker/fin-processes [post]
+func (h *Handler) AddFinProcessHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	var req AddFinProcessRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid request format"})
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+
+	event, ok, err := h.getEvent(c)
+	if !ok {
+		return err
+	}
+
+	acc := h.getAccess(ctx, principal, event)
+	if !acc.organizer && !acc.fin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Not an organizer or Finance reviewer of this event"})
+	}
+
+	if err := q.CreateEventTracker(ctx, event.ID); err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to create tracker")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	id, err := q.AddFinProcess(ctx, repository.AddFinProcessParams{
+		TrackerID: event.ID,
+		Process:   req.Process,
+	})
+	if err != nil {
+		if helpers.IsDuplicateEntry(err) {
+			return c.JSON(http.StatusConflict, map[string]string{"error": "Finance process already added"})
+		}
+		if helpers.IsForeignKeyViolation(err) {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "Unknown finance process"})
+		}
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to add finance process")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Error adding finance process"})
+	}
+
+	return c.JSON(http.StatusCreated, FinProcessResponse{ID: int32(id), Process: req.Process})
+}
+
+// RemoveFinProcessHandler removes a finance process from a tracker
+// @Summary Remove finance process
+// @Description Remove a finance process from an event's tracker. Organizers and Finance reviewers only.
+// @Tags trackers
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param process_id path int true "Finance process ID"
+// @Success 200 {object} map[string]string "Finance process removed"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Not an organizer or Finance reviewer"
+// @Failure 404 {object} helpers.ErrorResponse "Event or finance process not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /events/{id}/tracker/fin-processes/{process_id} [delete]
+func (h *Handler) RemoveFinProcessHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	principal, ok := auth.GetPrincipal(c)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "Unauthorized"})
+	}
+
+	processID, err := strconv.ParseInt(c.Param("process_id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid finance process ID"})
+	}
+
+	event, ok, err := h.getEvent(c)
+	if !ok {
+		return err
+	}
+
+	acc := h.getAccess(ctx, principal, event)
+	if !acc.organizer && !acc.fin {
+		return c.JSON(http.StatusForbidden, map[string]string{"error": "Not an organizer or Finance reviewer of this event"})
+	}
+
+	rows, err := q.RemoveFinProcess(ctx, repository.RemoveFinProcessParams{
+		ID:        int32(processID),
+		TrackerID: event.ID,
+	})
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to remove finance process")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Error removing finance process"})
+	}
+	if rows == 0 {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Finance process not found"})
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Finance process removed successfully"})
+}
+
+// access describes what a member may do on the tracker of an event
+type access struct {
+	organizer   bool // manages the event's committee or heads the event
+	docuManager bool // manages the Documentation committee
+	finManager  bool // manages the Finance committee
+	docu        bool // reviews documentation: Documentation managers and the event's documentation heads
+	fin         bool // reviews finance: Finance managers and the event's finance head
+}
+
+// reviews returns true if the member reviews the given stage
+func (a access) reviews(stage Stage) bool {
+	if stage.IsFinance() {
+		return a.fin
+	}
+	return a.docu
+}
+
+func (h *Handler) getAccess(ctx context.Context, principal *auth.Principal, event repository.Event) access {
+	q := repository.New(h.dbService.GetConnection())
+	actorID := principal.MemberID
+
+	managed := h.rbacService.CanManageCommittees(ctx, actorID, event.CommitteeID, h.cfg.DocuCommitteeID, h.cfg.FinCommitteeID)
+	acc := access{
+		organizer:   managed[event.CommitteeID],
+		docuManager: managed[h.cfg.DocuCommitteeID],
+		finManager:  managed[h.cfg.FinCommitteeID],
+	}
+	acc.docu = acc.docuManager || (event.DocuHead.Valid && event.DocuHead.Int32 == actorID)
+	acc.fin = acc.finManager || (event.FinHead.Valid && event.FinHead.Int32 == actorID)
+
+	if !acc.organizer {
+		isHead, err := q.IsEventHead(ctx, repository.IsEventHeadParams{EventID: event.ID, MemberID: actorID})
+		if err != nil {
+			log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to check event head")
+		}
+		acc.organizer = isHead
+	}
+	if !acc.docu {
+		isDocuHead, err := q.IsEventDocuHead(ctx, repository.IsEventDocuHeadParams{EventID: event.ID, MemberID: actorID})
+		if err != nil {
+			log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to check documentation head")
+		}
+		acc.docu = isDocuHead
+	}
+
+	return acc
+}
+
+// getEvent loads the event in the "id" path parameter.
+// If ok is false, the error response was already written.
+func (h *Handler) getEvent(c echo.Context) (repository.Event, bool, error) {
+	q := repository.New(h.dbService.GetConnection())
+
+	eventID, err := parseEventID(c)
+	if err != nil {
+		return repository.Event{}, false, c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid event ID"})
+	}
+
+	event, err := q.GetEventById(c.Request().Context(), eventID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return repository.Event{}, false, c.JSON(http.StatusNotFound, map[string]string{"error": "Event not found"})
+		}
+		log.Error().Err(err).Int32("event_id", eventID).Msg("failed to get event")
+		return repository.Event{}, false, c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	return event, true, nil
+}
+
+// respondWithTracker writes the full tracker of an event
+func (h *Handler) respondWithTracker(c echo.Context, event repository.Event) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	tracker, err := h.service.Get(ctx, event.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to get tracker")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	docuHeads, err := q.ListEventDocuHeads(ctx, event.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to list documentation heads")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+	processes, err := q.ListFinProcesses(ctx, event.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("event_id", event.ID).Msg("failed to list finance processes")
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+	}
+
+	response := toTrackerResponse(tracker, time.Now())
+	response.EventName = event.Name
+	response.CommitteeID = event.CommitteeID
+	if event.DocuHead.Valid {
+		response.DocuHead = &event.DocuHead.Int32
+	}
+	if event.FinHead.Valid {
+		response.FinHead = &event.FinHead.Int32
+	}
+	response.DocuHeads = make([]HeadResponse, 0, len(docuHeads))
+	for _, head := range docuHeads {
+		response.DocuHeads = append(response.DocuHeads, HeadResponse{MemberID: head.ID, FullName: head.FullName, Email: head.Email})
+	}
+	response.FinProcesses = make([]FinProcessResponse, 0, len(processes))
+	for _, p := range processes {
+		response.FinProcesses = append(response.FinProcesses, FinProcessResponse{ID: p.ID, Process: p.Process, Name: p.Name})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// writeError reports a failed write, turning unknown members into a 400
+func writeError(c echo.Context, err error, msg string) error {
+	if helpers.IsForeignKeyViolation(err) {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Member not found"})
+	}
+	log.Error().Err(err).Msg(msg)
+	return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Internal server error"})
+}
+
+func toWorkflowResponse(stages []Stage, m Machine, titles map[string]string) WorkflowResponse {
+	resp := WorkflowResponse{
+		Stages:      stages,
+		Initial:     m.Initial,
+		Transitions: m.Transitions,
+	}
+	for _, id := range m.Statuses() {
+		resp.Statuses = append(resp.Statuses, StatusResponse{ID: id, Title: titles[id]})
+	}
+	return resp
+}
+
+func trackerFromRow(r repository.ListEventTrackersRow) repository.EventTracker {
+	return repository.EventTracker{
+		EventID:             r.EventID,
+		PreactsDeadline:     r.PreactsDeadline,
+		PreactsStatus:       r.PreactsStatus,
+		PostactsDeadline:    r.PostactsDeadline,
+		PostactsStatus:      r.PostactsStatus,
+		DocuDriveID:         r.DocuDriveID,
+		FinDriveID:          r.FinDriveID,
+		FinPreactsDeadline:  r.FinPreactsDeadline,
+		FinPreactsStatus:    r.FinPreactsStatus,
+		FinPostactsDeadline: r.FinPostactsDeadline,
+		FinPostactsStatus:   r.FinPostactsStatus,
+	}
+}
+
+// optionalMember converts a member ID to sql.NullInt32, with 0 meaning none
+func optionalMember(id int32) sql.NullInt32 {
+	return sql.NullInt32{Int32: id, Valid: id != 0}
+}
+
+func parseEventID(c echo.Context) (int32, error) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	return int32(id), err
+}
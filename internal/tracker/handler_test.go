@@ -0,0 +1,236 @@
+package tracker
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/auth"
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+)
+
+// mockDBService is a mock implementation of the database.Service interface.
+type mockDBService struct {
+	db *sql.DB
+}
+
+func (m *mockDBService) Health() map[string]string {
+	return nil
+}
+
+func (m *mockDBService) Close() error {
+	return nil
+}
+
+func (m *mockDBService) GetConnection() *sql.DB {
+	return m.db
+}
+
+var trackerColumns = []string{
+	"event_id", "preacts_deadline", "preacts_status", "postacts_deadline", "postacts_status",
+	"docu_drive_id", "fin_drive_id", "fin_preacts_deadline", "fin_preacts_status",
+	"fin_postacts_deadline", "fin_postacts_status",
+}
+
+func trackerRow(eventID int32, preacts, postacts string) *sqlmock.Rows {
+	return sqlmock.NewRows(trackerColumns).AddRow(
+		eventID, nil, preacts, nil, postacts,
+		nil, nil, nil, StatusInit,
+		nil, StatusInit,
+	)
+}
+
+func eventRow(id int32, committeeID string) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{
+		"id", "arn", "name", "committee_id", "type", "nature_id", "term_id", "created_at", "duration_id",
+		"brief_description", "goals", "objectives", "strategies", "measures", "budget_allocation", "venue",
+		"docu_head", "fin_head",
+	}).AddRow(
+		id, "2526T1-RND-001", "Intro to Go Workshop", committeeID, "Workshop", 1, 1, time.Now(), nil,
+		nil, nil, nil, nil, nil, "1500.00", "Online",
+		nil, nil,
+	)
+}
+
+// expectAccess mocks the access lookups of a non-admin member who is neither an event head nor a documentation head
+func expectAccess(mock sqlmock.Sqlmock, memberID int32, position, committeeID string, eventID int32) {
+	mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(memberID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery("SELECT (.+) FROM members m").
+		WithArgs(memberID).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "email", "full_name", "nickname", "image_url",
+			"committee_id", "committee_name",
+			"division_id", "division_name",
+			"position_id", "position_name",
+			"house_name",
+			"contact_number", "college", "program",
+			"interests", "discord", "fb_link", "telegram",
+		}).AddRow(
+			memberID, "test@dlsu.edu.ph", "Test User", nil, nil,
+			committeeID, nil,
+			nil, nil,
+			position, nil,
+			nil,
+			nil, nil, nil,
+			nil, nil, nil, nil,
+		))
+	if committeeID != "RND" {
+		mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM event_heads").
+			WithArgs(eventID, memberID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	}
+	if committeeID != "DOCU" {
+		mock.ExpectQuery("SELECT EXISTS\\(SELECT 1 FROM event_docu_head").
+			WithArgs(eventID, memberID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	}
+}
+
+func newHandler(db *sql.DB) *Handler {
+	dbService := &mockDBService{db: db}
+	cfg := &config.Config{DocuCommitteeID: "DOCU", FinCommitteeID: "FIN"}
+	return NewHandler(cfg, dbService, auth.NewRBACService(dbService))
+}
+
+func newTransitionContext(body string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/events/1/tracker/transitions", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	auth.SetPrincipal(c, &auth.Principal{MemberID: 1, Method: auth.AuthMethodSession})
+	return c, rec
+}
+
+func TestTransitionHandler(t *testing.T) {
+	t.Run("success - organizer starts pre-acts", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(eventRow(1, "RND"))
+		expectAccess(mock, 1, "VP", "RND", 1)
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT IGNORE INTO event_trackers").
+			WithArgs(int32(1)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT (.+) FROM event_trackers").
+			WithArgs(int32(1)).
+			WillReturnRows(trackerRow(1, StatusInit, StatusInit))
+		mock.ExpectExec("UPDATE event_trackers SET preacts_status").
+			WithArgs(StatusInProgress, int32(1), StatusInit).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO tracker_status_history").
+			WithArgs(int32(1), "preacts", StatusInit, StatusInProgress, int32(1), "Drafting the proposal").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery("SELECT (.+) FROM event_trackers").
+			WithArgs(int32(1)).
+			WillReturnRows(trackerRow(1, StatusInProgress, StatusInit))
+		mock.ExpectCommit()
+		mock.ExpectQuery("SELECT (.+) FROM event_trackers").
+			WithArgs(int32(1)).
+			WillReturnRows(trackerRow(1, StatusInProgress, StatusInit))
+		mock.ExpectQuery("SELECT (.+) FROM event_docu_head").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "email"}))
+		mock.ExpectQuery("SELECT (.+) FROM fin_processes").
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "process", "name"}))
+
+		c, rec := newTransitionContext(`{"stage":"preacts","status":"IN_PROGRESS","note":"Drafting the proposal"}`)
+		if assert.NoError(t, newHandler(db).TransitionHandler(c)) {
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var resp TrackerResponse
+			json.Unmarshal(rec.Body.Bytes(), &resp)
+			assert.Equal(t, StatusInProgress, resp.Preacts.Status)
+			assert.Equal(t, []Transition{{From: StatusInProgress, To: StatusForReview}}, resp.Preacts.Next)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - organizer cannot approve", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(eventRow(1, "RND"))
+		expectAccess(mock, 1, "VP", "RND", 1)
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT IGNORE INTO event_trackers").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT (.+) FROM event_trackers").
+			WithArgs(int32(1)).
+			WillReturnRows(trackerRow(1, StatusSubmitted, StatusInit))
+		mock.ExpectRollback()
+
+		c, rec := newTransitionContext(`{"stage":"preacts","status":"APPROVED"}`)
+		if assert.NoError(t, newHandler(db).TransitionHandler(c)) {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - post-acts before pre-acts are approved", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(eventRow(1, "RND"))
+		expectAccess(mock, 1, "VP", "DOCU", 1)
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT IGNORE INTO event_trackers").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT (.+) FROM event_trackers").
+			WithArgs(int32(1)).
+			WillReturnRows(trackerRow(1, StatusSubmitted, StatusInit))
+		mock.ExpectRollback()
+
+		c, rec := newTransitionContext(`{"stage":"postacts","status":"IN_PROGRESS"}`)
+		if assert.NoError(t, newHandler(db).TransitionHandler(c)) {
+			assert.Equal(t, http.StatusConflict, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - not an organizer or reviewer", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM events WHERE id = ?").
+			WithArgs(int32(1)).
+			WillReturnRows(eventRow(1, "RND"))
+		expectAccess(mock, 1, "CT", "EXT", 1)
+
+		c, rec := newTransitionContext(`{"stage":"preacts","status":"IN_PROGRESS"}`)
+		if assert.NoError(t, newHandler(db).TransitionHandler(c)) {
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - unknown stage", func(t *testing.T) {
+		c, rec := newTransitionContext(`{"stage":"budget","status":"IN_PROGRESS"}`)
+		if assert.NoError(t, newHandler(nil).TransitionHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package tracker
+
+// Stage identifies one of the workflows of an event tracker
+type Stage string
+
+const (
+	StagePreacts     Stage = "preacts"
+	StagePostacts    Stage = "postacts"
+	StageFinPreacts  Stage = "fin_preacts"
+	StageFinPostacts Stage = "fin_postacts"
+)
+
+// Stages lists all tracker stages
+var Stages = []Stage{StagePreacts, StagePostacts, StageFinPreacts, StageFinPostacts}
+
+// Status IDs (docu_status and fin_status tables)
+const (
+	StatusInit          = "INIT"
+	StatusNotApplicable = "NOT_APPLICABLE" // finance only: the event has no budget
+	StatusInProgress    = "IN_PROGRESS"
+	StatusForReview     = "FOR_REVIEW"
+	StatusForRevision   = "FOR_REVISION"
+	StatusSubmitted     = "SUBMITTED"
+	StatusApproved      = "APPROVED"
+)
+
+// Transition is an allowed status change of a stage.
+// Review transitions can only be made by the reviewing committee (Documentation or Finance);
+// the others can also be made by the event's organizers.
+type Transition struct {
+	From   string `json:"from" example:"FOR_REVIEW"`
+	To     string `json:"to" example:"SUBMITTED"`
+	Review bool   `json:"review" example:"true"`
+}
+
+// Machine is the state machine of a stage
+type Machine struct {
+	Initial     string
+	Transitions []Transition
+}
+
+// DocuMachine is the workflow of pre-acts and post-acts documentation:
+// organizers prepare the documents, Documentation reviews them and submits them to the university
+var DocuMachine = Machine{
+	Initial: StatusInit,
+	Transitions: []Transition{
+		{From: StatusInit, To: StatusInProgress},
+		{From: StatusInProgress, To: StatusForReview},
+		{From: StatusForReview, To: StatusForRevision, Review: true},
+		{From: StatusForReview, To: StatusSubmitted, Review: true},
+		{From: StatusForRevision, To: StatusForReview},
+		{From: StatusSubmitted, To: StatusApproved, Review: true},
+		{From: StatusSubmitted, To: StatusForRevision, Review: true},
+	},
+}
+
+// FinMachine is the workflow of pre-acts (budget) and post-acts (liquidation) finance requirements.
+// It is the documentation workflow, plus marking events without a budget as not applicable.
+var FinMachine = Machine{
+	Initial: StatusInit,
+	Transitions: append([]Transition{
+		{From: StatusInit, To: StatusNotApplicable, Review: true},
+		{From: StatusNotApplicable, To: StatusInit, Review: true},
+	}, DocuMachine.Transitions...),
+}
+
+// Next returns the transitions allowed from a status
+func (m Machine) Next(from string) []Transition {
+	next := []Transition{}
+	for _, t := range m.Transitions {
+		if t.From == from {
+			next = append(next, t)
+		}
+	}
+	return next
+}
+
+// Find returns the transition from one status to another, if it is allowed
+func (m Machine) Find(from, to string) (Transition, bool) {
+	for _, t := range m.Transitions {
+		if t.From == from && t.To == to {
+			return t, true
+		}
+	}
+	return Transition{}, false
+}
+
+// Statuses returns every status of the machine, starting with the initial one
+func (m Machine) Statuses() []string {
+	seen := map[string]bool{m.Initial: true}
+	statuses := []string{m.Initial}
+	for _, t := range m.Transitions {
+		for _, s := range []string{t.From, t.To} {
+			if !seen[s] {
+				seen[s] = true
+				statuses = append(statuses, s)
+			}
+		}
+	}
+	return statuses
+}
+
+// ParseStage returns the stage with the given name
+func ParseStage(name string) (Stage, bool) {
+	for _, s := range Stages {
+		if string(s) == name {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// IsFinance returns true for the stages reviewed by the Finance committee
+func (s Stage) IsFinance() bool {
+	return s == StageFinPreacts || s == StageFinPostacts
+}
+
+// Machine returns the state machine of the stage
+func (s Stage) Machine() Machine {
+	if s.IsFinance() {
+		return FinMachine
+	}
+	return DocuMachine
+}
+
+// Prerequisite returns the stage that must be complete before this one can start
+func (s Stage) Prerequisite() (Stage, bool) {
+	switch s {
+	case StagePostacts:
+		return StagePreacts, true
+	case StageFinPostacts:
+		return StageFinPreacts, true
+	}
+	return "", false
+}
+
+// IsComplete returns true if a stage in this status needs no further work
+func IsComplete(status string) bool {
+	return status == StatusApproved || status == StatusNotApplicable
+}
@@ -0,0 +1,69 @@
+package tracker
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+func TestMachineFind(t *testing.T) {
+	tests := []struct {
+		name    string
+		machine Machine
+		from    string
+		to      string
+		ok      bool
+		review  bool
+	}{
+		{"start documentation", DocuMachine, StatusInit, StatusInProgress, true, false},
+		{"submit for review", DocuMachine, StatusInProgress, StatusForReview, true, false},
+		{"reviewer submits", DocuMachine, StatusForReview, StatusSubmitted, true, true},
+		{"reviewer approves", DocuMachine, StatusSubmitted, StatusApproved, true, true},
+		{"cannot skip review", DocuMachine, StatusInProgress, StatusApproved, false, false},
+		{"approved is final", DocuMachine, StatusApproved, StatusForRevision, false, false},
+		{"documentation cannot be not applicable", DocuMachine, StatusInit, StatusNotApplicable, false, false},
+		{"finance not applicable", FinMachine, StatusInit, StatusNotApplicable, true, true},
+		{"finance follows documentation workflow", FinMachine, StatusForReview, StatusForRevision, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transition, ok := tt.machine.Find(tt.from, tt.to)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.review, transition.Review)
+		})
+	}
+}
+
+func TestMachineStatuses(t *testing.T) {
+	assert.Equal(t, []string{
+		StatusInit, StatusInProgress, StatusForReview, StatusForRevision, StatusSubmitted, StatusApproved,
+	}, DocuMachine.Statuses())
+	assert.Contains(t, FinMachine.Statuses(), StatusNotApplicable)
+}
+
+func TestNextTransitions(t *testing.T) {
+	tracker := defaultTracker(1)
+
+	// post-acts cannot start before pre-acts are complete
+	assert.Len(t, NextTransitions(tracker, StagePreacts), 1)
+	assert.Empty(t, NextTransitions(tracker, StagePostacts))
+
+	tracker.PreactsStatus = StatusApproved
+	assert.Empty(t, NextTransitions(tracker, StagePreacts))
+	assert.Equal(t, []Transition{{From: StatusInit, To: StatusInProgress}}, NextTransitions(tracker, StagePostacts))
+
+	// events without a budget can skip finance post-acts
+	tracker.FinPreactsStatus = sql.NullString{String: StatusNotApplicable, Valid: true}
+	assert.NotEmpty(t, NextTransitions(tracker, StageFinPostacts))
+}
+
+func TestStageStatus(t *testing.T) {
+	tracker := repository.EventTracker{PreactsStatus: StatusForReview}
+	assert.Equal(t, StatusForReview, StageStatus(tracker, StagePreacts))
+	// NULL finance statuses are not started
+	assert.Equal(t, StatusInit, StageStatus(tracker, StageFinPreacts))
+}
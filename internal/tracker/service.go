@@ -0,0 +1,323 @@
+package tracker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+var (
+	// ErrInvalidTransition is returned when the stage cannot move from its current status to the requested one
+	ErrInvalidTransition = errors.New("status transition not allowed")
+	// ErrReviewRequired is returned when a review transition is requested by someone who is not a reviewer
+	ErrReviewRequired = errors.New("transition can only be made by the reviewing committee")
+	// ErrPrerequisiteIncomplete is returned when a post-acts stage is started before its pre-acts stage is complete
+	ErrPrerequisiteIncomplete = errors.New("previous stage is not complete")
+	// ErrStatusChanged is returned when the status was changed by someone else while the transition was applied
+	ErrStatusChanged = errors.New("status was changed concurrently")
+)
+
+// Service applies status transitions to event trackers
+type Service struct {
+	dbService database.Service
+}
+
+func NewService(dbService database.Service) *Service {
+	return &Service{dbService: dbService}
+}
+
+// TransitionInput describes a requested status change
+type TransitionInput struct {
+	EventID  int32
+	Stage    Stage
+	To       string
+	Reviewer bool // the actor belongs to the reviewing committee of the stage
+	ActorID  int32
+	Note     sql.NullString
+}
+
+// Get returns the tracker of an event. Events without a tracker get a new one with every stage not started.
+func (s *Service) Get(ctx context.Context, eventID int32) (repository.EventTracker, error) {
+	q := repository.New(s.dbService.GetConnection())
+
+	tracker, err := q.GetEventTracker(ctx, eventID)
+	if err == sql.ErrNoRows {
+		return defaultTracker(eventID), nil
+	}
+	return tracker, err
+}
+
+// Advance moves a stage to a new status, following the stage's state machine,
+// and records the change in the status history
+func (s *Service) Advance(ctx context.Context, in TransitionInput) (repository.EventTracker, error) {
+	db := s.dbService.GetConnection()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return repository.EventTracker{}, err
+	}
+	defer tx.Rollback()
+	qtx := repository.New(db).WithTx(tx)
+
+	if err := qtx.CreateEventTracker(ctx, in.EventID); err != nil {
+		return repository.EventTracker{}, err
+	}
+	tracker, err := qtx.GetEventTracker(ctx, in.EventID)
+	if err != nil {
+		return repository.EventTracker{}, err
+	}
+
+	from := StageStatus(tracker, in.Stage)
+	transition, ok := in.Stage.Machine().Find(from, in.To)
+	if !ok {
+		return tracker, ErrInvalidTransition
+	}
+	if transition.Review && !in.Reviewer {
+		return tracker, ErrReviewRequired
+	}
+	if prereq, ok := in.Stage.Prerequisite(); ok && from == StatusInit && !IsComplete(StageStatus(tracker, prereq)) {
+		return tracker, ErrPrerequisiteIncomplete
+	}
+
+	rows, err := setStageStatus(ctx, qtx, in.EventID, in.Stage, from, in.To)
+	if err != nil {
+		return tracker, err
+	}
+	if rows == 0 {
+		return tracker, ErrStatusChanged
+	}
+
+	if err := qtx.CreateTrackerStatusHistory(ctx, repository.CreateTrackerStatusHistoryParams{
+		EventID:    in.EventID,
+		Stage:      string(in.Stage),
+		FromStatus: from,
+		ToStatus:   in.To,
+		ChangedBy:  sql.NullInt32{Int32: in.ActorID, Valid: true},
+		Note:       in.Note,
+	}); err != nil {
+		return tracker, err
+	}
+
+	tracker, err = qtx.GetEventTracker(ctx, in.EventID)
+	if err != nil {
+		return tracker, err
+	}
+
+	return tracker, tx.Commit()
+}
+
+// NextTransitions returns the transitions currently allowed for a stage of a tracker
+func NextTransitions(t repository.EventTracker, stage Stage) []Transition {
+	status := StageStatus(t, stage)
+	if prereq, ok := stage.Prerequisite(); ok && status == StatusInit && !IsComplete(StageStatus(t, prereq)) {
+		return []Transition{}
+	}
+	return stage.Machine().Next(status)
+}
+
+// StageStatus returns the current status of a stage
+func StageStatus(t repository.EventTracker, stage Stage) string {
+	var status sql.NullString
+	switch stage {
+	case StagePreacts:
+		status = sql.NullString{String: t.PreactsStatus, Valid: true}
+	case StagePostacts:
+		status = sql.NullString{String: t.PostactsStatus, Valid: true}
+	case StageFinPreacts:
+		status = t.FinPreactsStatus
+	case StageFinPostacts:
+		status = t.FinPostactsStatus
+	}
+	if !status.Valid || status.String == "" {
+		return StatusInit
+	}
+	return status.String
+}
+
+// StageDeadline returns the deadline of a stage
+func StageDeadline(t repository.EventTracker, stage Stage) sql.NullTime {
+	switch stage {
+	case StagePreacts:
+		return t.PreactsDeadline
+	case StagePostacts:
+		return t.PostactsDeadline
+	case StageFinPreacts:
+		return t.FinPreactsDeadline
+	case StageFinPostacts:
+		return t.FinPostactsDeadline
+	}
+	return sql.NullTime{}
+}
+
+func setStageStatus(ctx context.Context, q *repository.Queries, eventID int32, stage Stage, from, to string) (int64, error) {
+	switch stage {
+	case StagePreacts:
+		return q.UpdatePreactsStatus(ctx, repository.UpdatePreactsStatusParams{
+			PreactsStatus: to, EventID: eventID, PreactsStatus_2: from,
+		})
+	case StagePostacts:
+		return q.UpdatePostactsStatus(ctx, repository.UpdatePostactsStatusParams{
+			PostactsStatus: to, EventID: eventID, PostactsStatus_2: from,
+		})
+	case StageFinPreacts:
+		return q.UpdateFinPreactsStatus(ctx, repository.UpdateFinPreactsStatusParams{
+			FinPreactsStatus:   sql.NullString{String: to, Valid: true},
+			EventID:            eventID,
+			FinPreactsStatus_2: sql.NullString{String: from, Valid: true},
+		})
+	case StageFinPostacts:
+		return q.UpdateFinPostactsStatus(ctx, repository.UpdateFinPostactsStatusParams{
+			FinPostactsStatus:   sql.NullString{String: to, Valid: true},
+			EventID:             eventID,
+			FinPostactsStatus_2: sql.NullString{String: from, Valid: true},
+		})
+	}
+	return 0, ErrInvalidTransition
+}
+
+func defaultTracker(eventID int32) repository.EventTracker {
+	return repository.EventTracker{
+		EventID:           eventID,
+		PreactsStatus:     StatusInit,
+		PostactsStatus:    StatusInit,
+		FinPreactsStatus:  sql.NullString{String: StatusInit, Valid: true},
+		FinPostactsStatus: sql.NullString{String: StatusInit, Valid: true},
+	}
+}
+
+// OverdueItem is a stage of a tracker whose deadline passed before it was complete
+type OverdueItem struct {
+	EventID     int32          `json:"event_id" example:"1"`
+	EventName   string         `json:"event_name" example:"Intro to Go Workshop"`
+	CommitteeID string         `json:"committee_id" example:"RND"`
+	Stage       Stage          `json:"stage" example:"preacts"`
+	Status      string         `json:"status" example:"IN_PROGRESS"`
+	Deadline    time.Time      `json:"deadline" example:"2026-10-29T23:59:00+08:00"`
+	DaysOverdue int            `json:"days_overdue" example:"3"`
+	Heads       []HeadResponse `json:"heads"`
+}
+
+// Overdue returns the incomplete stages whose deadline has passed, oldest deadline first.
+// Heads are the members responsible for each stage: the event heads, and its documentation or finance heads.
+func (s *Service) Overdue(ctx context.Context, now time.Time) ([]OverdueItem, error) {
+	q := repository.New(s.dbService.GetConnection())
+
+	trackers, err := q.ListTrackerDeadlines(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []OverdueItem{}
+	for _, row := range trackers {
+		t := trackerFromDeadlineRow(row)
+		var heads *eventHeads
+
+		for _, stage := range Stages {
+			status := StageStatus(t, stage)
+			deadline := StageDeadline(t, stage)
+			if IsComplete(status) || !deadline.Valid || !now.After(deadline.Time) {
+				continue
+			}
+
+			if heads == nil {
+				h, err := s.eventHeads(ctx, row)
+				if err != nil {
+					return nil, err
+				}
+				heads = &h
+			}
+
+			items = append(items, OverdueItem{
+				EventID:     row.EventID,
+				EventName:   row.Name,
+				CommitteeID: row.CommitteeID,
+				Stage:       stage,
+				Status:      status,
+				Deadline:    deadline.Time,
+				DaysOverdue: int(now.Sub(deadline.Time).Hours() / 24),
+				Heads:       heads.forStage(stage),
+			})
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Deadline.Before(items[j].Deadline)
+	})
+	return items, nil
+}
+
+// eventHeads are the members responsible for the stages of an event's tracker
+type eventHeads struct {
+	organizers []HeadResponse // event heads
+	docu       []HeadResponse // documentation head and documentation heads
+	fin        []HeadResponse // finance head
+}
+
+// forStage returns the organizers and the reviewing heads of a stage, without duplicates
+func (h eventHeads) forStage(stage Stage) []HeadResponse {
+	reviewers := h.docu
+	if stage.IsFinance() {
+		reviewers = h.fin
+	}
+
+	heads := []HeadResponse{}
+	seen := map[int32]bool{}
+	for _, head := range append(append([]HeadResponse{}, h.organizers...), reviewers...) {
+		if !seen[head.MemberID] {
+			seen[head.MemberID] = true
+			heads = append(heads, head)
+		}
+	}
+	return heads
+}
+
+func (s *Service) eventHeads(ctx context.Context, row repository.ListTrackerDeadlinesRow) (eventHeads, error) {
+	q := repository.New(s.dbService.GetConnection())
+	var heads eventHeads
+
+	organizers, err := q.ListEventHeads(ctx, row.EventID)
+	if err != nil {
+		return heads, err
+	}
+	for _, m := range organizers {
+		heads.organizers = append(heads.organizers, HeadResponse{MemberID: m.ID, FullName: m.FullName, Email: m.Email})
+	}
+
+	if row.DocuHead.Valid {
+		head, err := memberContact(ctx, q, row.DocuHead.Int32)
+		if err != nil {
+			return heads, err
+		}
+		heads.docu = append(heads.docu, head)
+	}
+	docuHeads, err := q.ListEventDocuHeads(ctx, row.EventID)
+	if err != nil {
+		return heads, err
+	}
+	for _, m := range docuHeads {
+		heads.docu = append(heads.docu, HeadResponse{MemberID: m.ID, FullName: m.FullName, Email: m.Email})
+	}
+
+	if row.FinHead.Valid {
+		head, err := memberContact(ctx, q, row.FinHead.Int32)
+		if err != nil {
+			return heads, err
+		}
+		heads.fin = append(heads.fin, head)
+	}
+
+	return heads, nil
+}
+
+func memberContact(ctx context.Context, q *repository.Queries, memberID int32) (HeadResponse, error) {
+	m, err := q.GetMemberContact(ctx, memberID)
+	if err != nil {
+		return HeadResponse{}, err
+	}
+	return HeadResponse{MemberID: m.ID, FullName: m.FullName, Email: m.Email}, nil
+}
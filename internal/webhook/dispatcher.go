@@ -0,0 +1,228 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// Delivery statuses (webhook_deliveries.status)
+const (
+	StatusPending   = "PENDING"
+	StatusDelivered = "DELIVERED"
+	StatusFailed    = "FAILED"
+)
+
+const (
+	// batchSize is the maximum number of deliveries sent per run
+	batchSize = 50
+	// baseRetryDelay is the delay before the first retry; each retry doubles it
+	baseRetryDelay = time.Minute
+	// maxRetryDelay caps the delay between retries
+	maxRetryDelay = 6 * time.Hour
+	// maxErrorLength is how much of an error (including the response body) is logged per attempt
+	maxErrorLength = 1000
+)
+
+// Dispatcher sends queued deliveries and retries failed ones with exponential backoff.
+// Each delivery is claimed right before it is sent, so several instances can run it.
+type Dispatcher struct {
+	dbService   database.Service
+	client      *http.Client
+	maxAttempts int
+	lockTTL     time.Duration
+	now         func() time.Time
+}
+
+func NewDispatcher(dbService database.Service, cfg *config.Config) *Dispatcher {
+	return &Dispatcher{
+		dbService:   dbService,
+		client:      &http.Client{Timeout: cfg.WebhookTimeout},
+		maxAttempts: max(cfg.WebhookMaxAttempts, 1),
+		lockTTL:     cfg.WebhookTimeout + time.Minute,
+		now:         time.Now,
+	}
+}
+
+// StartDispatcher starts a background goroutine that sends due deliveries every interval
+func StartDispatcher(ctx context.Context, dbService database.Service, cfg *config.Config) {
+	d := NewDispatcher(dbService, cfg)
+	go func() {
+		ticker := time.NewTicker(cfg.WebhookDispatchInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("webhook dispatcher stopped")
+				return
+			case <-ticker.C:
+				if _, err := d.Run(ctx); err != nil {
+					log.Error().Err(err).Msg("webhook dispatch failed")
+				}
+			}
+		}
+	}()
+}
+
+// Run sends the deliveries that are due and returns how many were attempted.
+// Sending a batch can take a while, so the clock is read again for every delivery:
+// its lock runs from when it was claimed, and its signature is fresh when it is sent.
+func (d *Dispatcher) Run(ctx context.Context) (int, error) {
+	q := repository.New(d.dbService.GetConnection())
+
+	now := d.now()
+	due, err := q.ListDueWebhookDeliveries(ctx, repository.ListDueWebhookDeliveriesParams{
+		NextAttemptAt: now,
+		LockedUntil:   sql.NullTime{Time: now, Valid: true},
+		Limit:         batchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("list due webhook deliveries: %w", err)
+	}
+
+	attempted := 0
+	var errs []error
+	for _, delivery := range due {
+		claimedAt := d.now()
+		claimed, err := q.ClaimWebhookDelivery(ctx, repository.ClaimWebhookDeliveryParams{
+			LockedUntil:   sql.NullTime{Time: claimedAt.Add(d.lockTTL), Valid: true},
+			ID:            delivery.ID,
+			LockedUntil_2: sql.NullTime{Time: claimedAt, Valid: true},
+		})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if claimed == 0 {
+			continue // another instance is sending it
+		}
+
+		attempted++
+		if err := d.deliver(ctx, q, delivery); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return attempted, errors.Join(errs...)
+}
+
+// deliver sends a delivery once and records the attempt
+func (d *Dispatcher) deliver(ctx context.Context, q *repository.Queries, delivery repository.ListDueWebhookDeliveriesRow) error {
+	start := d.now()
+	statusCode, sendErr := d.send(ctx, delivery, start)
+	now := d.now()
+	duration := now.Sub(start)
+
+	attempt := delivery.Attempts + 1
+	result := repository.FinishWebhookDeliveryAttemptParams{
+		Status:        StatusDelivered,
+		Attempts:      attempt,
+		NextAttemptAt: now,
+		DeliveredAt:   sql.NullTime{Time: now, Valid: true},
+		ID:            delivery.ID,
+	}
+	logEntry := repository.CreateWebhookDeliveryAttemptParams{
+		DeliveryID: delivery.ID,
+		Attempt:    attempt,
+		DurationMs: int32(duration.Milliseconds()),
+	}
+	if statusCode != 0 {
+		result.LastStatusCode = sql.NullInt32{Int32: int32(statusCode), Valid: true}
+		logEntry.StatusCode = result.LastStatusCode
+	}
+
+	if sendErr != nil {
+		message := truncate(sendErr.Error(), maxErrorLength)
+		result.LastError = sql.NullString{String: message, Valid: true}
+		result.DeliveredAt = sql.NullTime{}
+		logEntry.Error = result.LastError
+
+		if int(attempt) >= d.maxAttempts {
+			result.Status = StatusFailed
+		} else {
+			result.Status = StatusPending
+			result.NextAttemptAt = now.Add(RetryDelay(int(attempt)))
+		}
+	}
+
+	if err := q.CreateWebhookDeliveryAttempt(ctx, logEntry); err != nil {
+		log.Error().Err(err).Int32("delivery_id", delivery.ID).Msg("failed to log webhook delivery attempt")
+	}
+	if err := q.FinishWebhookDeliveryAttempt(ctx, result); err != nil {
+		return fmt.Errorf("record webhook delivery %d: %w", delivery.ID, err)
+	}
+
+	entry := log.Info()
+	if sendErr != nil {
+		entry = log.Warn().Err(sendErr)
+	}
+	entry.
+		Int32("delivery_id", delivery.ID).
+		Int32("endpoint_id", delivery.EndpointID).
+		Str("event_type", delivery.EventType).
+		Int32("attempt", attempt).
+		Int("status_code", statusCode).
+		Str("status", result.Status).
+		Msg("webhook delivery attempted")
+	return nil
+}
+
+// send posts the payload to the endpoint, signed at sentAt. Any response other than 2xx is an error.
+func (d *Dispatcher) send(ctx context.Context, delivery repository.ListDueWebhookDeliveriesRow, sentAt time.Time) (int, error) {
+	payload := []byte(delivery.Payload)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "LSCS-Core-Webhooks")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderEventID, delivery.EventID)
+	req.Header.Set(HeaderDelivery, strconv.Itoa(int(delivery.ID)))
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, sentAt, payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorLength))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint responded with %d: %s", resp.StatusCode, body)
+	}
+	return resp.StatusCode, nil
+}
+
+// RetryDelay returns the delay after a failed attempt: 1 minute after the first, doubling up to 6 hours
+func RetryDelay(attempt int) time.Duration {
+	delay := baseRetryDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= maxRetryDelay {
+			return maxRetryDelay
+		}
+	}
+	return delay
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
@@ -0,0 +1,205 @@
+package webhook
+
+import (
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/config"
+)
+
+// mockDBService is a mock implementation of the database.Service interface.
+type mockDBService struct {
+	db *sql.DB
+}
+
+func (m *mockDBService) Health() map[string]string {
+	return nil
+}
+
+func (m *mockDBService) Close() error {
+	return nil
+}
+
+func (m *mockDBService) GetConnection() *sql.DB {
+	return m.db
+}
+
+const testSecret = "whsec_test"
+
+var dueColumns = []string{"id", "endpoint_id", "event_id", "event_type", "payload", "attempts", "url", "secret"}
+
+func newDispatcher(db *sql.DB) *Dispatcher {
+	return NewDispatcher(&mockDBService{db: db}, &config.Config{WebhookTimeout: 5 * time.Second, WebhookMaxAttempts: 3})
+}
+
+// expectDelivery expects a due delivery to be claimed and its attempt to be recorded
+func expectDelivery(mock sqlmock.Sqlmock, url string, attempts int32, status string, statusCode any) {
+	mock.ExpectQuery("SELECT (.+) FROM webhook_deliveries d").
+		WillReturnRows(sqlmock.NewRows(dueColumns).
+			AddRow(7, 1, "evt_1", EventMemberUpdated, `{"id":"evt_1"}`, attempts, url, testSecret))
+	mock.ExpectExec("UPDATE webhook_deliveries SET locked_until").
+		WithArgs(sqlmock.AnyArg(), int32(7), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO webhook_delivery_attempts").
+		WithArgs(int32(7), attempts+1, statusCode, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE webhook_deliveries SET status").
+		WithArgs(status, attempts+1, sqlmock.AnyArg(), statusCode, sqlmock.AnyArg(), sqlmock.AnyArg(), int32(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func TestDispatcherRun(t *testing.T) {
+	t.Run("success - signed delivery", func(t *testing.T) {
+		var received *http.Request
+		var body []byte
+		standIn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer standIn.Close()
+
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectDelivery(mock, standIn.URL, 0, StatusDelivered, sql.NullInt32{Int32: http.StatusNoContent, Valid: true})
+
+		now := time.Now()
+		attempted, err := newDispatcher(db).Run(t.Context())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, attempted)
+
+		if assert.NotNil(t, received) {
+			assert.Equal(t, EventMemberUpdated, received.Header.Get(HeaderEvent))
+			assert.Equal(t, "7", received.Header.Get(HeaderDelivery))
+			assert.True(t, Verify(testSecret, received.Header.Get(HeaderSignature), body, 5*time.Minute, now))
+			assert.False(t, Verify("other", received.Header.Get(HeaderSignature), body, 5*time.Minute, now))
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - retried after an error response", func(t *testing.T) {
+		standIn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer standIn.Close()
+
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectDelivery(mock, standIn.URL, 0, StatusPending, sql.NullInt32{Int32: http.StatusServiceUnavailable, Valid: true})
+
+		_, err = newDispatcher(db).Run(t.Context())
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - marked failed after the last attempt", func(t *testing.T) {
+		standIn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer standIn.Close()
+
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		expectDelivery(mock, standIn.URL, 2, StatusFailed, sql.NullInt32{Int32: http.StatusInternalServerError, Valid: true})
+
+		_, err = newDispatcher(db).Run(t.Context())
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - each delivery is claimed and signed when it is sent", func(t *testing.T) {
+		var signatures []string
+		standIn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			signatures = append(signatures, r.Header.Get(HeaderSignature))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer standIn.Close()
+
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		// every read of the clock is a minute later, as in a slow batch
+		start := time.Now()
+		clock := start
+		d := newDispatcher(db)
+		d.now = func() time.Time {
+			clock = clock.Add(time.Minute)
+			return clock
+		}
+
+		mock.ExpectQuery("SELECT (.+) FROM webhook_deliveries d").
+			WillReturnRows(sqlmock.NewRows(dueColumns).
+				AddRow(7, 1, "evt_1", EventMemberUpdated, `{}`, 0, standIn.URL, testSecret).
+				AddRow(8, 1, "evt_2", EventMemberUpdated, `{}`, 0, standIn.URL, testSecret))
+		for i, id := range []int32{7, 8} {
+			// list, then claim, send and finish for each delivery
+			claimedAt := start.Add(time.Duration(2+3*i) * time.Minute)
+			mock.ExpectExec("UPDATE webhook_deliveries SET locked_until").
+				WithArgs(sql.NullTime{Time: claimedAt.Add(d.lockTTL), Valid: true}, id, sql.NullTime{Time: claimedAt, Valid: true}).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("INSERT INTO webhook_delivery_attempts").
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec("UPDATE webhook_deliveries SET status").
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+
+		attempted, err := d.Run(t.Context())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, attempted)
+		if assert.Len(t, signatures, 2) {
+			sentAt := start.Add(6 * time.Minute)
+			assert.True(t, Verify(testSecret, signatures[1], []byte(`{}`), 5*time.Minute, sentAt))
+			assert.False(t, Verify(testSecret, signatures[1], []byte(`{}`), 5*time.Minute, start))
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("skip - claimed by another instance", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("SELECT (.+) FROM webhook_deliveries d").
+			WillReturnRows(sqlmock.NewRows(dueColumns).
+				AddRow(7, 1, "evt_1", EventMemberUpdated, `{}`, 0, "http://127.0.0.1:1", testSecret))
+		mock.ExpectExec("UPDATE webhook_deliveries SET locked_until").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		attempted, err := newDispatcher(db).Run(t.Context())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, attempted)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, time.Minute, RetryDelay(1))
+	assert.Equal(t, 4*time.Minute, RetryDelay(3))
+	assert.Equal(t, 6*time.Hour, RetryDelay(20))
+}
+
+func TestVerify(t *testing.T) {
+	payload := []byte(`{"id":"evt_1"}`)
+	signedAt := time.Unix(1760000000, 0)
+	header := Sign(testSecret, signedAt, payload)
+
+	assert.True(t, Verify(testSecret, header, payload, 0, time.Now()))
+	assert.True(t, Verify(testSecret, header, payload, 5*time.Minute, signedAt.Add(time.Minute)))
+	assert.False(t, Verify(testSecret, header, payload, 5*time.Minute, signedAt.Add(time.Hour)))
+	assert.False(t, Verify(testSecret, header, []byte(`{"id":"evt_2"}`), 0, time.Now()))
+	assert.False(t, Verify(testSecret, "v1=abc", payload, 0, time.Now()))
+}
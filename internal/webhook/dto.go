@@ -0,0 +1,152 @@
+package webhook
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// CreateEndpointRequest represents the request body for registering a webhook endpoint
+type CreateEndpointRequest struct {
+	URL         string   `json:"url" validate:"required,url,max=2048" example:"https://bot.lscs.org/webhooks/core"`
+	Description *string  `json:"description,omitempty" validate:"omitempty,max=255" example:"Discord bot"`
+	Events      []string `json:"events" validate:"required,min=1" example:"member.updated,member.position_changed"`
+}
+
+// UpdateEndpointRequest represents the request body for updating a webhook endpoint.
+// Omitting is_active keeps the endpoint's current state.
+type UpdateEndpointRequest struct {
+	URL         string   `json:"url" validate:"required,url,max=2048" example:"https://bot.lscs.org/webhooks/core"`
+	Description *string  `json:"description,omitempty" validate:"omitempty,max=255" example:"Discord bot"`
+	Events      []string `json:"events" validate:"required,min=1" example:"member.updated"`
+	IsActive    *bool    `json:"is_active,omitempty" example:"true"`
+}
+
+// EndpointResponse represents a webhook endpoint. The secret is only returned when the endpoint is created.
+type EndpointResponse struct {
+	ID          int32                  `json:"id" example:"1"`
+	URL         string                 `json:"url" example:"https://bot.lscs.org/webhooks/core"`
+	Description helpers.NullableString `json:"description"`
+	Events      []string               `json:"events" example:"member.updated,member.position_changed"`
+	IsActive    bool                   `json:"is_active" example:"true"`
+	CreatedAt   *time.Time             `json:"created_at,omitempty"`
+}
+
+// CreateEndpointResponse is returned once when an endpoint is registered.
+// The secret signs the payloads (see the X-LSCS-Signature header) and is never shown again.
+type CreateEndpointResponse struct {
+	EndpointResponse
+	Secret string `json:"secret" example:"whsec_8c1f0b7a..."`
+}
+
+// ListEndpointsResponse is the response for the GET /webhooks endpoint
+type ListEndpointsResponse struct {
+	Endpoints []EndpointResponse `json:"endpoints"`
+	// EventTypes are the event types endpoints can subscribe to
+	EventTypes []string `json:"event_types" example:"member.updated,member.position_changed"`
+}
+
+// DeliveryResponse represents a delivery of an event to an endpoint
+type DeliveryResponse struct {
+	ID             int32                  `json:"id" example:"120"`
+	EndpointID     int32                  `json:"endpoint_id" example:"1"`
+	EventID        string                 `json:"event_id" example:"evt_5f1c0e9a2b7d4e8f60a1b2c3d4e5f607"`
+	EventType      string                 `json:"event_type" example:"member.updated"`
+	Status         string                 `json:"status" example:"PENDING"`
+	Attempts       int32                  `json:"attempts" example:"2"`
+	NextAttemptAt  *time.Time             `json:"next_attempt_at,omitempty"`
+	LastStatusCode *int32                 `json:"last_status_code,omitempty" example:"503"`
+	LastError      helpers.NullableString `json:"last_error"`
+	DeliveredAt    *time.Time             `json:"delivered_at,omitempty"`
+	ReplayOf       *int32                 `json:"replay_of,omitempty" example:"97"`
+	CreatedAt      *time.Time             `json:"created_at,omitempty"`
+}
+
+// AttemptResponse is a logged delivery attempt
+type AttemptResponse struct {
+	Attempt     int32                  `json:"attempt" example:"1"`
+	StatusCode  *int32                 `json:"status_code,omitempty" example:"503"`
+	Error       helpers.NullableString `json:"error"`
+	DurationMs  int32                  `json:"duration_ms" example:"230"`
+	AttemptedAt *time.Time             `json:"attempted_at,omitempty"`
+}
+
+// DeliveryDetailResponse is a delivery with its payload and attempt log
+type DeliveryDetailResponse struct {
+	DeliveryResponse
+	Payload    json.RawMessage   `json:"payload"`
+	AttemptLog []AttemptResponse `json:"attempt_log"`
+}
+
+// ListDeliveriesResponse is the response for the GET /webhooks/{id}/deliveries endpoint, newest first
+type ListDeliveriesResponse struct {
+	Deliveries []DeliveryResponse `json:"deliveries"`
+}
+
+func toEndpointResponse(e repository.WebhookEndpoint) EndpointResponse {
+	resp := EndpointResponse{
+		ID:          e.ID,
+		URL:         e.Url,
+		Description: helpers.NullableString{NullString: e.Description},
+		Events:      ParseEvents(e.Events),
+		IsActive:    e.IsActive,
+	}
+	if e.CreatedAt.Valid {
+		resp.CreatedAt = &e.CreatedAt.Time
+	}
+	return resp
+}
+
+func toDeliveryResponse(d repository.WebhookDelivery) DeliveryResponse {
+	resp := DeliveryResponse{
+		ID:         d.ID,
+		EndpointID: d.EndpointID,
+		EventID:    d.EventID,
+		EventType:  d.EventType,
+		Status:     d.Status,
+		Attempts:   d.Attempts,
+		LastError:  helpers.NullableString{NullString: d.LastError},
+	}
+	if d.Status == StatusPending {
+		resp.NextAttemptAt = &d.NextAttemptAt
+	}
+	if d.LastStatusCode.Valid {
+		resp.LastStatusCode = &d.LastStatusCode.Int32
+	}
+	if d.DeliveredAt.Valid {
+		resp.DeliveredAt = &d.DeliveredAt.Time
+	}
+	if d.ReplayOf.Valid {
+		resp.ReplayOf = &d.ReplayOf.Int32
+	}
+	if d.CreatedAt.Valid {
+		resp.CreatedAt = &d.CreatedAt.Time
+	}
+	return resp
+}
+
+func toAttemptResponse(a repository.WebhookDeliveryAttempt) AttemptResponse {
+	resp := AttemptResponse{
+		Attempt:    a.Attempt,
+		Error:      helpers.NullableString{NullString: a.Error},
+		DurationMs: a.DurationMs,
+	}
+	if a.StatusCode.Valid {
+		resp.StatusCode = &a.StatusCode.Int32
+	}
+	if a.AttemptedAt.Valid {
+		resp.AttemptedAt = &a.AttemptedAt.Time
+	}
+	return resp
+}
+
+// toNullString converts an optional string to sql.NullString
+func toNullString(s *string) sql.NullString {
+	if s == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *s, Valid: true}
+}
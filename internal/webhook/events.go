@@ -0,0 +1,137 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+// Event types endpoints can subscribe to
+const (
+	EventMemberUpdated         = "member.updated"
+	EventMemberPositionChanged = "member.position_changed"
+	EventAPIKeyRevoked         = "api_key.revoked"
+)
+
+// EventPing is sent by the ping endpoint to every endpoint regardless of its subscriptions
+const EventPing = "ping"
+
+// EventTypes are the event types endpoints can subscribe to
+var EventTypes = []string{EventMemberUpdated, EventMemberPositionChanged, EventAPIKeyRevoked}
+
+// Event is the payload of a webhook delivery.
+// The ID is the same for every delivery (and replay) of an event, so receivers can deduplicate.
+type Event struct {
+	ID        string    `json:"id" example:"evt_5f1c0e9a2b7d4e8f60a1b2c3d4e5f607"`
+	Type      string    `json:"type" example:"member.updated"`
+	CreatedAt time.Time `json:"created_at" example:"2026-10-18T09:00:00Z"`
+	Data      any       `json:"data"`
+}
+
+// MemberPositionChangedData is the data of member.position_changed events
+type MemberPositionChangedData struct {
+	MemberID           int32   `json:"member_id" example:"12312345"`
+	PreviousPositionID *string `json:"previous_position_id" example:"CT"`
+	PositionID         *string `json:"position_id" example:"AVP"`
+}
+
+// APIKeyRevokedData is the data of api_key.revoked events
+type APIKeyRevokedData struct {
+	APIKeyID    int32  `json:"api_key_id" example:"42"`
+	MemberEmail string `json:"member_email" example:"juan_delacruz@dlsu.edu.ph"`
+}
+
+// Publisher queues events for delivery to the endpoints subscribed to them.
+// Deliveries are sent by the dispatcher, so publishing never waits on the endpoints.
+type Publisher struct {
+	dbService database.Service
+	now       func() time.Time
+}
+
+func NewPublisher(dbService database.Service) *Publisher {
+	return &Publisher{dbService: dbService, now: time.Now}
+}
+
+// Publish queues an event. Failures are logged rather than returned:
+// the change that caused the event has already been saved.
+func (p *Publisher) Publish(ctx context.Context, eventType string, data any) {
+	if _, err := p.publish(ctx, eventType, data, nil); err != nil {
+		log.Error().Err(err).Str("event_type", eventType).Msg("failed to queue webhook event")
+	}
+}
+
+// publish queues an event for the given endpoints, or for every active endpoint subscribed to it.
+// It returns the number of deliveries queued.
+func (p *Publisher) publish(ctx context.Context, eventType string, data any, endpoints []repository.WebhookEndpoint) (int, error) {
+	q := repository.New(p.dbService.GetConnection())
+
+	if endpoints == nil {
+		active, err := q.ListActiveWebhookEndpoints(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("list webhook endpoints: %w", err)
+		}
+		for _, e := range active {
+			if Subscribed(e, eventType) {
+				endpoints = append(endpoints, e)
+			}
+		}
+	}
+	if len(endpoints) == 0 {
+		return 0, nil
+	}
+
+	id, err := newEventID()
+	if err != nil {
+		return 0, err
+	}
+	now := p.now()
+	payload, err := json.Marshal(Event{ID: id, Type: eventType, CreatedAt: now.UTC(), Data: data})
+	if err != nil {
+		return 0, fmt.Errorf("encode webhook event: %w", err)
+	}
+
+	for i, e := range endpoints {
+		if _, err := q.CreateWebhookDelivery(ctx, repository.CreateWebhookDeliveryParams{
+			EndpointID:    e.ID,
+			EventID:       id,
+			EventType:     eventType,
+			Payload:       string(payload),
+			NextAttemptAt: now,
+			ReplayOf:      sql.NullInt32{},
+		}); err != nil {
+			return i, fmt.Errorf("queue webhook delivery: %w", err)
+		}
+	}
+
+	log.Debug().Str("event_id", id).Str("event_type", eventType).Int("endpoints", len(endpoints)).Msg("webhook event queued")
+	return len(endpoints), nil
+}
+
+// Subscribed reports whether an endpoint is subscribed to an event type
+func Subscribed(e repository.WebhookEndpoint, eventType string) bool {
+	return slices.Contains(ParseEvents(e.Events), eventType)
+}
+
+// ParseEvents splits the stored list of subscribed event types
+func ParseEvents(events string) []string {
+	return strings.Fields(events)
+}
+
+func newEventID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "evt_" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,512 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dlsu-lscs/lscs-core-api/internal/database"
+	"github.com/dlsu-lscs/lscs-core-api/internal/helpers"
+	"github.com/dlsu-lscs/lscs-core-api/internal/repository"
+)
+
+const (
+	defaultDeliveryLimit = 50
+	maxDeliveryLimit     = 200
+)
+
+// Handler manages webhook endpoints and their deliveries. The routes are admin only.
+type Handler struct {
+	dbService database.Service
+	publisher *Publisher
+}
+
+func NewHandler(dbService database.Service) *Handler {
+	return &Handler{dbService: dbService, publisher: NewPublisher(dbService)}
+}
+
+// ListEndpointsHandler godoc
+// @Summary List webhook endpoints
+// @Description Lists the registered webhook endpoints and the event types they can subscribe to. Admin only.
+// @Tags webhooks
+// @Produce json
+// @Success 200 {object} ListEndpointsResponse "Webhook endpoints"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /webhooks [get]
+func (h *Handler) ListEndpointsHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	endpoints, err := q.ListWebhookEndpoints(c.Request().Context())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list webhook endpoints")
+		return helpers.ErrInternal(c, "")
+	}
+
+	resp := ListEndpointsResponse{Endpoints: make([]EndpointResponse, 0, len(endpoints)), EventTypes: EventTypes}
+	for _, e := range endpoints {
+		resp.Endpoints = append(resp.Endpoints, toEndpointResponse(e))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetEndpointHandler godoc
+// @Summary Get webhook endpoint
+// @Description Retrieves a webhook endpoint. Admin only.
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Endpoint ID"
+// @Success 200 {object} EndpointResponse "Webhook endpoint"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid endpoint ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Endpoint not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /webhooks/{id} [get]
+func (h *Handler) GetEndpointHandler(c echo.Context) error {
+	id, err := parseID(c.Param("id"))
+	if err != nil {
+		return helpers.ErrBadRequest(c, "Invalid endpoint ID")
+	}
+
+	endpoint, err := h.getEndpoint(c, id)
+	if err != nil {
+		return endpointError(c, err, id)
+	}
+	return c.JSON(http.StatusOK, toEndpointResponse(endpoint))
+}
+
+// CreateEndpointHandler godoc
+// @Summary Register webhook endpoint
+// @Description Registers an endpoint for the given event types. The response contains the signing secret, which is not shown again. Admin only.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param request body CreateEndpointRequest true "Endpoint"
+// @Success 201 {object} CreateEndpointResponse "Registered endpoint with its secret"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request or unknown event type"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /webhooks [post]
+func (h *Handler) CreateEndpointHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	var req CreateEndpointRequest
+	if err := c.Bind(&req); err != nil {
+		return helpers.ErrBadRequest(c, "Invalid request format")
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+	events, msg := validateEndpoint(req.URL, req.Events)
+	if msg != "" {
+		return helpers.ErrBadRequest(c, msg)
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		log.Error().Err(err).Msg("failed to generate webhook secret")
+		return helpers.ErrInternal(c, "")
+	}
+
+	id, err := q.CreateWebhookEndpoint(ctx, repository.CreateWebhookEndpointParams{
+		Url:         req.URL,
+		Description: toNullString(req.Description),
+		Secret:      secret,
+		Events:      strings.Join(events, " "),
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("failed to create webhook endpoint")
+		return helpers.ErrInternal(c, "")
+	}
+
+	endpoint, err := h.getEndpoint(c, int32(id))
+	if err != nil {
+		return endpointError(c, err, int32(id))
+	}
+
+	log.Info().Int64("endpoint_id", id).Strs("events", events).Msg("webhook endpoint registered")
+
+	return c.JSON(http.StatusCreated, CreateEndpointResponse{
+		EndpointResponse: toEndpointResponse(endpoint),
+		Secret:           secret,
+	})
+}
+
+// UpdateEndpointHandler godoc
+// @Summary Update webhook endpoint
+// @Description Changes an endpoint's URL, description or subscriptions, or disables it. Deliveries to disabled endpoints are held until it is enabled again. Admin only.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "Endpoint ID"
+// @Param request body UpdateEndpointRequest true "Endpoint"
+// @Success 200 {object} EndpointResponse "Updated endpoint"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid request or unknown event type"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Endpoint not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /webhooks/{id} [put]
+func (h *Handler) UpdateEndpointHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	id, err := parseID(c.Param("id"))
+	if err != nil {
+		return helpers.ErrBadRequest(c, "Invalid endpoint ID")
+	}
+
+	var req UpdateEndpointRequest
+	if err := c.Bind(&req); err != nil {
+		return helpers.ErrBadRequest(c, "Invalid request format")
+	}
+	if validationErr := helpers.ValidateStruct(&req); validationErr != nil {
+		return helpers.ErrValidation(c, validationErr)
+	}
+	events, msg := validateEndpoint(req.URL, req.Events)
+	if msg != "" {
+		return helpers.ErrBadRequest(c, msg)
+	}
+
+	existing, err := h.getEndpoint(c, id)
+	if err != nil {
+		return endpointError(c, err, id)
+	}
+	active := existing.IsActive
+	if req.IsActive != nil {
+		active = *req.IsActive
+	}
+
+	if err := q.UpdateWebhookEndpoint(ctx, repository.UpdateWebhookEndpointParams{
+		Url:         req.URL,
+		Description: toNullString(req.Description),
+		Events:      strings.Join(events, " "),
+		IsActive:    active,
+		ID:          id,
+	}); err != nil {
+		log.Error().Err(err).Int32("endpoint_id", id).Msg("failed to update webhook endpoint")
+		return helpers.ErrInternal(c, "")
+	}
+
+	endpoint, err := h.getEndpoint(c, id)
+	if err != nil {
+		return endpointError(c, err, id)
+	}
+
+	log.Info().Int32("endpoint_id", id).Strs("events", events).Bool("active", active).Msg("webhook endpoint updated")
+
+	return c.JSON(http.StatusOK, toEndpointResponse(endpoint))
+}
+
+// DeleteEndpointHandler godoc
+// @Summary Delete webhook endpoint
+// @Description Deletes an endpoint with its deliveries and delivery log. Admin only.
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Endpoint ID"
+// @Success 200 {object} map[string]string "Endpoint deleted"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid endpoint ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Endpoint not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /webhooks/{id} [delete]
+func (h *Handler) DeleteEndpointHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	id, err := parseID(c.Param("id"))
+	if err != nil {
+		return helpers.ErrBadRequest(c, "Invalid endpoint ID")
+	}
+
+	rows, err := q.DeleteWebhookEndpoint(c.Request().Context(), id)
+	if err != nil {
+		log.Error().Err(err).Int32("endpoint_id", id).Msg("failed to delete webhook endpoint")
+		return helpers.ErrInternal(c, "")
+	}
+	if rows == 0 {
+		return helpers.ErrNotFound(c, "Endpoint not found")
+	}
+
+	log.Info().Int32("endpoint_id", id).Msg("webhook endpoint deleted")
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Endpoint deleted successfully"})
+}
+
+// PingHandler godoc
+// @Summary Ping webhook endpoint
+// @Description Queues a "ping" event to the endpoint, to check that it receives and verifies deliveries. Admin only.
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Endpoint ID"
+// @Success 202 {object} map[string]string "Ping queued"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid endpoint ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Endpoint not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /webhooks/{id}/ping [post]
+func (h *Handler) PingHandler(c echo.Context) error {
+	id, err := parseID(c.Param("id"))
+	if err != nil {
+		return helpers.ErrBadRequest(c, "Invalid endpoint ID")
+	}
+
+	endpoint, err := h.getEndpoint(c, id)
+	if err != nil {
+		return endpointError(c, err, id)
+	}
+
+	data := map[string]int32{"endpoint_id": endpoint.ID}
+	if _, err := h.publisher.publish(c.Request().Context(), EventPing, data, []repository.WebhookEndpoint{endpoint}); err != nil {
+		log.Error().Err(err).Int32("endpoint_id", id).Msg("failed to queue webhook ping")
+		return helpers.ErrInternal(c, "")
+	}
+
+	return c.JSON(http.StatusAccepted, map[string]string{"message": "Ping queued"})
+}
+
+// ListDeliveriesHandler godoc
+// @Summary List webhook deliveries
+// @Description Lists the latest deliveries to an endpoint, newest first. Admin only.
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Endpoint ID"
+// @Param limit query int false "Maximum number of deliveries (default 50, at most 200)"
+// @Success 200 {object} ListDeliveriesResponse "Deliveries"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid endpoint ID or limit"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Endpoint not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /webhooks/{id}/deliveries [get]
+func (h *Handler) ListDeliveriesHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	id, err := parseID(c.Param("id"))
+	if err != nil {
+		return helpers.ErrBadRequest(c, "Invalid endpoint ID")
+	}
+
+	limit := defaultDeliveryLimit
+	if raw := c.QueryParam("limit"); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit <= 0 || limit > maxDeliveryLimit {
+			return helpers.ErrBadRequest(c, "Invalid limit")
+		}
+	}
+
+	if _, err := h.getEndpoint(c, id); err != nil {
+		return endpointError(c, err, id)
+	}
+
+	deliveries, err := q.ListWebhookDeliveries(c.Request().Context(), repository.ListWebhookDeliveriesParams{
+		EndpointID: id,
+		Limit:      int32(limit),
+	})
+	if err != nil {
+		log.Error().Err(err).Int32("endpoint_id", id).Msg("failed to list webhook deliveries")
+		return helpers.ErrInternal(c, "")
+	}
+
+	resp := ListDeliveriesResponse{Deliveries: make([]DeliveryResponse, 0, len(deliveries))}
+	for _, d := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, toDeliveryResponse(d))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetDeliveryHandler godoc
+// @Summary Get webhook delivery
+// @Description Retrieves a delivery with its payload and the log of its attempts. Admin only.
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Endpoint ID"
+// @Param delivery_id path int true "Delivery ID"
+// @Success 200 {object} DeliveryDetailResponse "Delivery"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Delivery not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /webhooks/{id}/deliveries/{delivery_id} [get]
+func (h *Handler) GetDeliveryHandler(c echo.Context) error {
+	q := repository.New(h.dbService.GetConnection())
+
+	endpointID, deliveryID, msg := parseDeliveryIDs(c)
+	if msg != "" {
+		return helpers.ErrBadRequest(c, msg)
+	}
+	delivery, err := q.GetWebhookDelivery(c.Request().Context(), repository.GetWebhookDeliveryParams{
+		ID:         deliveryID,
+		EndpointID: endpointID,
+	})
+	if err != nil {
+		return deliveryError(c, err, deliveryID)
+	}
+
+	attempts, err := q.ListWebhookDeliveryAttempts(c.Request().Context(), delivery.ID)
+	if err != nil {
+		log.Error().Err(err).Int32("delivery_id", delivery.ID).Msg("failed to list webhook delivery attempts")
+		return helpers.ErrInternal(c, "")
+	}
+
+	resp := DeliveryDetailResponse{
+		DeliveryResponse: toDeliveryResponse(delivery),
+		Payload:          json.RawMessage(delivery.Payload),
+		AttemptLog:       make([]AttemptResponse, 0, len(attempts)),
+	}
+	for _, a := range attempts {
+		resp.AttemptLog = append(resp.AttemptLog, toAttemptResponse(a))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ReplayDeliveryHandler godoc
+// @Summary Replay webhook delivery
+// @Description Queues the delivery's event to the endpoint again, as a new delivery with the same event ID and payload. Admin only.
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "Endpoint ID"
+// @Param delivery_id path int true "Delivery ID"
+// @Success 202 {object} DeliveryResponse "Queued replay"
+// @Failure 400 {object} helpers.ErrorResponse "Invalid ID"
+// @Failure 401 {object} helpers.ErrorResponse "Unauthorized"
+// @Failure 403 {object} helpers.ErrorResponse "Forbidden"
+// @Failure 404 {object} helpers.ErrorResponse "Delivery not found"
+// @Failure 500 {object} helpers.ErrorResponse "Internal server error"
+// @Security SessionAuth
+// @Router /webhooks/{id}/deliveries/{delivery_id}/replay [post]
+func (h *Handler) ReplayDeliveryHandler(c echo.Context) error {
+	ctx := c.Request().Context()
+	q := repository.New(h.dbService.GetConnection())
+
+	endpointID, deliveryID, msg := parseDeliveryIDs(c)
+	if msg != "" {
+		return helpers.ErrBadRequest(c, msg)
+	}
+	original, err := q.GetWebhookDelivery(ctx, repository.GetWebhookDeliveryParams{
+		ID:         deliveryID,
+		EndpointID: endpointID,
+	})
+	if err != nil {
+		return deliveryError(c, err, deliveryID)
+	}
+
+	id, err := q.CreateWebhookDelivery(ctx, repository.CreateWebhookDeliveryParams{
+		EndpointID:    original.EndpointID,
+		EventID:       original.EventID,
+		EventType:     original.EventType,
+		Payload:       original.Payload,
+		NextAttemptAt: h.publisher.now(),
+		ReplayOf:      sql.NullInt32{Int32: original.ID, Valid: true},
+	})
+	if err != nil {
+		log.Error().Err(err).Int32("delivery_id", original.ID).Msg("failed to replay webhook delivery")
+		return helpers.ErrInternal(c, "")
+	}
+
+	replay, err := q.GetWebhookDelivery(ctx, repository.GetWebhookDeliveryParams{ID: int32(id), EndpointID: original.EndpointID})
+	if err != nil {
+		log.Error().Err(err).Int64("delivery_id", id).Msg("failed to get webhook delivery")
+		return helpers.ErrInternal(c, "")
+	}
+
+	log.Info().Int32("delivery_id", original.ID).Int64("replay_id", id).Str("event_id", original.EventID).Msg("webhook delivery replayed")
+
+	return c.JSON(http.StatusAccepted, toDeliveryResponse(replay))
+}
+
+func (h *Handler) getEndpoint(c echo.Context, id int32) (repository.WebhookEndpoint, error) {
+	q := repository.New(h.dbService.GetConnection())
+	return q.GetWebhookEndpoint(c.Request().Context(), id)
+}
+
+func endpointError(c echo.Context, err error, id int32) error {
+	if err == sql.ErrNoRows {
+		return helpers.ErrNotFound(c, "Endpoint not found")
+	}
+	log.Error().Err(err).Int32("endpoint_id", id).Msg("failed to get webhook endpoint")
+	return helpers.ErrInternal(c, "")
+}
+
+func deliveryError(c echo.Context, err error, id int32) error {
+	if err == sql.ErrNoRows {
+		return helpers.ErrNotFound(c, "Delivery not found")
+	}
+	log.Error().Err(err).Int32("delivery_id", id).Msg("failed to get webhook delivery")
+	return helpers.ErrInternal(c, "")
+}
+
+// parseDeliveryIDs returns the endpoint and delivery IDs of the path, or an error message
+func parseDeliveryIDs(c echo.Context) (endpointID, deliveryID int32, msg string) {
+	endpointID, err := parseID(c.Param("id"))
+	if err != nil {
+		return 0, 0, "Invalid endpoint ID"
+	}
+	deliveryID, err = parseID(c.Param("delivery_id"))
+	if err != nil {
+		return 0, 0, "Invalid delivery ID"
+	}
+	return endpointID, deliveryID, ""
+}
+
+// validateEndpoint checks the URL scheme and the event types, returning the deduplicated event types
+// or an error message
+func validateEndpoint(rawURL string, events []string) ([]string, string) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, "Endpoint URL must be an http or https URL"
+	}
+
+	var unique []string
+	for _, event := range events {
+		if !slices.Contains(EventTypes, event) {
+			return nil, "Unknown event type: " + event
+		}
+		if !slices.Contains(unique, event) {
+			unique = append(unique, event)
+		}
+	}
+	return unique, ""
+}
+
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+func parseID(s string) (int32, error) {
+	id, err := strconv.ParseInt(s, 10, 32)
+	if err != nil || id <= 0 {
+		return 0, strconv.ErrSyntax
+	}
+	return int32(id), nil
+}
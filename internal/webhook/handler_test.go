@@ -0,0 +1,151 @@
+package webhook
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	endpointColumns = []string{"id", "url", "description", "secret", "events", "is_active", "created_at"}
+	deliveryColumns = []string{
+		"id", "endpoint_id", "event_id", "event_type", "payload", "status", "attempts", "next_attempt_at",
+		"locked_until", "last_status_code", "last_error", "delivered_at", "replay_of", "created_at",
+	}
+)
+
+func TestCreateEndpointHandler(t *testing.T) {
+	newContext := func(body string) (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		return e.NewContext(req, rec), rec
+	}
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("INSERT INTO webhook_endpoints").
+			WithArgs("https://bot.lscs.org/hook", sql.NullString{}, sqlmock.AnyArg(), "member.updated api_key.revoked").
+			WillReturnResult(sqlmock.NewResult(3, 1))
+		mock.ExpectQuery("SELECT (.+) FROM webhook_endpoints WHERE id = ?").
+			WithArgs(int32(3)).
+			WillReturnRows(sqlmock.NewRows(endpointColumns).
+				AddRow(3, "https://bot.lscs.org/hook", nil, "whsec_x", "member.updated api_key.revoked", true, time.Now()))
+
+		c, rec := newContext(`{"url":"https://bot.lscs.org/hook","events":["member.updated","api_key.revoked","member.updated"]}`)
+
+		if assert.NoError(t, NewHandler(&mockDBService{db: db}).CreateEndpointHandler(c)) {
+			assert.Equal(t, http.StatusCreated, rec.Code)
+			var resp struct {
+				ID     int32    `json:"id"`
+				Events []string `json:"events"`
+				Secret string   `json:"secret"`
+			}
+			json.Unmarshal(rec.Body.Bytes(), &resp)
+			assert.Equal(t, int32(3), resp.ID)
+			assert.Equal(t, []string{"member.updated", "api_key.revoked"}, resp.Events)
+			assert.True(t, strings.HasPrefix(resp.Secret, "whsec_"))
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("fail - unknown event type", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		// nothing creates members, so member.created could never be delivered
+		c, rec := newContext(`{"url":"https://bot.lscs.org/hook","events":["member.created"]}`)
+
+		if assert.NoError(t, NewHandler(&mockDBService{db: db}).CreateEndpointHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("fail - not an http url", func(t *testing.T) {
+		db, _, err := sqlmock.New()
+		assert.NoError(t, err)
+		defer db.Close()
+
+		c, rec := newContext(`{"url":"ftp://bot.lscs.org/hook","events":["member.updated"]}`)
+
+		if assert.NoError(t, NewHandler(&mockDBService{db: db}).CreateEndpointHandler(c)) {
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		}
+	})
+}
+
+func TestReplayDeliveryHandler(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery("SELECT (.+) FROM webhook_deliveries WHERE id = ?").
+		WithArgs(int32(7), int32(1)).
+		WillReturnRows(sqlmock.NewRows(deliveryColumns).
+			AddRow(7, 1, "evt_1", EventMemberUpdated, `{}`, StatusFailed, 8, now, nil, 500, "boom", nil, nil, now))
+	mock.ExpectExec("INSERT INTO webhook_deliveries").
+		WithArgs(int32(1), "evt_1", EventMemberUpdated, `{}`, sqlmock.AnyArg(), sql.NullInt32{Int32: 7, Valid: true}).
+		WillReturnResult(sqlmock.NewResult(9, 1))
+	mock.ExpectQuery("SELECT (.+) FROM webhook_deliveries WHERE id = ?").
+		WithArgs(int32(9), int32(1)).
+		WillReturnRows(sqlmock.NewRows(deliveryColumns).
+			AddRow(9, 1, "evt_1", EventMemberUpdated, `{}`, StatusPending, 0, now, nil, nil, nil, nil, 7, now))
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/1/deliveries/7/replay", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id", "delivery_id")
+	c.SetParamValues("1", "7")
+
+	if assert.NoError(t, NewHandler(&mockDBService{db: db}).ReplayDeliveryHandler(c)) {
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+		var resp struct {
+			ID       int32  `json:"id"`
+			EventID  string `json:"event_id"`
+			Status   string `json:"status"`
+			ReplayOf *int32 `json:"replay_of"`
+		}
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		assert.Equal(t, int32(9), resp.ID)
+		assert.Equal(t, "evt_1", resp.EventID)
+		assert.Equal(t, StatusPending, resp.Status)
+		if assert.NotNil(t, resp.ReplayOf) {
+			assert.Equal(t, int32(7), *resp.ReplayOf)
+		}
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPublish(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT (.+) FROM webhook_endpoints WHERE is_active").
+		WillReturnRows(sqlmock.NewRows(endpointColumns).
+			AddRow(1, "https://a.example/hook", nil, "s1", "member.updated", true, nil).
+			AddRow(2, "https://b.example/hook", nil, "s2", "api_key.revoked", true, nil))
+	mock.ExpectExec("INSERT INTO webhook_deliveries").
+		WithArgs(int32(1), sqlmock.AnyArg(), EventMemberUpdated, sqlmock.AnyArg(), sqlmock.AnyArg(), sql.NullInt32{}).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	queued, err := NewPublisher(&mockDBService{db: db}).publish(t.Context(), EventMemberUpdated, map[string]int{"id": 1}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, queued)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Delivery request headers
+const (
+	HeaderEvent     = "X-LSCS-Event"
+	HeaderEventID   = "X-LSCS-Event-ID"
+	HeaderDelivery  = "X-LSCS-Delivery"
+	HeaderSignature = "X-LSCS-Signature"
+)
+
+// Sign returns the signature header of a payload: "t=<unix time>,v1=<hex HMAC-SHA256 of "<t>.<payload>">".
+// Signing the timestamp lets receivers reject old requests that are sent again.
+func Sign(secret string, timestamp time.Time, payload []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", t, signature(secret, t, payload))
+}
+
+// Verify checks a signature header made by Sign. Signatures older than tolerance are rejected;
+// a tolerance of 0 disables the check.
+func Verify(secret, header string, payload []byte, tolerance time.Duration, now time.Time) bool {
+	var t, v1 string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			t = value
+		case "v1":
+			v1 = value
+		}
+	}
+
+	unix, err := strconv.ParseInt(t, 10, 64)
+	if err != nil || v1 == "" {
+		return false
+	}
+	if tolerance > 0 && now.Sub(time.Unix(unix, 0)).Abs() > tolerance {
+		return false
+	}
+	return hmac.Equal([]byte(v1), []byte(signature(secret, t, payload)))
+}
+
+func signature(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- registered service clients for the OAuth2 client credentials flow
+CREATE TABLE oauth_clients (
+    client_id VARCHAR(64) PRIMARY KEY,
+    client_secret_hash VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    scopes TEXT NOT NULL,
+    created_by INT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (created_by) REFERENCES members(id) ON DELETE SET NULL
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS oauth_clients;
+-- +goose StatementEnd
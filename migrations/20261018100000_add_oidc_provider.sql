@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- relying parties (LSCS apps) register the redirect URIs allowed for "Sign in with LSCS"
+ALTER TABLE oauth_clients ADD COLUMN redirect_uris TEXT DEFAULT NULL;
+
+-- single-use authorization codes issued by /oauth/authorize
+CREATE TABLE oidc_authorization_codes (
+    code_hash VARCHAR(64) PRIMARY KEY,
+    client_id VARCHAR(64) NOT NULL,
+    member_id INT NOT NULL,
+    redirect_uri TEXT NOT NULL,
+    scope VARCHAR(255) NOT NULL,
+    nonce VARCHAR(255),
+    code_challenge VARCHAR(128) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (client_id) REFERENCES oauth_clients(client_id) ON DELETE CASCADE,
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE,
+    INDEX idx_oidc_authorization_codes_expires_at (expires_at)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS oidc_authorization_codes;
+ALTER TABLE oauth_clients DROP COLUMN IF EXISTS redirect_uris;
+
+-- +goose StatementEnd
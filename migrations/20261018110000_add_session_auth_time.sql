@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- when the member last completed a Google login on this session (step-up re-authentication)
+ALTER TABLE sessions ADD COLUMN auth_time TIMESTAMP NULL DEFAULT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE sessions DROP COLUMN IF EXISTS auth_time;
+
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- registration and check-in (attendance) timestamps of event participants
+ALTER TABLE event_participants
+    ADD COLUMN registered_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN checked_in_at TIMESTAMP NULL DEFAULT NULL,
+    ADD COLUMN checked_in_by INT NULL DEFAULT NULL,
+    ADD CONSTRAINT fk_event_participants_checked_in_by FOREIGN KEY (checked_in_by) REFERENCES members(id) ON DELETE SET NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE event_participants DROP FOREIGN KEY fk_event_participants_checked_in_by;
+ALTER TABLE event_participants
+    DROP COLUMN checked_in_by,
+    DROP COLUMN checked_in_at,
+    DROP COLUMN registered_at;
+
+-- +goose StatementEnd
@@ -0,0 +1,82 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- statuses of the pre-acts/post-acts documentation and finance workflows
+-- (allowed transitions are defined in internal/tracker)
+INSERT IGNORE INTO docu_status (id, title) VALUES
+    ('INIT', 'Not started'),
+    ('IN_PROGRESS', 'In progress'),
+    ('FOR_REVIEW', 'For review'),
+    ('FOR_REVISION', 'For revision'),
+    ('SUBMITTED', 'Submitted'),
+    ('APPROVED', 'Approved');
+
+INSERT IGNORE INTO fin_status (id, title) VALUES
+    ('INIT', 'Not started'),
+    ('NOT_APPLICABLE', 'Not applicable'),
+    ('IN_PROGRESS', 'In progress'),
+    ('FOR_REVIEW', 'For review'),
+    ('FOR_REVISION', 'For revision'),
+    ('SUBMITTED', 'Submitted'),
+    ('APPROVED', 'Approved');
+
+INSERT IGNORE INTO fin_process_ref (id, name) VALUES
+    ('CASH_ADVANCE', 'Cash Advance'),
+    ('REIMBURSEMENT', 'Reimbursement'),
+    ('DIRECT_PAYMENT', 'Direct Payment'),
+    ('LIQUIDATION', 'Liquidation');
+
+-- the finance statuses are nullable; older trackers without one haven't started
+UPDATE event_trackers SET fin_preacts_status = 'INIT' WHERE fin_preacts_status IS NULL;
+UPDATE event_trackers SET fin_postacts_status = 'INIT' WHERE fin_postacts_status IS NULL;
+
+-- fin processes are deleted with their tracker, and each process is listed once per tracker.
+-- the baseline didn't name the tracker foreign key, so look up the name MySQL generated for it
+SET @fk_fin_processes_tracker = (
+    SELECT CONSTRAINT_NAME FROM information_schema.KEY_COLUMN_USAGE
+    WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'fin_processes'
+        AND COLUMN_NAME = 'tracker_id' AND REFERENCED_TABLE_NAME = 'event_trackers'
+    LIMIT 1
+);
+SET @drop_fk_fin_processes_tracker = COALESCE(
+    CONCAT('ALTER TABLE fin_processes DROP FOREIGN KEY `', @fk_fin_processes_tracker, '`'),
+    'DO 0'
+);
+PREPARE drop_fk_fin_processes_tracker FROM @drop_fk_fin_processes_tracker;
+EXECUTE drop_fk_fin_processes_tracker;
+DEALLOCATE PREPARE drop_fk_fin_processes_tracker;
+
+ALTER TABLE fin_processes
+    ADD CONSTRAINT fk_fin_processes_tracker FOREIGN KEY (tracker_id) REFERENCES event_trackers(event_id) ON DELETE CASCADE,
+    ADD UNIQUE KEY uq_fin_processes_tracker_process (tracker_id, process);
+
+-- audit log of tracker status changes
+CREATE TABLE tracker_status_history (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    event_id INT NOT NULL,
+    stage VARCHAR(20) NOT NULL,
+    from_status VARCHAR(100) NOT NULL,
+    to_status VARCHAR(100) NOT NULL,
+    changed_by INT,
+    note TEXT,
+    changed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_tracker_status_history_event (event_id, changed_at),
+    FOREIGN KEY (event_id) REFERENCES event_trackers(event_id) ON DELETE CASCADE,
+    FOREIGN KEY (changed_by) REFERENCES members(id) ON DELETE SET NULL
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS tracker_status_history;
+
+ALTER TABLE fin_processes
+    DROP FOREIGN KEY fk_fin_processes_tracker,
+    DROP INDEX uq_fin_processes_tracker_process,
+    ADD CONSTRAINT fin_processes_ibfk_1 FOREIGN KEY (tracker_id) REFERENCES event_trackers(event_id);
+
+-- the seeded statuses are kept: existing trackers reference them
+
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- run state of background jobs, so restarts and multiple instances don't run a job more often than its interval
+CREATE TABLE scheduled_jobs (
+    name VARCHAR(100) PRIMARY KEY,
+    last_run_at TIMESTAMP NULL DEFAULT NULL,
+    last_status VARCHAR(20),
+    last_error TEXT,
+    locked_until TIMESTAMP NULL DEFAULT NULL
+);
+
+-- reminders sent for tracker deadlines, so each one is only sent once per deadline
+CREATE TABLE tracker_reminders (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    event_id INT NOT NULL,
+    stage VARCHAR(20) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    days_before INT NOT NULL,
+    deadline DATETIME NOT NULL,
+    sent_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_tracker_reminders (event_id, stage, kind, days_before, deadline),
+    FOREIGN KEY (event_id) REFERENCES event_trackers(event_id) ON DELETE CASCADE
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tracker_reminders;
+DROP TABLE IF EXISTS scheduled_jobs;
+-- +goose StatementEnd
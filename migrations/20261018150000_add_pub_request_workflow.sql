@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- statuses of the publicity request workflow
+-- (allowed transitions are defined in internal/publicity)
+INSERT IGNORE INTO pub_req_status (id, name) VALUES
+    ('PENDING', 'Pending'),
+    ('IN_PROGRESS', 'In progress'),
+    ('FOR_REVIEW', 'For review'),
+    ('FOR_REVISION', 'For revision'),
+    ('APPROVED', 'Approved'),
+    ('POSTED', 'Posted'),
+    ('CANCELLED', 'Cancelled');
+
+-- requests without a status have not been claimed yet
+UPDATE pub_requests SET pub_status = 'PENDING' WHERE pub_status IS NULL;
+
+-- keep statuses of existing requests so the foreign key can be added
+INSERT IGNORE INTO pub_req_status (id, name)
+SELECT DISTINCT pub_status, pub_status FROM pub_requests WHERE pub_status IS NOT NULL;
+
+ALTER TABLE pub_requests
+    ADD COLUMN updated_at TIMESTAMP NULL DEFAULT NULL ON UPDATE CURRENT_TIMESTAMP,
+    ADD INDEX idx_pub_requests_posting_date (posting_date),
+    ADD CONSTRAINT fk_pub_requests_status FOREIGN KEY (pub_status) REFERENCES pub_req_status(id),
+    ADD CONSTRAINT fk_pub_requests_pub_head FOREIGN KEY (pub_head) REFERENCES members(id) ON DELETE SET NULL;
+
+-- review comments and status changes of publicity requests
+CREATE TABLE pub_request_comments (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    pub_request_id INT NOT NULL,
+    member_id INT,
+    body TEXT,
+    from_status VARCHAR(50),
+    to_status VARCHAR(50),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_pub_request_comments_request (pub_request_id, created_at),
+    FOREIGN KEY (pub_request_id) REFERENCES pub_requests(id) ON DELETE CASCADE,
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE SET NULL
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS pub_request_comments;
+
+ALTER TABLE pub_requests
+    DROP FOREIGN KEY fk_pub_requests_pub_head,
+    DROP FOREIGN KEY fk_pub_requests_status,
+    DROP INDEX idx_pub_requests_posting_date,
+    DROP COLUMN updated_at;
+
+-- the seeded statuses are kept: existing requests reference them
+
+-- +goose StatementEnd
@@ -0,0 +1,97 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- file_statuses becomes the list of statuses, like docu_status and fin_status;
+-- the status of each file moves to event_files.file_status
+ALTER TABLE event_files ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'DRAFTING';
+
+UPDATE event_files f
+JOIN file_statuses s ON s.file_key = f.file_key
+SET f.status = s.status
+WHERE s.status IS NOT NULL;
+
+ALTER TABLE event_files
+    DROP COLUMN file_status,
+    CHANGE COLUMN status file_status VARCHAR(20) NOT NULL DEFAULT 'DRAFTING';
+
+DROP TABLE file_statuses;
+
+CREATE TABLE file_statuses (
+    id VARCHAR(20) PRIMARY KEY,
+    title VARCHAR(100) NOT NULL
+);
+
+-- allowed transitions are defined in internal/event
+INSERT INTO file_statuses (id, title) VALUES
+    ('DRAFTING', 'Drafting'),
+    ('DONE', 'Done'),
+    ('REVISE', 'For revision'),
+    ('APPROVED', 'Approved');
+
+-- templates of the documents every event must submit; doc_key is the object key of the blank template
+ALTER TABLE document_templates
+    ADD COLUMN doc_order INT NOT NULL DEFAULT 0,
+    ADD COLUMN required BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- files without a file_key are required documents that have not been uploaded yet
+ALTER TABLE event_files
+    MODIFY COLUMN file_key VARCHAR(255),
+    ADD COLUMN title VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN template_id INT,
+    ADD COLUMN content_type VARCHAR(100),
+    ADD COLUMN uploaded_by INT,
+    ADD COLUMN uploaded_at DATETIME,
+    ADD COLUMN reviewer_note TEXT,
+    ADD COLUMN reviewed_by INT,
+    ADD COLUMN reviewed_at DATETIME,
+    ADD INDEX idx_event_files_order (event_id, file_order),
+    ADD UNIQUE KEY uq_event_files_template (event_id, template_id),
+    ADD CONSTRAINT fk_event_files_status FOREIGN KEY (file_status) REFERENCES file_statuses(id),
+    ADD CONSTRAINT fk_event_files_template FOREIGN KEY (template_id) REFERENCES document_templates(id) ON DELETE SET NULL,
+    ADD CONSTRAINT fk_event_files_uploaded_by FOREIGN KEY (uploaded_by) REFERENCES members(id) ON DELETE SET NULL,
+    ADD CONSTRAINT fk_event_files_reviewed_by FOREIGN KEY (reviewed_by) REFERENCES members(id) ON DELETE SET NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE event_files
+    DROP FOREIGN KEY fk_event_files_reviewed_by,
+    DROP FOREIGN KEY fk_event_files_uploaded_by,
+    DROP FOREIGN KEY fk_event_files_template,
+    DROP FOREIGN KEY fk_event_files_status,
+    DROP INDEX uq_event_files_template,
+    DROP INDEX idx_event_files_order,
+    DROP COLUMN reviewed_at,
+    DROP COLUMN reviewed_by,
+    DROP COLUMN reviewer_note,
+    DROP COLUMN uploaded_at,
+    DROP COLUMN uploaded_by,
+    DROP COLUMN content_type,
+    DROP COLUMN template_id,
+    DROP COLUMN title;
+
+-- required documents that were never uploaded cannot be restored
+DELETE FROM event_files WHERE file_key IS NULL;
+ALTER TABLE event_files MODIFY COLUMN file_key VARCHAR(255) NOT NULL;
+
+ALTER TABLE document_templates
+    DROP COLUMN required,
+    DROP COLUMN doc_order;
+
+DROP TABLE file_statuses;
+
+CREATE TABLE file_statuses (
+    file_key VARCHAR(255) PRIMARY KEY,
+    status ENUM('DRAFTING','DONE','REVISE','APPROVED') DEFAULT 'DRAFTING'
+);
+
+INSERT INTO file_statuses (file_key, status)
+SELECT file_key, file_status FROM event_files;
+
+ALTER TABLE event_files
+    DROP COLUMN file_status,
+    ADD COLUMN file_status INT NOT NULL DEFAULT 1;
+
+-- +goose StatementEnd
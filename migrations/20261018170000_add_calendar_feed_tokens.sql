@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- per-member tokens authenticating iCalendar feed URLs (calendar apps can't send session cookies).
+-- Only the SHA-256 hash is stored; rotating replaces the row so old feed URLs stop working.
+CREATE TABLE calendar_feed_tokens (
+    member_id INT PRIMARY KEY,
+    token_hash CHAR(64) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    last_used_at TIMESTAMP NULL DEFAULT NULL,
+    UNIQUE KEY uq_calendar_feed_tokens_hash (token_hash),
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS calendar_feed_tokens;
+-- +goose StatementEnd
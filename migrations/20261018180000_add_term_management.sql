@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- academic calendar dates and the active term (at most one, enforced by the API)
+ALTER TABLE terms
+    ADD COLUMN start_date DATE NULL,
+    ADD COLUMN end_date DATE NULL,
+    ADD COLUMN is_active BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- the latest existing term becomes the active one
+UPDATE terms SET is_active = TRUE ORDER BY start_year DESC, term DESC LIMIT 1;
+
+-- officer roster of past terms, archived on term rollover.
+-- Positions and committees are copied as-is so the archive survives later renames or deletions.
+CREATE TABLE term_rosters (
+    term_id INT NOT NULL,
+    member_id INT NOT NULL,
+    position_id VARCHAR(10),
+    committee_id VARCHAR(10),
+    archived_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (term_id, member_id),
+    FOREIGN KEY (term_id) REFERENCES terms(id) ON DELETE CASCADE,
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS term_rosters;
+
+ALTER TABLE terms
+    DROP COLUMN is_active,
+    DROP COLUMN end_date,
+    DROP COLUMN start_date;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- house points ledger. Entries are never edited: a correction is a new entry with negative points.
+CREATE TABLE house_points (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    house_id INT NOT NULL,
+    term_id INT NOT NULL,
+    points INT NOT NULL,
+    reason VARCHAR(255) NOT NULL,
+    awarded_by INT,
+    event_id INT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_house_points_term (term_id, house_id),
+    FOREIGN KEY (house_id) REFERENCES houses(id),
+    FOREIGN KEY (term_id) REFERENCES terms(id),
+    FOREIGN KEY (awarded_by) REFERENCES members(id) ON DELETE SET NULL,
+    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE SET NULL
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS house_points;
+-- +goose StatementEnd
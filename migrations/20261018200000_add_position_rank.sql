@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- authority of a position, higher = more authority. Positions without a rank have no authority.
+ALTER TABLE positions ADD COLUMN position_rank INT NOT NULL DEFAULT 0;
+
+-- the hierarchy that used to be hard-coded in the API
+UPDATE positions SET position_rank = CASE position_id
+    WHEN 'PRES' THEN 7
+    WHEN 'EVP' THEN 6
+    WHEN 'VP' THEN 5
+    WHEN 'AVP' THEN 4
+    WHEN 'CT' THEN 3
+    WHEN 'JO' THEN 2
+    WHEN 'MEM' THEN 1
+    ELSE 0
+END;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE positions DROP COLUMN position_rank;
+-- +goose StatementEnd
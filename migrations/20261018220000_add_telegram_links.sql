@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Telegram accounts linked to members. A member links their account by sending the bot
+-- a one-time code from the web UI, from the Telegram username on their profile.
+CREATE TABLE telegram_links (
+    member_id INT PRIMARY KEY,
+    telegram_user_id BIGINT NOT NULL UNIQUE,
+    telegram_username VARCHAR(100),
+    linked_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE
+);
+
+-- one-time link codes; only the SHA-256 hash of the code is stored
+CREATE TABLE telegram_link_codes (
+    code_hash VARCHAR(64) PRIMARY KEY,
+    member_id INT NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS telegram_link_codes;
+DROP TABLE IF EXISTS telegram_links;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Google Drive folders created for an event once its pre-acts are approved.
+-- folders maps each created folder's path in the template to its Drive ID, so a failed
+-- run resumes where it stopped instead of creating duplicate folders.
+CREATE TABLE event_drive_folders (
+    event_id INT PRIMARY KEY,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    root_folder_id VARCHAR(255),
+    docu_folder_id VARCHAR(255),
+    fin_folder_id VARCHAR(255),
+    pub_folder_id VARCHAR(255),
+    folders TEXT,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NULL,
+    last_error TEXT,
+    provisioned_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_event_drive_folders_due (status, next_attempt_at),
+    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS event_drive_folders;
+-- +goose StatementEnd
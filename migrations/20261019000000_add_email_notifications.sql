@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- email outbox: notifications are rendered when queued and sent by the dispatcher,
+-- retried with exponential backoff until SENT or FAILED. dedupe_key keeps a notification
+-- (e.g. one expiry warning per key and threshold) from being queued twice.
+CREATE TABLE email_outbox (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    member_id INT,
+    recipient VARCHAR(255) NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    text_body TEXT NOT NULL,
+    html_body TEXT NOT NULL,
+    dedupe_key VARCHAR(191) UNIQUE,
+    status VARCHAR(16) NOT NULL DEFAULT 'PENDING',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP NULL,
+    last_error TEXT,
+    sent_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_email_outbox_due (status, next_attempt_at),
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE SET NULL
+);
+
+-- per-member opt-outs; a notification type without a row is sent
+CREATE TABLE notification_preferences (
+    member_id INT NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    email_enabled BOOLEAN NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (member_id, type),
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notification_preferences;
+DROP TABLE IF EXISTS email_outbox;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- in-app notifications shown in the web UI's inbox. Unlike emails they are
+-- always created; dedupe_key keeps a notification from being added twice.
+CREATE TABLE notifications (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    member_id INT NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    link VARCHAR(512),
+    dedupe_key VARCHAR(191) UNIQUE,
+    read_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_notifications_member (member_id, id),
+    INDEX idx_notifications_unread (member_id, read_at),
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notifications;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- public event registrations get their check-in ticket only once the email is verified.
+-- Registrations made before this (members, imports, earlier public sign-ups) stay valid.
+ALTER TABLE event_participants
+    ADD COLUMN verified_at TIMESTAMP NULL DEFAULT NULL;
+
+UPDATE event_participants SET verified_at = COALESCE(registered_at, CURRENT_TIMESTAMP);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+ALTER TABLE event_participants DROP COLUMN verified_at;
+
+-- +goose StatementEnd
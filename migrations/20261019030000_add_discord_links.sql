@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Discord accounts linked to members. A member links their account by signing in with
+-- Discord, so the role sync never has to trust the handle typed on a profile.
+CREATE TABLE discord_links (
+    member_id INT PRIMARY KEY,
+    discord_user_id VARCHAR(32) NOT NULL UNIQUE,
+    discord_username VARCHAR(100),
+    linked_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS discord_links;
+-- +goose StatementEnd
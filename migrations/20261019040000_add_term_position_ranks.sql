@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- position hierarchy of past terms, archived with the roster on term rollover and editable afterwards.
+-- The current term uses the ranks in positions. Position IDs are copied as-is, like the roster.
+CREATE TABLE term_position_ranks (
+    term_id INT NOT NULL,
+    position_id VARCHAR(10) NOT NULL,
+    position_rank INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (term_id, position_id),
+    FOREIGN KEY (term_id) REFERENCES terms(id) ON DELETE CASCADE
+);
+
+-- terms archived so far get the ranks as they are now
+INSERT INTO term_position_ranks (term_id, position_id, position_rank)
+SELECT t.term_id, p.position_id, p.position_rank
+FROM (SELECT DISTINCT term_id FROM term_rosters) t
+CROSS JOIN positions p;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS term_position_ranks;
+-- +goose StatementEnd
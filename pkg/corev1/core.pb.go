@@ -0,0 +1,842 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
+// source: lscs/core/v1/core.proto
+
+// gRPC API for internal services. Calls are authenticated with an API key,
+// sent as "authorization: Bearer <api key>" metadata, and need the same scopes
+// as the REST endpoints they mirror.
+
+package corev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Lookup:
+	//
+	//	*GetMemberRequest_Email
+	//	*GetMemberRequest_Id
+	Lookup        isGetMemberRequest_Lookup `protobuf_oneof:"lookup"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemberRequest) Reset() {
+	*x = GetMemberRequest{}
+	mi := &file_lscs_core_v1_core_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberRequest) ProtoMessage() {}
+
+func (x *GetMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lscs_core_v1_core_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberRequest.ProtoReflect.Descriptor instead.
+func (*GetMemberRequest) Descriptor() ([]byte, []int) {
+	return file_lscs_core_v1_core_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetMemberRequest) GetLookup() isGetMemberRequest_Lookup {
+	if x != nil {
+		return x.Lookup
+	}
+	return nil
+}
+
+func (x *GetMemberRequest) GetEmail() string {
+	if x != nil {
+		if x, ok := x.Lookup.(*GetMemberRequest_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *GetMemberRequest) GetId() int32 {
+	if x != nil {
+		if x, ok := x.Lookup.(*GetMemberRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return 0
+}
+
+type isGetMemberRequest_Lookup interface {
+	isGetMemberRequest_Lookup()
+}
+
+type GetMemberRequest_Email struct {
+	Email string `protobuf:"bytes,1,opt,name=email,proto3,oneof"`
+}
+
+type GetMemberRequest_Id struct {
+	Id int32 `protobuf:"varint,2,opt,name=id,proto3,oneof"`
+}
+
+func (*GetMemberRequest_Email) isGetMemberRequest_Lookup() {}
+
+func (*GetMemberRequest_Id) isGetMemberRequest_Lookup() {}
+
+type Member struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	FullName      string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Nickname      *string                `protobuf:"bytes,4,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
+	ImageUrl      *string                `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	CommitteeId   *string                `protobuf:"bytes,6,opt,name=committee_id,json=committeeId,proto3,oneof" json:"committee_id,omitempty"`
+	CommitteeName *string                `protobuf:"bytes,7,opt,name=committee_name,json=committeeName,proto3,oneof" json:"committee_name,omitempty"`
+	DivisionId    *string                `protobuf:"bytes,8,opt,name=division_id,json=divisionId,proto3,oneof" json:"division_id,omitempty"`
+	DivisionName  *string                `protobuf:"bytes,9,opt,name=division_name,json=divisionName,proto3,oneof" json:"division_name,omitempty"`
+	PositionId    *string                `protobuf:"bytes,10,opt,name=position_id,json=positionId,proto3,oneof" json:"position_id,omitempty"`
+	PositionName  *string                `protobuf:"bytes,11,opt,name=position_name,json=positionName,proto3,oneof" json:"position_name,omitempty"`
+	HouseName     *string                `protobuf:"bytes,12,opt,name=house_name,json=houseName,proto3,oneof" json:"house_name,omitempty"`
+	ContactNumber *string                `protobuf:"bytes,13,opt,name=contact_number,json=contactNumber,proto3,oneof" json:"contact_number,omitempty"`
+	College       *string                `protobuf:"bytes,14,opt,name=college,proto3,oneof" json:"college,omitempty"`
+	Program       *string                `protobuf:"bytes,15,opt,name=program,proto3,oneof" json:"program,omitempty"`
+	Interests     *string                `protobuf:"bytes,16,opt,name=interests,proto3,oneof" json:"interests,omitempty"`
+	Discord       *string                `protobuf:"bytes,17,opt,name=discord,proto3,oneof" json:"discord,omitempty"`
+	FbLink        *string                `protobuf:"bytes,18,opt,name=fb_link,json=fbLink,proto3,oneof" json:"fb_link,omitempty"`
+	Telegram      *string                `protobuf:"bytes,19,opt,name=telegram,proto3,oneof" json:"telegram,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Member) Reset() {
+	*x = Member{}
+	mi := &file_lscs_core_v1_core_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Member) ProtoMessage() {}
+
+func (x *Member) ProtoReflect() protoreflect.Message {
+	mi := &file_lscs_core_v1_core_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Member.ProtoReflect.Descriptor instead.
+func (*Member) Descriptor() ([]byte, []int) {
+	return file_lscs_core_v1_core_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Member) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Member) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Member) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Member) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *Member) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Member) GetCommitteeId() string {
+	if x != nil && x.CommitteeId != nil {
+		return *x.CommitteeId
+	}
+	return ""
+}
+
+func (x *Member) GetCommitteeName() string {
+	if x != nil && x.CommitteeName != nil {
+		return *x.CommitteeName
+	}
+	return ""
+}
+
+func (x *Member) GetDivisionId() string {
+	if x != nil && x.DivisionId != nil {
+		return *x.DivisionId
+	}
+	return ""
+}
+
+func (x *Member) GetDivisionName() string {
+	if x != nil && x.DivisionName != nil {
+		return *x.DivisionName
+	}
+	return ""
+}
+
+func (x *Member) GetPositionId() string {
+	if x != nil && x.PositionId != nil {
+		return *x.PositionId
+	}
+	return ""
+}
+
+func (x *Member) GetPositionName() string {
+	if x != nil && x.PositionName != nil {
+		return *x.PositionName
+	}
+	return ""
+}
+
+func (x *Member) GetHouseName() string {
+	if x != nil && x.HouseName != nil {
+		return *x.HouseName
+	}
+	return ""
+}
+
+func (x *Member) GetContactNumber() string {
+	if x != nil && x.ContactNumber != nil {
+		return *x.ContactNumber
+	}
+	return ""
+}
+
+func (x *Member) GetCollege() string {
+	if x != nil && x.College != nil {
+		return *x.College
+	}
+	return ""
+}
+
+func (x *Member) GetProgram() string {
+	if x != nil && x.Program != nil {
+		return *x.Program
+	}
+	return ""
+}
+
+func (x *Member) GetInterests() string {
+	if x != nil && x.Interests != nil {
+		return *x.Interests
+	}
+	return ""
+}
+
+func (x *Member) GetDiscord() string {
+	if x != nil && x.Discord != nil {
+		return *x.Discord
+	}
+	return ""
+}
+
+func (x *Member) GetFbLink() string {
+	if x != nil && x.FbLink != nil {
+		return *x.FbLink
+	}
+	return ""
+}
+
+func (x *Member) GetTelegram() string {
+	if x != nil && x.Telegram != nil {
+		return *x.Telegram
+	}
+	return ""
+}
+
+type MembershipQuery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Lookup:
+	//
+	//	*MembershipQuery_Email
+	//	*MembershipQuery_Id
+	Lookup        isMembershipQuery_Lookup `protobuf_oneof:"lookup"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MembershipQuery) Reset() {
+	*x = MembershipQuery{}
+	mi := &file_lscs_core_v1_core_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MembershipQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MembershipQuery) ProtoMessage() {}
+
+func (x *MembershipQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_lscs_core_v1_core_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MembershipQuery.ProtoReflect.Descriptor instead.
+func (*MembershipQuery) Descriptor() ([]byte, []int) {
+	return file_lscs_core_v1_core_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MembershipQuery) GetLookup() isMembershipQuery_Lookup {
+	if x != nil {
+		return x.Lookup
+	}
+	return nil
+}
+
+func (x *MembershipQuery) GetEmail() string {
+	if x != nil {
+		if x, ok := x.Lookup.(*MembershipQuery_Email); ok {
+			return x.Email
+		}
+	}
+	return ""
+}
+
+func (x *MembershipQuery) GetId() int32 {
+	if x != nil {
+		if x, ok := x.Lookup.(*MembershipQuery_Id); ok {
+			return x.Id
+		}
+	}
+	return 0
+}
+
+type isMembershipQuery_Lookup interface {
+	isMembershipQuery_Lookup()
+}
+
+type MembershipQuery_Email struct {
+	Email string `protobuf:"bytes,1,opt,name=email,proto3,oneof"`
+}
+
+type MembershipQuery_Id struct {
+	Id int32 `protobuf:"varint,2,opt,name=id,proto3,oneof"`
+}
+
+func (*MembershipQuery_Email) isMembershipQuery_Lookup() {}
+
+func (*MembershipQuery_Id) isMembershipQuery_Lookup() {}
+
+type MembershipResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         *MembershipQuery       `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	IsMember      bool                   `protobuf:"varint,2,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MembershipResult) Reset() {
+	*x = MembershipResult{}
+	mi := &file_lscs_core_v1_core_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MembershipResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MembershipResult) ProtoMessage() {}
+
+func (x *MembershipResult) ProtoReflect() protoreflect.Message {
+	mi := &file_lscs_core_v1_core_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MembershipResult.ProtoReflect.Descriptor instead.
+func (*MembershipResult) Descriptor() ([]byte, []int) {
+	return file_lscs_core_v1_core_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MembershipResult) GetQuery() *MembershipQuery {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+func (x *MembershipResult) GetIsMember() bool {
+	if x != nil {
+		return x.IsMember
+	}
+	return false
+}
+
+type CheckMembershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queries       []*MembershipQuery     `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckMembershipRequest) Reset() {
+	*x = CheckMembershipRequest{}
+	mi := &file_lscs_core_v1_core_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckMembershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckMembershipRequest) ProtoMessage() {}
+
+func (x *CheckMembershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lscs_core_v1_core_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckMembershipRequest.ProtoReflect.Descriptor instead.
+func (*CheckMembershipRequest) Descriptor() ([]byte, []int) {
+	return file_lscs_core_v1_core_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CheckMembershipRequest) GetQueries() []*MembershipQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type CheckMembershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*MembershipResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckMembershipResponse) Reset() {
+	*x = CheckMembershipResponse{}
+	mi := &file_lscs_core_v1_core_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckMembershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckMembershipResponse) ProtoMessage() {}
+
+func (x *CheckMembershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lscs_core_v1_core_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckMembershipResponse.ProtoReflect.Descriptor instead.
+func (*CheckMembershipResponse) Descriptor() ([]byte, []int) {
+	return file_lscs_core_v1_core_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CheckMembershipResponse) GetResults() []*MembershipResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ListCommitteesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommitteesRequest) Reset() {
+	*x = ListCommitteesRequest{}
+	mi := &file_lscs_core_v1_core_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommitteesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommitteesRequest) ProtoMessage() {}
+
+func (x *ListCommitteesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lscs_core_v1_core_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommitteesRequest.ProtoReflect.Descriptor instead.
+func (*ListCommitteesRequest) Descriptor() ([]byte, []int) {
+	return file_lscs_core_v1_core_proto_rawDescGZIP(), []int{6}
+}
+
+type Committee struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommitteeId   string                 `protobuf:"bytes,1,opt,name=committee_id,json=committeeId,proto3" json:"committee_id,omitempty"`
+	CommitteeName string                 `protobuf:"bytes,2,opt,name=committee_name,json=committeeName,proto3" json:"committee_name,omitempty"`
+	CommitteeHead *int32                 `protobuf:"varint,3,opt,name=committee_head,json=committeeHead,proto3,oneof" json:"committee_head,omitempty"`
+	HeadName      *string                `protobuf:"bytes,4,opt,name=head_name,json=headName,proto3,oneof" json:"head_name,omitempty"`
+	DivisionId    *string                `protobuf:"bytes,5,opt,name=division_id,json=divisionId,proto3,oneof" json:"division_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Committee) Reset() {
+	*x = Committee{}
+	mi := &file_lscs_core_v1_core_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Committee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Committee) ProtoMessage() {}
+
+func (x *Committee) ProtoReflect() protoreflect.Message {
+	mi := &file_lscs_core_v1_core_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Committee.ProtoReflect.Descriptor instead.
+func (*Committee) Descriptor() ([]byte, []int) {
+	return file_lscs_core_v1_core_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Committee) GetCommitteeId() string {
+	if x != nil {
+		return x.CommitteeId
+	}
+	return ""
+}
+
+func (x *Committee) GetCommitteeName() string {
+	if x != nil {
+		return x.CommitteeName
+	}
+	return ""
+}
+
+func (x *Committee) GetCommitteeHead() int32 {
+	if x != nil && x.CommitteeHead != nil {
+		return *x.CommitteeHead
+	}
+	return 0
+}
+
+func (x *Committee) GetHeadName() string {
+	if x != nil && x.HeadName != nil {
+		return *x.HeadName
+	}
+	return ""
+}
+
+func (x *Committee) GetDivisionId() string {
+	if x != nil && x.DivisionId != nil {
+		return *x.DivisionId
+	}
+	return ""
+}
+
+type ListCommitteesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Committees    []*Committee           `protobuf:"bytes,1,rep,name=committees,proto3" json:"committees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommitteesResponse) Reset() {
+	*x = ListCommitteesResponse{}
+	mi := &file_lscs_core_v1_core_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommitteesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommitteesResponse) ProtoMessage() {}
+
+func (x *ListCommitteesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lscs_core_v1_core_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommitteesResponse.ProtoReflect.Descriptor instead.
+func (*ListCommitteesResponse) Descriptor() ([]byte, []int) {
+	return file_lscs_core_v1_core_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListCommitteesResponse) GetCommittees() []*Committee {
+	if x != nil {
+		return x.Committees
+	}
+	return nil
+}
+
+var File_lscs_core_v1_core_proto protoreflect.FileDescriptor
+
+const file_lscs_core_v1_core_proto_rawDesc = "" +
+	"\n" +
+	"\x17lscs/core/v1/core.proto\x12\flscs.core.v1\"F\n" +
+	"\x10GetMemberRequest\x12\x16\n" +
+	"\x05email\x18\x01 \x01(\tH\x00R\x05email\x12\x10\n" +
+	"\x02id\x18\x02 \x01(\x05H\x00R\x02idB\b\n" +
+	"\x06lookup\"\x81\a\n" +
+	"\x06Member\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1b\n" +
+	"\tfull_name\x18\x03 \x01(\tR\bfullName\x12\x1f\n" +
+	"\bnickname\x18\x04 \x01(\tH\x00R\bnickname\x88\x01\x01\x12 \n" +
+	"\timage_url\x18\x05 \x01(\tH\x01R\bimageUrl\x88\x01\x01\x12&\n" +
+	"\fcommittee_id\x18\x06 \x01(\tH\x02R\vcommitteeId\x88\x01\x01\x12*\n" +
+	"\x0ecommittee_name\x18\a \x01(\tH\x03R\rcommitteeName\x88\x01\x01\x12$\n" +
+	"\vdivision_id\x18\b \x01(\tH\x04R\n" +
+	"divisionId\x88\x01\x01\x12(\n" +
+	"\rdivision_name\x18\t \x01(\tH\x05R\fdivisionName\x88\x01\x01\x12$\n" +
+	"\vposition_id\x18\n" +
+	" \x01(\tH\x06R\n" +
+	"positionId\x88\x01\x01\x12(\n" +
+	"\rposition_name\x18\v \x01(\tH\aR\fpositionName\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"house_name\x18\f \x01(\tH\bR\thouseName\x88\x01\x01\x12*\n" +
+	"\x0econtact_number\x18\r \x01(\tH\tR\rcontactNumber\x88\x01\x01\x12\x1d\n" +
+	"\acollege\x18\x0e \x01(\tH\n" +
+	"R\acollege\x88\x01\x01\x12\x1d\n" +
+	"\aprogram\x18\x0f \x01(\tH\vR\aprogram\x88\x01\x01\x12!\n" +
+	"\tinterests\x18\x10 \x01(\tH\fR\tinterests\x88\x01\x01\x12\x1d\n" +
+	"\adiscord\x18\x11 \x01(\tH\rR\adiscord\x88\x01\x01\x12\x1c\n" +
+	"\afb_link\x18\x12 \x01(\tH\x0eR\x06fbLink\x88\x01\x01\x12\x1f\n" +
+	"\btelegram\x18\x13 \x01(\tH\x0fR\btelegram\x88\x01\x01B\v\n" +
+	"\t_nicknameB\f\n" +
+	"\n" +
+	"_image_urlB\x0f\n" +
+	"\r_committee_idB\x11\n" +
+	"\x0f_committee_nameB\x0e\n" +
+	"\f_division_idB\x10\n" +
+	"\x0e_division_nameB\x0e\n" +
+	"\f_position_idB\x10\n" +
+	"\x0e_position_nameB\r\n" +
+	"\v_house_nameB\x11\n" +
+	"\x0f_contact_numberB\n" +
+	"\n" +
+	"\b_collegeB\n" +
+	"\n" +
+	"\b_programB\f\n" +
+	"\n" +
+	"_interestsB\n" +
+	"\n" +
+	"\b_discordB\n" +
+	"\n" +
+	"\b_fb_linkB\v\n" +
+	"\t_telegram\"E\n" +
+	"\x0fMembershipQuery\x12\x16\n" +
+	"\x05email\x18\x01 \x01(\tH\x00R\x05email\x12\x10\n" +
+	"\x02id\x18\x02 \x01(\x05H\x00R\x02idB\b\n" +
+	"\x06lookup\"d\n" +
+	"\x10MembershipResult\x123\n" +
+	"\x05query\x18\x01 \x01(\v2\x1d.lscs.core.v1.MembershipQueryR\x05query\x12\x1b\n" +
+	"\tis_member\x18\x02 \x01(\bR\bisMember\"Q\n" +
+	"\x16CheckMembershipRequest\x127\n" +
+	"\aqueries\x18\x01 \x03(\v2\x1d.lscs.core.v1.MembershipQueryR\aqueries\"S\n" +
+	"\x17CheckMembershipResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.lscs.core.v1.MembershipResultR\aresults\"\x17\n" +
+	"\x15ListCommitteesRequest\"\xfa\x01\n" +
+	"\tCommittee\x12!\n" +
+	"\fcommittee_id\x18\x01 \x01(\tR\vcommitteeId\x12%\n" +
+	"\x0ecommittee_name\x18\x02 \x01(\tR\rcommitteeName\x12*\n" +
+	"\x0ecommittee_head\x18\x03 \x01(\x05H\x00R\rcommitteeHead\x88\x01\x01\x12 \n" +
+	"\thead_name\x18\x04 \x01(\tH\x01R\bheadName\x88\x01\x01\x12$\n" +
+	"\vdivision_id\x18\x05 \x01(\tH\x02R\n" +
+	"divisionId\x88\x01\x01B\x11\n" +
+	"\x0f_committee_headB\f\n" +
+	"\n" +
+	"_head_nameB\x0e\n" +
+	"\f_division_id\"Q\n" +
+	"\x16ListCommitteesResponse\x127\n" +
+	"\n" +
+	"committees\x18\x01 \x03(\v2\x17.lscs.core.v1.CommitteeR\n" +
+	"committees2\x89\x02\n" +
+	"\rMemberService\x12A\n" +
+	"\tGetMember\x12\x1e.lscs.core.v1.GetMemberRequest\x1a\x14.lscs.core.v1.Member\x12^\n" +
+	"\x0fCheckMembership\x12$.lscs.core.v1.CheckMembershipRequest\x1a%.lscs.core.v1.CheckMembershipResponse\x12U\n" +
+	"\x10StreamMembership\x12\x1d.lscs.core.v1.MembershipQuery\x1a\x1e.lscs.core.v1.MembershipResult(\x010\x012o\n" +
+	"\x10CommitteeService\x12[\n" +
+	"\x0eListCommittees\x12#.lscs.core.v1.ListCommitteesRequest\x1a$.lscs.core.v1.ListCommitteesResponseB6Z4github.com/dlsu-lscs/lscs-core-api/pkg/corev1;corev1b\x06proto3"
+
+var (
+	file_lscs_core_v1_core_proto_rawDescOnce sync.Once
+	file_lscs_core_v1_core_proto_rawDescData []byte
+)
+
+func file_lscs_core_v1_core_proto_rawDescGZIP() []byte {
+	file_lscs_core_v1_core_proto_rawDescOnce.Do(func() {
+		file_lscs_core_v1_core_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lscs_core_v1_core_proto_rawDesc), len(file_lscs_core_v1_core_proto_rawDesc)))
+	})
+	return file_lscs_core_v1_core_proto_rawDescData
+}
+
+var file_lscs_core_v1_core_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_lscs_core_v1_core_proto_goTypes = []any{
+	(*GetMemberRequest)(nil),        // 0: lscs.core.v1.GetMemberRequest
+	(*Member)(nil),                  // 1: lscs.core.v1.Member
+	(*MembershipQuery)(nil),         // 2: lscs.core.v1.MembershipQuery
+	(*MembershipResult)(nil),        // 3: lscs.core.v1.MembershipResult
+	(*CheckMembershipRequest)(nil),  // 4: lscs.core.v1.CheckMembershipRequest
+	(*CheckMembershipResponse)(nil), // 5: lscs.core.v1.CheckMembershipResponse
+	(*ListCommitteesRequest)(nil),   // 6: lscs.core.v1.ListCommitteesRequest
+	(*Committee)(nil),               // 7: lscs.core.v1.Committee
+	(*ListCommitteesResponse)(nil),  // 8: lscs.core.v1.ListCommitteesResponse
+}
+var file_lscs_core_v1_core_proto_depIdxs = []int32{
+	2, // 0: lscs.core.v1.MembershipResult.query:type_name -> lscs.core.v1.MembershipQuery
+	2, // 1: lscs.core.v1.CheckMembershipRequest.queries:type_name -> lscs.core.v1.MembershipQuery
+	3, // 2: lscs.core.v1.CheckMembershipResponse.results:type_name -> lscs.core.v1.MembershipResult
+	7, // 3: lscs.core.v1.ListCommitteesResponse.committees:type_name -> lscs.core.v1.Committee
+	0, // 4: lscs.core.v1.MemberService.GetMember:input_type -> lscs.core.v1.GetMemberRequest
+	4, // 5: lscs.core.v1.MemberService.CheckMembership:input_type -> lscs.core.v1.CheckMembershipRequest
+	2, // 6: lscs.core.v1.MemberService.StreamMembership:input_type -> lscs.core.v1.MembershipQuery
+	6, // 7: lscs.core.v1.CommitteeService.ListCommittees:input_type -> lscs.core.v1.ListCommitteesRequest
+	1, // 8: lscs.core.v1.MemberService.GetMember:output_type -> lscs.core.v1.Member
+	5, // 9: lscs.core.v1.MemberService.CheckMembership:output_type -> lscs.core.v1.CheckMembershipResponse
+	3, // 10: lscs.core.v1.MemberService.StreamMembership:output_type -> lscs.core.v1.MembershipResult
+	8, // 11: lscs.core.v1.CommitteeService.ListCommittees:output_type -> lscs.core.v1.ListCommitteesResponse
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_lscs_core_v1_core_proto_init() }
+func file_lscs_core_v1_core_proto_init() {
+	if File_lscs_core_v1_core_proto != nil {
+		return
+	}
+	file_lscs_core_v1_core_proto_msgTypes[0].OneofWrappers = []any{
+		(*GetMemberRequest_Email)(nil),
+		(*GetMemberRequest_Id)(nil),
+	}
+	file_lscs_core_v1_core_proto_msgTypes[1].OneofWrappers = []any{}
+	file_lscs_core_v1_core_proto_msgTypes[2].OneofWrappers = []any{
+		(*MembershipQuery_Email)(nil),
+		(*MembershipQuery_Id)(nil),
+	}
+	file_lscs_core_v1_core_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lscs_core_v1_core_proto_rawDesc), len(file_lscs_core_v1_core_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_lscs_core_v1_core_proto_goTypes,
+		DependencyIndexes: file_lscs_core_v1_core_proto_depIdxs,
+		MessageInfos:      file_lscs_core_v1_core_proto_msgTypes,
+	}.Build()
+	File_lscs_core_v1_core_proto = out.File
+	file_lscs_core_v1_core_proto_goTypes = nil
+	file_lscs_core_v1_core_proto_depIdxs = nil
+}
@@ -0,0 +1,318 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.32.1
+// source: lscs/core/v1/core.proto
+
+// gRPC API for internal services. Calls are authenticated with an API key,
+// sent as "authorization: Bearer <api key>" metadata, and need the same scopes
+// as the REST endpoints they mirror.
+
+package corev1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MemberService_GetMember_FullMethodName        = "/lscs.core.v1.MemberService/GetMember"
+	MemberService_CheckMembership_FullMethodName  = "/lscs.core.v1.MemberService/CheckMembership"
+	MemberService_StreamMembership_FullMethodName = "/lscs.core.v1.MemberService/StreamMembership"
+)
+
+// MemberServiceClient is the client API for MemberService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MemberService looks members up and checks membership (scope members:read).
+type MemberServiceClient interface {
+	// GetMember returns a member's profile by email or ID (POST /member, POST /member-id).
+	// Fails with NOT_FOUND if there is no such member.
+	GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*Member, error)
+	// CheckMembership checks up to 1000 emails and IDs at once (POST /check-email, POST /check-id).
+	// Results are in the order of the queries.
+	CheckMembership(ctx context.Context, in *CheckMembershipRequest, opts ...grpc.CallOption) (*CheckMembershipResponse, error)
+	// StreamMembership checks each query as it arrives, for services that check members one at a time.
+	StreamMembership(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[MembershipQuery, MembershipResult], error)
+}
+
+type memberServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMemberServiceClient(cc grpc.ClientConnInterface) MemberServiceClient {
+	return &memberServiceClient{cc}
+}
+
+func (c *memberServiceClient) GetMember(ctx context.Context, in *GetMemberRequest, opts ...grpc.CallOption) (*Member, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Member)
+	err := c.cc.Invoke(ctx, MemberService_GetMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) CheckMembership(ctx context.Context, in *CheckMembershipRequest, opts ...grpc.CallOption) (*CheckMembershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckMembershipResponse)
+	err := c.cc.Invoke(ctx, MemberService_CheckMembership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memberServiceClient) StreamMembership(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[MembershipQuery, MembershipResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MemberService_ServiceDesc.Streams[0], MemberService_StreamMembership_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[MembershipQuery, MembershipResult]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemberService_StreamMembershipClient = grpc.BidiStreamingClient[MembershipQuery, MembershipResult]
+
+// MemberServiceServer is the server API for MemberService service.
+// All implementations must embed UnimplementedMemberServiceServer
+// for forward compatibility.
+//
+// MemberService looks members up and checks membership (scope members:read).
+type MemberServiceServer interface {
+	// GetMember returns a member's profile by email or ID (POST /member, POST /member-id).
+	// Fails with NOT_FOUND if there is no such member.
+	GetMember(context.Context, *GetMemberRequest) (*Member, error)
+	// CheckMembership checks up to 1000 emails and IDs at once (POST /check-email, POST /check-id).
+	// Results are in the order of the queries.
+	CheckMembership(context.Context, *CheckMembershipRequest) (*CheckMembershipResponse, error)
+	// StreamMembership checks each query as it arrives, for services that check members one at a time.
+	StreamMembership(grpc.BidiStreamingServer[MembershipQuery, MembershipResult]) error
+	mustEmbedUnimplementedMemberServiceServer()
+}
+
+// UnimplementedMemberServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMemberServiceServer struct{}
+
+func (UnimplementedMemberServiceServer) GetMember(context.Context, *GetMemberRequest) (*Member, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMember not implemented")
+}
+func (UnimplementedMemberServiceServer) CheckMembership(context.Context, *CheckMembershipRequest) (*CheckMembershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckMembership not implemented")
+}
+func (UnimplementedMemberServiceServer) StreamMembership(grpc.BidiStreamingServer[MembershipQuery, MembershipResult]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMembership not implemented")
+}
+func (UnimplementedMemberServiceServer) mustEmbedUnimplementedMemberServiceServer() {}
+func (UnimplementedMemberServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeMemberServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MemberServiceServer will
+// result in compilation errors.
+type UnsafeMemberServiceServer interface {
+	mustEmbedUnimplementedMemberServiceServer()
+}
+
+func RegisterMemberServiceServer(s grpc.ServiceRegistrar, srv MemberServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMemberServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MemberService_ServiceDesc, srv)
+}
+
+func _MemberService_GetMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).GetMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_GetMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).GetMember(ctx, req.(*GetMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_CheckMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckMembershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemberServiceServer).CheckMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemberService_CheckMembership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemberServiceServer).CheckMembership(ctx, req.(*CheckMembershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemberService_StreamMembership_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MemberServiceServer).StreamMembership(&grpc.GenericServerStream[MembershipQuery, MembershipResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MemberService_StreamMembershipServer = grpc.BidiStreamingServer[MembershipQuery, MembershipResult]
+
+// MemberService_ServiceDesc is the grpc.ServiceDesc for MemberService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MemberService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "lscs.core.v1.MemberService",
+	HandlerType: (*MemberServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMember",
+			Handler:    _MemberService_GetMember_Handler,
+		},
+		{
+			MethodName: "CheckMembership",
+			Handler:    _MemberService_CheckMembership_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamMembership",
+			Handler:       _MemberService_StreamMembership_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "lscs/core/v1/core.proto",
+}
+
+const (
+	CommitteeService_ListCommittees_FullMethodName = "/lscs.core.v1.CommitteeService/ListCommittees"
+)
+
+// CommitteeServiceClient is the client API for CommitteeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CommitteeService lists committees (scope committees:read).
+type CommitteeServiceClient interface {
+	// ListCommittees returns all committees with their heads (GET /committees).
+	ListCommittees(ctx context.Context, in *ListCommitteesRequest, opts ...grpc.CallOption) (*ListCommitteesResponse, error)
+}
+
+type committeeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommitteeServiceClient(cc grpc.ClientConnInterface) CommitteeServiceClient {
+	return &committeeServiceClient{cc}
+}
+
+func (c *committeeServiceClient) ListCommittees(ctx context.Context, in *ListCommitteesRequest, opts ...grpc.CallOption) (*ListCommitteesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommitteesResponse)
+	err := c.cc.Invoke(ctx, CommitteeService_ListCommittees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommitteeServiceServer is the server API for CommitteeService service.
+// All implementations must embed UnimplementedCommitteeServiceServer
+// for forward compatibility.
+//
+// CommitteeService lists committees (scope committees:read).
+type CommitteeServiceServer interface {
+	// ListCommittees returns all committees with their heads (GET /committees).
+	ListCommittees(context.Context, *ListCommitteesRequest) (*ListCommitteesResponse, error)
+	mustEmbedUnimplementedCommitteeServiceServer()
+}
+
+// UnimplementedCommitteeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCommitteeServiceServer struct{}
+
+func (UnimplementedCommitteeServiceServer) ListCommittees(context.Context, *ListCommitteesRequest) (*ListCommitteesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommittees not implemented")
+}
+func (UnimplementedCommitteeServiceServer) mustEmbedUnimplementedCommitteeServiceServer() {}
+func (UnimplementedCommitteeServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeCommitteeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommitteeServiceServer will
+// result in compilation errors.
+type UnsafeCommitteeServiceServer interface {
+	mustEmbedUnimplementedCommitteeServiceServer()
+}
+
+func RegisterCommitteeServiceServer(s grpc.ServiceRegistrar, srv CommitteeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCommitteeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CommitteeService_ServiceDesc, srv)
+}
+
+func _CommitteeService_ListCommittees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommitteesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommitteeServiceServer).ListCommittees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommitteeService_ListCommittees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommitteeServiceServer).ListCommittees(ctx, req.(*ListCommitteesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CommitteeService_ServiceDesc is the grpc.ServiceDesc for CommitteeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CommitteeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "lscs.core.v1.CommitteeService",
+	HandlerType: (*CommitteeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCommittees",
+			Handler:    _CommitteeService_ListCommittees_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lscs/core/v1/core.proto",
+}
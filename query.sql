@@ -63,6 +63,63 @@ SELECT c.committee_id, c.committee_name, c.committee_head, c.division_id FROM co
 -- name: GetAllDivisions :many
 SELECT d.division_id, d.division_name, d.division_head FROM divisions d;
 
+-- name: GetCommittee :one
+SELECT c.committee_id, c.committee_name, c.committee_head, c.division_id,
+       d.division_name, h.full_name AS head_name
+FROM committees c
+LEFT JOIN divisions d ON c.division_id = d.division_id
+LEFT JOIN members h ON c.committee_head = h.id
+WHERE c.committee_id = ?;
+
+-- name: ListCommitteesWithHeads :many
+SELECT c.committee_id, c.committee_name, c.committee_head, c.division_id, h.full_name AS head_name
+FROM committees c
+LEFT JOIN members h ON c.committee_head = h.id
+ORDER BY c.committee_name;
+
+-- name: CreateCommittee :exec
+INSERT INTO committees (committee_id, committee_name, division_id) VALUES (?, ?, ?);
+
+-- name: UpdateCommittee :exec
+UPDATE committees SET committee_name = ?, division_id = ? WHERE committee_id = ?;
+
+-- name: SetCommitteeHead :exec
+UPDATE committees SET committee_head = ? WHERE committee_id = ?;
+
+-- name: DeleteCommittee :execrows
+DELETE FROM committees WHERE committee_id = ?;
+
+-- name: ListCommitteeMembers :many
+SELECT m.id, m.full_name, m.nickname, m.email, m.image_url, p.position_id, p.position_name
+FROM members m
+LEFT JOIN positions p ON m.position_id = p.position_id
+WHERE m.committee_id = ?
+ORDER BY m.full_name;
+
+-- name: GetDivision :one
+SELECT d.division_id, d.division_name, d.division_head, h.full_name AS head_name
+FROM divisions d
+LEFT JOIN members h ON d.division_head = h.id
+WHERE d.division_id = ?;
+
+-- name: ListDivisionsWithHeads :many
+SELECT d.division_id, d.division_name, d.division_head, h.full_name AS head_name
+FROM divisions d
+LEFT JOIN members h ON d.division_head = h.id
+ORDER BY d.division_name;
+
+-- name: CreateDivision :exec
+INSERT INTO divisions (division_id, division_name) VALUES (?, ?);
+
+-- name: UpdateDivision :exec
+UPDATE divisions SET division_name = ? WHERE division_id = ?;
+
+-- name: SetDivisionHead :exec
+UPDATE divisions SET division_head = ? WHERE division_id = ?;
+
+-- name: DeleteDivision :execrows
+DELETE FROM divisions WHERE division_id = ?;
+
 -- name: StoreAPIKey :exec
 INSERT INTO api_keys (
     member_email,
@@ -97,22 +154,22 @@ SELECT EXISTS(SELECT 1 FROM api_keys WHERE allowed_origin = ? AND is_dev = false
 -- name: ListAPIKeysByEmail :many
 SELECT api_key_id, member_email, project, allowed_origin, is_dev, is_admin, created_at, expires_at FROM api_keys WHERE member_email = ? ORDER BY created_at DESC;
 
--- name: DeleteAPIKeyById :exec
+-- name: DeleteAPIKeyById :execrows
 DELETE FROM api_keys WHERE api_key_id = ? AND member_email = ?;
 
 -- Session queries for web UI authentication
 
 -- name: CreateSession :exec
-INSERT INTO sessions (id, member_id, expires_at, user_agent, ip_address)
-VALUES (?, ?, ?, ?, ?);
+INSERT INTO sessions (id, member_id, expires_at, user_agent, ip_address, auth_time)
+VALUES (?, ?, ?, ?, ?, NOW());
 
 -- name: GetSession :one
-SELECT id, member_id, created_at, expires_at, last_activity, user_agent, ip_address
+SELECT id, member_id, created_at, expires_at, last_activity, user_agent, ip_address, auth_time
 FROM sessions WHERE id = ? AND expires_at > NOW();
 
 -- name: GetSessionWithMember :one
 SELECT 
-    s.id, s.member_id, s.created_at, s.expires_at, s.last_activity, s.user_agent, s.ip_address,
+    s.id, s.member_id, s.created_at, s.expires_at, s.last_activity, s.user_agent, s.ip_address, s.auth_time,
     m.email, m.full_name
 FROM sessions s
 JOIN members m ON s.member_id = m.id
@@ -121,6 +178,9 @@ WHERE s.id = ? AND s.expires_at > NOW();
 -- name: UpdateSessionActivity :exec
 UPDATE sessions SET last_activity = NOW() WHERE id = ?;
 
+-- name: UpdateSessionAuthTime :exec
+UPDATE sessions SET auth_time = NOW() WHERE id = ?;
+
 -- name: ExtendSession :exec
 UPDATE sessions SET expires_at = ?, last_activity = NOW() WHERE id = ?;
 
@@ -205,3 +265,981 @@ SELECT EXISTS(SELECT 1 FROM member_roles WHERE member_id = ? AND role_id = 'ADMI
 -- name: GetMemberAuthInfo :one
 -- lightweight query for authorization checks (no image_url dependency)
 SELECT id, position_id, committee_id FROM members WHERE email = ?;
+
+-- OAuth2 client credentials queries
+
+-- name: CreateOAuthClient :exec
+INSERT INTO oauth_clients (client_id, client_secret_hash, name, scopes, redirect_uris, created_by)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetOAuthClient :one
+SELECT client_id, client_secret_hash, name, scopes, created_by, created_at, redirect_uris
+FROM oauth_clients WHERE client_id = ?;
+
+-- name: ListOAuthClients :many
+SELECT client_id, client_secret_hash, name, scopes, created_by, created_at, redirect_uris
+FROM oauth_clients ORDER BY created_at DESC;
+
+-- name: DeleteOAuthClient :execrows
+DELETE FROM oauth_clients WHERE client_id = ?;
+
+-- OIDC provider queries
+
+-- name: CreateAuthorizationCode :exec
+INSERT INTO oidc_authorization_codes (code_hash, client_id, member_id, redirect_uri, scope, nonce, code_challenge, expires_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetAuthorizationCode :one
+SELECT code_hash, client_id, member_id, redirect_uri, scope, nonce, code_challenge, expires_at, created_at
+FROM oidc_authorization_codes WHERE code_hash = ? AND expires_at > NOW();
+
+-- name: DeleteAuthorizationCode :execrows
+DELETE FROM oidc_authorization_codes WHERE code_hash = ?;
+
+-- name: CleanupExpiredAuthorizationCodes :exec
+DELETE FROM oidc_authorization_codes WHERE expires_at < NOW();
+
+-- Event queries
+
+-- name: ListEvents :many
+SELECT * FROM events ORDER BY created_at DESC, id DESC;
+
+-- name: ListEventsByCommittee :many
+SELECT * FROM events WHERE committee_id = ? ORDER BY created_at DESC, id DESC;
+
+-- name: ListEventsByTerm :many
+SELECT * FROM events WHERE term_id = ? ORDER BY created_at DESC, id DESC;
+
+-- name: GetEventById :one
+SELECT * FROM events WHERE id = ?;
+
+-- name: CreateEvent :execlastid
+INSERT INTO events (arn, name, committee_id, type, nature_id, term_id, duration_id, brief_description,
+                    goals, objectives, strategies, measures, budget_allocation, venue, docu_head, fin_head)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: UpdateEvent :exec
+UPDATE events SET
+    arn = ?,
+    name = ?,
+    committee_id = ?,
+    type = ?,
+    nature_id = ?,
+    term_id = ?,
+    duration_id = ?,
+    brief_description = ?,
+    goals = ?,
+    objectives = ?,
+    strategies = ?,
+    measures = ?,
+    budget_allocation = ?,
+    venue = ?,
+    docu_head = ?,
+    fin_head = ?
+WHERE id = ?;
+
+-- name: DeleteEvent :execrows
+DELETE FROM events WHERE id = ?;
+
+-- name: ListEventDates :many
+SELECT * FROM event_dates WHERE event_id = ? ORDER BY start_time;
+
+-- name: CreateEventDate :exec
+INSERT INTO event_dates (event_id, start_time, end_time) VALUES (?, ?, ?);
+
+-- name: DeleteEventDates :exec
+DELETE FROM event_dates WHERE event_id = ?;
+
+-- name: ListEventHeads :many
+SELECT m.id, m.full_name, m.email, m.position_id, m.committee_id
+FROM event_heads eh
+JOIN members m ON eh.member_id = m.id
+WHERE eh.event_id = ?
+ORDER BY m.full_name;
+
+-- name: AddEventHead :exec
+INSERT INTO event_heads (event_id, member_id) VALUES (?, ?);
+
+-- name: RemoveEventHead :execrows
+DELETE FROM event_heads WHERE event_id = ? AND member_id = ?;
+
+-- name: ListEventNatures :many
+SELECT * FROM event_natures ORDER BY name;
+
+-- name: ListEventTypes :many
+SELECT * FROM event_types ORDER BY name;
+
+-- name: ListEventDurations :many
+SELECT * FROM event_durations ORDER BY id;
+
+-- name: ListTerms :many
+SELECT * FROM terms ORDER BY start_year DESC, term DESC;
+
+-- name: CheckEventTypeExists :one
+SELECT EXISTS(SELECT 1 FROM event_types WHERE name = ?);
+
+-- Event participant queries
+
+-- name: ListEventParticipants :many
+SELECT * FROM event_participants WHERE event_id = ? ORDER BY student_name, student_id;
+
+-- name: GetEventParticipant :one
+SELECT * FROM event_participants WHERE event_id = ? AND student_id = ?;
+
+-- name: CreateEventParticipant :exec
+-- verified_at stays NULL for public registrations until their email is verified
+INSERT INTO event_participants (event_id, student_id, student_name, student_email, notes, verified_at)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: UpdatePendingEventParticipant :execrows
+-- a public registration replaces an earlier one whose email was never verified
+UPDATE event_participants
+SET student_name = ?, student_email = ?, notes = ?, registered_at = CURRENT_TIMESTAMP
+WHERE event_id = ? AND student_id = ? AND verified_at IS NULL;
+
+-- name: IsStudentClaimed :one
+-- whether a verified registration ties the student ID to another email, or the email to another student ID
+SELECT EXISTS(
+    SELECT 1 FROM event_participants
+    WHERE verified_at IS NOT NULL
+      AND ((student_id = ? AND student_email <> ?) OR (student_email = ? AND student_id <> ?))
+);
+
+-- name: VerifyEventParticipant :execrows
+UPDATE event_participants SET verified_at = NOW()
+WHERE event_id = ? AND student_id = ? AND student_email = ? AND verified_at IS NULL;
+
+-- name: DeleteEventParticipant :execrows
+DELETE FROM event_participants WHERE event_id = ? AND student_id = ?;
+
+-- name: CheckInEventParticipant :execrows
+-- only the first scan records attendance
+UPDATE event_participants SET checked_in_at = NOW(), checked_in_by = ?
+WHERE event_id = ? AND student_id = ? AND checked_in_at IS NULL;
+
+-- name: IsEventHead :one
+SELECT EXISTS(SELECT 1 FROM event_heads WHERE event_id = ? AND member_id = ?);
+
+-- Event tracker queries
+
+-- name: GetEventTracker :one
+SELECT * FROM event_trackers WHERE event_id = ?;
+
+-- name: ListEventTrackers :many
+SELECT t.*, e.name, e.committee_id
+FROM event_trackers t
+JOIN events e ON e.id = t.event_id
+ORDER BY t.event_id DESC;
+
+-- name: ListEventTrackersByCommittee :many
+SELECT t.*, e.name, e.committee_id
+FROM event_trackers t
+JOIN events e ON e.id = t.event_id
+WHERE e.committee_id = ?
+ORDER BY t.event_id DESC;
+
+-- name: CreateEventTracker :exec
+INSERT IGNORE INTO event_trackers (event_id) VALUES (?);
+
+-- name: UpdateEventTracker :exec
+UPDATE event_trackers
+SET preacts_deadline = ?, postacts_deadline = ?, fin_preacts_deadline = ?, fin_postacts_deadline = ?,
+    docu_drive_id = ?, fin_drive_id = ?
+WHERE event_id = ?;
+
+-- name: UpdatePreactsStatus :execrows
+-- only applies if the status has not changed since it was read
+UPDATE event_trackers SET preacts_status = ? WHERE event_id = ? AND preacts_status = ?;
+
+-- name: UpdatePostactsStatus :execrows
+-- only applies if the status has not changed since it was read
+UPDATE event_trackers SET postacts_status = ? WHERE event_id = ? AND postacts_status = ?;
+
+-- name: UpdateFinPreactsStatus :execrows
+-- only applies if the status has not changed since it was read
+UPDATE event_trackers SET fin_preacts_status = ? WHERE event_id = ? AND fin_preacts_status = ?;
+
+-- name: UpdateFinPostactsStatus :execrows
+-- only applies if the status has not changed since it was read
+UPDATE event_trackers SET fin_postacts_status = ? WHERE event_id = ? AND fin_postacts_status = ?;
+
+-- name: CreateTrackerStatusHistory :exec
+INSERT INTO tracker_status_history (event_id, stage, from_status, to_status, changed_by, note)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: ListTrackerStatusHistory :many
+SELECT * FROM tracker_status_history WHERE event_id = ? ORDER BY changed_at DESC, id DESC;
+
+-- name: ListDocuStatuses :many
+SELECT * FROM docu_status;
+
+-- name: ListFinStatuses :many
+SELECT * FROM fin_status;
+
+-- name: ListFinProcessRefs :many
+SELECT * FROM fin_process_ref ORDER BY name;
+
+-- name: ListFinProcesses :many
+SELECT fp.id, fp.process, r.name
+FROM fin_processes fp
+JOIN fin_process_ref r ON r.id = fp.process
+WHERE fp.tracker_id = ?
+ORDER BY fp.id;
+
+-- name: AddFinProcess :execlastid
+INSERT INTO fin_processes (tracker_id, process) VALUES (?, ?);
+
+-- name: RemoveFinProcess :execrows
+DELETE FROM fin_processes WHERE id = ? AND tracker_id = ?;
+
+-- name: ListEventDocuHeads :many
+SELECT m.id, m.full_name, m.email
+FROM event_docu_head d
+JOIN members m ON m.id = d.member_id
+WHERE d.event_id = ?
+ORDER BY m.full_name;
+
+-- name: AddEventDocuHead :exec
+INSERT INTO event_docu_head (event_id, member_id) VALUES (?, ?);
+
+-- name: RemoveEventDocuHead :execrows
+DELETE FROM event_docu_head WHERE event_id = ? AND member_id = ?;
+
+-- name: IsEventDocuHead :one
+SELECT EXISTS(SELECT 1 FROM event_docu_head WHERE event_id = ? AND member_id = ?);
+
+-- name: UpdateEventDocuHead :exec
+UPDATE events SET docu_head = ? WHERE id = ?;
+
+-- name: UpdateEventFinHead :exec
+UPDATE events SET fin_head = ? WHERE id = ?;
+
+-- Tracker deadline queries
+
+-- name: ListEventDatesEndingAfter :many
+SELECT * FROM event_dates WHERE end_time >= ? ORDER BY event_id, start_time;
+
+-- name: FillTrackerPreactsDeadline :execrows
+-- only fills in the deadline if none was set by hand
+UPDATE event_trackers SET preacts_deadline = ? WHERE event_id = ? AND preacts_deadline IS NULL;
+
+-- name: FillTrackerPostactsDeadline :execrows
+-- only fills in the deadline if none was set by hand
+UPDATE event_trackers SET postacts_deadline = ? WHERE event_id = ? AND postacts_deadline IS NULL;
+
+-- name: FillTrackerFinPreactsDeadline :execrows
+-- only fills in the deadline if none was set by hand
+UPDATE event_trackers SET fin_preacts_deadline = ? WHERE event_id = ? AND fin_preacts_deadline IS NULL;
+
+-- name: FillTrackerFinPostactsDeadline :execrows
+-- only fills in the deadline if none was set by hand
+UPDATE event_trackers SET fin_postacts_deadline = ? WHERE event_id = ? AND fin_postacts_deadline IS NULL;
+
+-- name: ListTrackerDeadlines :many
+SELECT t.*, e.name, e.committee_id, e.docu_head, e.fin_head
+FROM event_trackers t
+JOIN events e ON e.id = t.event_id
+ORDER BY t.event_id;
+
+-- name: CreateTrackerReminder :execrows
+-- returns 0 if the reminder was already sent
+INSERT IGNORE INTO tracker_reminders (event_id, stage, kind, days_before, deadline)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: DeleteTrackerReminder :exec
+DELETE FROM tracker_reminders
+WHERE event_id = ? AND stage = ? AND kind = ? AND days_before = ? AND deadline = ?;
+
+-- name: GetMemberContact :one
+SELECT id, full_name, email FROM members WHERE id = ?;
+
+-- Scheduled job queries
+
+-- name: CreateScheduledJob :exec
+INSERT IGNORE INTO scheduled_jobs (name) VALUES (?);
+
+-- name: GetScheduledJob :one
+SELECT * FROM scheduled_jobs WHERE name = ?;
+
+-- name: AcquireScheduledJob :execrows
+-- locks the job if it is not running and its last run is older than the given time
+UPDATE scheduled_jobs SET locked_until = ?
+WHERE name = ?
+  AND (locked_until IS NULL OR locked_until < ?)
+  AND (last_run_at IS NULL OR last_run_at <= ?);
+
+-- name: FinishScheduledJob :exec
+UPDATE scheduled_jobs
+SET last_run_at = ?, last_status = ?, last_error = ?, locked_until = NULL
+WHERE name = ?;
+
+-- Publicity request queries
+
+-- name: ListPubReqStatuses :many
+SELECT * FROM pub_req_status;
+
+-- name: CreatePubRequest :execlastid
+INSERT INTO pub_requests (
+  event_id, pub_type, pub_drive_id, pub_status, posting_date, pub_details, pub_content,
+  caption, opa_numbers, for_posting, created_at, requester_id, dimensions
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetPubRequest :one
+SELECT p.*, e.name AS event_name, e.committee_id, r.full_name AS requester_name, h.full_name AS pub_head_name
+FROM pub_requests p
+LEFT JOIN events e ON e.id = p.event_id
+LEFT JOIN members r ON r.id = p.requester_id
+LEFT JOIN members h ON h.id = p.pub_head
+WHERE p.id = ?;
+
+-- name: ListPubRequests :many
+SELECT p.*, e.name AS event_name, e.committee_id, r.full_name AS requester_name, h.full_name AS pub_head_name
+FROM pub_requests p
+LEFT JOIN events e ON e.id = p.event_id
+LEFT JOIN members r ON r.id = p.requester_id
+LEFT JOIN members h ON h.id = p.pub_head
+ORDER BY p.posting_date DESC, p.id DESC;
+
+-- name: ListPubRequestsByEvent :many
+SELECT p.*, e.name AS event_name, e.committee_id, r.full_name AS requester_name, h.full_name AS pub_head_name
+FROM pub_requests p
+LEFT JOIN events e ON e.id = p.event_id
+LEFT JOIN members r ON r.id = p.requester_id
+LEFT JOIN members h ON h.id = p.pub_head
+WHERE p.event_id = ?
+ORDER BY p.posting_date DESC, p.id DESC;
+
+-- name: ListPubRequestsPostingBetween :many
+-- requests to be posted in [from, to), excluding cancelled ones
+SELECT p.*, e.name AS event_name, e.committee_id, r.full_name AS requester_name, h.full_name AS pub_head_name
+FROM pub_requests p
+LEFT JOIN events e ON e.id = p.event_id
+LEFT JOIN members r ON r.id = p.requester_id
+LEFT JOIN members h ON h.id = p.pub_head
+WHERE p.posting_date >= ? AND p.posting_date < ?
+  AND p.for_posting = TRUE
+  AND p.pub_status <> 'CANCELLED'
+ORDER BY p.posting_date, p.id;
+
+-- name: UpdatePubRequest :exec
+UPDATE pub_requests
+SET pub_type = ?, pub_drive_id = ?, posting_date = ?, pub_details = ?, pub_content = ?,
+    caption = ?, opa_numbers = ?, for_posting = ?, dimensions = ?
+WHERE id = ?;
+
+-- name: UpdatePubRequestStatus :execrows
+-- compare-and-set: returns 0 if the status is no longer the expected one
+UPDATE pub_requests SET pub_status = ? WHERE id = ? AND pub_status = ?;
+
+-- name: ClaimPubRequest :execrows
+-- returns 0 if the request already has a publicity head
+UPDATE pub_requests SET pub_head = ? WHERE id = ? AND pub_head IS NULL;
+
+-- name: UpdatePubRequestHead :exec
+UPDATE pub_requests SET pub_head = ? WHERE id = ?;
+
+-- name: CreatePubRequestComment :execlastid
+INSERT INTO pub_request_comments (pub_request_id, member_id, body, from_status, to_status)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: ListPubRequestComments :many
+SELECT c.*, m.full_name AS member_name
+FROM pub_request_comments c
+LEFT JOIN members m ON m.id = c.member_id
+WHERE c.pub_request_id = ?
+ORDER BY c.created_at, c.id;
+
+-- Event file queries
+
+-- name: ListFileStatuses :many
+SELECT * FROM file_statuses;
+
+-- name: ListDocumentTemplates :many
+SELECT * FROM document_templates ORDER BY doc_order, id;
+
+-- name: ListRequiredDocumentTemplates :many
+SELECT * FROM document_templates WHERE required = TRUE ORDER BY doc_order, id;
+
+-- name: ListEventFiles :many
+SELECT f.*, u.full_name AS uploaded_by_name, r.full_name AS reviewed_by_name
+FROM event_files f
+LEFT JOIN members u ON u.id = f.uploaded_by
+LEFT JOIN members r ON r.id = f.reviewed_by
+WHERE f.event_id = ?
+ORDER BY f.file_order, f.id;
+
+-- name: GetEventFile :one
+SELECT f.*, u.full_name AS uploaded_by_name, r.full_name AS reviewed_by_name
+FROM event_files f
+LEFT JOIN members u ON u.id = f.uploaded_by
+LEFT JOIN members r ON r.id = f.reviewed_by
+WHERE f.id = ? AND f.event_id = ?;
+
+-- name: CreateEventFile :execlastid
+INSERT INTO event_files (event_id, file_key, file_order, file_status, title, content_type, uploaded_by, uploaded_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: CreateTemplateFile :execrows
+-- returns 0 if the event already has the template's document
+INSERT IGNORE INTO event_files (event_id, template_id, title, file_order, file_status)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: UpdateEventFileUpload :exec
+UPDATE event_files
+SET file_key = ?, content_type = ?, uploaded_by = ?, uploaded_at = ?, file_status = ?
+WHERE id = ?;
+
+-- name: UpdateEventFileOrder :exec
+UPDATE event_files SET file_order = ? WHERE id = ? AND event_id = ?;
+
+-- name: UpdateEventFileStatus :execrows
+-- compare-and-set: returns 0 if the status is no longer the expected one
+UPDATE event_files SET file_status = ? WHERE id = ? AND file_status = ?;
+
+-- name: ReviewEventFile :execrows
+-- compare-and-set, like UpdateEventFileStatus, also recording the reviewer's note
+UPDATE event_files
+SET file_status = ?, reviewer_note = ?, reviewed_by = ?, reviewed_at = ?
+WHERE id = ? AND file_status = ?;
+
+-- name: DeleteEventFile :exec
+DELETE FROM event_files WHERE id = ? AND event_id = ?;
+
+-- Calendar feed queries
+
+-- name: GetCalendarFeedToken :one
+SELECT * FROM calendar_feed_tokens WHERE member_id = ?;
+
+-- name: GetCalendarFeedTokenByHash :one
+SELECT * FROM calendar_feed_tokens WHERE token_hash = ?;
+
+-- name: DeleteCalendarFeedToken :exec
+DELETE FROM calendar_feed_tokens WHERE member_id = ?;
+
+-- name: CreateCalendarFeedToken :exec
+INSERT INTO calendar_feed_tokens (member_id, token_hash) VALUES (?, ?);
+
+-- name: TouchCalendarFeedToken :exec
+UPDATE calendar_feed_tokens SET last_used_at = NOW() WHERE member_id = ?;
+
+-- name: ListCalendarEventDates :many
+SELECT d.id, d.event_id, d.start_time, d.end_time, e.name, e.arn, e.committee_id, e.venue, e.brief_description
+FROM event_dates d
+JOIN events e ON e.id = d.event_id
+WHERE d.end_time >= ?
+ORDER BY d.start_time;
+
+-- name: ListCalendarEventDatesByCommittee :many
+SELECT d.id, d.event_id, d.start_time, d.end_time, e.name, e.arn, e.committee_id, e.venue, e.brief_description
+FROM event_dates d
+JOIN events e ON e.id = d.event_id
+WHERE d.end_time >= ? AND e.committee_id = ?
+ORDER BY d.start_time;
+
+-- name: ListCalendarEventDatesByHead :many
+SELECT d.id, d.event_id, d.start_time, d.end_time, e.name, e.arn, e.committee_id, e.venue, e.brief_description
+FROM event_dates d
+JOIN events e ON e.id = d.event_id
+JOIN event_heads h ON h.event_id = e.id
+WHERE d.end_time >= ? AND h.member_id = ?
+ORDER BY d.start_time;
+
+-- name: ListEventTrackersByHead :many
+SELECT t.*, e.name, e.committee_id
+FROM event_trackers t
+JOIN events e ON e.id = t.event_id
+JOIN event_heads h ON h.event_id = t.event_id
+WHERE h.member_id = ?
+ORDER BY t.event_id DESC;
+
+-- Term queries
+
+-- name: GetTerm :one
+SELECT * FROM terms WHERE id = ?;
+
+-- name: GetActiveTerm :one
+SELECT * FROM terms WHERE is_active = TRUE LIMIT 1;
+
+-- name: GetTermByDate :one
+SELECT * FROM terms WHERE start_date <= ? AND end_date >= ? ORDER BY start_date DESC LIMIT 1;
+
+-- name: GetLatestTerm :one
+SELECT * FROM terms ORDER BY start_year DESC, term DESC LIMIT 1;
+
+-- name: GetTermByNumber :one
+SELECT * FROM terms WHERE term = ? AND start_year = ?;
+
+-- name: CreateTerm :execlastid
+INSERT INTO terms (term, start_year, end_year, start_date, end_date) VALUES (?, ?, ?, ?, ?);
+
+-- name: UpdateTerm :exec
+UPDATE terms SET term = ?, start_year = ?, end_year = ?, start_date = ?, end_date = ? WHERE id = ?;
+
+-- name: DeleteTerm :execrows
+DELETE FROM terms WHERE id = ?;
+
+-- name: ClearActiveTerm :exec
+UPDATE terms SET is_active = FALSE WHERE is_active = TRUE;
+
+-- name: SetActiveTerm :execrows
+UPDATE terms SET is_active = TRUE WHERE id = ?;
+
+-- name: ListMemberAssignments :many
+-- every member with their position and committee, archived as the membership of a term on rollover
+SELECT id, position_id, committee_id FROM members ORDER BY id;
+
+-- name: ListOfficers :many
+-- members with a position above plain member, i.e. the current officer roster
+SELECT m.id, m.full_name, m.email, m.position_id, m.committee_id
+FROM members m
+WHERE m.position_id IS NOT NULL AND m.position_id <> 'MEM'
+ORDER BY m.committee_id, m.position_id, m.full_name;
+
+-- name: CreateTermRosterEntry :execrows
+-- returns 0 if the member is already in the term's archived membership
+INSERT IGNORE INTO term_rosters (term_id, member_id, position_id, committee_id) VALUES (?, ?, ?, ?);
+
+-- name: HasTermRoster :one
+SELECT EXISTS(SELECT 1 FROM term_rosters WHERE term_id = ?);
+
+-- name: ArchiveTermPositionRanks :execrows
+-- keeps the ranks of a term archived (and possibly edited) before
+INSERT IGNORE INTO term_position_ranks (term_id, position_id, position_rank)
+SELECT t.id, p.position_id, p.position_rank FROM terms t CROSS JOIN positions p WHERE t.id = ?;
+
+-- name: ListTermPositionRanks :many
+SELECT position_id, position_rank FROM term_position_ranks WHERE term_id = ? ORDER BY position_rank DESC, position_id;
+
+-- name: SetTermPositionRank :exec
+INSERT INTO term_position_ranks (term_id, position_id, position_rank) VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE position_rank = VALUES(position_rank);
+
+-- name: ListTermRoster :many
+-- the officers among the archived members of a term
+SELECT r.*, m.full_name, m.email
+FROM term_rosters r
+JOIN members m ON m.id = r.member_id
+WHERE r.term_id = ? AND r.position_id IS NOT NULL AND r.position_id <> 'MEM'
+ORDER BY r.committee_id, r.position_id, m.full_name;
+
+-- name: ListTermMembers :many
+-- the archived members of a term, with the position and committee they had then
+SELECT
+    m.id,
+    m.full_name,
+    m.nickname,
+    m.email,
+    m.telegram,
+    r.position_id,
+    r.committee_id,
+    m.college,
+    m.program,
+    m.discord,
+    m.interests,
+    m.contact_number,
+    m.fb_link,
+    m.image_url,
+    h.name as house_name
+FROM term_rosters r
+JOIN members m ON m.id = r.member_id
+LEFT JOIN houses h ON m.house_id = h.id
+WHERE r.term_id = ?
+ORDER BY m.email;
+
+-- name: CheckEmailIfTermMember :one
+SELECT m.email FROM term_rosters r JOIN members m ON m.id = r.member_id WHERE r.term_id = ? AND m.email = ?;
+
+-- name: CheckIdIfTermMember :one
+SELECT member_id FROM term_rosters WHERE term_id = ? AND member_id = ?;
+
+-- House queries
+
+-- name: ListHouses :many
+SELECT h.id, h.name, h.description, COUNT(m.id) AS member_count
+FROM houses h
+LEFT JOIN members m ON m.house_id = h.id
+GROUP BY h.id, h.name, h.description
+ORDER BY h.name;
+
+-- name: GetHouse :one
+SELECT * FROM houses WHERE id = ?;
+
+-- name: GetHouseByName :one
+SELECT * FROM houses WHERE name = ?;
+
+-- name: CreateHouse :execlastid
+INSERT INTO houses (name, description) VALUES (?, ?);
+
+-- name: UpdateHouse :exec
+UPDATE houses SET name = ?, description = ? WHERE id = ?;
+
+-- name: DeleteHouse :execrows
+DELETE FROM houses WHERE id = ?;
+
+-- name: ListHouseMembers :many
+SELECT m.id, m.full_name, m.nickname, m.email, m.position_id, m.committee_id, m.image_url
+FROM members m
+WHERE m.house_id = ?
+ORDER BY m.full_name;
+
+-- name: CreateHousePoints :execlastid
+INSERT INTO house_points (house_id, term_id, points, reason, awarded_by, event_id) VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetHousePoints :one
+SELECT p.*, m.full_name AS awarded_by_name, e.name AS event_name
+FROM house_points p
+LEFT JOIN members m ON m.id = p.awarded_by
+LEFT JOIN events e ON e.id = p.event_id
+WHERE p.id = ?;
+
+-- name: ListHousePoints :many
+SELECT p.*, m.full_name AS awarded_by_name, e.name AS event_name
+FROM house_points p
+LEFT JOIN members m ON m.id = p.awarded_by
+LEFT JOIN events e ON e.id = p.event_id
+WHERE p.house_id = ? AND p.term_id = ?
+ORDER BY p.created_at DESC, p.id DESC;
+
+-- name: ListHousePointsAllTerms :many
+SELECT p.*, m.full_name AS awarded_by_name, e.name AS event_name
+FROM house_points p
+LEFT JOIN members m ON m.id = p.awarded_by
+LEFT JOIN events e ON e.id = p.event_id
+WHERE p.house_id = ?
+ORDER BY p.created_at DESC, p.id DESC;
+
+-- name: GetHouseLeaderboard :many
+-- every house with its points total in a term, houses without points score 0
+SELECT h.id, h.name, CAST(COALESCE(SUM(p.points), 0) AS SIGNED) AS points
+FROM houses h
+LEFT JOIN house_points p ON p.house_id = h.id AND p.term_id = ?
+GROUP BY h.id, h.name
+ORDER BY points DESC, h.name;
+
+-- name: GetHouseLeaderboardAllTerms :many
+SELECT h.id, h.name, CAST(COALESCE(SUM(p.points), 0) AS SIGNED) AS points
+FROM houses h
+LEFT JOIN house_points p ON p.house_id = h.id
+GROUP BY h.id, h.name
+ORDER BY points DESC, h.name;
+
+-- Org chart queries
+
+-- name: ListOrgChartMembers :many
+-- members placed on the org chart of the current term: committee members and officers
+SELECT m.id, m.full_name, m.nickname, m.image_url, m.position_id, m.committee_id
+FROM members m
+WHERE m.committee_id IS NOT NULL OR m.position_id IS NOT NULL
+ORDER BY m.full_name;
+
+-- name: ListOrgChartRoster :many
+-- members placed on the org chart of a past term, from the roster archived at rollover
+SELECT m.id, m.full_name, m.nickname, m.image_url, r.position_id, r.committee_id
+FROM term_rosters r
+JOIN members m ON m.id = r.member_id
+WHERE r.term_id = ?
+ORDER BY m.full_name;
+
+-- Position queries
+
+-- name: ListPositions :many
+SELECT * FROM positions ORDER BY position_rank DESC, position_name;
+
+-- name: GetPosition :one
+SELECT * FROM positions WHERE position_id = ?;
+
+-- name: CreatePosition :exec
+INSERT INTO positions (position_id, position_name, position_rank) VALUES (?, ?, ?);
+
+-- name: UpdatePosition :exec
+UPDATE positions SET position_name = ?, position_rank = ? WHERE position_id = ?;
+
+-- name: DeletePosition :execrows
+DELETE FROM positions WHERE position_id = ?;
+
+-- name: CountMembersWithPosition :one
+SELECT COUNT(*) FROM members WHERE position_id = ?;
+
+-- Webhook queries
+
+-- name: ListWebhookEndpoints :many
+SELECT * FROM webhook_endpoints ORDER BY id;
+
+-- name: ListActiveWebhookEndpoints :many
+SELECT * FROM webhook_endpoints WHERE is_active = TRUE ORDER BY id;
+
+-- name: GetWebhookEndpoint :one
+SELECT * FROM webhook_endpoints WHERE id = ?;
+
+-- name: CreateWebhookEndpoint :execlastid
+INSERT INTO webhook_endpoints (url, description, secret, events) VALUES (?, ?, ?, ?);
+
+-- name: UpdateWebhookEndpoint :exec
+UPDATE webhook_endpoints SET url = ?, description = ?, events = ?, is_active = ? WHERE id = ?;
+
+-- name: DeleteWebhookEndpoint :execrows
+DELETE FROM webhook_endpoints WHERE id = ?;
+
+-- name: CreateWebhookDelivery :execlastid
+INSERT INTO webhook_deliveries (endpoint_id, event_id, event_type, payload, next_attempt_at, replay_of)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries WHERE id = ? AND endpoint_id = ?;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries WHERE endpoint_id = ? ORDER BY id DESC LIMIT ?;
+
+-- name: ListDueWebhookDeliveries :many
+-- pending deliveries to active endpoints that are due and not being sent by another instance
+SELECT d.id, d.endpoint_id, d.event_id, d.event_type, d.payload, d.attempts, e.url, e.secret
+FROM webhook_deliveries d
+JOIN webhook_endpoints e ON e.id = d.endpoint_id
+WHERE d.status = 'PENDING' AND e.is_active = TRUE AND d.next_attempt_at <= ?
+  AND (d.locked_until IS NULL OR d.locked_until < ?)
+ORDER BY d.next_attempt_at
+LIMIT ?;
+
+-- name: ClaimWebhookDelivery :execrows
+-- returns 0 if another instance claimed the delivery first
+UPDATE webhook_deliveries SET locked_until = ?
+WHERE id = ? AND status = 'PENDING' AND (locked_until IS NULL OR locked_until < ?);
+
+-- name: FinishWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET status = ?, attempts = ?, next_attempt_at = ?, last_status_code = ?, last_error = ?, delivered_at = ?, locked_until = NULL
+WHERE id = ?;
+
+-- name: CreateWebhookDeliveryAttempt :exec
+INSERT INTO webhook_delivery_attempts (delivery_id, attempt, status_code, error, duration_ms) VALUES (?, ?, ?, ?, ?);
+
+-- name: ListWebhookDeliveryAttempts :many
+SELECT * FROM webhook_delivery_attempts WHERE delivery_id = ? ORDER BY attempt;
+
+-- Discord queries
+
+-- name: ListDiscordLinkedMembers :many
+-- members with a verified Discord account, for role sync
+SELECT m.id, m.full_name, l.discord_user_id, l.discord_username, m.committee_id, m.position_id, m.house_id
+FROM discord_links l
+JOIN members m ON m.id = l.member_id
+ORDER BY m.id;
+
+-- name: GetDiscordLink :one
+SELECT * FROM discord_links WHERE member_id = ?;
+
+-- name: LinkDiscordAccount :exec
+INSERT INTO discord_links (member_id, discord_user_id, discord_username) VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE discord_user_id = VALUES(discord_user_id), discord_username = VALUES(discord_username), linked_at = CURRENT_TIMESTAMP;
+
+-- name: DeleteDiscordLinkByUser :execrows
+DELETE FROM discord_links WHERE discord_user_id = ?;
+
+-- name: DeleteDiscordLink :execrows
+DELETE FROM discord_links WHERE member_id = ?;
+
+-- Telegram bot queries
+
+-- name: CreateTelegramLinkCode :exec
+-- replaces the member's previous code, if any
+INSERT INTO telegram_link_codes (code_hash, member_id, expires_at) VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE code_hash = VALUES(code_hash), expires_at = VALUES(expires_at), created_at = CURRENT_TIMESTAMP;
+
+-- name: GetTelegramLinkCode :one
+SELECT code_hash, member_id, expires_at, created_at
+FROM telegram_link_codes WHERE code_hash = ? AND expires_at > NOW();
+
+-- name: DeleteTelegramLinkCode :execrows
+DELETE FROM telegram_link_codes WHERE code_hash = ?;
+
+-- name: GetTelegramLink :one
+SELECT * FROM telegram_links WHERE member_id = ?;
+
+-- name: GetTelegramLinkByUser :one
+SELECT * FROM telegram_links WHERE telegram_user_id = ?;
+
+-- name: LinkTelegramAccount :exec
+INSERT INTO telegram_links (member_id, telegram_user_id, telegram_username) VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE telegram_user_id = VALUES(telegram_user_id), telegram_username = VALUES(telegram_username), linked_at = CURRENT_TIMESTAMP;
+
+-- name: DeleteTelegramLinkByUser :execrows
+DELETE FROM telegram_links WHERE telegram_user_id = ?;
+
+-- name: DeleteTelegramLink :execrows
+DELETE FROM telegram_links WHERE member_id = ?;
+
+-- name: SearchMembers :many
+-- members whose name or nickname contains the search term
+SELECT m.id, m.full_name, m.nickname, m.position_id, m.committee_id
+FROM members m
+WHERE m.full_name LIKE ? OR m.nickname LIKE ?
+ORDER BY m.full_name
+LIMIT ?;
+
+-- Event Drive folder queries
+
+-- name: ListEventsDueForDriveFolders :many
+-- events with approved pre-acts whose Drive folders have not been created yet
+SELECT e.id, e.arn, e.name, e.committee_id
+FROM events e
+JOIN event_trackers t ON t.event_id = e.id
+LEFT JOIN event_drive_folders f ON f.event_id = e.id
+WHERE t.preacts_status = 'APPROVED'
+  AND (f.event_id IS NULL OR (f.status = 'PENDING' AND f.next_attempt_at <= ?))
+ORDER BY e.id
+LIMIT ?;
+
+-- name: GetEventDriveFolders :one
+SELECT * FROM event_drive_folders WHERE event_id = ?;
+
+-- name: CreateEventDriveFolders :exec
+INSERT IGNORE INTO event_drive_folders (event_id, next_attempt_at) VALUES (?, ?);
+
+-- name: RequeueEventDriveFolders :exec
+UPDATE event_drive_folders
+SET status = 'PENDING', attempts = 0, next_attempt_at = ?, last_error = NULL
+WHERE event_id = ?;
+
+-- name: SaveEventDriveFolderIDs :exec
+UPDATE event_drive_folders
+SET root_folder_id = ?, docu_folder_id = ?, fin_folder_id = ?, pub_folder_id = ?, folders = ?
+WHERE event_id = ?;
+
+-- name: FinishEventDriveFolders :exec
+UPDATE event_drive_folders
+SET status = ?, attempts = ?, next_attempt_at = ?, last_error = ?, provisioned_at = ?
+WHERE event_id = ?;
+
+-- name: FillTrackerDocuDriveID :exec
+-- only fills in the ID if none was set by hand
+UPDATE event_trackers SET docu_drive_id = ? WHERE event_id = ? AND (docu_drive_id IS NULL OR docu_drive_id = '');
+
+-- name: FillTrackerFinDriveID :exec
+-- only fills in the ID if none was set by hand
+UPDATE event_trackers SET fin_drive_id = ? WHERE event_id = ? AND (fin_drive_id IS NULL OR fin_drive_id = '');
+
+-- name: FillPubRequestDriveIDs :execrows
+UPDATE pub_requests SET pub_drive_id = ? WHERE event_id = ? AND pub_drive_id = '';
+
+-- Email notification queries
+
+-- name: CreateOutboxEmail :execrows
+-- returns 0 if an email with the same dedupe key was already queued
+INSERT IGNORE INTO email_outbox (member_id, recipient, type, subject, text_body, html_body, dedupe_key, next_attempt_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListDueOutboxEmails :many
+-- pending emails that are due and not being sent by another instance
+SELECT id, recipient, type, subject, text_body, html_body, attempts
+FROM email_outbox
+WHERE status = 'PENDING' AND next_attempt_at <= ?
+  AND (locked_until IS NULL OR locked_until < ?)
+ORDER BY next_attempt_at
+LIMIT ?;
+
+-- name: ClaimOutboxEmail :execrows
+-- returns 0 if another instance claimed the email first
+UPDATE email_outbox SET locked_until = ?
+WHERE id = ? AND status = 'PENDING' AND (locked_until IS NULL OR locked_until < ?);
+
+-- name: FinishOutboxEmailAttempt :exec
+UPDATE email_outbox
+SET status = ?, attempts = ?, next_attempt_at = ?, last_error = ?, sent_at = ?, locked_until = NULL
+WHERE id = ?;
+
+-- name: ListNotificationPreferences :many
+SELECT * FROM notification_preferences WHERE member_id = ?;
+
+-- name: GetNotificationPreference :one
+SELECT email_enabled FROM notification_preferences WHERE member_id = ? AND type = ?;
+
+-- name: SetNotificationPreference :exec
+INSERT INTO notification_preferences (member_id, type, email_enabled) VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE email_enabled = VALUES(email_enabled);
+
+-- name: ListAPIKeysExpiringBetween :many
+SELECT k.api_key_id, k.project, k.expires_at, m.id AS member_id
+FROM api_keys k
+JOIN members m ON m.email = k.member_email
+WHERE k.expires_at > ? AND k.expires_at <= ?
+ORDER BY k.expires_at;
+
+-- In-app notification queries
+
+-- name: CreateNotification :execrows
+-- returns 0 if a notification with the same dedupe key was already added
+INSERT IGNORE INTO notifications (member_id, type, title, body, link, dedupe_key)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetNotification :one
+SELECT * FROM notifications WHERE id = ? AND member_id = ?;
+
+-- name: ListNotifications :many
+-- newest first, before the given ID
+SELECT * FROM notifications WHERE member_id = ? AND id < ? ORDER BY id DESC LIMIT ?;
+
+-- name: ListUnreadNotifications :many
+SELECT * FROM notifications WHERE member_id = ? AND id < ? AND read_at IS NULL ORDER BY id DESC LIMIT ?;
+
+-- name: ListNotificationsAfter :many
+-- oldest first, for the live stream
+SELECT * FROM notifications WHERE member_id = ? AND id > ? ORDER BY id LIMIT ?;
+
+-- name: CountUnreadNotifications :one
+SELECT COUNT(*) FROM notifications WHERE member_id = ? AND read_at IS NULL;
+
+-- name: MarkNotificationRead :execrows
+UPDATE notifications SET read_at = ? WHERE id = ? AND member_id = ? AND read_at IS NULL;
+
+-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications SET read_at = ? WHERE member_id = ? AND read_at IS NULL;
+
+-- GraphQL queries (batched by the GraphQL loaders)
+
+-- name: ListMembersPage :many
+SELECT * FROM members WHERE id > ? ORDER BY id LIMIT ?;
+
+-- name: ListMembersByIDs :many
+SELECT * FROM members WHERE id IN (sqlc.slice('ids'));
+
+-- name: ListMembersByCommitteeIDs :many
+SELECT * FROM members WHERE committee_id IN (sqlc.slice('committee_ids')) ORDER BY full_name;
+
+-- name: ListMembersByHouseIDs :many
+SELECT * FROM members WHERE house_id IN (sqlc.slice('house_ids')) ORDER BY full_name;
+
+-- name: ListCommittees :many
+SELECT * FROM committees ORDER BY committee_name;
+
+-- name: ListCommitteesByIDs :many
+SELECT * FROM committees WHERE committee_id IN (sqlc.slice('committee_ids'));
+
+-- name: ListCommitteesByDivisionIDs :many
+SELECT * FROM committees WHERE division_id IN (sqlc.slice('division_ids')) ORDER BY committee_name;
+
+-- name: ListDivisions :many
+SELECT * FROM divisions ORDER BY division_name;
+
+-- name: ListDivisionsByIDs :many
+SELECT * FROM divisions WHERE division_id IN (sqlc.slice('division_ids'));
+
+-- name: ListPositionsByIDs :many
+SELECT * FROM positions WHERE position_id IN (sqlc.slice('position_ids'));
+
+-- name: ListHousesByIDs :many
+SELECT * FROM houses WHERE id IN (sqlc.slice('ids'));
+
+-- name: ListRolesByMemberIDs :many
+SELECT mr.member_id, r.id, r.name, r.description
+FROM member_roles mr
+JOIN roles r ON mr.role_id = r.id
+WHERE mr.member_id IN (sqlc.slice('member_ids'))
+ORDER BY r.id;
+
+-- gRPC membership checks
+
+-- name: ListMemberEmails :many
+SELECT email FROM members WHERE email IN (sqlc.slice('emails'));
+
+-- name: ListMemberIDs :many
+SELECT id FROM members WHERE id IN (sqlc.slice('ids'));
@@ -1,7 +1,8 @@
 -- Table: positions
 CREATE TABLE positions (
     position_id VARCHAR(10) PRIMARY KEY,
-    position_name VARCHAR(100) NOT NULL
+    position_name VARCHAR(100) NOT NULL,
+    position_rank INT NOT NULL DEFAULT 0
 );
 
 -- Table: houses
@@ -21,7 +22,9 @@ CREATE TABLE docu_status (
 CREATE TABLE document_templates (
     id INT AUTO_INCREMENT PRIMARY KEY,
     doc_title VARCHAR(255) NOT NULL,
-    doc_key VARCHAR(255) NOT NULL
+    doc_key VARCHAR(255) NOT NULL,
+    doc_order INT NOT NULL DEFAULT 0,
+    required BOOLEAN NOT NULL DEFAULT TRUE
 );
 
 -- Table: event_durations
@@ -44,8 +47,8 @@ CREATE TABLE event_types (
 
 -- Table: file_statuses
 CREATE TABLE file_statuses (
-    file_key VARCHAR(255) PRIMARY KEY,
-    status ENUM('DRAFTING','DONE','REVISE','APPROVED') DEFAULT 'DRAFTING'
+    id VARCHAR(20) PRIMARY KEY,
+    title VARCHAR(100) NOT NULL
 );
 
 -- Table: fin_process_ref
@@ -77,7 +80,10 @@ CREATE TABLE terms (
     id INT AUTO_INCREMENT PRIMARY KEY,
     term INT NOT NULL,
     start_year INT NOT NULL,
-    end_year INT NOT NULL
+    end_year INT NOT NULL,
+    start_date DATE NULL,
+    end_date DATE NULL,
+    is_active BOOLEAN NOT NULL DEFAULT FALSE
 );
 
 -- Table: members
@@ -144,6 +150,7 @@ CREATE TABLE sessions (
     last_activity TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     user_agent VARCHAR(512),
     ip_address VARCHAR(45),
+    auth_time TIMESTAMP NULL DEFAULT NULL,
     FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE,
     INDEX idx_sessions_member_id (member_id),
     INDEX idx_sessions_expires_at (expires_at)
@@ -199,10 +206,24 @@ CREATE TABLE event_docu_head (
 CREATE TABLE event_files (
     id INT AUTO_INCREMENT PRIMARY KEY,
     event_id INT NOT NULL,
-    file_key VARCHAR(255) NOT NULL,
+    file_key VARCHAR(255),
     file_order INT NOT NULL,
-    file_status INT NOT NULL DEFAULT 1,
-    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE
+    file_status VARCHAR(20) NOT NULL DEFAULT 'DRAFTING',
+    title VARCHAR(255) NOT NULL DEFAULT '',
+    template_id INT,
+    content_type VARCHAR(100),
+    uploaded_by INT,
+    uploaded_at DATETIME,
+    reviewer_note TEXT,
+    reviewed_by INT,
+    reviewed_at DATETIME,
+    INDEX idx_event_files_order (event_id, file_order),
+    UNIQUE KEY uq_event_files_template (event_id, template_id),
+    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    CONSTRAINT fk_event_files_status FOREIGN KEY (file_status) REFERENCES file_statuses(id),
+    CONSTRAINT fk_event_files_template FOREIGN KEY (template_id) REFERENCES document_templates(id) ON DELETE SET NULL,
+    CONSTRAINT fk_event_files_uploaded_by FOREIGN KEY (uploaded_by) REFERENCES members(id) ON DELETE SET NULL,
+    CONSTRAINT fk_event_files_reviewed_by FOREIGN KEY (reviewed_by) REFERENCES members(id) ON DELETE SET NULL
 );
 
 -- Table: event_heads
@@ -221,8 +242,13 @@ CREATE TABLE event_participants (
     student_name VARCHAR(255),
     student_email VARCHAR(255),
     notes VARCHAR(512),
+    registered_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    checked_in_at TIMESTAMP NULL DEFAULT NULL,
+    checked_in_by INT NULL DEFAULT NULL,
+    verified_at TIMESTAMP NULL DEFAULT NULL,
     PRIMARY KEY (event_id, student_id),
-    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE
+    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE,
+    FOREIGN KEY (checked_in_by) REFERENCES members(id) ON DELETE SET NULL
 );
 
 -- Table: event_trackers
@@ -248,10 +274,39 @@ CREATE TABLE fin_processes (
     id INT AUTO_INCREMENT PRIMARY KEY,
     tracker_id INT NOT NULL,
     process VARCHAR(50) NOT NULL,
-    FOREIGN KEY (tracker_id) REFERENCES event_trackers(event_id),
+    UNIQUE KEY uq_fin_processes_tracker_process (tracker_id, process),
+    CONSTRAINT fk_fin_processes_tracker FOREIGN KEY (tracker_id) REFERENCES event_trackers(event_id) ON DELETE CASCADE,
     FOREIGN KEY (process) REFERENCES fin_process_ref(id)
 );
 
+-- Table: tracker_status_history
+CREATE TABLE tracker_status_history (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    event_id INT NOT NULL,
+    stage VARCHAR(20) NOT NULL,
+    from_status VARCHAR(100) NOT NULL,
+    to_status VARCHAR(100) NOT NULL,
+    changed_by INT,
+    note TEXT,
+    changed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_tracker_status_history_event (event_id, changed_at),
+    FOREIGN KEY (event_id) REFERENCES event_trackers(event_id) ON DELETE CASCADE,
+    FOREIGN KEY (changed_by) REFERENCES members(id) ON DELETE SET NULL
+);
+
+-- Table: tracker_reminders
+CREATE TABLE tracker_reminders (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    event_id INT NOT NULL,
+    stage VARCHAR(20) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    days_before INT NOT NULL,
+    deadline DATETIME NOT NULL,
+    sent_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_tracker_reminders (event_id, stage, kind, days_before, deadline),
+    FOREIGN KEY (event_id) REFERENCES event_trackers(event_id) ON DELETE CASCADE
+);
+
 -- Table: pub_requests
 CREATE TABLE pub_requests (
     id INT AUTO_INCREMENT PRIMARY KEY,
@@ -269,8 +324,26 @@ CREATE TABLE pub_requests (
     created_at DATETIME NOT NULL,
     requester_id INT,
     dimensions VARCHAR(255),
+    updated_at TIMESTAMP NULL DEFAULT NULL ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_pub_requests_posting_date (posting_date),
     FOREIGN KEY (event_id) REFERENCES events(id),
-    FOREIGN KEY (requester_id) REFERENCES members(id)
+    FOREIGN KEY (requester_id) REFERENCES members(id),
+    CONSTRAINT fk_pub_requests_status FOREIGN KEY (pub_status) REFERENCES pub_req_status(id),
+    CONSTRAINT fk_pub_requests_pub_head FOREIGN KEY (pub_head) REFERENCES members(id) ON DELETE SET NULL
+);
+
+-- Table: pub_request_comments (review comments and status changes)
+CREATE TABLE pub_request_comments (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    pub_request_id INT NOT NULL,
+    member_id INT,
+    body TEXT,
+    from_status VARCHAR(50),
+    to_status VARCHAR(50),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_pub_request_comments_request (pub_request_id, created_at),
+    FOREIGN KEY (pub_request_id) REFERENCES pub_requests(id) ON DELETE CASCADE,
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE SET NULL
 );
 
 -- Table: roles (system-level roles separate from position hierarchy)
@@ -291,3 +364,224 @@ CREATE TABLE member_roles (
     FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE,
     FOREIGN KEY (granted_by) REFERENCES members(id) ON DELETE SET NULL
 );
+
+-- Table: oauth_clients (service clients for the OAuth2 client credentials flow)
+CREATE TABLE oauth_clients (
+    client_id VARCHAR(64) PRIMARY KEY,
+    client_secret_hash VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    scopes TEXT NOT NULL,
+    created_by INT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    redirect_uris TEXT DEFAULT NULL,
+    FOREIGN KEY (created_by) REFERENCES members(id) ON DELETE SET NULL
+);
+
+-- Table: oidc_authorization_codes (single-use codes for the OIDC authorization code flow)
+CREATE TABLE oidc_authorization_codes (
+    code_hash VARCHAR(64) PRIMARY KEY,
+    client_id VARCHAR(64) NOT NULL,
+    member_id INT NOT NULL,
+    redirect_uri TEXT NOT NULL,
+    scope VARCHAR(255) NOT NULL,
+    nonce VARCHAR(255),
+    code_challenge VARCHAR(128) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (client_id) REFERENCES oauth_clients(client_id) ON DELETE CASCADE,
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE,
+    INDEX idx_oidc_authorization_codes_expires_at (expires_at)
+);
+
+-- Table: scheduled_jobs (run state of background jobs)
+CREATE TABLE scheduled_jobs (
+    name VARCHAR(100) PRIMARY KEY,
+    last_run_at TIMESTAMP NULL DEFAULT NULL,
+    last_status VARCHAR(20),
+    last_error TEXT,
+    locked_until TIMESTAMP NULL DEFAULT NULL
+);
+
+-- Table: calendar_feed_tokens (per-member tokens of iCalendar feed URLs, stored hashed)
+CREATE TABLE calendar_feed_tokens (
+    member_id INT PRIMARY KEY,
+    token_hash CHAR(64) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    last_used_at TIMESTAMP NULL DEFAULT NULL,
+    UNIQUE KEY uq_calendar_feed_tokens_hash (token_hash),
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE
+);
+
+-- Table: term_rosters (membership and officer roster of past terms, archived on term rollover)
+CREATE TABLE term_rosters (
+    term_id INT NOT NULL,
+    member_id INT NOT NULL,
+    position_id VARCHAR(10),
+    committee_id VARCHAR(10),
+    archived_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (term_id, member_id),
+    FOREIGN KEY (term_id) REFERENCES terms(id) ON DELETE CASCADE,
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE
+);
+
+-- Table: term_position_ranks (position hierarchy of past terms, archived on term rollover)
+CREATE TABLE term_position_ranks (
+    term_id INT NOT NULL,
+    position_id VARCHAR(10) NOT NULL,
+    position_rank INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (term_id, position_id),
+    FOREIGN KEY (term_id) REFERENCES terms(id) ON DELETE CASCADE
+);
+
+-- Table: house_points (house points ledger, corrections are new entries)
+CREATE TABLE house_points (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    house_id INT NOT NULL,
+    term_id INT NOT NULL,
+    points INT NOT NULL,
+    reason VARCHAR(255) NOT NULL,
+    awarded_by INT,
+    event_id INT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_house_points_term (term_id, house_id),
+    FOREIGN KEY (house_id) REFERENCES houses(id),
+    FOREIGN KEY (term_id) REFERENCES terms(id),
+    FOREIGN KEY (awarded_by) REFERENCES members(id) ON DELETE SET NULL,
+    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE SET NULL
+);
+
+-- Table: webhook_endpoints (outbound webhook endpoints and their subscribed events)
+CREATE TABLE webhook_endpoints (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    url VARCHAR(2048) NOT NULL,
+    description VARCHAR(255),
+    secret VARCHAR(128) NOT NULL,
+    events TEXT NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Table: webhook_deliveries (webhook delivery queue)
+CREATE TABLE webhook_deliveries (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    endpoint_id INT NOT NULL,
+    event_id VARCHAR(64) NOT NULL,
+    event_type VARCHAR(64) NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'PENDING',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP NULL,
+    last_status_code INT,
+    last_error TEXT,
+    delivered_at TIMESTAMP NULL,
+    replay_of INT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_webhook_deliveries_due (status, next_attempt_at),
+    FOREIGN KEY (endpoint_id) REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+    FOREIGN KEY (replay_of) REFERENCES webhook_deliveries(id) ON DELETE SET NULL
+);
+
+-- Table: webhook_delivery_attempts (webhook delivery log)
+CREATE TABLE webhook_delivery_attempts (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    delivery_id INT NOT NULL,
+    attempt INT NOT NULL,
+    status_code INT,
+    error TEXT,
+    duration_ms INT NOT NULL,
+    attempted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (delivery_id) REFERENCES webhook_deliveries(id) ON DELETE CASCADE
+);
+
+-- Table: telegram_links (Telegram accounts linked to members, for the bot)
+CREATE TABLE telegram_links (
+    member_id INT PRIMARY KEY,
+    telegram_user_id BIGINT NOT NULL UNIQUE,
+    telegram_username VARCHAR(100),
+    linked_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE
+);
+
+-- Table: telegram_link_codes (one-time codes for linking a Telegram account)
+CREATE TABLE telegram_link_codes (
+    code_hash VARCHAR(64) PRIMARY KEY,
+    member_id INT NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE
+);
+
+-- Table: discord_links (Discord accounts linked to members with Discord login, for role sync)
+CREATE TABLE discord_links (
+    member_id INT PRIMARY KEY,
+    discord_user_id VARCHAR(32) NOT NULL UNIQUE,
+    discord_username VARCHAR(100),
+    linked_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE
+);
+
+-- Table: event_drive_folders (Google Drive folders created for approved events)
+CREATE TABLE event_drive_folders (
+    event_id INT PRIMARY KEY,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    root_folder_id VARCHAR(255),
+    docu_folder_id VARCHAR(255),
+    fin_folder_id VARCHAR(255),
+    pub_folder_id VARCHAR(255),
+    folders TEXT,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NULL,
+    last_error TEXT,
+    provisioned_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_event_drive_folders_due (status, next_attempt_at),
+    FOREIGN KEY (event_id) REFERENCES events(id) ON DELETE CASCADE
+);
+
+-- Table: email_outbox (queued notification emails)
+CREATE TABLE email_outbox (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    member_id INT,
+    recipient VARCHAR(255) NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    text_body TEXT NOT NULL,
+    html_body TEXT NOT NULL,
+    dedupe_key VARCHAR(191) UNIQUE,
+    status VARCHAR(16) NOT NULL DEFAULT 'PENDING',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP NULL,
+    last_error TEXT,
+    sent_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_email_outbox_due (status, next_attempt_at),
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE SET NULL
+);
+
+-- Table: notification_preferences (per-member notification opt-outs)
+CREATE TABLE notification_preferences (
+    member_id INT NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    email_enabled BOOLEAN NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    PRIMARY KEY (member_id, type),
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE
+);
+
+-- Table: notifications (in-app notification inbox)
+CREATE TABLE notifications (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    member_id INT NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    link VARCHAR(512),
+    dedupe_key VARCHAR(191) UNIQUE,
+    read_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_notifications_member (member_id, id),
+    INDEX idx_notifications_unread (member_id, read_at),
+    FOREIGN KEY (member_id) REFERENCES members(id) ON DELETE CASCADE
+);
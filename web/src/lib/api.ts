@@ -1,13 +1,35 @@
 const API_BASE = process.env.NEXT_PUBLIC_API_URL || 'http://localhost:8080';
 
+const SAFE_METHODS = ['GET', 'HEAD', 'OPTIONS'];
+
+// CSRF token bound to the current session, fetched lazily before the first mutating request
+let csrfToken: string | null = null;
+
+async function getCSRFToken(): Promise<string | null> {
+  if (csrfToken) return csrfToken;
+  const res = await fetch(`${API_BASE}/auth/csrf`, { credentials: 'include' });
+  if (!res.ok) return null;
+  const data: { csrf_token: string } = await res.json();
+  csrfToken = data.csrf_token;
+  return csrfToken;
+}
+
 export async function fetchAPI<T>(endpoint: string, options?: RequestInit): Promise<T> {
+  const method = (options?.method || 'GET').toUpperCase();
+  const csrfHeaders: Record<string, string> = {};
+  if (!SAFE_METHODS.includes(method) && endpoint !== '/auth/logout') {
+    const token = await getCSRFToken();
+    if (token) csrfHeaders['X-CSRF-Token'] = token;
+  }
+
   const res = await fetch(`${API_BASE}${endpoint}`, {
+    ...options,
     credentials: 'include',
     headers: {
       'Content-Type': 'application/json',
+      ...csrfHeaders,
       ...options?.headers
-    },
-    ...options
+    }
   });
 
   if (!res.ok) {
@@ -26,6 +48,7 @@ export const api = {
 
   logout: async () => {
     await fetchAPI('/auth/logout', { method: 'POST' });
+    csrfToken = null;
   },
 
   getMe: () => fetchAPI<Member>('/auth/me'),